@@ -11,6 +11,7 @@ import (
 	fmt "fmt"
 	tetragon "github.com/cilium/tetragon/api/v1/tetragon"
 	bytesmatcher "github.com/cilium/tetragon/pkg/matchers/bytesmatcher"
+	durationmatcher "github.com/cilium/tetragon/pkg/matchers/durationmatcher"
 	listmatcher "github.com/cilium/tetragon/pkg/matchers/listmatcher"
 	stringmatcher "github.com/cilium/tetragon/pkg/matchers/stringmatcher"
 	timestampmatcher "github.com/cilium/tetragon/pkg/matchers/timestampmatcher"
@@ -282,6 +283,12 @@ func CheckerFromEvent(event Event) (EventChecker, error) {
 		return NewTestChecker("").FromTest(ev), nil
 	case *tetragon.ProcessLoader:
 		return NewProcessLoaderChecker("").FromProcessLoader(ev), nil
+	case *tetragon.ProcessNetworkFlow:
+		return NewProcessNetworkFlowChecker("").FromProcessNetworkFlow(ev), nil
+	case *tetragon.ProcessDns:
+		return NewProcessDnsChecker("").FromProcessDns(ev), nil
+	case *tetragon.ProcessTls:
+		return NewProcessTlsChecker("").FromProcessTls(ev), nil
 	case *tetragon.RateLimitInfo:
 		return NewRateLimitInfoChecker("").FromRateLimitInfo(ev), nil
 	case *tetragon.ProcessThrottle:
@@ -348,6 +355,12 @@ func EventFromResponse(response *tetragon.GetEventsResponse) (Event, error) {
 		return ev.Test, nil
 	case *tetragon.GetEventsResponse_ProcessLoader:
 		return ev.ProcessLoader, nil
+	case *tetragon.GetEventsResponse_ProcessNetworkFlow:
+		return ev.ProcessNetworkFlow, nil
+	case *tetragon.GetEventsResponse_ProcessDns:
+		return ev.ProcessDns, nil
+	case *tetragon.GetEventsResponse_ProcessTls:
+		return ev.ProcessTls, nil
 	case *tetragon.GetEventsResponse_RateLimitInfo:
 		return ev.RateLimitInfo, nil
 	case *tetragon.GetEventsResponse_ProcessThrottle:
@@ -360,10 +373,11 @@ func EventFromResponse(response *tetragon.GetEventsResponse) (Event, error) {
 
 // ProcessExecChecker implements a checker struct to check a ProcessExec event
 type ProcessExecChecker struct {
-	CheckerName string              `json:"checkerName"`
-	Process     *ProcessChecker     `json:"process,omitempty"`
-	Parent      *ProcessChecker     `json:"parent,omitempty"`
-	Ancestors   *ProcessListMatcher `json:"ancestors,omitempty"`
+	CheckerName   string                       `json:"checkerName"`
+	Process       *ProcessChecker              `json:"process,omitempty"`
+	Parent        *ProcessChecker              `json:"parent,omitempty"`
+	Ancestors     *ProcessListMatcher          `json:"ancestors,omitempty"`
+	FullArguments *stringmatcher.StringMatcher `json:"fullArguments,omitempty"`
 }
 
 // CheckEvent checks a single event and implements the EventChecker interface
@@ -420,6 +434,11 @@ func (checker *ProcessExecChecker) Check(event *tetragon.ProcessExec) error {
 				return fmt.Errorf("Ancestors check failed: %w", err)
 			}
 		}
+		if checker.FullArguments != nil {
+			if err := checker.FullArguments.Match(event.FullArguments); err != nil {
+				return fmt.Errorf("FullArguments check failed: %w", err)
+			}
+		}
 		return nil
 	}
 	if err := fieldChecks(); err != nil {
@@ -446,6 +465,12 @@ func (checker *ProcessExecChecker) WithAncestors(check *ProcessListMatcher) *Pro
 	return checker
 }
 
+// WithFullArguments adds a FullArguments check to the ProcessExecChecker
+func (checker *ProcessExecChecker) WithFullArguments(check *stringmatcher.StringMatcher) *ProcessExecChecker {
+	checker.FullArguments = check
+	return checker
+}
+
 //FromProcessExec populates the ProcessExecChecker using data from a ProcessExec event
 func (checker *ProcessExecChecker) FromProcessExec(event *tetragon.ProcessExec) *ProcessExecChecker {
 	if event == nil {
@@ -470,6 +495,7 @@ func (checker *ProcessExecChecker) FromProcessExec(event *tetragon.ProcessExec)
 			WithValues(checks...)
 		checker.Ancestors = lm
 	}
+	checker.FullArguments = stringmatcher.Full(event.FullArguments)
 	return checker
 }
 
@@ -2615,22 +2641,33 @@ func (checker *ProcessLoaderChecker) FromProcessLoader(event *tetragon.ProcessLo
 	return checker
 }
 
-// RateLimitInfoChecker implements a checker struct to check a RateLimitInfo event
-type RateLimitInfoChecker struct {
-	CheckerName                  string  `json:"checkerName"`
-	NumberOfDroppedProcessEvents *uint64 `json:"numberOfDroppedProcessEvents,omitempty"`
+// ProcessNetworkFlowChecker implements a checker struct to check a ProcessNetworkFlow event
+type ProcessNetworkFlowChecker struct {
+	CheckerName     string                             `json:"checkerName"`
+	Process         *ProcessChecker                    `json:"process,omitempty"`
+	Parent          *ProcessChecker                    `json:"parent,omitempty"`
+	Ancestors       *ProcessListMatcher                `json:"ancestors,omitempty"`
+	Sock            *KprobeSockChecker                 `json:"sock,omitempty"`
+	Direction       *FlowDirectionChecker              `json:"direction,omitempty"`
+	BytesSent       *uint64                            `json:"bytesSent,omitempty"`
+	BytesReceived   *uint64                            `json:"bytesReceived,omitempty"`
+	PacketsSent     *uint64                            `json:"packetsSent,omitempty"`
+	PacketsReceived *uint64                            `json:"packetsReceived,omitempty"`
+	StartTime       *timestampmatcher.TimestampMatcher `json:"startTime,omitempty"`
+	Duration        *durationmatcher.DurationMatcher   `json:"duration,omitempty"`
+	End             *bool                              `json:"end,omitempty"`
 }
 
 // CheckEvent checks a single event and implements the EventChecker interface
-func (checker *RateLimitInfoChecker) CheckEvent(event Event) error {
-	if ev, ok := event.(*tetragon.RateLimitInfo); ok {
+func (checker *ProcessNetworkFlowChecker) CheckEvent(event Event) error {
+	if ev, ok := event.(*tetragon.ProcessNetworkFlow); ok {
 		return checker.Check(ev)
 	}
-	return fmt.Errorf("%s: %T is not a RateLimitInfo event", CheckerLogPrefix(checker), event)
+	return fmt.Errorf("%s: %T is not a ProcessNetworkFlow event", CheckerLogPrefix(checker), event)
 }
 
 // CheckResponse checks a single gRPC response and implements the EventChecker interface
-func (checker *RateLimitInfoChecker) CheckResponse(response *tetragon.GetEventsResponse) error {
+func (checker *ProcessNetworkFlowChecker) CheckResponse(response *tetragon.GetEventsResponse) error {
 	event, err := EventFromResponse(response)
 	if err != nil {
 		return err
@@ -2638,31 +2675,86 @@ func (checker *RateLimitInfoChecker) CheckResponse(response *tetragon.GetEventsR
 	return checker.CheckEvent(event)
 }
 
-// NewRateLimitInfoChecker creates a new RateLimitInfoChecker
-func NewRateLimitInfoChecker(name string) *RateLimitInfoChecker {
-	return &RateLimitInfoChecker{CheckerName: name}
+// NewProcessNetworkFlowChecker creates a new ProcessNetworkFlowChecker
+func NewProcessNetworkFlowChecker(name string) *ProcessNetworkFlowChecker {
+	return &ProcessNetworkFlowChecker{CheckerName: name}
 }
 
 // Get the name associated with the checker
-func (checker *RateLimitInfoChecker) GetCheckerName() string {
+func (checker *ProcessNetworkFlowChecker) GetCheckerName() string {
 	return checker.CheckerName
 }
 
 // Get the type of the checker as a string
-func (checker *RateLimitInfoChecker) GetCheckerType() string {
-	return "RateLimitInfoChecker"
+func (checker *ProcessNetworkFlowChecker) GetCheckerType() string {
+	return "ProcessNetworkFlowChecker"
 }
 
-// Check checks a RateLimitInfo event
-func (checker *RateLimitInfoChecker) Check(event *tetragon.RateLimitInfo) error {
+// Check checks a ProcessNetworkFlow event
+func (checker *ProcessNetworkFlowChecker) Check(event *tetragon.ProcessNetworkFlow) error {
 	if event == nil {
-		return fmt.Errorf("%s: RateLimitInfo event is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: ProcessNetworkFlow event is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.NumberOfDroppedProcessEvents != nil {
-			if *checker.NumberOfDroppedProcessEvents != event.NumberOfDroppedProcessEvents {
-				return fmt.Errorf("NumberOfDroppedProcessEvents has value %d which does not match expected value %d", event.NumberOfDroppedProcessEvents, *checker.NumberOfDroppedProcessEvents)
+		if checker.Process != nil {
+			if err := checker.Process.Check(event.Process); err != nil {
+				return fmt.Errorf("Process check failed: %w", err)
+			}
+		}
+		if checker.Parent != nil {
+			if err := checker.Parent.Check(event.Parent); err != nil {
+				return fmt.Errorf("Parent check failed: %w", err)
+			}
+		}
+		if checker.Ancestors != nil {
+			if err := checker.Ancestors.Check(event.Ancestors); err != nil {
+				return fmt.Errorf("Ancestors check failed: %w", err)
+			}
+		}
+		if checker.Sock != nil {
+			if err := checker.Sock.Check(event.Sock); err != nil {
+				return fmt.Errorf("Sock check failed: %w", err)
+			}
+		}
+		if checker.Direction != nil {
+			if err := checker.Direction.Check(&event.Direction); err != nil {
+				return fmt.Errorf("Direction check failed: %w", err)
+			}
+		}
+		if checker.BytesSent != nil {
+			if *checker.BytesSent != event.BytesSent {
+				return fmt.Errorf("BytesSent has value %d which does not match expected value %d", event.BytesSent, *checker.BytesSent)
+			}
+		}
+		if checker.BytesReceived != nil {
+			if *checker.BytesReceived != event.BytesReceived {
+				return fmt.Errorf("BytesReceived has value %d which does not match expected value %d", event.BytesReceived, *checker.BytesReceived)
+			}
+		}
+		if checker.PacketsSent != nil {
+			if *checker.PacketsSent != event.PacketsSent {
+				return fmt.Errorf("PacketsSent has value %d which does not match expected value %d", event.PacketsSent, *checker.PacketsSent)
+			}
+		}
+		if checker.PacketsReceived != nil {
+			if *checker.PacketsReceived != event.PacketsReceived {
+				return fmt.Errorf("PacketsReceived has value %d which does not match expected value %d", event.PacketsReceived, *checker.PacketsReceived)
+			}
+		}
+		if checker.StartTime != nil {
+			if err := checker.StartTime.Match(event.StartTime); err != nil {
+				return fmt.Errorf("StartTime check failed: %w", err)
+			}
+		}
+		if checker.Duration != nil {
+			if err := checker.Duration.Match(event.Duration); err != nil {
+				return fmt.Errorf("Duration check failed: %w", err)
+			}
+		}
+		if checker.End != nil {
+			if *checker.End != event.End {
+				return fmt.Errorf("End has value %t which does not match expected value %t", event.End, *checker.End)
 			}
 		}
 		return nil
@@ -2673,41 +2765,160 @@ func (checker *RateLimitInfoChecker) Check(event *tetragon.RateLimitInfo) error
 	return nil
 }
 
-// WithNumberOfDroppedProcessEvents adds a NumberOfDroppedProcessEvents check to the RateLimitInfoChecker
-func (checker *RateLimitInfoChecker) WithNumberOfDroppedProcessEvents(check uint64) *RateLimitInfoChecker {
-	checker.NumberOfDroppedProcessEvents = &check
+// WithProcess adds a Process check to the ProcessNetworkFlowChecker
+func (checker *ProcessNetworkFlowChecker) WithProcess(check *ProcessChecker) *ProcessNetworkFlowChecker {
+	checker.Process = check
 	return checker
 }
 
-//FromRateLimitInfo populates the RateLimitInfoChecker using data from a RateLimitInfo event
-func (checker *RateLimitInfoChecker) FromRateLimitInfo(event *tetragon.RateLimitInfo) *RateLimitInfoChecker {
+// WithParent adds a Parent check to the ProcessNetworkFlowChecker
+func (checker *ProcessNetworkFlowChecker) WithParent(check *ProcessChecker) *ProcessNetworkFlowChecker {
+	checker.Parent = check
+	return checker
+}
+
+// WithAncestors adds a Ancestors check to the ProcessNetworkFlowChecker
+func (checker *ProcessNetworkFlowChecker) WithAncestors(check *ProcessListMatcher) *ProcessNetworkFlowChecker {
+	checker.Ancestors = check
+	return checker
+}
+
+// WithSock adds a Sock check to the ProcessNetworkFlowChecker
+func (checker *ProcessNetworkFlowChecker) WithSock(check *KprobeSockChecker) *ProcessNetworkFlowChecker {
+	checker.Sock = check
+	return checker
+}
+
+// WithDirection adds a Direction check to the ProcessNetworkFlowChecker
+func (checker *ProcessNetworkFlowChecker) WithDirection(check tetragon.FlowDirection) *ProcessNetworkFlowChecker {
+	wrappedCheck := FlowDirectionChecker(check)
+	checker.Direction = &wrappedCheck
+	return checker
+}
+
+// WithBytesSent adds a BytesSent check to the ProcessNetworkFlowChecker
+func (checker *ProcessNetworkFlowChecker) WithBytesSent(check uint64) *ProcessNetworkFlowChecker {
+	checker.BytesSent = &check
+	return checker
+}
+
+// WithBytesReceived adds a BytesReceived check to the ProcessNetworkFlowChecker
+func (checker *ProcessNetworkFlowChecker) WithBytesReceived(check uint64) *ProcessNetworkFlowChecker {
+	checker.BytesReceived = &check
+	return checker
+}
+
+// WithPacketsSent adds a PacketsSent check to the ProcessNetworkFlowChecker
+func (checker *ProcessNetworkFlowChecker) WithPacketsSent(check uint64) *ProcessNetworkFlowChecker {
+	checker.PacketsSent = &check
+	return checker
+}
+
+// WithPacketsReceived adds a PacketsReceived check to the ProcessNetworkFlowChecker
+func (checker *ProcessNetworkFlowChecker) WithPacketsReceived(check uint64) *ProcessNetworkFlowChecker {
+	checker.PacketsReceived = &check
+	return checker
+}
+
+// WithStartTime adds a StartTime check to the ProcessNetworkFlowChecker
+func (checker *ProcessNetworkFlowChecker) WithStartTime(check *timestampmatcher.TimestampMatcher) *ProcessNetworkFlowChecker {
+	checker.StartTime = check
+	return checker
+}
+
+// WithDuration adds a Duration check to the ProcessNetworkFlowChecker
+func (checker *ProcessNetworkFlowChecker) WithDuration(check *durationmatcher.DurationMatcher) *ProcessNetworkFlowChecker {
+	checker.Duration = check
+	return checker
+}
+
+// WithEnd adds a End check to the ProcessNetworkFlowChecker
+func (checker *ProcessNetworkFlowChecker) WithEnd(check bool) *ProcessNetworkFlowChecker {
+	checker.End = &check
+	return checker
+}
+
+//FromProcessNetworkFlow populates the ProcessNetworkFlowChecker using data from a ProcessNetworkFlow event
+func (checker *ProcessNetworkFlowChecker) FromProcessNetworkFlow(event *tetragon.ProcessNetworkFlow) *ProcessNetworkFlowChecker {
 	if event == nil {
 		return checker
 	}
+	if event.Process != nil {
+		checker.Process = NewProcessChecker().FromProcess(event.Process)
+	}
+	if event.Parent != nil {
+		checker.Parent = NewProcessChecker().FromProcess(event.Parent)
+	}
 	{
-		val := event.NumberOfDroppedProcessEvents
-		checker.NumberOfDroppedProcessEvents = &val
+		var checks []*ProcessChecker
+		for _, check := range event.Ancestors {
+			var convertedCheck *ProcessChecker
+			if check != nil {
+				convertedCheck = NewProcessChecker().FromProcess(check)
+			}
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewProcessListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Ancestors = lm
+	}
+	if event.Sock != nil {
+		checker.Sock = NewKprobeSockChecker().FromKprobeSock(event.Sock)
+	}
+	checker.Direction = NewFlowDirectionChecker(event.Direction)
+	{
+		val := event.BytesSent
+		checker.BytesSent = &val
+	}
+	{
+		val := event.BytesReceived
+		checker.BytesReceived = &val
+	}
+	{
+		val := event.PacketsSent
+		checker.PacketsSent = &val
+	}
+	{
+		val := event.PacketsReceived
+		checker.PacketsReceived = &val
+	}
+	// NB: We don't want to match timestamps for now
+	checker.StartTime = nil
+	// NB: We don't want to match durations for now
+	checker.Duration = nil
+	{
+		val := event.End
+		checker.End = &val
 	}
 	return checker
 }
 
-// ProcessThrottleChecker implements a checker struct to check a ProcessThrottle event
-type ProcessThrottleChecker struct {
+// ProcessDnsChecker implements a checker struct to check a ProcessDns event
+type ProcessDnsChecker struct {
 	CheckerName string                       `json:"checkerName"`
-	Type        *ThrottleTypeChecker         `json:"type,omitempty"`
-	Cgroup      *stringmatcher.StringMatcher `json:"cgroup,omitempty"`
+	Process     *ProcessChecker              `json:"process,omitempty"`
+	Parent      *ProcessChecker              `json:"parent,omitempty"`
+	Ancestors   *ProcessListMatcher          `json:"ancestors,omitempty"`
+	Sock        *KprobeSockChecker           `json:"sock,omitempty"`
+	Response    *bool                        `json:"response,omitempty"`
+	Id          *uint32                      `json:"id,omitempty"`
+	Qname       *stringmatcher.StringMatcher `json:"qname,omitempty"`
+	Qtype       *stringmatcher.StringMatcher `json:"qtype,omitempty"`
+	Rcode       *stringmatcher.StringMatcher `json:"rcode,omitempty"`
+	Answers     *DnsAnswerListMatcher        `json:"answers,omitempty"`
+	Truncated   *bool                        `json:"truncated,omitempty"`
 }
 
 // CheckEvent checks a single event and implements the EventChecker interface
-func (checker *ProcessThrottleChecker) CheckEvent(event Event) error {
-	if ev, ok := event.(*tetragon.ProcessThrottle); ok {
+func (checker *ProcessDnsChecker) CheckEvent(event Event) error {
+	if ev, ok := event.(*tetragon.ProcessDns); ok {
 		return checker.Check(ev)
 	}
-	return fmt.Errorf("%s: %T is not a ProcessThrottle event", CheckerLogPrefix(checker), event)
+	return fmt.Errorf("%s: %T is not a ProcessDns event", CheckerLogPrefix(checker), event)
 }
 
 // CheckResponse checks a single gRPC response and implements the EventChecker interface
-func (checker *ProcessThrottleChecker) CheckResponse(response *tetragon.GetEventsResponse) error {
+func (checker *ProcessDnsChecker) CheckResponse(response *tetragon.GetEventsResponse) error {
 	event, err := EventFromResponse(response)
 	if err != nil {
 		return err
@@ -2715,36 +2926,81 @@ func (checker *ProcessThrottleChecker) CheckResponse(response *tetragon.GetEvent
 	return checker.CheckEvent(event)
 }
 
-// NewProcessThrottleChecker creates a new ProcessThrottleChecker
-func NewProcessThrottleChecker(name string) *ProcessThrottleChecker {
-	return &ProcessThrottleChecker{CheckerName: name}
+// NewProcessDnsChecker creates a new ProcessDnsChecker
+func NewProcessDnsChecker(name string) *ProcessDnsChecker {
+	return &ProcessDnsChecker{CheckerName: name}
 }
 
 // Get the name associated with the checker
-func (checker *ProcessThrottleChecker) GetCheckerName() string {
+func (checker *ProcessDnsChecker) GetCheckerName() string {
 	return checker.CheckerName
 }
 
 // Get the type of the checker as a string
-func (checker *ProcessThrottleChecker) GetCheckerType() string {
-	return "ProcessThrottleChecker"
+func (checker *ProcessDnsChecker) GetCheckerType() string {
+	return "ProcessDnsChecker"
 }
 
-// Check checks a ProcessThrottle event
-func (checker *ProcessThrottleChecker) Check(event *tetragon.ProcessThrottle) error {
+// Check checks a ProcessDns event
+func (checker *ProcessDnsChecker) Check(event *tetragon.ProcessDns) error {
 	if event == nil {
-		return fmt.Errorf("%s: ProcessThrottle event is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: ProcessDns event is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Type != nil {
-			if err := checker.Type.Check(&event.Type); err != nil {
-				return fmt.Errorf("Type check failed: %w", err)
+		if checker.Process != nil {
+			if err := checker.Process.Check(event.Process); err != nil {
+				return fmt.Errorf("Process check failed: %w", err)
 			}
 		}
-		if checker.Cgroup != nil {
-			if err := checker.Cgroup.Match(event.Cgroup); err != nil {
-				return fmt.Errorf("Cgroup check failed: %w", err)
+		if checker.Parent != nil {
+			if err := checker.Parent.Check(event.Parent); err != nil {
+				return fmt.Errorf("Parent check failed: %w", err)
+			}
+		}
+		if checker.Ancestors != nil {
+			if err := checker.Ancestors.Check(event.Ancestors); err != nil {
+				return fmt.Errorf("Ancestors check failed: %w", err)
+			}
+		}
+		if checker.Sock != nil {
+			if err := checker.Sock.Check(event.Sock); err != nil {
+				return fmt.Errorf("Sock check failed: %w", err)
+			}
+		}
+		if checker.Response != nil {
+			if *checker.Response != event.Response {
+				return fmt.Errorf("Response has value %t which does not match expected value %t", event.Response, *checker.Response)
+			}
+		}
+		if checker.Id != nil {
+			if *checker.Id != event.Id {
+				return fmt.Errorf("Id has value %d which does not match expected value %d", event.Id, *checker.Id)
+			}
+		}
+		if checker.Qname != nil {
+			if err := checker.Qname.Match(event.Qname); err != nil {
+				return fmt.Errorf("Qname check failed: %w", err)
+			}
+		}
+		if checker.Qtype != nil {
+			if err := checker.Qtype.Match(event.Qtype); err != nil {
+				return fmt.Errorf("Qtype check failed: %w", err)
+			}
+		}
+		if checker.Rcode != nil {
+			if err := checker.Rcode.Match(event.Rcode); err != nil {
+				return fmt.Errorf("Rcode check failed: %w", err)
+			}
+		}
+		if checker.Answers != nil {
+			if err := checker.Answers.Check(event.Answers); err != nil {
+				return fmt.Errorf("Answers check failed: %w", err)
+			}
+		}
+		if checker.Truncated != nil {
+			if *checker.Truncated != event.Truncated {
+				return fmt.Errorf("Truncated has value %t which does not match expected value %t", event.Truncated, *checker.Truncated)
 			}
 		}
 		return nil
@@ -2755,209 +3011,326 @@ func (checker *ProcessThrottleChecker) Check(event *tetragon.ProcessThrottle) er
 	return nil
 }
 
-// WithType adds a Type check to the ProcessThrottleChecker
-func (checker *ProcessThrottleChecker) WithType(check tetragon.ThrottleType) *ProcessThrottleChecker {
-	wrappedCheck := ThrottleTypeChecker(check)
-	checker.Type = &wrappedCheck
+// WithProcess adds a Process check to the ProcessDnsChecker
+func (checker *ProcessDnsChecker) WithProcess(check *ProcessChecker) *ProcessDnsChecker {
+	checker.Process = check
 	return checker
 }
 
-// WithCgroup adds a Cgroup check to the ProcessThrottleChecker
-func (checker *ProcessThrottleChecker) WithCgroup(check *stringmatcher.StringMatcher) *ProcessThrottleChecker {
-	checker.Cgroup = check
+// WithParent adds a Parent check to the ProcessDnsChecker
+func (checker *ProcessDnsChecker) WithParent(check *ProcessChecker) *ProcessDnsChecker {
+	checker.Parent = check
 	return checker
 }
 
-//FromProcessThrottle populates the ProcessThrottleChecker using data from a ProcessThrottle event
-func (checker *ProcessThrottleChecker) FromProcessThrottle(event *tetragon.ProcessThrottle) *ProcessThrottleChecker {
-	if event == nil {
-		return checker
-	}
-	checker.Type = NewThrottleTypeChecker(event.Type)
-	checker.Cgroup = stringmatcher.Full(event.Cgroup)
+// WithAncestors adds a Ancestors check to the ProcessDnsChecker
+func (checker *ProcessDnsChecker) WithAncestors(check *ProcessListMatcher) *ProcessDnsChecker {
+	checker.Ancestors = check
 	return checker
 }
 
-// ImageChecker implements a checker struct to check a Image field
-type ImageChecker struct {
-	Id   *stringmatcher.StringMatcher `json:"id,omitempty"`
-	Name *stringmatcher.StringMatcher `json:"name,omitempty"`
+// WithSock adds a Sock check to the ProcessDnsChecker
+func (checker *ProcessDnsChecker) WithSock(check *KprobeSockChecker) *ProcessDnsChecker {
+	checker.Sock = check
+	return checker
 }
 
-// NewImageChecker creates a new ImageChecker
-func NewImageChecker() *ImageChecker {
-	return &ImageChecker{}
+// WithResponse adds a Response check to the ProcessDnsChecker
+func (checker *ProcessDnsChecker) WithResponse(check bool) *ProcessDnsChecker {
+	checker.Response = &check
+	return checker
 }
 
-// Get the type of the checker as a string
-func (checker *ImageChecker) GetCheckerType() string {
-	return "ImageChecker"
+// WithId adds a Id check to the ProcessDnsChecker
+func (checker *ProcessDnsChecker) WithId(check uint32) *ProcessDnsChecker {
+	checker.Id = &check
+	return checker
 }
 
-// Check checks a Image field
-func (checker *ImageChecker) Check(event *tetragon.Image) error {
-	if event == nil {
-		return fmt.Errorf("%s: Image field is nil", CheckerLogPrefix(checker))
-	}
+// WithQname adds a Qname check to the ProcessDnsChecker
+func (checker *ProcessDnsChecker) WithQname(check *stringmatcher.StringMatcher) *ProcessDnsChecker {
+	checker.Qname = check
+	return checker
+}
 
-	fieldChecks := func() error {
-		if checker.Id != nil {
-			if err := checker.Id.Match(event.Id); err != nil {
-				return fmt.Errorf("Id check failed: %w", err)
-			}
-		}
-		if checker.Name != nil {
-			if err := checker.Name.Match(event.Name); err != nil {
-				return fmt.Errorf("Name check failed: %w", err)
-			}
-		}
-		return nil
-	}
-	if err := fieldChecks(); err != nil {
-		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
-	}
-	return nil
+// WithQtype adds a Qtype check to the ProcessDnsChecker
+func (checker *ProcessDnsChecker) WithQtype(check *stringmatcher.StringMatcher) *ProcessDnsChecker {
+	checker.Qtype = check
+	return checker
 }
 
-// WithId adds a Id check to the ImageChecker
-func (checker *ImageChecker) WithId(check *stringmatcher.StringMatcher) *ImageChecker {
-	checker.Id = check
+// WithRcode adds a Rcode check to the ProcessDnsChecker
+func (checker *ProcessDnsChecker) WithRcode(check *stringmatcher.StringMatcher) *ProcessDnsChecker {
+	checker.Rcode = check
 	return checker
 }
 
-// WithName adds a Name check to the ImageChecker
-func (checker *ImageChecker) WithName(check *stringmatcher.StringMatcher) *ImageChecker {
-	checker.Name = check
+// WithAnswers adds a Answers check to the ProcessDnsChecker
+func (checker *ProcessDnsChecker) WithAnswers(check *DnsAnswerListMatcher) *ProcessDnsChecker {
+	checker.Answers = check
 	return checker
 }
 
-//FromImage populates the ImageChecker using data from a Image field
-func (checker *ImageChecker) FromImage(event *tetragon.Image) *ImageChecker {
-	if event == nil {
-		return checker
+// WithTruncated adds a Truncated check to the ProcessDnsChecker
+func (checker *ProcessDnsChecker) WithTruncated(check bool) *ProcessDnsChecker {
+	checker.Truncated = &check
+	return checker
+}
+
+//FromProcessDns populates the ProcessDnsChecker using data from a ProcessDns event
+func (checker *ProcessDnsChecker) FromProcessDns(event *tetragon.ProcessDns) *ProcessDnsChecker {
+	if event == nil {
+		return checker
+	}
+	if event.Process != nil {
+		checker.Process = NewProcessChecker().FromProcess(event.Process)
+	}
+	if event.Parent != nil {
+		checker.Parent = NewProcessChecker().FromProcess(event.Parent)
+	}
+	{
+		var checks []*ProcessChecker
+		for _, check := range event.Ancestors {
+			var convertedCheck *ProcessChecker
+			if check != nil {
+				convertedCheck = NewProcessChecker().FromProcess(check)
+			}
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewProcessListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Ancestors = lm
+	}
+	if event.Sock != nil {
+		checker.Sock = NewKprobeSockChecker().FromKprobeSock(event.Sock)
+	}
+	{
+		val := event.Response
+		checker.Response = &val
+	}
+	{
+		val := event.Id
+		checker.Id = &val
+	}
+	checker.Qname = stringmatcher.Full(event.Qname)
+	checker.Qtype = stringmatcher.Full(event.Qtype)
+	checker.Rcode = stringmatcher.Full(event.Rcode)
+	{
+		var checks []*DnsAnswerChecker
+		for _, check := range event.Answers {
+			var convertedCheck *DnsAnswerChecker
+			if check != nil {
+				convertedCheck = NewDnsAnswerChecker().FromDnsAnswer(check)
+			}
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewDnsAnswerListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Answers = lm
+	}
+	{
+		val := event.Truncated
+		checker.Truncated = &val
 	}
-	checker.Id = stringmatcher.Full(event.Id)
-	checker.Name = stringmatcher.Full(event.Name)
 	return checker
 }
 
-// SecurityContextChecker implements a checker struct to check a SecurityContext field
-type SecurityContextChecker struct {
-	Privileged *bool `json:"privileged,omitempty"`
+// DnsAnswerListMatcher checks a list of *tetragon.DnsAnswer fields
+type DnsAnswerListMatcher struct {
+	Operator listmatcher.Operator `json:"operator"`
+	Values   []*DnsAnswerChecker  `json:"values"`
 }
 
-// NewSecurityContextChecker creates a new SecurityContextChecker
-func NewSecurityContextChecker() *SecurityContextChecker {
-	return &SecurityContextChecker{}
+// NewDnsAnswerListMatcher creates a new DnsAnswerListMatcher. The checker defaults to a subset checker unless otherwise specified using WithOperator()
+func NewDnsAnswerListMatcher() *DnsAnswerListMatcher {
+	return &DnsAnswerListMatcher{
+		Operator: listmatcher.Subset,
+	}
 }
 
-// Get the type of the checker as a string
-func (checker *SecurityContextChecker) GetCheckerType() string {
-	return "SecurityContextChecker"
+// WithOperator sets the match kind for the DnsAnswerListMatcher
+func (checker *DnsAnswerListMatcher) WithOperator(operator listmatcher.Operator) *DnsAnswerListMatcher {
+	checker.Operator = operator
+	return checker
 }
 
-// Check checks a SecurityContext field
-func (checker *SecurityContextChecker) Check(event *tetragon.SecurityContext) error {
-	if event == nil {
-		return fmt.Errorf("%s: SecurityContext field is nil", CheckerLogPrefix(checker))
+// WithValues sets the checkers that the DnsAnswerListMatcher should use
+func (checker *DnsAnswerListMatcher) WithValues(values ...*DnsAnswerChecker) *DnsAnswerListMatcher {
+	checker.Values = values
+	return checker
+}
+
+// Check checks a list of *tetragon.DnsAnswer fields
+func (checker *DnsAnswerListMatcher) Check(values []*tetragon.DnsAnswer) error {
+	switch checker.Operator {
+	case listmatcher.Ordered:
+		return checker.orderedCheck(values)
+	case listmatcher.Unordered:
+		return checker.unorderedCheck(values)
+	case listmatcher.Subset:
+		return checker.subsetCheck(values)
+	default:
+		return fmt.Errorf("Unhandled ListMatcher operator %s", checker.Operator)
 	}
+}
 
-	fieldChecks := func() error {
-		if checker.Privileged != nil {
-			if *checker.Privileged != event.Privileged {
-				return fmt.Errorf("Privileged has value %t which does not match expected value %t", event.Privileged, *checker.Privileged)
-			}
+// orderedCheck checks a list of ordered *tetragon.DnsAnswer fields
+func (checker *DnsAnswerListMatcher) orderedCheck(values []*tetragon.DnsAnswer) error {
+	innerCheck := func(check *DnsAnswerChecker, value *tetragon.DnsAnswer) error {
+		if err := check.Check(value); err != nil {
+			return fmt.Errorf("Answers check failed: %w", err)
 		}
 		return nil
 	}
-	if err := fieldChecks(); err != nil {
-		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+
+	if len(checker.Values) != len(values) {
+		return fmt.Errorf("DnsAnswerListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+	}
+
+	for i, check := range checker.Values {
+		value := values[i]
+		if err := innerCheck(check, value); err != nil {
+			return fmt.Errorf("DnsAnswerListMatcher: Check failed on element %d: %w", i, err)
+		}
 	}
+
 	return nil
 }
 
-// WithPrivileged adds a Privileged check to the SecurityContextChecker
-func (checker *SecurityContextChecker) WithPrivileged(check bool) *SecurityContextChecker {
-	checker.Privileged = &check
-	return checker
+// unorderedCheck checks a list of unordered *tetragon.DnsAnswer fields
+func (checker *DnsAnswerListMatcher) unorderedCheck(values []*tetragon.DnsAnswer) error {
+	if len(checker.Values) != len(values) {
+		return fmt.Errorf("DnsAnswerListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+	}
+
+	return checker.subsetCheck(values)
 }
 
-//FromSecurityContext populates the SecurityContextChecker using data from a SecurityContext field
-func (checker *SecurityContextChecker) FromSecurityContext(event *tetragon.SecurityContext) *SecurityContextChecker {
-	if event == nil {
-		return checker
+// subsetCheck checks a subset of *tetragon.DnsAnswer fields
+func (checker *DnsAnswerListMatcher) subsetCheck(values []*tetragon.DnsAnswer) error {
+	innerCheck := func(check *DnsAnswerChecker, value *tetragon.DnsAnswer) error {
+		if err := check.Check(value); err != nil {
+			return fmt.Errorf("Answers check failed: %w", err)
+		}
+		return nil
 	}
-	{
-		val := event.Privileged
-		checker.Privileged = &val
+
+	numDesired := len(checker.Values)
+	numMatched := 0
+
+nextCheck:
+	for _, check := range checker.Values {
+		for _, value := range values {
+			if err := innerCheck(check, value); err == nil {
+				numMatched += 1
+				continue nextCheck
+			}
+		}
 	}
-	return checker
+
+	if numMatched < numDesired {
+		return fmt.Errorf("DnsAnswerListMatcher: Check failed, only matched %d elements but wanted %d", numMatched, numDesired)
+	}
+
+	return nil
 }
 
-// ContainerChecker implements a checker struct to check a Container field
-type ContainerChecker struct {
-	Id              *stringmatcher.StringMatcher       `json:"id,omitempty"`
-	Name            *stringmatcher.StringMatcher       `json:"name,omitempty"`
-	Image           *ImageChecker                      `json:"image,omitempty"`
-	StartTime       *timestampmatcher.TimestampMatcher `json:"startTime,omitempty"`
-	Pid             *uint32                            `json:"pid,omitempty"`
-	MaybeExecProbe  *bool                              `json:"maybeExecProbe,omitempty"`
-	SecurityContext *SecurityContextChecker            `json:"securityContext,omitempty"`
+// ProcessTlsChecker implements a checker struct to check a ProcessTls event
+type ProcessTlsChecker struct {
+	CheckerName string               `json:"checkerName"`
+	Process     *ProcessChecker      `json:"process,omitempty"`
+	Parent      *ProcessChecker      `json:"parent,omitempty"`
+	Ancestors   *ProcessListMatcher  `json:"ancestors,omitempty"`
+	Sock        *KprobeSockChecker   `json:"sock,omitempty"`
+	Library     *TlsLibraryChecker   `json:"library,omitempty"`
+	Direction   *TlsDirectionChecker `json:"direction,omitempty"`
+	Size        *uint32              `json:"size,omitempty"`
+	Http        *HttpMessageChecker  `json:"http,omitempty"`
+	Truncated   *bool                `json:"truncated,omitempty"`
 }
 
-// NewContainerChecker creates a new ContainerChecker
-func NewContainerChecker() *ContainerChecker {
-	return &ContainerChecker{}
+// CheckEvent checks a single event and implements the EventChecker interface
+func (checker *ProcessTlsChecker) CheckEvent(event Event) error {
+	if ev, ok := event.(*tetragon.ProcessTls); ok {
+		return checker.Check(ev)
+	}
+	return fmt.Errorf("%s: %T is not a ProcessTls event", CheckerLogPrefix(checker), event)
+}
+
+// CheckResponse checks a single gRPC response and implements the EventChecker interface
+func (checker *ProcessTlsChecker) CheckResponse(response *tetragon.GetEventsResponse) error {
+	event, err := EventFromResponse(response)
+	if err != nil {
+		return err
+	}
+	return checker.CheckEvent(event)
+}
+
+// NewProcessTlsChecker creates a new ProcessTlsChecker
+func NewProcessTlsChecker(name string) *ProcessTlsChecker {
+	return &ProcessTlsChecker{CheckerName: name}
+}
+
+// Get the name associated with the checker
+func (checker *ProcessTlsChecker) GetCheckerName() string {
+	return checker.CheckerName
 }
 
 // Get the type of the checker as a string
-func (checker *ContainerChecker) GetCheckerType() string {
-	return "ContainerChecker"
+func (checker *ProcessTlsChecker) GetCheckerType() string {
+	return "ProcessTlsChecker"
 }
 
-// Check checks a Container field
-func (checker *ContainerChecker) Check(event *tetragon.Container) error {
+// Check checks a ProcessTls event
+func (checker *ProcessTlsChecker) Check(event *tetragon.ProcessTls) error {
 	if event == nil {
-		return fmt.Errorf("%s: Container field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: ProcessTls event is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Id != nil {
-			if err := checker.Id.Match(event.Id); err != nil {
-				return fmt.Errorf("Id check failed: %w", err)
+		if checker.Process != nil {
+			if err := checker.Process.Check(event.Process); err != nil {
+				return fmt.Errorf("Process check failed: %w", err)
 			}
 		}
-		if checker.Name != nil {
-			if err := checker.Name.Match(event.Name); err != nil {
-				return fmt.Errorf("Name check failed: %w", err)
+		if checker.Parent != nil {
+			if err := checker.Parent.Check(event.Parent); err != nil {
+				return fmt.Errorf("Parent check failed: %w", err)
 			}
 		}
-		if checker.Image != nil {
-			if err := checker.Image.Check(event.Image); err != nil {
-				return fmt.Errorf("Image check failed: %w", err)
+		if checker.Ancestors != nil {
+			if err := checker.Ancestors.Check(event.Ancestors); err != nil {
+				return fmt.Errorf("Ancestors check failed: %w", err)
 			}
 		}
-		if checker.StartTime != nil {
-			if err := checker.StartTime.Match(event.StartTime); err != nil {
-				return fmt.Errorf("StartTime check failed: %w", err)
+		if checker.Sock != nil {
+			if err := checker.Sock.Check(event.Sock); err != nil {
+				return fmt.Errorf("Sock check failed: %w", err)
 			}
 		}
-		if checker.Pid != nil {
-			if event.Pid == nil {
-				return fmt.Errorf("Pid is nil and does not match expected value %v", *checker.Pid)
+		if checker.Library != nil {
+			if err := checker.Library.Check(&event.Library); err != nil {
+				return fmt.Errorf("Library check failed: %w", err)
 			}
-			if *checker.Pid != event.Pid.Value {
-				return fmt.Errorf("Pid has value %v which does not match expected value %v", event.Pid.Value, *checker.Pid)
+		}
+		if checker.Direction != nil {
+			if err := checker.Direction.Check(&event.Direction); err != nil {
+				return fmt.Errorf("Direction check failed: %w", err)
 			}
 		}
-		if checker.MaybeExecProbe != nil {
-			if *checker.MaybeExecProbe != event.MaybeExecProbe {
-				return fmt.Errorf("MaybeExecProbe has value %t which does not match expected value %t", event.MaybeExecProbe, *checker.MaybeExecProbe)
+		if checker.Size != nil {
+			if *checker.Size != event.Size {
+				return fmt.Errorf("Size has value %d which does not match expected value %d", event.Size, *checker.Size)
 			}
 		}
-		if checker.SecurityContext != nil {
-			if err := checker.SecurityContext.Check(event.SecurityContext); err != nil {
-				return fmt.Errorf("SecurityContext check failed: %w", err)
+		if checker.Http != nil {
+			if err := checker.Http.Check(event.Http); err != nil {
+				return fmt.Errorf("Http check failed: %w", err)
+			}
+		}
+		if checker.Truncated != nil {
+			if *checker.Truncated != event.Truncated {
+				return fmt.Errorf("Truncated has value %t which does not match expected value %t", event.Truncated, *checker.Truncated)
 			}
 		}
 		return nil
@@ -2968,181 +3341,153 @@ func (checker *ContainerChecker) Check(event *tetragon.Container) error {
 	return nil
 }
 
-// WithId adds a Id check to the ContainerChecker
-func (checker *ContainerChecker) WithId(check *stringmatcher.StringMatcher) *ContainerChecker {
-	checker.Id = check
+// WithProcess adds a Process check to the ProcessTlsChecker
+func (checker *ProcessTlsChecker) WithProcess(check *ProcessChecker) *ProcessTlsChecker {
+	checker.Process = check
 	return checker
 }
 
-// WithName adds a Name check to the ContainerChecker
-func (checker *ContainerChecker) WithName(check *stringmatcher.StringMatcher) *ContainerChecker {
-	checker.Name = check
+// WithParent adds a Parent check to the ProcessTlsChecker
+func (checker *ProcessTlsChecker) WithParent(check *ProcessChecker) *ProcessTlsChecker {
+	checker.Parent = check
 	return checker
 }
 
-// WithImage adds a Image check to the ContainerChecker
-func (checker *ContainerChecker) WithImage(check *ImageChecker) *ContainerChecker {
-	checker.Image = check
+// WithAncestors adds a Ancestors check to the ProcessTlsChecker
+func (checker *ProcessTlsChecker) WithAncestors(check *ProcessListMatcher) *ProcessTlsChecker {
+	checker.Ancestors = check
 	return checker
 }
 
-// WithStartTime adds a StartTime check to the ContainerChecker
-func (checker *ContainerChecker) WithStartTime(check *timestampmatcher.TimestampMatcher) *ContainerChecker {
-	checker.StartTime = check
+// WithSock adds a Sock check to the ProcessTlsChecker
+func (checker *ProcessTlsChecker) WithSock(check *KprobeSockChecker) *ProcessTlsChecker {
+	checker.Sock = check
 	return checker
 }
 
-// WithPid adds a Pid check to the ContainerChecker
-func (checker *ContainerChecker) WithPid(check uint32) *ContainerChecker {
-	checker.Pid = &check
+// WithLibrary adds a Library check to the ProcessTlsChecker
+func (checker *ProcessTlsChecker) WithLibrary(check tetragon.TlsLibrary) *ProcessTlsChecker {
+	wrappedCheck := TlsLibraryChecker(check)
+	checker.Library = &wrappedCheck
 	return checker
 }
 
-// WithMaybeExecProbe adds a MaybeExecProbe check to the ContainerChecker
-func (checker *ContainerChecker) WithMaybeExecProbe(check bool) *ContainerChecker {
-	checker.MaybeExecProbe = &check
+// WithDirection adds a Direction check to the ProcessTlsChecker
+func (checker *ProcessTlsChecker) WithDirection(check tetragon.TlsDirection) *ProcessTlsChecker {
+	wrappedCheck := TlsDirectionChecker(check)
+	checker.Direction = &wrappedCheck
 	return checker
 }
 
-// WithSecurityContext adds a SecurityContext check to the ContainerChecker
-func (checker *ContainerChecker) WithSecurityContext(check *SecurityContextChecker) *ContainerChecker {
-	checker.SecurityContext = check
+// WithSize adds a Size check to the ProcessTlsChecker
+func (checker *ProcessTlsChecker) WithSize(check uint32) *ProcessTlsChecker {
+	checker.Size = &check
 	return checker
 }
 
-//FromContainer populates the ContainerChecker using data from a Container field
-func (checker *ContainerChecker) FromContainer(event *tetragon.Container) *ContainerChecker {
+// WithHttp adds a Http check to the ProcessTlsChecker
+func (checker *ProcessTlsChecker) WithHttp(check *HttpMessageChecker) *ProcessTlsChecker {
+	checker.Http = check
+	return checker
+}
+
+// WithTruncated adds a Truncated check to the ProcessTlsChecker
+func (checker *ProcessTlsChecker) WithTruncated(check bool) *ProcessTlsChecker {
+	checker.Truncated = &check
+	return checker
+}
+
+//FromProcessTls populates the ProcessTlsChecker using data from a ProcessTls event
+func (checker *ProcessTlsChecker) FromProcessTls(event *tetragon.ProcessTls) *ProcessTlsChecker {
 	if event == nil {
 		return checker
 	}
-	checker.Id = stringmatcher.Full(event.Id)
-	checker.Name = stringmatcher.Full(event.Name)
-	if event.Image != nil {
-		checker.Image = NewImageChecker().FromImage(event.Image)
+	if event.Process != nil {
+		checker.Process = NewProcessChecker().FromProcess(event.Process)
 	}
-	// NB: We don't want to match timestamps for now
-	checker.StartTime = nil
-	if event.Pid != nil {
-		val := event.Pid.Value
-		checker.Pid = &val
+	if event.Parent != nil {
+		checker.Parent = NewProcessChecker().FromProcess(event.Parent)
 	}
 	{
-		val := event.MaybeExecProbe
-		checker.MaybeExecProbe = &val
+		var checks []*ProcessChecker
+		for _, check := range event.Ancestors {
+			var convertedCheck *ProcessChecker
+			if check != nil {
+				convertedCheck = NewProcessChecker().FromProcess(check)
+			}
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewProcessListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Ancestors = lm
 	}
-	if event.SecurityContext != nil {
-		checker.SecurityContext = NewSecurityContextChecker().FromSecurityContext(event.SecurityContext)
+	if event.Sock != nil {
+		checker.Sock = NewKprobeSockChecker().FromKprobeSock(event.Sock)
+	}
+	checker.Library = NewTlsLibraryChecker(event.Library)
+	checker.Direction = NewTlsDirectionChecker(event.Direction)
+	{
+		val := event.Size
+		checker.Size = &val
+	}
+	if event.Http != nil {
+		checker.Http = NewHttpMessageChecker().FromHttpMessage(event.Http)
+	}
+	{
+		val := event.Truncated
+		checker.Truncated = &val
 	}
 	return checker
 }
 
-// PodChecker implements a checker struct to check a Pod field
-type PodChecker struct {
-	Namespace      *stringmatcher.StringMatcher           `json:"namespace,omitempty"`
-	Name           *stringmatcher.StringMatcher           `json:"name,omitempty"`
-	Uid            *stringmatcher.StringMatcher           `json:"uid,omitempty"`
-	Container      *ContainerChecker                      `json:"container,omitempty"`
-	PodLabels      map[string]stringmatcher.StringMatcher `json:"podLabels,omitempty"`
-	Workload       *stringmatcher.StringMatcher           `json:"workload,omitempty"`
-	WorkloadKind   *stringmatcher.StringMatcher           `json:"workloadKind,omitempty"`
-	PodAnnotations map[string]stringmatcher.StringMatcher `json:"podAnnotations,omitempty"`
+// RateLimitInfoChecker implements a checker struct to check a RateLimitInfo event
+type RateLimitInfoChecker struct {
+	CheckerName                  string  `json:"checkerName"`
+	NumberOfDroppedProcessEvents *uint64 `json:"numberOfDroppedProcessEvents,omitempty"`
 }
 
-// NewPodChecker creates a new PodChecker
-func NewPodChecker() *PodChecker {
-	return &PodChecker{}
+// CheckEvent checks a single event and implements the EventChecker interface
+func (checker *RateLimitInfoChecker) CheckEvent(event Event) error {
+	if ev, ok := event.(*tetragon.RateLimitInfo); ok {
+		return checker.Check(ev)
+	}
+	return fmt.Errorf("%s: %T is not a RateLimitInfo event", CheckerLogPrefix(checker), event)
+}
+
+// CheckResponse checks a single gRPC response and implements the EventChecker interface
+func (checker *RateLimitInfoChecker) CheckResponse(response *tetragon.GetEventsResponse) error {
+	event, err := EventFromResponse(response)
+	if err != nil {
+		return err
+	}
+	return checker.CheckEvent(event)
+}
+
+// NewRateLimitInfoChecker creates a new RateLimitInfoChecker
+func NewRateLimitInfoChecker(name string) *RateLimitInfoChecker {
+	return &RateLimitInfoChecker{CheckerName: name}
+}
+
+// Get the name associated with the checker
+func (checker *RateLimitInfoChecker) GetCheckerName() string {
+	return checker.CheckerName
 }
 
 // Get the type of the checker as a string
-func (checker *PodChecker) GetCheckerType() string {
-	return "PodChecker"
+func (checker *RateLimitInfoChecker) GetCheckerType() string {
+	return "RateLimitInfoChecker"
 }
 
-// Check checks a Pod field
-func (checker *PodChecker) Check(event *tetragon.Pod) error {
+// Check checks a RateLimitInfo event
+func (checker *RateLimitInfoChecker) Check(event *tetragon.RateLimitInfo) error {
 	if event == nil {
-		return fmt.Errorf("%s: Pod field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: RateLimitInfo event is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Namespace != nil {
-			if err := checker.Namespace.Match(event.Namespace); err != nil {
-				return fmt.Errorf("Namespace check failed: %w", err)
-			}
-		}
-		if checker.Name != nil {
-			if err := checker.Name.Match(event.Name); err != nil {
-				return fmt.Errorf("Name check failed: %w", err)
-			}
-		}
-		if checker.Uid != nil {
-			if err := checker.Uid.Match(event.Uid); err != nil {
-				return fmt.Errorf("Uid check failed: %w", err)
-			}
-		}
-		if checker.Container != nil {
-			if err := checker.Container.Check(event.Container); err != nil {
-				return fmt.Errorf("Container check failed: %w", err)
-			}
-		}
-		{
-			var unmatched []string
-			matched := make(map[string]struct{})
-			for key, value := range event.PodLabels {
-				if len(checker.PodLabels) > 0 {
-					// Attempt to grab the matcher for this key
-					if matcher, ok := checker.PodLabels[key]; ok {
-						if err := matcher.Match(value); err != nil {
-							return fmt.Errorf("PodLabels[%s] (%s=%s) check failed: %w", key, key, value, err)
-						}
-						matched[key] = struct{}{}
-					}
-				}
-			}
-
-			// See if we have any unmatched values that we wanted to match
-			if len(matched) != len(checker.PodLabels) {
-				for k := range checker.PodLabels {
-					if _, ok := matched[k]; !ok {
-						unmatched = append(unmatched, k)
-					}
-				}
-				return fmt.Errorf("PodLabels unmatched: %v", unmatched)
-			}
-		}
-		if checker.Workload != nil {
-			if err := checker.Workload.Match(event.Workload); err != nil {
-				return fmt.Errorf("Workload check failed: %w", err)
-			}
-		}
-		if checker.WorkloadKind != nil {
-			if err := checker.WorkloadKind.Match(event.WorkloadKind); err != nil {
-				return fmt.Errorf("WorkloadKind check failed: %w", err)
-			}
-		}
-		{
-			var unmatched []string
-			matched := make(map[string]struct{})
-			for key, value := range event.PodAnnotations {
-				if len(checker.PodAnnotations) > 0 {
-					// Attempt to grab the matcher for this key
-					if matcher, ok := checker.PodAnnotations[key]; ok {
-						if err := matcher.Match(value); err != nil {
-							return fmt.Errorf("PodAnnotations[%s] (%s=%s) check failed: %w", key, key, value, err)
-						}
-						matched[key] = struct{}{}
-					}
-				}
-			}
-
-			// See if we have any unmatched values that we wanted to match
-			if len(matched) != len(checker.PodAnnotations) {
-				for k := range checker.PodAnnotations {
-					if _, ok := matched[k]; !ok {
-						unmatched = append(unmatched, k)
-					}
-				}
-				return fmt.Errorf("PodAnnotations unmatched: %v", unmatched)
+		if checker.NumberOfDroppedProcessEvents != nil {
+			if *checker.NumberOfDroppedProcessEvents != event.NumberOfDroppedProcessEvents {
+				return fmt.Errorf("NumberOfDroppedProcessEvents has value %d which does not match expected value %d", event.NumberOfDroppedProcessEvents, *checker.NumberOfDroppedProcessEvents)
 			}
 		}
 		return nil
@@ -3153,109 +3498,78 @@ func (checker *PodChecker) Check(event *tetragon.Pod) error {
 	return nil
 }
 
-// WithNamespace adds a Namespace check to the PodChecker
-func (checker *PodChecker) WithNamespace(check *stringmatcher.StringMatcher) *PodChecker {
-	checker.Namespace = check
-	return checker
-}
-
-// WithName adds a Name check to the PodChecker
-func (checker *PodChecker) WithName(check *stringmatcher.StringMatcher) *PodChecker {
-	checker.Name = check
-	return checker
-}
-
-// WithUid adds a Uid check to the PodChecker
-func (checker *PodChecker) WithUid(check *stringmatcher.StringMatcher) *PodChecker {
-	checker.Uid = check
-	return checker
-}
-
-// WithContainer adds a Container check to the PodChecker
-func (checker *PodChecker) WithContainer(check *ContainerChecker) *PodChecker {
-	checker.Container = check
-	return checker
-}
-
-// WithPodLabels adds a PodLabels check to the PodChecker
-func (checker *PodChecker) WithPodLabels(check map[string]stringmatcher.StringMatcher) *PodChecker {
-	checker.PodLabels = check
+// WithNumberOfDroppedProcessEvents adds a NumberOfDroppedProcessEvents check to the RateLimitInfoChecker
+func (checker *RateLimitInfoChecker) WithNumberOfDroppedProcessEvents(check uint64) *RateLimitInfoChecker {
+	checker.NumberOfDroppedProcessEvents = &check
 	return checker
 }
 
-// WithWorkload adds a Workload check to the PodChecker
-func (checker *PodChecker) WithWorkload(check *stringmatcher.StringMatcher) *PodChecker {
-	checker.Workload = check
+//FromRateLimitInfo populates the RateLimitInfoChecker using data from a RateLimitInfo event
+func (checker *RateLimitInfoChecker) FromRateLimitInfo(event *tetragon.RateLimitInfo) *RateLimitInfoChecker {
+	if event == nil {
+		return checker
+	}
+	{
+		val := event.NumberOfDroppedProcessEvents
+		checker.NumberOfDroppedProcessEvents = &val
+	}
 	return checker
 }
 
-// WithWorkloadKind adds a WorkloadKind check to the PodChecker
-func (checker *PodChecker) WithWorkloadKind(check *stringmatcher.StringMatcher) *PodChecker {
-	checker.WorkloadKind = check
-	return checker
+// ProcessThrottleChecker implements a checker struct to check a ProcessThrottle event
+type ProcessThrottleChecker struct {
+	CheckerName string                       `json:"checkerName"`
+	Type        *ThrottleTypeChecker         `json:"type,omitempty"`
+	Cgroup      *stringmatcher.StringMatcher `json:"cgroup,omitempty"`
 }
 
-// WithPodAnnotations adds a PodAnnotations check to the PodChecker
-func (checker *PodChecker) WithPodAnnotations(check map[string]stringmatcher.StringMatcher) *PodChecker {
-	checker.PodAnnotations = check
-	return checker
+// CheckEvent checks a single event and implements the EventChecker interface
+func (checker *ProcessThrottleChecker) CheckEvent(event Event) error {
+	if ev, ok := event.(*tetragon.ProcessThrottle); ok {
+		return checker.Check(ev)
+	}
+	return fmt.Errorf("%s: %T is not a ProcessThrottle event", CheckerLogPrefix(checker), event)
 }
 
-//FromPod populates the PodChecker using data from a Pod field
-func (checker *PodChecker) FromPod(event *tetragon.Pod) *PodChecker {
-	if event == nil {
-		return checker
-	}
-	checker.Namespace = stringmatcher.Full(event.Namespace)
-	checker.Name = stringmatcher.Full(event.Name)
-	checker.Uid = stringmatcher.Full(event.Uid)
-	if event.Container != nil {
-		checker.Container = NewContainerChecker().FromContainer(event.Container)
+// CheckResponse checks a single gRPC response and implements the EventChecker interface
+func (checker *ProcessThrottleChecker) CheckResponse(response *tetragon.GetEventsResponse) error {
+	event, err := EventFromResponse(response)
+	if err != nil {
+		return err
 	}
-	// TODO: implement fromMap
-	checker.Workload = stringmatcher.Full(event.Workload)
-	checker.WorkloadKind = stringmatcher.Full(event.WorkloadKind)
-	// TODO: implement fromMap
-	return checker
+	return checker.CheckEvent(event)
 }
 
-// CapabilitiesChecker implements a checker struct to check a Capabilities field
-type CapabilitiesChecker struct {
-	Permitted   *CapabilitiesTypeListMatcher `json:"permitted,omitempty"`
-	Effective   *CapabilitiesTypeListMatcher `json:"effective,omitempty"`
-	Inheritable *CapabilitiesTypeListMatcher `json:"inheritable,omitempty"`
+// NewProcessThrottleChecker creates a new ProcessThrottleChecker
+func NewProcessThrottleChecker(name string) *ProcessThrottleChecker {
+	return &ProcessThrottleChecker{CheckerName: name}
 }
 
-// NewCapabilitiesChecker creates a new CapabilitiesChecker
-func NewCapabilitiesChecker() *CapabilitiesChecker {
-	return &CapabilitiesChecker{}
+// Get the name associated with the checker
+func (checker *ProcessThrottleChecker) GetCheckerName() string {
+	return checker.CheckerName
 }
 
 // Get the type of the checker as a string
-func (checker *CapabilitiesChecker) GetCheckerType() string {
-	return "CapabilitiesChecker"
+func (checker *ProcessThrottleChecker) GetCheckerType() string {
+	return "ProcessThrottleChecker"
 }
 
-// Check checks a Capabilities field
-func (checker *CapabilitiesChecker) Check(event *tetragon.Capabilities) error {
+// Check checks a ProcessThrottle event
+func (checker *ProcessThrottleChecker) Check(event *tetragon.ProcessThrottle) error {
 	if event == nil {
-		return fmt.Errorf("%s: Capabilities field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: ProcessThrottle event is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Permitted != nil {
-			if err := checker.Permitted.Check(event.Permitted); err != nil {
-				return fmt.Errorf("Permitted check failed: %w", err)
+		if checker.Type != nil {
+			if err := checker.Type.Check(&event.Type); err != nil {
+				return fmt.Errorf("Type check failed: %w", err)
 			}
 		}
-		if checker.Effective != nil {
-			if err := checker.Effective.Check(event.Effective); err != nil {
-				return fmt.Errorf("Effective check failed: %w", err)
-			}
-		}
-		if checker.Inheritable != nil {
-			if err := checker.Inheritable.Check(event.Inheritable); err != nil {
-				return fmt.Errorf("Inheritable check failed: %w", err)
+		if checker.Cgroup != nil {
+			if err := checker.Cgroup.Match(event.Cgroup); err != nil {
+				return fmt.Errorf("Cgroup check failed: %w", err)
 			}
 		}
 		return nil
@@ -3266,196 +3580,117 @@ func (checker *CapabilitiesChecker) Check(event *tetragon.Capabilities) error {
 	return nil
 }
 
-// WithPermitted adds a Permitted check to the CapabilitiesChecker
-func (checker *CapabilitiesChecker) WithPermitted(check *CapabilitiesTypeListMatcher) *CapabilitiesChecker {
-	checker.Permitted = check
-	return checker
-}
-
-// WithEffective adds a Effective check to the CapabilitiesChecker
-func (checker *CapabilitiesChecker) WithEffective(check *CapabilitiesTypeListMatcher) *CapabilitiesChecker {
-	checker.Effective = check
+// WithType adds a Type check to the ProcessThrottleChecker
+func (checker *ProcessThrottleChecker) WithType(check tetragon.ThrottleType) *ProcessThrottleChecker {
+	wrappedCheck := ThrottleTypeChecker(check)
+	checker.Type = &wrappedCheck
 	return checker
 }
 
-// WithInheritable adds a Inheritable check to the CapabilitiesChecker
-func (checker *CapabilitiesChecker) WithInheritable(check *CapabilitiesTypeListMatcher) *CapabilitiesChecker {
-	checker.Inheritable = check
+// WithCgroup adds a Cgroup check to the ProcessThrottleChecker
+func (checker *ProcessThrottleChecker) WithCgroup(check *stringmatcher.StringMatcher) *ProcessThrottleChecker {
+	checker.Cgroup = check
 	return checker
 }
 
-//FromCapabilities populates the CapabilitiesChecker using data from a Capabilities field
-func (checker *CapabilitiesChecker) FromCapabilities(event *tetragon.Capabilities) *CapabilitiesChecker {
+//FromProcessThrottle populates the ProcessThrottleChecker using data from a ProcessThrottle event
+func (checker *ProcessThrottleChecker) FromProcessThrottle(event *tetragon.ProcessThrottle) *ProcessThrottleChecker {
 	if event == nil {
 		return checker
 	}
-	{
-		var checks []*CapabilitiesTypeChecker
-		for _, check := range event.Permitted {
-			var convertedCheck *CapabilitiesTypeChecker
-			convertedCheck = NewCapabilitiesTypeChecker(check)
-			checks = append(checks, convertedCheck)
-		}
-		lm := NewCapabilitiesTypeListMatcher().WithOperator(listmatcher.Ordered).
-			WithValues(checks...)
-		checker.Permitted = lm
-	}
-	{
-		var checks []*CapabilitiesTypeChecker
-		for _, check := range event.Effective {
-			var convertedCheck *CapabilitiesTypeChecker
-			convertedCheck = NewCapabilitiesTypeChecker(check)
-			checks = append(checks, convertedCheck)
-		}
-		lm := NewCapabilitiesTypeListMatcher().WithOperator(listmatcher.Ordered).
-			WithValues(checks...)
-		checker.Effective = lm
-	}
-	{
-		var checks []*CapabilitiesTypeChecker
-		for _, check := range event.Inheritable {
-			var convertedCheck *CapabilitiesTypeChecker
-			convertedCheck = NewCapabilitiesTypeChecker(check)
-			checks = append(checks, convertedCheck)
-		}
-		lm := NewCapabilitiesTypeListMatcher().WithOperator(listmatcher.Ordered).
-			WithValues(checks...)
-		checker.Inheritable = lm
-	}
+	checker.Type = NewThrottleTypeChecker(event.Type)
+	checker.Cgroup = stringmatcher.Full(event.Cgroup)
 	return checker
 }
 
-// CapabilitiesTypeListMatcher checks a list of tetragon.CapabilitiesType fields
-type CapabilitiesTypeListMatcher struct {
-	Operator listmatcher.Operator       `json:"operator"`
-	Values   []*CapabilitiesTypeChecker `json:"values"`
-}
-
-// NewCapabilitiesTypeListMatcher creates a new CapabilitiesTypeListMatcher. The checker defaults to a subset checker unless otherwise specified using WithOperator()
-func NewCapabilitiesTypeListMatcher() *CapabilitiesTypeListMatcher {
-	return &CapabilitiesTypeListMatcher{
-		Operator: listmatcher.Subset,
-	}
+// ImageChecker implements a checker struct to check a Image field
+type ImageChecker struct {
+	Id   *stringmatcher.StringMatcher `json:"id,omitempty"`
+	Name *stringmatcher.StringMatcher `json:"name,omitempty"`
 }
 
-// WithOperator sets the match kind for the CapabilitiesTypeListMatcher
-func (checker *CapabilitiesTypeListMatcher) WithOperator(operator listmatcher.Operator) *CapabilitiesTypeListMatcher {
-	checker.Operator = operator
-	return checker
+// NewImageChecker creates a new ImageChecker
+func NewImageChecker() *ImageChecker {
+	return &ImageChecker{}
 }
 
-// WithValues sets the checkers that the CapabilitiesTypeListMatcher should use
-func (checker *CapabilitiesTypeListMatcher) WithValues(values ...*CapabilitiesTypeChecker) *CapabilitiesTypeListMatcher {
-	checker.Values = values
-	return checker
+// Get the type of the checker as a string
+func (checker *ImageChecker) GetCheckerType() string {
+	return "ImageChecker"
 }
 
-// Check checks a list of tetragon.CapabilitiesType fields
-func (checker *CapabilitiesTypeListMatcher) Check(values []tetragon.CapabilitiesType) error {
-	switch checker.Operator {
-	case listmatcher.Ordered:
-		return checker.orderedCheck(values)
-	case listmatcher.Unordered:
-		return checker.unorderedCheck(values)
-	case listmatcher.Subset:
-		return checker.subsetCheck(values)
-	default:
-		return fmt.Errorf("Unhandled ListMatcher operator %s", checker.Operator)
+// Check checks a Image field
+func (checker *ImageChecker) Check(event *tetragon.Image) error {
+	if event == nil {
+		return fmt.Errorf("%s: Image field is nil", CheckerLogPrefix(checker))
 	}
-}
 
-// orderedCheck checks a list of ordered tetragon.CapabilitiesType fields
-func (checker *CapabilitiesTypeListMatcher) orderedCheck(values []tetragon.CapabilitiesType) error {
-	innerCheck := func(check *CapabilitiesTypeChecker, value tetragon.CapabilitiesType) error {
-		if err := check.Check(&value); err != nil {
-			return fmt.Errorf("Permitted check failed: %w", err)
+	fieldChecks := func() error {
+		if checker.Id != nil {
+			if err := checker.Id.Match(event.Id); err != nil {
+				return fmt.Errorf("Id check failed: %w", err)
+			}
+		}
+		if checker.Name != nil {
+			if err := checker.Name.Match(event.Name); err != nil {
+				return fmt.Errorf("Name check failed: %w", err)
+			}
 		}
 		return nil
 	}
-
-	if len(checker.Values) != len(values) {
-		return fmt.Errorf("CapabilitiesTypeListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
-	}
-
-	for i, check := range checker.Values {
-		value := values[i]
-		if err := innerCheck(check, value); err != nil {
-			return fmt.Errorf("CapabilitiesTypeListMatcher: Check failed on element %d: %w", i, err)
-		}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
 	}
-
 	return nil
 }
 
-// unorderedCheck checks a list of unordered tetragon.CapabilitiesType fields
-func (checker *CapabilitiesTypeListMatcher) unorderedCheck(values []tetragon.CapabilitiesType) error {
-	if len(checker.Values) != len(values) {
-		return fmt.Errorf("CapabilitiesTypeListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
-	}
-
-	return checker.subsetCheck(values)
+// WithId adds a Id check to the ImageChecker
+func (checker *ImageChecker) WithId(check *stringmatcher.StringMatcher) *ImageChecker {
+	checker.Id = check
+	return checker
 }
 
-// subsetCheck checks a subset of tetragon.CapabilitiesType fields
-func (checker *CapabilitiesTypeListMatcher) subsetCheck(values []tetragon.CapabilitiesType) error {
-	innerCheck := func(check *CapabilitiesTypeChecker, value tetragon.CapabilitiesType) error {
-		if err := check.Check(&value); err != nil {
-			return fmt.Errorf("Permitted check failed: %w", err)
-		}
-		return nil
-	}
-
-	numDesired := len(checker.Values)
-	numMatched := 0
-
-nextCheck:
-	for _, check := range checker.Values {
-		for _, value := range values {
-			if err := innerCheck(check, value); err == nil {
-				numMatched += 1
-				continue nextCheck
-			}
-		}
-	}
+// WithName adds a Name check to the ImageChecker
+func (checker *ImageChecker) WithName(check *stringmatcher.StringMatcher) *ImageChecker {
+	checker.Name = check
+	return checker
+}
 
-	if numMatched < numDesired {
-		return fmt.Errorf("CapabilitiesTypeListMatcher: Check failed, only matched %d elements but wanted %d", numMatched, numDesired)
+//FromImage populates the ImageChecker using data from a Image field
+func (checker *ImageChecker) FromImage(event *tetragon.Image) *ImageChecker {
+	if event == nil {
+		return checker
 	}
-
-	return nil
+	checker.Id = stringmatcher.Full(event.Id)
+	checker.Name = stringmatcher.Full(event.Name)
+	return checker
 }
 
-// NamespaceChecker implements a checker struct to check a Namespace field
-type NamespaceChecker struct {
-	Inum   *uint32 `json:"inum,omitempty"`
-	IsHost *bool   `json:"isHost,omitempty"`
+// SecurityContextChecker implements a checker struct to check a SecurityContext field
+type SecurityContextChecker struct {
+	Privileged *bool `json:"privileged,omitempty"`
 }
 
-// NewNamespaceChecker creates a new NamespaceChecker
-func NewNamespaceChecker() *NamespaceChecker {
-	return &NamespaceChecker{}
+// NewSecurityContextChecker creates a new SecurityContextChecker
+func NewSecurityContextChecker() *SecurityContextChecker {
+	return &SecurityContextChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *NamespaceChecker) GetCheckerType() string {
-	return "NamespaceChecker"
+func (checker *SecurityContextChecker) GetCheckerType() string {
+	return "SecurityContextChecker"
 }
 
-// Check checks a Namespace field
-func (checker *NamespaceChecker) Check(event *tetragon.Namespace) error {
+// Check checks a SecurityContext field
+func (checker *SecurityContextChecker) Check(event *tetragon.SecurityContext) error {
 	if event == nil {
-		return fmt.Errorf("%s: Namespace field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: SecurityContext field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Inum != nil {
-			if *checker.Inum != event.Inum {
-				return fmt.Errorf("Inum has value %d which does not match expected value %d", event.Inum, *checker.Inum)
-			}
-		}
-		if checker.IsHost != nil {
-			if *checker.IsHost != event.IsHost {
-				return fmt.Errorf("IsHost has value %t which does not match expected value %t", event.IsHost, *checker.IsHost)
+		if checker.Privileged != nil {
+			if *checker.Privileged != event.Privileged {
+				return fmt.Errorf("Privileged has value %t which does not match expected value %t", event.Privileged, *checker.Privileged)
 			}
 		}
 		return nil
@@ -3466,113 +3701,88 @@ func (checker *NamespaceChecker) Check(event *tetragon.Namespace) error {
 	return nil
 }
 
-// WithInum adds a Inum check to the NamespaceChecker
-func (checker *NamespaceChecker) WithInum(check uint32) *NamespaceChecker {
-	checker.Inum = &check
-	return checker
-}
-
-// WithIsHost adds a IsHost check to the NamespaceChecker
-func (checker *NamespaceChecker) WithIsHost(check bool) *NamespaceChecker {
-	checker.IsHost = &check
+// WithPrivileged adds a Privileged check to the SecurityContextChecker
+func (checker *SecurityContextChecker) WithPrivileged(check bool) *SecurityContextChecker {
+	checker.Privileged = &check
 	return checker
 }
 
-//FromNamespace populates the NamespaceChecker using data from a Namespace field
-func (checker *NamespaceChecker) FromNamespace(event *tetragon.Namespace) *NamespaceChecker {
+//FromSecurityContext populates the SecurityContextChecker using data from a SecurityContext field
+func (checker *SecurityContextChecker) FromSecurityContext(event *tetragon.SecurityContext) *SecurityContextChecker {
 	if event == nil {
 		return checker
 	}
 	{
-		val := event.Inum
-		checker.Inum = &val
-	}
-	{
-		val := event.IsHost
-		checker.IsHost = &val
+		val := event.Privileged
+		checker.Privileged = &val
 	}
 	return checker
 }
 
-// NamespacesChecker implements a checker struct to check a Namespaces field
-type NamespacesChecker struct {
-	Uts             *NamespaceChecker `json:"uts,omitempty"`
-	Ipc             *NamespaceChecker `json:"ipc,omitempty"`
-	Mnt             *NamespaceChecker `json:"mnt,omitempty"`
-	Pid             *NamespaceChecker `json:"pid,omitempty"`
-	PidForChildren  *NamespaceChecker `json:"pidForChildren,omitempty"`
-	Net             *NamespaceChecker `json:"net,omitempty"`
-	Time            *NamespaceChecker `json:"time,omitempty"`
-	TimeForChildren *NamespaceChecker `json:"timeForChildren,omitempty"`
-	Cgroup          *NamespaceChecker `json:"cgroup,omitempty"`
-	User            *NamespaceChecker `json:"user,omitempty"`
-}
-
-// NewNamespacesChecker creates a new NamespacesChecker
-func NewNamespacesChecker() *NamespacesChecker {
-	return &NamespacesChecker{}
+// ContainerChecker implements a checker struct to check a Container field
+type ContainerChecker struct {
+	Id              *stringmatcher.StringMatcher       `json:"id,omitempty"`
+	Name            *stringmatcher.StringMatcher       `json:"name,omitempty"`
+	Image           *ImageChecker                      `json:"image,omitempty"`
+	StartTime       *timestampmatcher.TimestampMatcher `json:"startTime,omitempty"`
+	Pid             *uint32                            `json:"pid,omitempty"`
+	MaybeExecProbe  *bool                              `json:"maybeExecProbe,omitempty"`
+	SecurityContext *SecurityContextChecker            `json:"securityContext,omitempty"`
+}
+
+// NewContainerChecker creates a new ContainerChecker
+func NewContainerChecker() *ContainerChecker {
+	return &ContainerChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *NamespacesChecker) GetCheckerType() string {
-	return "NamespacesChecker"
+func (checker *ContainerChecker) GetCheckerType() string {
+	return "ContainerChecker"
 }
 
-// Check checks a Namespaces field
-func (checker *NamespacesChecker) Check(event *tetragon.Namespaces) error {
+// Check checks a Container field
+func (checker *ContainerChecker) Check(event *tetragon.Container) error {
 	if event == nil {
-		return fmt.Errorf("%s: Namespaces field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: Container field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Uts != nil {
-			if err := checker.Uts.Check(event.Uts); err != nil {
-				return fmt.Errorf("Uts check failed: %w", err)
-			}
-		}
-		if checker.Ipc != nil {
-			if err := checker.Ipc.Check(event.Ipc); err != nil {
-				return fmt.Errorf("Ipc check failed: %w", err)
-			}
-		}
-		if checker.Mnt != nil {
-			if err := checker.Mnt.Check(event.Mnt); err != nil {
-				return fmt.Errorf("Mnt check failed: %w", err)
+		if checker.Id != nil {
+			if err := checker.Id.Match(event.Id); err != nil {
+				return fmt.Errorf("Id check failed: %w", err)
 			}
 		}
-		if checker.Pid != nil {
-			if err := checker.Pid.Check(event.Pid); err != nil {
-				return fmt.Errorf("Pid check failed: %w", err)
+		if checker.Name != nil {
+			if err := checker.Name.Match(event.Name); err != nil {
+				return fmt.Errorf("Name check failed: %w", err)
 			}
 		}
-		if checker.PidForChildren != nil {
-			if err := checker.PidForChildren.Check(event.PidForChildren); err != nil {
-				return fmt.Errorf("PidForChildren check failed: %w", err)
+		if checker.Image != nil {
+			if err := checker.Image.Check(event.Image); err != nil {
+				return fmt.Errorf("Image check failed: %w", err)
 			}
 		}
-		if checker.Net != nil {
-			if err := checker.Net.Check(event.Net); err != nil {
-				return fmt.Errorf("Net check failed: %w", err)
+		if checker.StartTime != nil {
+			if err := checker.StartTime.Match(event.StartTime); err != nil {
+				return fmt.Errorf("StartTime check failed: %w", err)
 			}
 		}
-		if checker.Time != nil {
-			if err := checker.Time.Check(event.Time); err != nil {
-				return fmt.Errorf("Time check failed: %w", err)
+		if checker.Pid != nil {
+			if event.Pid == nil {
+				return fmt.Errorf("Pid is nil and does not match expected value %v", *checker.Pid)
 			}
-		}
-		if checker.TimeForChildren != nil {
-			if err := checker.TimeForChildren.Check(event.TimeForChildren); err != nil {
-				return fmt.Errorf("TimeForChildren check failed: %w", err)
+			if *checker.Pid != event.Pid.Value {
+				return fmt.Errorf("Pid has value %v which does not match expected value %v", event.Pid.Value, *checker.Pid)
 			}
 		}
-		if checker.Cgroup != nil {
-			if err := checker.Cgroup.Check(event.Cgroup); err != nil {
-				return fmt.Errorf("Cgroup check failed: %w", err)
+		if checker.MaybeExecProbe != nil {
+			if *checker.MaybeExecProbe != event.MaybeExecProbe {
+				return fmt.Errorf("MaybeExecProbe has value %t which does not match expected value %t", event.MaybeExecProbe, *checker.MaybeExecProbe)
 			}
 		}
-		if checker.User != nil {
-			if err := checker.User.Check(event.User); err != nil {
-				return fmt.Errorf("User check failed: %w", err)
+		if checker.SecurityContext != nil {
+			if err := checker.SecurityContext.Check(event.SecurityContext); err != nil {
+				return fmt.Errorf("SecurityContext check failed: %w", err)
 			}
 		}
 		return nil
@@ -3583,322 +3793,294 @@ func (checker *NamespacesChecker) Check(event *tetragon.Namespaces) error {
 	return nil
 }
 
-// WithUts adds a Uts check to the NamespacesChecker
-func (checker *NamespacesChecker) WithUts(check *NamespaceChecker) *NamespacesChecker {
-	checker.Uts = check
-	return checker
-}
-
-// WithIpc adds a Ipc check to the NamespacesChecker
-func (checker *NamespacesChecker) WithIpc(check *NamespaceChecker) *NamespacesChecker {
-	checker.Ipc = check
-	return checker
-}
-
-// WithMnt adds a Mnt check to the NamespacesChecker
-func (checker *NamespacesChecker) WithMnt(check *NamespaceChecker) *NamespacesChecker {
-	checker.Mnt = check
-	return checker
-}
-
-// WithPid adds a Pid check to the NamespacesChecker
-func (checker *NamespacesChecker) WithPid(check *NamespaceChecker) *NamespacesChecker {
-	checker.Pid = check
+// WithId adds a Id check to the ContainerChecker
+func (checker *ContainerChecker) WithId(check *stringmatcher.StringMatcher) *ContainerChecker {
+	checker.Id = check
 	return checker
 }
 
-// WithPidForChildren adds a PidForChildren check to the NamespacesChecker
-func (checker *NamespacesChecker) WithPidForChildren(check *NamespaceChecker) *NamespacesChecker {
-	checker.PidForChildren = check
+// WithName adds a Name check to the ContainerChecker
+func (checker *ContainerChecker) WithName(check *stringmatcher.StringMatcher) *ContainerChecker {
+	checker.Name = check
 	return checker
 }
 
-// WithNet adds a Net check to the NamespacesChecker
-func (checker *NamespacesChecker) WithNet(check *NamespaceChecker) *NamespacesChecker {
-	checker.Net = check
+// WithImage adds a Image check to the ContainerChecker
+func (checker *ContainerChecker) WithImage(check *ImageChecker) *ContainerChecker {
+	checker.Image = check
 	return checker
 }
 
-// WithTime adds a Time check to the NamespacesChecker
-func (checker *NamespacesChecker) WithTime(check *NamespaceChecker) *NamespacesChecker {
-	checker.Time = check
+// WithStartTime adds a StartTime check to the ContainerChecker
+func (checker *ContainerChecker) WithStartTime(check *timestampmatcher.TimestampMatcher) *ContainerChecker {
+	checker.StartTime = check
 	return checker
 }
 
-// WithTimeForChildren adds a TimeForChildren check to the NamespacesChecker
-func (checker *NamespacesChecker) WithTimeForChildren(check *NamespaceChecker) *NamespacesChecker {
-	checker.TimeForChildren = check
+// WithPid adds a Pid check to the ContainerChecker
+func (checker *ContainerChecker) WithPid(check uint32) *ContainerChecker {
+	checker.Pid = &check
 	return checker
 }
 
-// WithCgroup adds a Cgroup check to the NamespacesChecker
-func (checker *NamespacesChecker) WithCgroup(check *NamespaceChecker) *NamespacesChecker {
-	checker.Cgroup = check
+// WithMaybeExecProbe adds a MaybeExecProbe check to the ContainerChecker
+func (checker *ContainerChecker) WithMaybeExecProbe(check bool) *ContainerChecker {
+	checker.MaybeExecProbe = &check
 	return checker
 }
 
-// WithUser adds a User check to the NamespacesChecker
-func (checker *NamespacesChecker) WithUser(check *NamespaceChecker) *NamespacesChecker {
-	checker.User = check
+// WithSecurityContext adds a SecurityContext check to the ContainerChecker
+func (checker *ContainerChecker) WithSecurityContext(check *SecurityContextChecker) *ContainerChecker {
+	checker.SecurityContext = check
 	return checker
 }
 
-//FromNamespaces populates the NamespacesChecker using data from a Namespaces field
-func (checker *NamespacesChecker) FromNamespaces(event *tetragon.Namespaces) *NamespacesChecker {
+//FromContainer populates the ContainerChecker using data from a Container field
+func (checker *ContainerChecker) FromContainer(event *tetragon.Container) *ContainerChecker {
 	if event == nil {
 		return checker
 	}
-	if event.Uts != nil {
-		checker.Uts = NewNamespaceChecker().FromNamespace(event.Uts)
-	}
-	if event.Ipc != nil {
-		checker.Ipc = NewNamespaceChecker().FromNamespace(event.Ipc)
-	}
-	if event.Mnt != nil {
-		checker.Mnt = NewNamespaceChecker().FromNamespace(event.Mnt)
+	checker.Id = stringmatcher.Full(event.Id)
+	checker.Name = stringmatcher.Full(event.Name)
+	if event.Image != nil {
+		checker.Image = NewImageChecker().FromImage(event.Image)
 	}
+	// NB: We don't want to match timestamps for now
+	checker.StartTime = nil
 	if event.Pid != nil {
-		checker.Pid = NewNamespaceChecker().FromNamespace(event.Pid)
-	}
-	if event.PidForChildren != nil {
-		checker.PidForChildren = NewNamespaceChecker().FromNamespace(event.PidForChildren)
-	}
-	if event.Net != nil {
-		checker.Net = NewNamespaceChecker().FromNamespace(event.Net)
-	}
-	if event.Time != nil {
-		checker.Time = NewNamespaceChecker().FromNamespace(event.Time)
-	}
-	if event.TimeForChildren != nil {
-		checker.TimeForChildren = NewNamespaceChecker().FromNamespace(event.TimeForChildren)
+		val := event.Pid.Value
+		checker.Pid = &val
 	}
-	if event.Cgroup != nil {
-		checker.Cgroup = NewNamespaceChecker().FromNamespace(event.Cgroup)
+	{
+		val := event.MaybeExecProbe
+		checker.MaybeExecProbe = &val
 	}
-	if event.User != nil {
-		checker.User = NewNamespaceChecker().FromNamespace(event.User)
+	if event.SecurityContext != nil {
+		checker.SecurityContext = NewSecurityContextChecker().FromSecurityContext(event.SecurityContext)
 	}
 	return checker
 }
 
-// UserNamespaceChecker implements a checker struct to check a UserNamespace field
-type UserNamespaceChecker struct {
-	Level *int32            `json:"level,omitempty"`
-	Uid   *uint32           `json:"uid,omitempty"`
-	Gid   *uint32           `json:"gid,omitempty"`
-	Ns    *NamespaceChecker `json:"ns,omitempty"`
+// PodChecker implements a checker struct to check a Pod field
+type PodChecker struct {
+	Namespace      *stringmatcher.StringMatcher           `json:"namespace,omitempty"`
+	Name           *stringmatcher.StringMatcher           `json:"name,omitempty"`
+	Uid            *stringmatcher.StringMatcher           `json:"uid,omitempty"`
+	Container      *ContainerChecker                      `json:"container,omitempty"`
+	PodLabels      map[string]stringmatcher.StringMatcher `json:"podLabels,omitempty"`
+	Workload       *stringmatcher.StringMatcher           `json:"workload,omitempty"`
+	WorkloadKind   *stringmatcher.StringMatcher           `json:"workloadKind,omitempty"`
+	PodAnnotations map[string]stringmatcher.StringMatcher `json:"podAnnotations,omitempty"`
 }
 
-// NewUserNamespaceChecker creates a new UserNamespaceChecker
-func NewUserNamespaceChecker() *UserNamespaceChecker {
-	return &UserNamespaceChecker{}
+// NewPodChecker creates a new PodChecker
+func NewPodChecker() *PodChecker {
+	return &PodChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *UserNamespaceChecker) GetCheckerType() string {
-	return "UserNamespaceChecker"
+func (checker *PodChecker) GetCheckerType() string {
+	return "PodChecker"
 }
 
-// Check checks a UserNamespace field
-func (checker *UserNamespaceChecker) Check(event *tetragon.UserNamespace) error {
+// Check checks a Pod field
+func (checker *PodChecker) Check(event *tetragon.Pod) error {
 	if event == nil {
-		return fmt.Errorf("%s: UserNamespace field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: Pod field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Level != nil {
-			if event.Level == nil {
-				return fmt.Errorf("Level is nil and does not match expected value %v", *checker.Level)
-			}
-			if *checker.Level != event.Level.Value {
-				return fmt.Errorf("Level has value %v which does not match expected value %v", event.Level.Value, *checker.Level)
+		if checker.Namespace != nil {
+			if err := checker.Namespace.Match(event.Namespace); err != nil {
+				return fmt.Errorf("Namespace check failed: %w", err)
 			}
 		}
-		if checker.Uid != nil {
-			if event.Uid == nil {
-				return fmt.Errorf("Uid is nil and does not match expected value %v", *checker.Uid)
-			}
-			if *checker.Uid != event.Uid.Value {
-				return fmt.Errorf("Uid has value %v which does not match expected value %v", event.Uid.Value, *checker.Uid)
+		if checker.Name != nil {
+			if err := checker.Name.Match(event.Name); err != nil {
+				return fmt.Errorf("Name check failed: %w", err)
 			}
 		}
-		if checker.Gid != nil {
-			if event.Gid == nil {
-				return fmt.Errorf("Gid is nil and does not match expected value %v", *checker.Gid)
-			}
-			if *checker.Gid != event.Gid.Value {
-				return fmt.Errorf("Gid has value %v which does not match expected value %v", event.Gid.Value, *checker.Gid)
+		if checker.Uid != nil {
+			if err := checker.Uid.Match(event.Uid); err != nil {
+				return fmt.Errorf("Uid check failed: %w", err)
 			}
 		}
-		if checker.Ns != nil {
-			if err := checker.Ns.Check(event.Ns); err != nil {
-				return fmt.Errorf("Ns check failed: %w", err)
+		if checker.Container != nil {
+			if err := checker.Container.Check(event.Container); err != nil {
+				return fmt.Errorf("Container check failed: %w", err)
 			}
 		}
-		return nil
-	}
-	if err := fieldChecks(); err != nil {
-		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+		{
+			var unmatched []string
+			matched := make(map[string]struct{})
+			for key, value := range event.PodLabels {
+				if len(checker.PodLabels) > 0 {
+					// Attempt to grab the matcher for this key
+					if matcher, ok := checker.PodLabels[key]; ok {
+						if err := matcher.Match(value); err != nil {
+							return fmt.Errorf("PodLabels[%s] (%s=%s) check failed: %w", key, key, value, err)
+						}
+						matched[key] = struct{}{}
+					}
+				}
+			}
+
+			// See if we have any unmatched values that we wanted to match
+			if len(matched) != len(checker.PodLabels) {
+				for k := range checker.PodLabels {
+					if _, ok := matched[k]; !ok {
+						unmatched = append(unmatched, k)
+					}
+				}
+				return fmt.Errorf("PodLabels unmatched: %v", unmatched)
+			}
+		}
+		if checker.Workload != nil {
+			if err := checker.Workload.Match(event.Workload); err != nil {
+				return fmt.Errorf("Workload check failed: %w", err)
+			}
+		}
+		if checker.WorkloadKind != nil {
+			if err := checker.WorkloadKind.Match(event.WorkloadKind); err != nil {
+				return fmt.Errorf("WorkloadKind check failed: %w", err)
+			}
+		}
+		{
+			var unmatched []string
+			matched := make(map[string]struct{})
+			for key, value := range event.PodAnnotations {
+				if len(checker.PodAnnotations) > 0 {
+					// Attempt to grab the matcher for this key
+					if matcher, ok := checker.PodAnnotations[key]; ok {
+						if err := matcher.Match(value); err != nil {
+							return fmt.Errorf("PodAnnotations[%s] (%s=%s) check failed: %w", key, key, value, err)
+						}
+						matched[key] = struct{}{}
+					}
+				}
+			}
+
+			// See if we have any unmatched values that we wanted to match
+			if len(matched) != len(checker.PodAnnotations) {
+				for k := range checker.PodAnnotations {
+					if _, ok := matched[k]; !ok {
+						unmatched = append(unmatched, k)
+					}
+				}
+				return fmt.Errorf("PodAnnotations unmatched: %v", unmatched)
+			}
+		}
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
 	}
 	return nil
 }
 
-// WithLevel adds a Level check to the UserNamespaceChecker
-func (checker *UserNamespaceChecker) WithLevel(check int32) *UserNamespaceChecker {
-	checker.Level = &check
+// WithNamespace adds a Namespace check to the PodChecker
+func (checker *PodChecker) WithNamespace(check *stringmatcher.StringMatcher) *PodChecker {
+	checker.Namespace = check
 	return checker
 }
 
-// WithUid adds a Uid check to the UserNamespaceChecker
-func (checker *UserNamespaceChecker) WithUid(check uint32) *UserNamespaceChecker {
-	checker.Uid = &check
+// WithName adds a Name check to the PodChecker
+func (checker *PodChecker) WithName(check *stringmatcher.StringMatcher) *PodChecker {
+	checker.Name = check
 	return checker
 }
 
-// WithGid adds a Gid check to the UserNamespaceChecker
-func (checker *UserNamespaceChecker) WithGid(check uint32) *UserNamespaceChecker {
-	checker.Gid = &check
+// WithUid adds a Uid check to the PodChecker
+func (checker *PodChecker) WithUid(check *stringmatcher.StringMatcher) *PodChecker {
+	checker.Uid = check
 	return checker
 }
 
-// WithNs adds a Ns check to the UserNamespaceChecker
-func (checker *UserNamespaceChecker) WithNs(check *NamespaceChecker) *UserNamespaceChecker {
-	checker.Ns = check
+// WithContainer adds a Container check to the PodChecker
+func (checker *PodChecker) WithContainer(check *ContainerChecker) *PodChecker {
+	checker.Container = check
 	return checker
 }
 
-//FromUserNamespace populates the UserNamespaceChecker using data from a UserNamespace field
-func (checker *UserNamespaceChecker) FromUserNamespace(event *tetragon.UserNamespace) *UserNamespaceChecker {
+// WithPodLabels adds a PodLabels check to the PodChecker
+func (checker *PodChecker) WithPodLabels(check map[string]stringmatcher.StringMatcher) *PodChecker {
+	checker.PodLabels = check
+	return checker
+}
+
+// WithWorkload adds a Workload check to the PodChecker
+func (checker *PodChecker) WithWorkload(check *stringmatcher.StringMatcher) *PodChecker {
+	checker.Workload = check
+	return checker
+}
+
+// WithWorkloadKind adds a WorkloadKind check to the PodChecker
+func (checker *PodChecker) WithWorkloadKind(check *stringmatcher.StringMatcher) *PodChecker {
+	checker.WorkloadKind = check
+	return checker
+}
+
+// WithPodAnnotations adds a PodAnnotations check to the PodChecker
+func (checker *PodChecker) WithPodAnnotations(check map[string]stringmatcher.StringMatcher) *PodChecker {
+	checker.PodAnnotations = check
+	return checker
+}
+
+//FromPod populates the PodChecker using data from a Pod field
+func (checker *PodChecker) FromPod(event *tetragon.Pod) *PodChecker {
 	if event == nil {
 		return checker
 	}
-	if event.Level != nil {
-		val := event.Level.Value
-		checker.Level = &val
-	}
-	if event.Uid != nil {
-		val := event.Uid.Value
-		checker.Uid = &val
-	}
-	if event.Gid != nil {
-		val := event.Gid.Value
-		checker.Gid = &val
-	}
-	if event.Ns != nil {
-		checker.Ns = NewNamespaceChecker().FromNamespace(event.Ns)
+	checker.Namespace = stringmatcher.Full(event.Namespace)
+	checker.Name = stringmatcher.Full(event.Name)
+	checker.Uid = stringmatcher.Full(event.Uid)
+	if event.Container != nil {
+		checker.Container = NewContainerChecker().FromContainer(event.Container)
 	}
+	// TODO: implement fromMap
+	checker.Workload = stringmatcher.Full(event.Workload)
+	checker.WorkloadKind = stringmatcher.Full(event.WorkloadKind)
+	// TODO: implement fromMap
 	return checker
 }
 
-// ProcessCredentialsChecker implements a checker struct to check a ProcessCredentials field
-type ProcessCredentialsChecker struct {
-	Uid        *uint32                    `json:"uid,omitempty"`
-	Gid        *uint32                    `json:"gid,omitempty"`
-	Euid       *uint32                    `json:"euid,omitempty"`
-	Egid       *uint32                    `json:"egid,omitempty"`
-	Suid       *uint32                    `json:"suid,omitempty"`
-	Sgid       *uint32                    `json:"sgid,omitempty"`
-	Fsuid      *uint32                    `json:"fsuid,omitempty"`
-	Fsgid      *uint32                    `json:"fsgid,omitempty"`
-	Securebits *SecureBitsTypeListMatcher `json:"securebits,omitempty"`
-	Caps       *CapabilitiesChecker       `json:"caps,omitempty"`
-	UserNs     *UserNamespaceChecker      `json:"userNs,omitempty"`
+// CapabilitiesChecker implements a checker struct to check a Capabilities field
+type CapabilitiesChecker struct {
+	Permitted   *CapabilitiesTypeListMatcher `json:"permitted,omitempty"`
+	Effective   *CapabilitiesTypeListMatcher `json:"effective,omitempty"`
+	Inheritable *CapabilitiesTypeListMatcher `json:"inheritable,omitempty"`
 }
 
-// NewProcessCredentialsChecker creates a new ProcessCredentialsChecker
-func NewProcessCredentialsChecker() *ProcessCredentialsChecker {
-	return &ProcessCredentialsChecker{}
+// NewCapabilitiesChecker creates a new CapabilitiesChecker
+func NewCapabilitiesChecker() *CapabilitiesChecker {
+	return &CapabilitiesChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *ProcessCredentialsChecker) GetCheckerType() string {
-	return "ProcessCredentialsChecker"
+func (checker *CapabilitiesChecker) GetCheckerType() string {
+	return "CapabilitiesChecker"
 }
 
-// Check checks a ProcessCredentials field
-func (checker *ProcessCredentialsChecker) Check(event *tetragon.ProcessCredentials) error {
+// Check checks a Capabilities field
+func (checker *CapabilitiesChecker) Check(event *tetragon.Capabilities) error {
 	if event == nil {
-		return fmt.Errorf("%s: ProcessCredentials field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: Capabilities field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Uid != nil {
-			if event.Uid == nil {
-				return fmt.Errorf("Uid is nil and does not match expected value %v", *checker.Uid)
-			}
-			if *checker.Uid != event.Uid.Value {
-				return fmt.Errorf("Uid has value %v which does not match expected value %v", event.Uid.Value, *checker.Uid)
-			}
-		}
-		if checker.Gid != nil {
-			if event.Gid == nil {
-				return fmt.Errorf("Gid is nil and does not match expected value %v", *checker.Gid)
-			}
-			if *checker.Gid != event.Gid.Value {
-				return fmt.Errorf("Gid has value %v which does not match expected value %v", event.Gid.Value, *checker.Gid)
-			}
-		}
-		if checker.Euid != nil {
-			if event.Euid == nil {
-				return fmt.Errorf("Euid is nil and does not match expected value %v", *checker.Euid)
-			}
-			if *checker.Euid != event.Euid.Value {
-				return fmt.Errorf("Euid has value %v which does not match expected value %v", event.Euid.Value, *checker.Euid)
-			}
-		}
-		if checker.Egid != nil {
-			if event.Egid == nil {
-				return fmt.Errorf("Egid is nil and does not match expected value %v", *checker.Egid)
-			}
-			if *checker.Egid != event.Egid.Value {
-				return fmt.Errorf("Egid has value %v which does not match expected value %v", event.Egid.Value, *checker.Egid)
-			}
-		}
-		if checker.Suid != nil {
-			if event.Suid == nil {
-				return fmt.Errorf("Suid is nil and does not match expected value %v", *checker.Suid)
-			}
-			if *checker.Suid != event.Suid.Value {
-				return fmt.Errorf("Suid has value %v which does not match expected value %v", event.Suid.Value, *checker.Suid)
-			}
-		}
-		if checker.Sgid != nil {
-			if event.Sgid == nil {
-				return fmt.Errorf("Sgid is nil and does not match expected value %v", *checker.Sgid)
-			}
-			if *checker.Sgid != event.Sgid.Value {
-				return fmt.Errorf("Sgid has value %v which does not match expected value %v", event.Sgid.Value, *checker.Sgid)
-			}
-		}
-		if checker.Fsuid != nil {
-			if event.Fsuid == nil {
-				return fmt.Errorf("Fsuid is nil and does not match expected value %v", *checker.Fsuid)
-			}
-			if *checker.Fsuid != event.Fsuid.Value {
-				return fmt.Errorf("Fsuid has value %v which does not match expected value %v", event.Fsuid.Value, *checker.Fsuid)
-			}
-		}
-		if checker.Fsgid != nil {
-			if event.Fsgid == nil {
-				return fmt.Errorf("Fsgid is nil and does not match expected value %v", *checker.Fsgid)
-			}
-			if *checker.Fsgid != event.Fsgid.Value {
-				return fmt.Errorf("Fsgid has value %v which does not match expected value %v", event.Fsgid.Value, *checker.Fsgid)
-			}
-		}
-		if checker.Securebits != nil {
-			if err := checker.Securebits.Check(event.Securebits); err != nil {
-				return fmt.Errorf("Securebits check failed: %w", err)
+		if checker.Permitted != nil {
+			if err := checker.Permitted.Check(event.Permitted); err != nil {
+				return fmt.Errorf("Permitted check failed: %w", err)
 			}
 		}
-		if checker.Caps != nil {
-			if err := checker.Caps.Check(event.Caps); err != nil {
-				return fmt.Errorf("Caps check failed: %w", err)
+		if checker.Effective != nil {
+			if err := checker.Effective.Check(event.Effective); err != nil {
+				return fmt.Errorf("Effective check failed: %w", err)
 			}
 		}
-		if checker.UserNs != nil {
-			if err := checker.UserNs.Check(event.UserNs); err != nil {
-				return fmt.Errorf("UserNs check failed: %w", err)
+		if checker.Inheritable != nil {
+			if err := checker.Inheritable.Check(event.Inheritable); err != nil {
+				return fmt.Errorf("Inheritable check failed: %w", err)
 			}
 		}
 		return nil
@@ -3909,156 +4091,92 @@ func (checker *ProcessCredentialsChecker) Check(event *tetragon.ProcessCredentia
 	return nil
 }
 
-// WithUid adds a Uid check to the ProcessCredentialsChecker
-func (checker *ProcessCredentialsChecker) WithUid(check uint32) *ProcessCredentialsChecker {
-	checker.Uid = &check
-	return checker
-}
-
-// WithGid adds a Gid check to the ProcessCredentialsChecker
-func (checker *ProcessCredentialsChecker) WithGid(check uint32) *ProcessCredentialsChecker {
-	checker.Gid = &check
-	return checker
-}
-
-// WithEuid adds a Euid check to the ProcessCredentialsChecker
-func (checker *ProcessCredentialsChecker) WithEuid(check uint32) *ProcessCredentialsChecker {
-	checker.Euid = &check
-	return checker
-}
-
-// WithEgid adds a Egid check to the ProcessCredentialsChecker
-func (checker *ProcessCredentialsChecker) WithEgid(check uint32) *ProcessCredentialsChecker {
-	checker.Egid = &check
-	return checker
-}
-
-// WithSuid adds a Suid check to the ProcessCredentialsChecker
-func (checker *ProcessCredentialsChecker) WithSuid(check uint32) *ProcessCredentialsChecker {
-	checker.Suid = &check
-	return checker
-}
-
-// WithSgid adds a Sgid check to the ProcessCredentialsChecker
-func (checker *ProcessCredentialsChecker) WithSgid(check uint32) *ProcessCredentialsChecker {
-	checker.Sgid = &check
-	return checker
-}
-
-// WithFsuid adds a Fsuid check to the ProcessCredentialsChecker
-func (checker *ProcessCredentialsChecker) WithFsuid(check uint32) *ProcessCredentialsChecker {
-	checker.Fsuid = &check
-	return checker
-}
-
-// WithFsgid adds a Fsgid check to the ProcessCredentialsChecker
-func (checker *ProcessCredentialsChecker) WithFsgid(check uint32) *ProcessCredentialsChecker {
-	checker.Fsgid = &check
-	return checker
-}
-
-// WithSecurebits adds a Securebits check to the ProcessCredentialsChecker
-func (checker *ProcessCredentialsChecker) WithSecurebits(check *SecureBitsTypeListMatcher) *ProcessCredentialsChecker {
-	checker.Securebits = check
+// WithPermitted adds a Permitted check to the CapabilitiesChecker
+func (checker *CapabilitiesChecker) WithPermitted(check *CapabilitiesTypeListMatcher) *CapabilitiesChecker {
+	checker.Permitted = check
 	return checker
 }
 
-// WithCaps adds a Caps check to the ProcessCredentialsChecker
-func (checker *ProcessCredentialsChecker) WithCaps(check *CapabilitiesChecker) *ProcessCredentialsChecker {
-	checker.Caps = check
+// WithEffective adds a Effective check to the CapabilitiesChecker
+func (checker *CapabilitiesChecker) WithEffective(check *CapabilitiesTypeListMatcher) *CapabilitiesChecker {
+	checker.Effective = check
 	return checker
 }
 
-// WithUserNs adds a UserNs check to the ProcessCredentialsChecker
-func (checker *ProcessCredentialsChecker) WithUserNs(check *UserNamespaceChecker) *ProcessCredentialsChecker {
-	checker.UserNs = check
+// WithInheritable adds a Inheritable check to the CapabilitiesChecker
+func (checker *CapabilitiesChecker) WithInheritable(check *CapabilitiesTypeListMatcher) *CapabilitiesChecker {
+	checker.Inheritable = check
 	return checker
 }
 
-//FromProcessCredentials populates the ProcessCredentialsChecker using data from a ProcessCredentials field
-func (checker *ProcessCredentialsChecker) FromProcessCredentials(event *tetragon.ProcessCredentials) *ProcessCredentialsChecker {
+//FromCapabilities populates the CapabilitiesChecker using data from a Capabilities field
+func (checker *CapabilitiesChecker) FromCapabilities(event *tetragon.Capabilities) *CapabilitiesChecker {
 	if event == nil {
 		return checker
 	}
-	if event.Uid != nil {
-		val := event.Uid.Value
-		checker.Uid = &val
-	}
-	if event.Gid != nil {
-		val := event.Gid.Value
-		checker.Gid = &val
-	}
-	if event.Euid != nil {
-		val := event.Euid.Value
-		checker.Euid = &val
-	}
-	if event.Egid != nil {
-		val := event.Egid.Value
-		checker.Egid = &val
-	}
-	if event.Suid != nil {
-		val := event.Suid.Value
-		checker.Suid = &val
-	}
-	if event.Sgid != nil {
-		val := event.Sgid.Value
-		checker.Sgid = &val
-	}
-	if event.Fsuid != nil {
-		val := event.Fsuid.Value
-		checker.Fsuid = &val
-	}
-	if event.Fsgid != nil {
-		val := event.Fsgid.Value
-		checker.Fsgid = &val
-	}
 	{
-		var checks []*SecureBitsTypeChecker
-		for _, check := range event.Securebits {
-			var convertedCheck *SecureBitsTypeChecker
-			convertedCheck = NewSecureBitsTypeChecker(check)
+		var checks []*CapabilitiesTypeChecker
+		for _, check := range event.Permitted {
+			var convertedCheck *CapabilitiesTypeChecker
+			convertedCheck = NewCapabilitiesTypeChecker(check)
 			checks = append(checks, convertedCheck)
 		}
-		lm := NewSecureBitsTypeListMatcher().WithOperator(listmatcher.Ordered).
+		lm := NewCapabilitiesTypeListMatcher().WithOperator(listmatcher.Ordered).
 			WithValues(checks...)
-		checker.Securebits = lm
+		checker.Permitted = lm
 	}
-	if event.Caps != nil {
-		checker.Caps = NewCapabilitiesChecker().FromCapabilities(event.Caps)
+	{
+		var checks []*CapabilitiesTypeChecker
+		for _, check := range event.Effective {
+			var convertedCheck *CapabilitiesTypeChecker
+			convertedCheck = NewCapabilitiesTypeChecker(check)
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewCapabilitiesTypeListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Effective = lm
 	}
-	if event.UserNs != nil {
-		checker.UserNs = NewUserNamespaceChecker().FromUserNamespace(event.UserNs)
+	{
+		var checks []*CapabilitiesTypeChecker
+		for _, check := range event.Inheritable {
+			var convertedCheck *CapabilitiesTypeChecker
+			convertedCheck = NewCapabilitiesTypeChecker(check)
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewCapabilitiesTypeListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Inheritable = lm
 	}
 	return checker
 }
 
-// SecureBitsTypeListMatcher checks a list of tetragon.SecureBitsType fields
-type SecureBitsTypeListMatcher struct {
-	Operator listmatcher.Operator     `json:"operator"`
-	Values   []*SecureBitsTypeChecker `json:"values"`
+// CapabilitiesTypeListMatcher checks a list of tetragon.CapabilitiesType fields
+type CapabilitiesTypeListMatcher struct {
+	Operator listmatcher.Operator       `json:"operator"`
+	Values   []*CapabilitiesTypeChecker `json:"values"`
 }
 
-// NewSecureBitsTypeListMatcher creates a new SecureBitsTypeListMatcher. The checker defaults to a subset checker unless otherwise specified using WithOperator()
-func NewSecureBitsTypeListMatcher() *SecureBitsTypeListMatcher {
-	return &SecureBitsTypeListMatcher{
+// NewCapabilitiesTypeListMatcher creates a new CapabilitiesTypeListMatcher. The checker defaults to a subset checker unless otherwise specified using WithOperator()
+func NewCapabilitiesTypeListMatcher() *CapabilitiesTypeListMatcher {
+	return &CapabilitiesTypeListMatcher{
 		Operator: listmatcher.Subset,
 	}
 }
 
-// WithOperator sets the match kind for the SecureBitsTypeListMatcher
-func (checker *SecureBitsTypeListMatcher) WithOperator(operator listmatcher.Operator) *SecureBitsTypeListMatcher {
+// WithOperator sets the match kind for the CapabilitiesTypeListMatcher
+func (checker *CapabilitiesTypeListMatcher) WithOperator(operator listmatcher.Operator) *CapabilitiesTypeListMatcher {
 	checker.Operator = operator
 	return checker
 }
 
-// WithValues sets the checkers that the SecureBitsTypeListMatcher should use
-func (checker *SecureBitsTypeListMatcher) WithValues(values ...*SecureBitsTypeChecker) *SecureBitsTypeListMatcher {
+// WithValues sets the checkers that the CapabilitiesTypeListMatcher should use
+func (checker *CapabilitiesTypeListMatcher) WithValues(values ...*CapabilitiesTypeChecker) *CapabilitiesTypeListMatcher {
 	checker.Values = values
 	return checker
 }
 
-// Check checks a list of tetragon.SecureBitsType fields
-func (checker *SecureBitsTypeListMatcher) Check(values []tetragon.SecureBitsType) error {
+// Check checks a list of tetragon.CapabilitiesType fields
+func (checker *CapabilitiesTypeListMatcher) Check(values []tetragon.CapabilitiesType) error {
 	switch checker.Operator {
 	case listmatcher.Ordered:
 		return checker.orderedCheck(values)
@@ -4071,43 +4189,43 @@ func (checker *SecureBitsTypeListMatcher) Check(values []tetragon.SecureBitsType
 	}
 }
 
-// orderedCheck checks a list of ordered tetragon.SecureBitsType fields
-func (checker *SecureBitsTypeListMatcher) orderedCheck(values []tetragon.SecureBitsType) error {
-	innerCheck := func(check *SecureBitsTypeChecker, value tetragon.SecureBitsType) error {
+// orderedCheck checks a list of ordered tetragon.CapabilitiesType fields
+func (checker *CapabilitiesTypeListMatcher) orderedCheck(values []tetragon.CapabilitiesType) error {
+	innerCheck := func(check *CapabilitiesTypeChecker, value tetragon.CapabilitiesType) error {
 		if err := check.Check(&value); err != nil {
-			return fmt.Errorf("Securebits check failed: %w", err)
+			return fmt.Errorf("Permitted check failed: %w", err)
 		}
 		return nil
 	}
 
 	if len(checker.Values) != len(values) {
-		return fmt.Errorf("SecureBitsTypeListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+		return fmt.Errorf("CapabilitiesTypeListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
 	}
 
 	for i, check := range checker.Values {
 		value := values[i]
 		if err := innerCheck(check, value); err != nil {
-			return fmt.Errorf("SecureBitsTypeListMatcher: Check failed on element %d: %w", i, err)
+			return fmt.Errorf("CapabilitiesTypeListMatcher: Check failed on element %d: %w", i, err)
 		}
 	}
 
 	return nil
 }
 
-// unorderedCheck checks a list of unordered tetragon.SecureBitsType fields
-func (checker *SecureBitsTypeListMatcher) unorderedCheck(values []tetragon.SecureBitsType) error {
+// unorderedCheck checks a list of unordered tetragon.CapabilitiesType fields
+func (checker *CapabilitiesTypeListMatcher) unorderedCheck(values []tetragon.CapabilitiesType) error {
 	if len(checker.Values) != len(values) {
-		return fmt.Errorf("SecureBitsTypeListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+		return fmt.Errorf("CapabilitiesTypeListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
 	}
 
 	return checker.subsetCheck(values)
 }
 
-// subsetCheck checks a subset of tetragon.SecureBitsType fields
-func (checker *SecureBitsTypeListMatcher) subsetCheck(values []tetragon.SecureBitsType) error {
-	innerCheck := func(check *SecureBitsTypeChecker, value tetragon.SecureBitsType) error {
+// subsetCheck checks a subset of tetragon.CapabilitiesType fields
+func (checker *CapabilitiesTypeListMatcher) subsetCheck(values []tetragon.CapabilitiesType) error {
+	innerCheck := func(check *CapabilitiesTypeChecker, value tetragon.CapabilitiesType) error {
 		if err := check.Check(&value); err != nil {
-			return fmt.Errorf("Securebits check failed: %w", err)
+			return fmt.Errorf("Permitted check failed: %w", err)
 		}
 		return nil
 	}
@@ -4126,46 +4244,43 @@ nextCheck:
 	}
 
 	if numMatched < numDesired {
-		return fmt.Errorf("SecureBitsTypeListMatcher: Check failed, only matched %d elements but wanted %d", numMatched, numDesired)
+		return fmt.Errorf("CapabilitiesTypeListMatcher: Check failed, only matched %d elements but wanted %d", numMatched, numDesired)
 	}
 
 	return nil
 }
 
-// InodePropertiesChecker implements a checker struct to check a InodeProperties field
-type InodePropertiesChecker struct {
-	Number *uint64 `json:"number,omitempty"`
-	Links  *uint32 `json:"links,omitempty"`
+// NamespaceChecker implements a checker struct to check a Namespace field
+type NamespaceChecker struct {
+	Inum   *uint32 `json:"inum,omitempty"`
+	IsHost *bool   `json:"isHost,omitempty"`
 }
 
-// NewInodePropertiesChecker creates a new InodePropertiesChecker
-func NewInodePropertiesChecker() *InodePropertiesChecker {
-	return &InodePropertiesChecker{}
+// NewNamespaceChecker creates a new NamespaceChecker
+func NewNamespaceChecker() *NamespaceChecker {
+	return &NamespaceChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *InodePropertiesChecker) GetCheckerType() string {
-	return "InodePropertiesChecker"
+func (checker *NamespaceChecker) GetCheckerType() string {
+	return "NamespaceChecker"
 }
 
-// Check checks a InodeProperties field
-func (checker *InodePropertiesChecker) Check(event *tetragon.InodeProperties) error {
+// Check checks a Namespace field
+func (checker *NamespaceChecker) Check(event *tetragon.Namespace) error {
 	if event == nil {
-		return fmt.Errorf("%s: InodeProperties field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: Namespace field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Number != nil {
-			if *checker.Number != event.Number {
-				return fmt.Errorf("Number has value %d which does not match expected value %d", event.Number, *checker.Number)
+		if checker.Inum != nil {
+			if *checker.Inum != event.Inum {
+				return fmt.Errorf("Inum has value %d which does not match expected value %d", event.Inum, *checker.Inum)
 			}
 		}
-		if checker.Links != nil {
-			if event.Links == nil {
-				return fmt.Errorf("Links is nil and does not match expected value %v", *checker.Links)
-			}
-			if *checker.Links != event.Links.Value {
-				return fmt.Errorf("Links has value %v which does not match expected value %v", event.Links.Value, *checker.Links)
+		if checker.IsHost != nil {
+			if *checker.IsHost != event.IsHost {
+				return fmt.Errorf("IsHost has value %t which does not match expected value %t", event.IsHost, *checker.IsHost)
 			}
 		}
 		return nil
@@ -4176,65 +4291,113 @@ func (checker *InodePropertiesChecker) Check(event *tetragon.InodeProperties) er
 	return nil
 }
 
-// WithNumber adds a Number check to the InodePropertiesChecker
-func (checker *InodePropertiesChecker) WithNumber(check uint64) *InodePropertiesChecker {
-	checker.Number = &check
+// WithInum adds a Inum check to the NamespaceChecker
+func (checker *NamespaceChecker) WithInum(check uint32) *NamespaceChecker {
+	checker.Inum = &check
 	return checker
 }
 
-// WithLinks adds a Links check to the InodePropertiesChecker
-func (checker *InodePropertiesChecker) WithLinks(check uint32) *InodePropertiesChecker {
-	checker.Links = &check
+// WithIsHost adds a IsHost check to the NamespaceChecker
+func (checker *NamespaceChecker) WithIsHost(check bool) *NamespaceChecker {
+	checker.IsHost = &check
 	return checker
 }
 
-//FromInodeProperties populates the InodePropertiesChecker using data from a InodeProperties field
-func (checker *InodePropertiesChecker) FromInodeProperties(event *tetragon.InodeProperties) *InodePropertiesChecker {
+//FromNamespace populates the NamespaceChecker using data from a Namespace field
+func (checker *NamespaceChecker) FromNamespace(event *tetragon.Namespace) *NamespaceChecker {
 	if event == nil {
 		return checker
 	}
 	{
-		val := event.Number
-		checker.Number = &val
+		val := event.Inum
+		checker.Inum = &val
 	}
-	if event.Links != nil {
-		val := event.Links.Value
-		checker.Links = &val
+	{
+		val := event.IsHost
+		checker.IsHost = &val
 	}
 	return checker
 }
 
-// FilePropertiesChecker implements a checker struct to check a FileProperties field
-type FilePropertiesChecker struct {
-	Inode *InodePropertiesChecker      `json:"inode,omitempty"`
-	Path  *stringmatcher.StringMatcher `json:"path,omitempty"`
+// NamespacesChecker implements a checker struct to check a Namespaces field
+type NamespacesChecker struct {
+	Uts             *NamespaceChecker `json:"uts,omitempty"`
+	Ipc             *NamespaceChecker `json:"ipc,omitempty"`
+	Mnt             *NamespaceChecker `json:"mnt,omitempty"`
+	Pid             *NamespaceChecker `json:"pid,omitempty"`
+	PidForChildren  *NamespaceChecker `json:"pidForChildren,omitempty"`
+	Net             *NamespaceChecker `json:"net,omitempty"`
+	Time            *NamespaceChecker `json:"time,omitempty"`
+	TimeForChildren *NamespaceChecker `json:"timeForChildren,omitempty"`
+	Cgroup          *NamespaceChecker `json:"cgroup,omitempty"`
+	User            *NamespaceChecker `json:"user,omitempty"`
 }
 
-// NewFilePropertiesChecker creates a new FilePropertiesChecker
-func NewFilePropertiesChecker() *FilePropertiesChecker {
-	return &FilePropertiesChecker{}
+// NewNamespacesChecker creates a new NamespacesChecker
+func NewNamespacesChecker() *NamespacesChecker {
+	return &NamespacesChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *FilePropertiesChecker) GetCheckerType() string {
-	return "FilePropertiesChecker"
+func (checker *NamespacesChecker) GetCheckerType() string {
+	return "NamespacesChecker"
 }
 
-// Check checks a FileProperties field
-func (checker *FilePropertiesChecker) Check(event *tetragon.FileProperties) error {
+// Check checks a Namespaces field
+func (checker *NamespacesChecker) Check(event *tetragon.Namespaces) error {
 	if event == nil {
-		return fmt.Errorf("%s: FileProperties field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: Namespaces field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Inode != nil {
-			if err := checker.Inode.Check(event.Inode); err != nil {
-				return fmt.Errorf("Inode check failed: %w", err)
+		if checker.Uts != nil {
+			if err := checker.Uts.Check(event.Uts); err != nil {
+				return fmt.Errorf("Uts check failed: %w", err)
 			}
 		}
-		if checker.Path != nil {
-			if err := checker.Path.Match(event.Path); err != nil {
-				return fmt.Errorf("Path check failed: %w", err)
+		if checker.Ipc != nil {
+			if err := checker.Ipc.Check(event.Ipc); err != nil {
+				return fmt.Errorf("Ipc check failed: %w", err)
+			}
+		}
+		if checker.Mnt != nil {
+			if err := checker.Mnt.Check(event.Mnt); err != nil {
+				return fmt.Errorf("Mnt check failed: %w", err)
+			}
+		}
+		if checker.Pid != nil {
+			if err := checker.Pid.Check(event.Pid); err != nil {
+				return fmt.Errorf("Pid check failed: %w", err)
+			}
+		}
+		if checker.PidForChildren != nil {
+			if err := checker.PidForChildren.Check(event.PidForChildren); err != nil {
+				return fmt.Errorf("PidForChildren check failed: %w", err)
+			}
+		}
+		if checker.Net != nil {
+			if err := checker.Net.Check(event.Net); err != nil {
+				return fmt.Errorf("Net check failed: %w", err)
+			}
+		}
+		if checker.Time != nil {
+			if err := checker.Time.Check(event.Time); err != nil {
+				return fmt.Errorf("Time check failed: %w", err)
+			}
+		}
+		if checker.TimeForChildren != nil {
+			if err := checker.TimeForChildren.Check(event.TimeForChildren); err != nil {
+				return fmt.Errorf("TimeForChildren check failed: %w", err)
+			}
+		}
+		if checker.Cgroup != nil {
+			if err := checker.Cgroup.Check(event.Cgroup); err != nil {
+				return fmt.Errorf("Cgroup check failed: %w", err)
+			}
+		}
+		if checker.User != nil {
+			if err := checker.User.Check(event.User); err != nil {
+				return fmt.Errorf("User check failed: %w", err)
 			}
 		}
 		return nil
@@ -4245,79 +4408,156 @@ func (checker *FilePropertiesChecker) Check(event *tetragon.FileProperties) erro
 	return nil
 }
 
-// WithInode adds a Inode check to the FilePropertiesChecker
-func (checker *FilePropertiesChecker) WithInode(check *InodePropertiesChecker) *FilePropertiesChecker {
-	checker.Inode = check
+// WithUts adds a Uts check to the NamespacesChecker
+func (checker *NamespacesChecker) WithUts(check *NamespaceChecker) *NamespacesChecker {
+	checker.Uts = check
 	return checker
 }
 
-// WithPath adds a Path check to the FilePropertiesChecker
-func (checker *FilePropertiesChecker) WithPath(check *stringmatcher.StringMatcher) *FilePropertiesChecker {
-	checker.Path = check
+// WithIpc adds a Ipc check to the NamespacesChecker
+func (checker *NamespacesChecker) WithIpc(check *NamespaceChecker) *NamespacesChecker {
+	checker.Ipc = check
 	return checker
 }
 
-//FromFileProperties populates the FilePropertiesChecker using data from a FileProperties field
-func (checker *FilePropertiesChecker) FromFileProperties(event *tetragon.FileProperties) *FilePropertiesChecker {
+// WithMnt adds a Mnt check to the NamespacesChecker
+func (checker *NamespacesChecker) WithMnt(check *NamespaceChecker) *NamespacesChecker {
+	checker.Mnt = check
+	return checker
+}
+
+// WithPid adds a Pid check to the NamespacesChecker
+func (checker *NamespacesChecker) WithPid(check *NamespaceChecker) *NamespacesChecker {
+	checker.Pid = check
+	return checker
+}
+
+// WithPidForChildren adds a PidForChildren check to the NamespacesChecker
+func (checker *NamespacesChecker) WithPidForChildren(check *NamespaceChecker) *NamespacesChecker {
+	checker.PidForChildren = check
+	return checker
+}
+
+// WithNet adds a Net check to the NamespacesChecker
+func (checker *NamespacesChecker) WithNet(check *NamespaceChecker) *NamespacesChecker {
+	checker.Net = check
+	return checker
+}
+
+// WithTime adds a Time check to the NamespacesChecker
+func (checker *NamespacesChecker) WithTime(check *NamespaceChecker) *NamespacesChecker {
+	checker.Time = check
+	return checker
+}
+
+// WithTimeForChildren adds a TimeForChildren check to the NamespacesChecker
+func (checker *NamespacesChecker) WithTimeForChildren(check *NamespaceChecker) *NamespacesChecker {
+	checker.TimeForChildren = check
+	return checker
+}
+
+// WithCgroup adds a Cgroup check to the NamespacesChecker
+func (checker *NamespacesChecker) WithCgroup(check *NamespaceChecker) *NamespacesChecker {
+	checker.Cgroup = check
+	return checker
+}
+
+// WithUser adds a User check to the NamespacesChecker
+func (checker *NamespacesChecker) WithUser(check *NamespaceChecker) *NamespacesChecker {
+	checker.User = check
+	return checker
+}
+
+//FromNamespaces populates the NamespacesChecker using data from a Namespaces field
+func (checker *NamespacesChecker) FromNamespaces(event *tetragon.Namespaces) *NamespacesChecker {
 	if event == nil {
 		return checker
 	}
-	if event.Inode != nil {
-		checker.Inode = NewInodePropertiesChecker().FromInodeProperties(event.Inode)
+	if event.Uts != nil {
+		checker.Uts = NewNamespaceChecker().FromNamespace(event.Uts)
+	}
+	if event.Ipc != nil {
+		checker.Ipc = NewNamespaceChecker().FromNamespace(event.Ipc)
+	}
+	if event.Mnt != nil {
+		checker.Mnt = NewNamespaceChecker().FromNamespace(event.Mnt)
+	}
+	if event.Pid != nil {
+		checker.Pid = NewNamespaceChecker().FromNamespace(event.Pid)
+	}
+	if event.PidForChildren != nil {
+		checker.PidForChildren = NewNamespaceChecker().FromNamespace(event.PidForChildren)
+	}
+	if event.Net != nil {
+		checker.Net = NewNamespaceChecker().FromNamespace(event.Net)
+	}
+	if event.Time != nil {
+		checker.Time = NewNamespaceChecker().FromNamespace(event.Time)
+	}
+	if event.TimeForChildren != nil {
+		checker.TimeForChildren = NewNamespaceChecker().FromNamespace(event.TimeForChildren)
+	}
+	if event.Cgroup != nil {
+		checker.Cgroup = NewNamespaceChecker().FromNamespace(event.Cgroup)
+	}
+	if event.User != nil {
+		checker.User = NewNamespaceChecker().FromNamespace(event.User)
 	}
-	checker.Path = stringmatcher.Full(event.Path)
 	return checker
 }
 
-// BinaryPropertiesChecker implements a checker struct to check a BinaryProperties field
-type BinaryPropertiesChecker struct {
-	Setuid            *uint32                              `json:"setuid,omitempty"`
-	Setgid            *uint32                              `json:"setgid,omitempty"`
-	PrivilegesChanged *ProcessPrivilegesChangedListMatcher `json:"privilegesChanged,omitempty"`
-	File              *FilePropertiesChecker               `json:"file,omitempty"`
+// UserNamespaceChecker implements a checker struct to check a UserNamespace field
+type UserNamespaceChecker struct {
+	Level *int32            `json:"level,omitempty"`
+	Uid   *uint32           `json:"uid,omitempty"`
+	Gid   *uint32           `json:"gid,omitempty"`
+	Ns    *NamespaceChecker `json:"ns,omitempty"`
 }
 
-// NewBinaryPropertiesChecker creates a new BinaryPropertiesChecker
-func NewBinaryPropertiesChecker() *BinaryPropertiesChecker {
-	return &BinaryPropertiesChecker{}
+// NewUserNamespaceChecker creates a new UserNamespaceChecker
+func NewUserNamespaceChecker() *UserNamespaceChecker {
+	return &UserNamespaceChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *BinaryPropertiesChecker) GetCheckerType() string {
-	return "BinaryPropertiesChecker"
+func (checker *UserNamespaceChecker) GetCheckerType() string {
+	return "UserNamespaceChecker"
 }
 
-// Check checks a BinaryProperties field
-func (checker *BinaryPropertiesChecker) Check(event *tetragon.BinaryProperties) error {
+// Check checks a UserNamespace field
+func (checker *UserNamespaceChecker) Check(event *tetragon.UserNamespace) error {
 	if event == nil {
-		return fmt.Errorf("%s: BinaryProperties field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: UserNamespace field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Setuid != nil {
-			if event.Setuid == nil {
-				return fmt.Errorf("Setuid is nil and does not match expected value %v", *checker.Setuid)
+		if checker.Level != nil {
+			if event.Level == nil {
+				return fmt.Errorf("Level is nil and does not match expected value %v", *checker.Level)
 			}
-			if *checker.Setuid != event.Setuid.Value {
-				return fmt.Errorf("Setuid has value %v which does not match expected value %v", event.Setuid.Value, *checker.Setuid)
+			if *checker.Level != event.Level.Value {
+				return fmt.Errorf("Level has value %v which does not match expected value %v", event.Level.Value, *checker.Level)
 			}
 		}
-		if checker.Setgid != nil {
-			if event.Setgid == nil {
-				return fmt.Errorf("Setgid is nil and does not match expected value %v", *checker.Setgid)
+		if checker.Uid != nil {
+			if event.Uid == nil {
+				return fmt.Errorf("Uid is nil and does not match expected value %v", *checker.Uid)
 			}
-			if *checker.Setgid != event.Setgid.Value {
-				return fmt.Errorf("Setgid has value %v which does not match expected value %v", event.Setgid.Value, *checker.Setgid)
+			if *checker.Uid != event.Uid.Value {
+				return fmt.Errorf("Uid has value %v which does not match expected value %v", event.Uid.Value, *checker.Uid)
 			}
 		}
-		if checker.PrivilegesChanged != nil {
-			if err := checker.PrivilegesChanged.Check(event.PrivilegesChanged); err != nil {
-				return fmt.Errorf("PrivilegesChanged check failed: %w", err)
+		if checker.Gid != nil {
+			if event.Gid == nil {
+				return fmt.Errorf("Gid is nil and does not match expected value %v", *checker.Gid)
+			}
+			if *checker.Gid != event.Gid.Value {
+				return fmt.Errorf("Gid has value %v which does not match expected value %v", event.Gid.Value, *checker.Gid)
 			}
 		}
-		if checker.File != nil {
-			if err := checker.File.Check(event.File); err != nil {
-				return fmt.Errorf("File check failed: %w", err)
+		if checker.Ns != nil {
+			if err := checker.Ns.Check(event.Ns); err != nil {
+				return fmt.Errorf("Ns check failed: %w", err)
 			}
 		}
 		return nil
@@ -4328,433 +4568,429 @@ func (checker *BinaryPropertiesChecker) Check(event *tetragon.BinaryProperties)
 	return nil
 }
 
-// WithSetuid adds a Setuid check to the BinaryPropertiesChecker
-func (checker *BinaryPropertiesChecker) WithSetuid(check uint32) *BinaryPropertiesChecker {
-	checker.Setuid = &check
+// WithLevel adds a Level check to the UserNamespaceChecker
+func (checker *UserNamespaceChecker) WithLevel(check int32) *UserNamespaceChecker {
+	checker.Level = &check
 	return checker
 }
 
-// WithSetgid adds a Setgid check to the BinaryPropertiesChecker
-func (checker *BinaryPropertiesChecker) WithSetgid(check uint32) *BinaryPropertiesChecker {
-	checker.Setgid = &check
+// WithUid adds a Uid check to the UserNamespaceChecker
+func (checker *UserNamespaceChecker) WithUid(check uint32) *UserNamespaceChecker {
+	checker.Uid = &check
 	return checker
 }
 
-// WithPrivilegesChanged adds a PrivilegesChanged check to the BinaryPropertiesChecker
-func (checker *BinaryPropertiesChecker) WithPrivilegesChanged(check *ProcessPrivilegesChangedListMatcher) *BinaryPropertiesChecker {
-	checker.PrivilegesChanged = check
+// WithGid adds a Gid check to the UserNamespaceChecker
+func (checker *UserNamespaceChecker) WithGid(check uint32) *UserNamespaceChecker {
+	checker.Gid = &check
 	return checker
 }
 
-// WithFile adds a File check to the BinaryPropertiesChecker
-func (checker *BinaryPropertiesChecker) WithFile(check *FilePropertiesChecker) *BinaryPropertiesChecker {
-	checker.File = check
+// WithNs adds a Ns check to the UserNamespaceChecker
+func (checker *UserNamespaceChecker) WithNs(check *NamespaceChecker) *UserNamespaceChecker {
+	checker.Ns = check
 	return checker
 }
 
-//FromBinaryProperties populates the BinaryPropertiesChecker using data from a BinaryProperties field
-func (checker *BinaryPropertiesChecker) FromBinaryProperties(event *tetragon.BinaryProperties) *BinaryPropertiesChecker {
+//FromUserNamespace populates the UserNamespaceChecker using data from a UserNamespace field
+func (checker *UserNamespaceChecker) FromUserNamespace(event *tetragon.UserNamespace) *UserNamespaceChecker {
 	if event == nil {
 		return checker
 	}
-	if event.Setuid != nil {
-		val := event.Setuid.Value
-		checker.Setuid = &val
+	if event.Level != nil {
+		val := event.Level.Value
+		checker.Level = &val
 	}
-	if event.Setgid != nil {
-		val := event.Setgid.Value
-		checker.Setgid = &val
+	if event.Uid != nil {
+		val := event.Uid.Value
+		checker.Uid = &val
 	}
-	{
-		var checks []*ProcessPrivilegesChangedChecker
-		for _, check := range event.PrivilegesChanged {
-			var convertedCheck *ProcessPrivilegesChangedChecker
-			convertedCheck = NewProcessPrivilegesChangedChecker(check)
-			checks = append(checks, convertedCheck)
-		}
-		lm := NewProcessPrivilegesChangedListMatcher().WithOperator(listmatcher.Ordered).
-			WithValues(checks...)
-		checker.PrivilegesChanged = lm
+	if event.Gid != nil {
+		val := event.Gid.Value
+		checker.Gid = &val
 	}
-	if event.File != nil {
-		checker.File = NewFilePropertiesChecker().FromFileProperties(event.File)
+	if event.Ns != nil {
+		checker.Ns = NewNamespaceChecker().FromNamespace(event.Ns)
 	}
 	return checker
 }
 
-// ProcessPrivilegesChangedListMatcher checks a list of tetragon.ProcessPrivilegesChanged fields
-type ProcessPrivilegesChangedListMatcher struct {
-	Operator listmatcher.Operator               `json:"operator"`
-	Values   []*ProcessPrivilegesChangedChecker `json:"values"`
-}
-
-// NewProcessPrivilegesChangedListMatcher creates a new ProcessPrivilegesChangedListMatcher. The checker defaults to a subset checker unless otherwise specified using WithOperator()
-func NewProcessPrivilegesChangedListMatcher() *ProcessPrivilegesChangedListMatcher {
-	return &ProcessPrivilegesChangedListMatcher{
-		Operator: listmatcher.Subset,
-	}
+// ProcessCredentialsChecker implements a checker struct to check a ProcessCredentials field
+type ProcessCredentialsChecker struct {
+	Uid        *uint32                    `json:"uid,omitempty"`
+	Gid        *uint32                    `json:"gid,omitempty"`
+	Euid       *uint32                    `json:"euid,omitempty"`
+	Egid       *uint32                    `json:"egid,omitempty"`
+	Suid       *uint32                    `json:"suid,omitempty"`
+	Sgid       *uint32                    `json:"sgid,omitempty"`
+	Fsuid      *uint32                    `json:"fsuid,omitempty"`
+	Fsgid      *uint32                    `json:"fsgid,omitempty"`
+	Securebits *SecureBitsTypeListMatcher `json:"securebits,omitempty"`
+	Caps       *CapabilitiesChecker       `json:"caps,omitempty"`
+	UserNs     *UserNamespaceChecker      `json:"userNs,omitempty"`
 }
 
-// WithOperator sets the match kind for the ProcessPrivilegesChangedListMatcher
-func (checker *ProcessPrivilegesChangedListMatcher) WithOperator(operator listmatcher.Operator) *ProcessPrivilegesChangedListMatcher {
-	checker.Operator = operator
-	return checker
+// NewProcessCredentialsChecker creates a new ProcessCredentialsChecker
+func NewProcessCredentialsChecker() *ProcessCredentialsChecker {
+	return &ProcessCredentialsChecker{}
 }
 
-// WithValues sets the checkers that the ProcessPrivilegesChangedListMatcher should use
-func (checker *ProcessPrivilegesChangedListMatcher) WithValues(values ...*ProcessPrivilegesChangedChecker) *ProcessPrivilegesChangedListMatcher {
-	checker.Values = values
-	return checker
+// Get the type of the checker as a string
+func (checker *ProcessCredentialsChecker) GetCheckerType() string {
+	return "ProcessCredentialsChecker"
 }
 
-// Check checks a list of tetragon.ProcessPrivilegesChanged fields
-func (checker *ProcessPrivilegesChangedListMatcher) Check(values []tetragon.ProcessPrivilegesChanged) error {
-	switch checker.Operator {
-	case listmatcher.Ordered:
-		return checker.orderedCheck(values)
-	case listmatcher.Unordered:
-		return checker.unorderedCheck(values)
-	case listmatcher.Subset:
-		return checker.subsetCheck(values)
-	default:
-		return fmt.Errorf("Unhandled ListMatcher operator %s", checker.Operator)
+// Check checks a ProcessCredentials field
+func (checker *ProcessCredentialsChecker) Check(event *tetragon.ProcessCredentials) error {
+	if event == nil {
+		return fmt.Errorf("%s: ProcessCredentials field is nil", CheckerLogPrefix(checker))
 	}
-}
 
-// orderedCheck checks a list of ordered tetragon.ProcessPrivilegesChanged fields
-func (checker *ProcessPrivilegesChangedListMatcher) orderedCheck(values []tetragon.ProcessPrivilegesChanged) error {
-	innerCheck := func(check *ProcessPrivilegesChangedChecker, value tetragon.ProcessPrivilegesChanged) error {
-		if err := check.Check(&value); err != nil {
-			return fmt.Errorf("PrivilegesChanged check failed: %w", err)
+	fieldChecks := func() error {
+		if checker.Uid != nil {
+			if event.Uid == nil {
+				return fmt.Errorf("Uid is nil and does not match expected value %v", *checker.Uid)
+			}
+			if *checker.Uid != event.Uid.Value {
+				return fmt.Errorf("Uid has value %v which does not match expected value %v", event.Uid.Value, *checker.Uid)
+			}
 		}
-		return nil
-	}
-
-	if len(checker.Values) != len(values) {
-		return fmt.Errorf("ProcessPrivilegesChangedListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
-	}
-
-	for i, check := range checker.Values {
-		value := values[i]
-		if err := innerCheck(check, value); err != nil {
-			return fmt.Errorf("ProcessPrivilegesChangedListMatcher: Check failed on element %d: %w", i, err)
+		if checker.Gid != nil {
+			if event.Gid == nil {
+				return fmt.Errorf("Gid is nil and does not match expected value %v", *checker.Gid)
+			}
+			if *checker.Gid != event.Gid.Value {
+				return fmt.Errorf("Gid has value %v which does not match expected value %v", event.Gid.Value, *checker.Gid)
+			}
 		}
-	}
-
-	return nil
-}
-
-// unorderedCheck checks a list of unordered tetragon.ProcessPrivilegesChanged fields
-func (checker *ProcessPrivilegesChangedListMatcher) unorderedCheck(values []tetragon.ProcessPrivilegesChanged) error {
-	if len(checker.Values) != len(values) {
-		return fmt.Errorf("ProcessPrivilegesChangedListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
-	}
-
-	return checker.subsetCheck(values)
-}
-
-// subsetCheck checks a subset of tetragon.ProcessPrivilegesChanged fields
-func (checker *ProcessPrivilegesChangedListMatcher) subsetCheck(values []tetragon.ProcessPrivilegesChanged) error {
-	innerCheck := func(check *ProcessPrivilegesChangedChecker, value tetragon.ProcessPrivilegesChanged) error {
-		if err := check.Check(&value); err != nil {
-			return fmt.Errorf("PrivilegesChanged check failed: %w", err)
+		if checker.Euid != nil {
+			if event.Euid == nil {
+				return fmt.Errorf("Euid is nil and does not match expected value %v", *checker.Euid)
+			}
+			if *checker.Euid != event.Euid.Value {
+				return fmt.Errorf("Euid has value %v which does not match expected value %v", event.Euid.Value, *checker.Euid)
+			}
 		}
-		return nil
-	}
-
-	numDesired := len(checker.Values)
-	numMatched := 0
-
-nextCheck:
-	for _, check := range checker.Values {
-		for _, value := range values {
-			if err := innerCheck(check, value); err == nil {
-				numMatched += 1
-				continue nextCheck
+		if checker.Egid != nil {
+			if event.Egid == nil {
+				return fmt.Errorf("Egid is nil and does not match expected value %v", *checker.Egid)
+			}
+			if *checker.Egid != event.Egid.Value {
+				return fmt.Errorf("Egid has value %v which does not match expected value %v", event.Egid.Value, *checker.Egid)
+			}
+		}
+		if checker.Suid != nil {
+			if event.Suid == nil {
+				return fmt.Errorf("Suid is nil and does not match expected value %v", *checker.Suid)
+			}
+			if *checker.Suid != event.Suid.Value {
+				return fmt.Errorf("Suid has value %v which does not match expected value %v", event.Suid.Value, *checker.Suid)
+			}
+		}
+		if checker.Sgid != nil {
+			if event.Sgid == nil {
+				return fmt.Errorf("Sgid is nil and does not match expected value %v", *checker.Sgid)
+			}
+			if *checker.Sgid != event.Sgid.Value {
+				return fmt.Errorf("Sgid has value %v which does not match expected value %v", event.Sgid.Value, *checker.Sgid)
+			}
+		}
+		if checker.Fsuid != nil {
+			if event.Fsuid == nil {
+				return fmt.Errorf("Fsuid is nil and does not match expected value %v", *checker.Fsuid)
+			}
+			if *checker.Fsuid != event.Fsuid.Value {
+				return fmt.Errorf("Fsuid has value %v which does not match expected value %v", event.Fsuid.Value, *checker.Fsuid)
+			}
+		}
+		if checker.Fsgid != nil {
+			if event.Fsgid == nil {
+				return fmt.Errorf("Fsgid is nil and does not match expected value %v", *checker.Fsgid)
+			}
+			if *checker.Fsgid != event.Fsgid.Value {
+				return fmt.Errorf("Fsgid has value %v which does not match expected value %v", event.Fsgid.Value, *checker.Fsgid)
+			}
+		}
+		if checker.Securebits != nil {
+			if err := checker.Securebits.Check(event.Securebits); err != nil {
+				return fmt.Errorf("Securebits check failed: %w", err)
+			}
+		}
+		if checker.Caps != nil {
+			if err := checker.Caps.Check(event.Caps); err != nil {
+				return fmt.Errorf("Caps check failed: %w", err)
+			}
+		}
+		if checker.UserNs != nil {
+			if err := checker.UserNs.Check(event.UserNs); err != nil {
+				return fmt.Errorf("UserNs check failed: %w", err)
 			}
 		}
+		return nil
 	}
-
-	if numMatched < numDesired {
-		return fmt.Errorf("ProcessPrivilegesChangedListMatcher: Check failed, only matched %d elements but wanted %d", numMatched, numDesired)
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
 	}
-
 	return nil
 }
 
-// UserRecordChecker implements a checker struct to check a UserRecord field
-type UserRecordChecker struct {
-	Name *stringmatcher.StringMatcher `json:"name,omitempty"`
+// WithUid adds a Uid check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithUid(check uint32) *ProcessCredentialsChecker {
+	checker.Uid = &check
+	return checker
 }
 
-// NewUserRecordChecker creates a new UserRecordChecker
-func NewUserRecordChecker() *UserRecordChecker {
-	return &UserRecordChecker{}
+// WithGid adds a Gid check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithGid(check uint32) *ProcessCredentialsChecker {
+	checker.Gid = &check
+	return checker
 }
 
-// Get the type of the checker as a string
-func (checker *UserRecordChecker) GetCheckerType() string {
-	return "UserRecordChecker"
+// WithEuid adds a Euid check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithEuid(check uint32) *ProcessCredentialsChecker {
+	checker.Euid = &check
+	return checker
 }
 
-// Check checks a UserRecord field
-func (checker *UserRecordChecker) Check(event *tetragon.UserRecord) error {
-	if event == nil {
-		return fmt.Errorf("%s: UserRecord field is nil", CheckerLogPrefix(checker))
-	}
-
-	fieldChecks := func() error {
-		if checker.Name != nil {
-			if err := checker.Name.Match(event.Name); err != nil {
-				return fmt.Errorf("Name check failed: %w", err)
-			}
-		}
-		return nil
-	}
-	if err := fieldChecks(); err != nil {
-		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
-	}
-	return nil
+// WithEgid adds a Egid check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithEgid(check uint32) *ProcessCredentialsChecker {
+	checker.Egid = &check
+	return checker
 }
 
-// WithName adds a Name check to the UserRecordChecker
-func (checker *UserRecordChecker) WithName(check *stringmatcher.StringMatcher) *UserRecordChecker {
-	checker.Name = check
+// WithSuid adds a Suid check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithSuid(check uint32) *ProcessCredentialsChecker {
+	checker.Suid = &check
 	return checker
 }
 
-//FromUserRecord populates the UserRecordChecker using data from a UserRecord field
-func (checker *UserRecordChecker) FromUserRecord(event *tetragon.UserRecord) *UserRecordChecker {
-	if event == nil {
-		return checker
-	}
-	checker.Name = stringmatcher.Full(event.Name)
+// WithSgid adds a Sgid check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithSgid(check uint32) *ProcessCredentialsChecker {
+	checker.Sgid = &check
 	return checker
 }
 
-// EnvVarChecker implements a checker struct to check a EnvVar field
-type EnvVarChecker struct {
-	Key   *stringmatcher.StringMatcher `json:"Key,omitempty"`
-	Value *stringmatcher.StringMatcher `json:"Value,omitempty"`
+// WithFsuid adds a Fsuid check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithFsuid(check uint32) *ProcessCredentialsChecker {
+	checker.Fsuid = &check
+	return checker
 }
 
-// NewEnvVarChecker creates a new EnvVarChecker
-func NewEnvVarChecker() *EnvVarChecker {
-	return &EnvVarChecker{}
+// WithFsgid adds a Fsgid check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithFsgid(check uint32) *ProcessCredentialsChecker {
+	checker.Fsgid = &check
+	return checker
 }
 
-// Get the type of the checker as a string
-func (checker *EnvVarChecker) GetCheckerType() string {
-	return "EnvVarChecker"
+// WithSecurebits adds a Securebits check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithSecurebits(check *SecureBitsTypeListMatcher) *ProcessCredentialsChecker {
+	checker.Securebits = check
+	return checker
 }
 
-// Check checks a EnvVar field
-func (checker *EnvVarChecker) Check(event *tetragon.EnvVar) error {
+// WithCaps adds a Caps check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithCaps(check *CapabilitiesChecker) *ProcessCredentialsChecker {
+	checker.Caps = check
+	return checker
+}
+
+// WithUserNs adds a UserNs check to the ProcessCredentialsChecker
+func (checker *ProcessCredentialsChecker) WithUserNs(check *UserNamespaceChecker) *ProcessCredentialsChecker {
+	checker.UserNs = check
+	return checker
+}
+
+//FromProcessCredentials populates the ProcessCredentialsChecker using data from a ProcessCredentials field
+func (checker *ProcessCredentialsChecker) FromProcessCredentials(event *tetragon.ProcessCredentials) *ProcessCredentialsChecker {
 	if event == nil {
-		return fmt.Errorf("%s: EnvVar field is nil", CheckerLogPrefix(checker))
+		return checker
 	}
-
-	fieldChecks := func() error {
-		if checker.Key != nil {
-			if err := checker.Key.Match(event.Key); err != nil {
-				return fmt.Errorf("Key check failed: %w", err)
-			}
-		}
-		if checker.Value != nil {
-			if err := checker.Value.Match(event.Value); err != nil {
-				return fmt.Errorf("Value check failed: %w", err)
-			}
+	if event.Uid != nil {
+		val := event.Uid.Value
+		checker.Uid = &val
+	}
+	if event.Gid != nil {
+		val := event.Gid.Value
+		checker.Gid = &val
+	}
+	if event.Euid != nil {
+		val := event.Euid.Value
+		checker.Euid = &val
+	}
+	if event.Egid != nil {
+		val := event.Egid.Value
+		checker.Egid = &val
+	}
+	if event.Suid != nil {
+		val := event.Suid.Value
+		checker.Suid = &val
+	}
+	if event.Sgid != nil {
+		val := event.Sgid.Value
+		checker.Sgid = &val
+	}
+	if event.Fsuid != nil {
+		val := event.Fsuid.Value
+		checker.Fsuid = &val
+	}
+	if event.Fsgid != nil {
+		val := event.Fsgid.Value
+		checker.Fsgid = &val
+	}
+	{
+		var checks []*SecureBitsTypeChecker
+		for _, check := range event.Securebits {
+			var convertedCheck *SecureBitsTypeChecker
+			convertedCheck = NewSecureBitsTypeChecker(check)
+			checks = append(checks, convertedCheck)
 		}
-		return nil
+		lm := NewSecureBitsTypeListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Securebits = lm
 	}
-	if err := fieldChecks(); err != nil {
-		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	if event.Caps != nil {
+		checker.Caps = NewCapabilitiesChecker().FromCapabilities(event.Caps)
 	}
-	return nil
+	if event.UserNs != nil {
+		checker.UserNs = NewUserNamespaceChecker().FromUserNamespace(event.UserNs)
+	}
+	return checker
 }
 
-// WithKey adds a Key check to the EnvVarChecker
-func (checker *EnvVarChecker) WithKey(check *stringmatcher.StringMatcher) *EnvVarChecker {
-	checker.Key = check
-	return checker
+// SecureBitsTypeListMatcher checks a list of tetragon.SecureBitsType fields
+type SecureBitsTypeListMatcher struct {
+	Operator listmatcher.Operator     `json:"operator"`
+	Values   []*SecureBitsTypeChecker `json:"values"`
 }
 
-// WithValue adds a Value check to the EnvVarChecker
-func (checker *EnvVarChecker) WithValue(check *stringmatcher.StringMatcher) *EnvVarChecker {
-	checker.Value = check
+// NewSecureBitsTypeListMatcher creates a new SecureBitsTypeListMatcher. The checker defaults to a subset checker unless otherwise specified using WithOperator()
+func NewSecureBitsTypeListMatcher() *SecureBitsTypeListMatcher {
+	return &SecureBitsTypeListMatcher{
+		Operator: listmatcher.Subset,
+	}
+}
+
+// WithOperator sets the match kind for the SecureBitsTypeListMatcher
+func (checker *SecureBitsTypeListMatcher) WithOperator(operator listmatcher.Operator) *SecureBitsTypeListMatcher {
+	checker.Operator = operator
 	return checker
 }
 
-//FromEnvVar populates the EnvVarChecker using data from a EnvVar field
-func (checker *EnvVarChecker) FromEnvVar(event *tetragon.EnvVar) *EnvVarChecker {
-	if event == nil {
-		return checker
-	}
-	checker.Key = stringmatcher.Full(event.Key)
-	checker.Value = stringmatcher.Full(event.Value)
+// WithValues sets the checkers that the SecureBitsTypeListMatcher should use
+func (checker *SecureBitsTypeListMatcher) WithValues(values ...*SecureBitsTypeChecker) *SecureBitsTypeListMatcher {
+	checker.Values = values
 	return checker
 }
 
-// ProcessChecker implements a checker struct to check a Process field
-type ProcessChecker struct {
-	ExecId               *stringmatcher.StringMatcher       `json:"execId,omitempty"`
-	Pid                  *uint32                            `json:"pid,omitempty"`
-	Uid                  *uint32                            `json:"uid,omitempty"`
-	Cwd                  *stringmatcher.StringMatcher       `json:"cwd,omitempty"`
-	Binary               *stringmatcher.StringMatcher       `json:"binary,omitempty"`
-	Arguments            *stringmatcher.StringMatcher       `json:"arguments,omitempty"`
-	Flags                *stringmatcher.StringMatcher       `json:"flags,omitempty"`
-	StartTime            *timestampmatcher.TimestampMatcher `json:"startTime,omitempty"`
-	Auid                 *uint32                            `json:"auid,omitempty"`
-	Pod                  *PodChecker                        `json:"pod,omitempty"`
-	Docker               *stringmatcher.StringMatcher       `json:"docker,omitempty"`
-	ParentExecId         *stringmatcher.StringMatcher       `json:"parentExecId,omitempty"`
-	Refcnt               *uint32                            `json:"refcnt,omitempty"`
-	Cap                  *CapabilitiesChecker               `json:"cap,omitempty"`
-	Ns                   *NamespacesChecker                 `json:"ns,omitempty"`
-	Tid                  *uint32                            `json:"tid,omitempty"`
-	ProcessCredentials   *ProcessCredentialsChecker         `json:"processCredentials,omitempty"`
-	BinaryProperties     *BinaryPropertiesChecker           `json:"binaryProperties,omitempty"`
-	User                 *UserRecordChecker                 `json:"user,omitempty"`
-	InInitTree           *bool                              `json:"inInitTree,omitempty"`
-	EnvironmentVariables *EnvVarListMatcher                 `json:"environmentVariables,omitempty"`
+// Check checks a list of tetragon.SecureBitsType fields
+func (checker *SecureBitsTypeListMatcher) Check(values []tetragon.SecureBitsType) error {
+	switch checker.Operator {
+	case listmatcher.Ordered:
+		return checker.orderedCheck(values)
+	case listmatcher.Unordered:
+		return checker.unorderedCheck(values)
+	case listmatcher.Subset:
+		return checker.subsetCheck(values)
+	default:
+		return fmt.Errorf("Unhandled ListMatcher operator %s", checker.Operator)
+	}
 }
 
-// NewProcessChecker creates a new ProcessChecker
-func NewProcessChecker() *ProcessChecker {
-	return &ProcessChecker{}
+// orderedCheck checks a list of ordered tetragon.SecureBitsType fields
+func (checker *SecureBitsTypeListMatcher) orderedCheck(values []tetragon.SecureBitsType) error {
+	innerCheck := func(check *SecureBitsTypeChecker, value tetragon.SecureBitsType) error {
+		if err := check.Check(&value); err != nil {
+			return fmt.Errorf("Securebits check failed: %w", err)
+		}
+		return nil
+	}
+
+	if len(checker.Values) != len(values) {
+		return fmt.Errorf("SecureBitsTypeListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+	}
+
+	for i, check := range checker.Values {
+		value := values[i]
+		if err := innerCheck(check, value); err != nil {
+			return fmt.Errorf("SecureBitsTypeListMatcher: Check failed on element %d: %w", i, err)
+		}
+	}
+
+	return nil
 }
 
-// Get the type of the checker as a string
-func (checker *ProcessChecker) GetCheckerType() string {
-	return "ProcessChecker"
+// unorderedCheck checks a list of unordered tetragon.SecureBitsType fields
+func (checker *SecureBitsTypeListMatcher) unorderedCheck(values []tetragon.SecureBitsType) error {
+	if len(checker.Values) != len(values) {
+		return fmt.Errorf("SecureBitsTypeListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+	}
+
+	return checker.subsetCheck(values)
 }
 
-// Check checks a Process field
-func (checker *ProcessChecker) Check(event *tetragon.Process) error {
-	if event == nil {
-		return fmt.Errorf("%s: Process field is nil", CheckerLogPrefix(checker))
+// subsetCheck checks a subset of tetragon.SecureBitsType fields
+func (checker *SecureBitsTypeListMatcher) subsetCheck(values []tetragon.SecureBitsType) error {
+	innerCheck := func(check *SecureBitsTypeChecker, value tetragon.SecureBitsType) error {
+		if err := check.Check(&value); err != nil {
+			return fmt.Errorf("Securebits check failed: %w", err)
+		}
+		return nil
 	}
 
-	fieldChecks := func() error {
-		if checker.ExecId != nil {
-			if err := checker.ExecId.Match(event.ExecId); err != nil {
-				return fmt.Errorf("ExecId check failed: %w", err)
+	numDesired := len(checker.Values)
+	numMatched := 0
+
+nextCheck:
+	for _, check := range checker.Values {
+		for _, value := range values {
+			if err := innerCheck(check, value); err == nil {
+				numMatched += 1
+				continue nextCheck
 			}
 		}
-		if checker.Pid != nil {
-			if event.Pid == nil {
-				return fmt.Errorf("Pid is nil and does not match expected value %v", *checker.Pid)
-			}
-			if *checker.Pid != event.Pid.Value {
-				return fmt.Errorf("Pid has value %v which does not match expected value %v", event.Pid.Value, *checker.Pid)
-			}
-		}
-		if checker.Uid != nil {
-			if event.Uid == nil {
-				return fmt.Errorf("Uid is nil and does not match expected value %v", *checker.Uid)
-			}
-			if *checker.Uid != event.Uid.Value {
-				return fmt.Errorf("Uid has value %v which does not match expected value %v", event.Uid.Value, *checker.Uid)
-			}
-		}
-		if checker.Cwd != nil {
-			if err := checker.Cwd.Match(event.Cwd); err != nil {
-				return fmt.Errorf("Cwd check failed: %w", err)
-			}
-		}
-		if checker.Binary != nil {
-			if err := checker.Binary.Match(event.Binary); err != nil {
-				return fmt.Errorf("Binary check failed: %w", err)
-			}
-		}
-		if checker.Arguments != nil {
-			if err := checker.Arguments.Match(event.Arguments); err != nil {
-				return fmt.Errorf("Arguments check failed: %w", err)
-			}
-		}
-		if checker.Flags != nil {
-			if err := checker.Flags.Match(event.Flags); err != nil {
-				return fmt.Errorf("Flags check failed: %w", err)
-			}
-		}
-		if checker.StartTime != nil {
-			if err := checker.StartTime.Match(event.StartTime); err != nil {
-				return fmt.Errorf("StartTime check failed: %w", err)
-			}
-		}
-		if checker.Auid != nil {
-			if event.Auid == nil {
-				return fmt.Errorf("Auid is nil and does not match expected value %v", *checker.Auid)
-			}
-			if *checker.Auid != event.Auid.Value {
-				return fmt.Errorf("Auid has value %v which does not match expected value %v", event.Auid.Value, *checker.Auid)
-			}
-		}
-		if checker.Pod != nil {
-			if err := checker.Pod.Check(event.Pod); err != nil {
-				return fmt.Errorf("Pod check failed: %w", err)
-			}
-		}
-		if checker.Docker != nil {
-			if err := checker.Docker.Match(event.Docker); err != nil {
-				return fmt.Errorf("Docker check failed: %w", err)
-			}
-		}
-		if checker.ParentExecId != nil {
-			if err := checker.ParentExecId.Match(event.ParentExecId); err != nil {
-				return fmt.Errorf("ParentExecId check failed: %w", err)
-			}
-		}
-		if checker.Refcnt != nil {
-			if *checker.Refcnt != event.Refcnt {
-				return fmt.Errorf("Refcnt has value %d which does not match expected value %d", event.Refcnt, *checker.Refcnt)
-			}
-		}
-		if checker.Cap != nil {
-			if err := checker.Cap.Check(event.Cap); err != nil {
-				return fmt.Errorf("Cap check failed: %w", err)
-			}
-		}
-		if checker.Ns != nil {
-			if err := checker.Ns.Check(event.Ns); err != nil {
-				return fmt.Errorf("Ns check failed: %w", err)
-			}
-		}
-		if checker.Tid != nil {
-			if event.Tid == nil {
-				return fmt.Errorf("Tid is nil and does not match expected value %v", *checker.Tid)
-			}
-			if *checker.Tid != event.Tid.Value {
-				return fmt.Errorf("Tid has value %v which does not match expected value %v", event.Tid.Value, *checker.Tid)
-			}
-		}
-		if checker.ProcessCredentials != nil {
-			if err := checker.ProcessCredentials.Check(event.ProcessCredentials); err != nil {
-				return fmt.Errorf("ProcessCredentials check failed: %w", err)
-			}
-		}
-		if checker.BinaryProperties != nil {
-			if err := checker.BinaryProperties.Check(event.BinaryProperties); err != nil {
-				return fmt.Errorf("BinaryProperties check failed: %w", err)
-			}
-		}
-		if checker.User != nil {
-			if err := checker.User.Check(event.User); err != nil {
-				return fmt.Errorf("User check failed: %w", err)
+	}
+
+	if numMatched < numDesired {
+		return fmt.Errorf("SecureBitsTypeListMatcher: Check failed, only matched %d elements but wanted %d", numMatched, numDesired)
+	}
+
+	return nil
+}
+
+// InodePropertiesChecker implements a checker struct to check a InodeProperties field
+type InodePropertiesChecker struct {
+	Number *uint64 `json:"number,omitempty"`
+	Links  *uint32 `json:"links,omitempty"`
+}
+
+// NewInodePropertiesChecker creates a new InodePropertiesChecker
+func NewInodePropertiesChecker() *InodePropertiesChecker {
+	return &InodePropertiesChecker{}
+}
+
+// Get the type of the checker as a string
+func (checker *InodePropertiesChecker) GetCheckerType() string {
+	return "InodePropertiesChecker"
+}
+
+// Check checks a InodeProperties field
+func (checker *InodePropertiesChecker) Check(event *tetragon.InodeProperties) error {
+	if event == nil {
+		return fmt.Errorf("%s: InodeProperties field is nil", CheckerLogPrefix(checker))
+	}
+
+	fieldChecks := func() error {
+		if checker.Number != nil {
+			if *checker.Number != event.Number {
+				return fmt.Errorf("Number has value %d which does not match expected value %d", event.Number, *checker.Number)
 			}
 		}
-		if checker.InInitTree != nil {
-			if event.InInitTree == nil {
-				return fmt.Errorf("InInitTree is nil and does not match expected value %v", *checker.InInitTree)
-			}
-			if *checker.InInitTree != event.InInitTree.Value {
-				return fmt.Errorf("InInitTree has value %v which does not match expected value %v", event.InInitTree.Value, *checker.InInitTree)
+		if checker.Links != nil {
+			if event.Links == nil {
+				return fmt.Errorf("Links is nil and does not match expected value %v", *checker.Links)
 			}
-		}
-		if checker.EnvironmentVariables != nil {
-			if err := checker.EnvironmentVariables.Check(event.EnvironmentVariables); err != nil {
-				return fmt.Errorf("EnvironmentVariables check failed: %w", err)
+			if *checker.Links != event.Links.Value {
+				return fmt.Errorf("Links has value %v which does not match expected value %v", event.Links.Value, *checker.Links)
 			}
 		}
 		return nil
@@ -4765,231 +5001,239 @@ func (checker *ProcessChecker) Check(event *tetragon.Process) error {
 	return nil
 }
 
-// WithExecId adds a ExecId check to the ProcessChecker
-func (checker *ProcessChecker) WithExecId(check *stringmatcher.StringMatcher) *ProcessChecker {
-	checker.ExecId = check
+// WithNumber adds a Number check to the InodePropertiesChecker
+func (checker *InodePropertiesChecker) WithNumber(check uint64) *InodePropertiesChecker {
+	checker.Number = &check
 	return checker
 }
 
-// WithPid adds a Pid check to the ProcessChecker
-func (checker *ProcessChecker) WithPid(check uint32) *ProcessChecker {
-	checker.Pid = &check
+// WithLinks adds a Links check to the InodePropertiesChecker
+func (checker *InodePropertiesChecker) WithLinks(check uint32) *InodePropertiesChecker {
+	checker.Links = &check
 	return checker
 }
 
-// WithUid adds a Uid check to the ProcessChecker
-func (checker *ProcessChecker) WithUid(check uint32) *ProcessChecker {
-	checker.Uid = &check
+//FromInodeProperties populates the InodePropertiesChecker using data from a InodeProperties field
+func (checker *InodePropertiesChecker) FromInodeProperties(event *tetragon.InodeProperties) *InodePropertiesChecker {
+	if event == nil {
+		return checker
+	}
+	{
+		val := event.Number
+		checker.Number = &val
+	}
+	if event.Links != nil {
+		val := event.Links.Value
+		checker.Links = &val
+	}
 	return checker
 }
 
-// WithCwd adds a Cwd check to the ProcessChecker
-func (checker *ProcessChecker) WithCwd(check *stringmatcher.StringMatcher) *ProcessChecker {
-	checker.Cwd = check
-	return checker
+// FilePropertiesChecker implements a checker struct to check a FileProperties field
+type FilePropertiesChecker struct {
+	Inode *InodePropertiesChecker      `json:"inode,omitempty"`
+	Path  *stringmatcher.StringMatcher `json:"path,omitempty"`
 }
 
-// WithBinary adds a Binary check to the ProcessChecker
-func (checker *ProcessChecker) WithBinary(check *stringmatcher.StringMatcher) *ProcessChecker {
-	checker.Binary = check
-	return checker
+// NewFilePropertiesChecker creates a new FilePropertiesChecker
+func NewFilePropertiesChecker() *FilePropertiesChecker {
+	return &FilePropertiesChecker{}
 }
 
-// WithArguments adds a Arguments check to the ProcessChecker
-func (checker *ProcessChecker) WithArguments(check *stringmatcher.StringMatcher) *ProcessChecker {
-	checker.Arguments = check
-	return checker
+// Get the type of the checker as a string
+func (checker *FilePropertiesChecker) GetCheckerType() string {
+	return "FilePropertiesChecker"
 }
 
-// WithFlags adds a Flags check to the ProcessChecker
-func (checker *ProcessChecker) WithFlags(check *stringmatcher.StringMatcher) *ProcessChecker {
-	checker.Flags = check
-	return checker
-}
+// Check checks a FileProperties field
+func (checker *FilePropertiesChecker) Check(event *tetragon.FileProperties) error {
+	if event == nil {
+		return fmt.Errorf("%s: FileProperties field is nil", CheckerLogPrefix(checker))
+	}
 
-// WithStartTime adds a StartTime check to the ProcessChecker
-func (checker *ProcessChecker) WithStartTime(check *timestampmatcher.TimestampMatcher) *ProcessChecker {
-	checker.StartTime = check
-	return checker
+	fieldChecks := func() error {
+		if checker.Inode != nil {
+			if err := checker.Inode.Check(event.Inode); err != nil {
+				return fmt.Errorf("Inode check failed: %w", err)
+			}
+		}
+		if checker.Path != nil {
+			if err := checker.Path.Match(event.Path); err != nil {
+				return fmt.Errorf("Path check failed: %w", err)
+			}
+		}
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
 }
 
-// WithAuid adds a Auid check to the ProcessChecker
-func (checker *ProcessChecker) WithAuid(check uint32) *ProcessChecker {
-	checker.Auid = &check
+// WithInode adds a Inode check to the FilePropertiesChecker
+func (checker *FilePropertiesChecker) WithInode(check *InodePropertiesChecker) *FilePropertiesChecker {
+	checker.Inode = check
 	return checker
 }
 
-// WithPod adds a Pod check to the ProcessChecker
-func (checker *ProcessChecker) WithPod(check *PodChecker) *ProcessChecker {
-	checker.Pod = check
+// WithPath adds a Path check to the FilePropertiesChecker
+func (checker *FilePropertiesChecker) WithPath(check *stringmatcher.StringMatcher) *FilePropertiesChecker {
+	checker.Path = check
 	return checker
 }
 
-// WithDocker adds a Docker check to the ProcessChecker
-func (checker *ProcessChecker) WithDocker(check *stringmatcher.StringMatcher) *ProcessChecker {
-	checker.Docker = check
+//FromFileProperties populates the FilePropertiesChecker using data from a FileProperties field
+func (checker *FilePropertiesChecker) FromFileProperties(event *tetragon.FileProperties) *FilePropertiesChecker {
+	if event == nil {
+		return checker
+	}
+	if event.Inode != nil {
+		checker.Inode = NewInodePropertiesChecker().FromInodeProperties(event.Inode)
+	}
+	checker.Path = stringmatcher.Full(event.Path)
 	return checker
 }
 
-// WithParentExecId adds a ParentExecId check to the ProcessChecker
-func (checker *ProcessChecker) WithParentExecId(check *stringmatcher.StringMatcher) *ProcessChecker {
-	checker.ParentExecId = check
-	return checker
-}
-
-// WithRefcnt adds a Refcnt check to the ProcessChecker
-func (checker *ProcessChecker) WithRefcnt(check uint32) *ProcessChecker {
-	checker.Refcnt = &check
-	return checker
+// BinaryPropertiesChecker implements a checker struct to check a BinaryProperties field
+type BinaryPropertiesChecker struct {
+	Setuid            *uint32                              `json:"setuid,omitempty"`
+	Setgid            *uint32                              `json:"setgid,omitempty"`
+	PrivilegesChanged *ProcessPrivilegesChangedListMatcher `json:"privilegesChanged,omitempty"`
+	File              *FilePropertiesChecker               `json:"file,omitempty"`
 }
 
-// WithCap adds a Cap check to the ProcessChecker
-func (checker *ProcessChecker) WithCap(check *CapabilitiesChecker) *ProcessChecker {
-	checker.Cap = check
-	return checker
+// NewBinaryPropertiesChecker creates a new BinaryPropertiesChecker
+func NewBinaryPropertiesChecker() *BinaryPropertiesChecker {
+	return &BinaryPropertiesChecker{}
 }
 
-// WithNs adds a Ns check to the ProcessChecker
-func (checker *ProcessChecker) WithNs(check *NamespacesChecker) *ProcessChecker {
-	checker.Ns = check
-	return checker
+// Get the type of the checker as a string
+func (checker *BinaryPropertiesChecker) GetCheckerType() string {
+	return "BinaryPropertiesChecker"
 }
 
-// WithTid adds a Tid check to the ProcessChecker
-func (checker *ProcessChecker) WithTid(check uint32) *ProcessChecker {
-	checker.Tid = &check
-	return checker
-}
+// Check checks a BinaryProperties field
+func (checker *BinaryPropertiesChecker) Check(event *tetragon.BinaryProperties) error {
+	if event == nil {
+		return fmt.Errorf("%s: BinaryProperties field is nil", CheckerLogPrefix(checker))
+	}
 
-// WithProcessCredentials adds a ProcessCredentials check to the ProcessChecker
-func (checker *ProcessChecker) WithProcessCredentials(check *ProcessCredentialsChecker) *ProcessChecker {
-	checker.ProcessCredentials = check
-	return checker
+	fieldChecks := func() error {
+		if checker.Setuid != nil {
+			if event.Setuid == nil {
+				return fmt.Errorf("Setuid is nil and does not match expected value %v", *checker.Setuid)
+			}
+			if *checker.Setuid != event.Setuid.Value {
+				return fmt.Errorf("Setuid has value %v which does not match expected value %v", event.Setuid.Value, *checker.Setuid)
+			}
+		}
+		if checker.Setgid != nil {
+			if event.Setgid == nil {
+				return fmt.Errorf("Setgid is nil and does not match expected value %v", *checker.Setgid)
+			}
+			if *checker.Setgid != event.Setgid.Value {
+				return fmt.Errorf("Setgid has value %v which does not match expected value %v", event.Setgid.Value, *checker.Setgid)
+			}
+		}
+		if checker.PrivilegesChanged != nil {
+			if err := checker.PrivilegesChanged.Check(event.PrivilegesChanged); err != nil {
+				return fmt.Errorf("PrivilegesChanged check failed: %w", err)
+			}
+		}
+		if checker.File != nil {
+			if err := checker.File.Check(event.File); err != nil {
+				return fmt.Errorf("File check failed: %w", err)
+			}
+		}
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
 }
 
-// WithBinaryProperties adds a BinaryProperties check to the ProcessChecker
-func (checker *ProcessChecker) WithBinaryProperties(check *BinaryPropertiesChecker) *ProcessChecker {
-	checker.BinaryProperties = check
+// WithSetuid adds a Setuid check to the BinaryPropertiesChecker
+func (checker *BinaryPropertiesChecker) WithSetuid(check uint32) *BinaryPropertiesChecker {
+	checker.Setuid = &check
 	return checker
 }
 
-// WithUser adds a User check to the ProcessChecker
-func (checker *ProcessChecker) WithUser(check *UserRecordChecker) *ProcessChecker {
-	checker.User = check
+// WithSetgid adds a Setgid check to the BinaryPropertiesChecker
+func (checker *BinaryPropertiesChecker) WithSetgid(check uint32) *BinaryPropertiesChecker {
+	checker.Setgid = &check
 	return checker
 }
 
-// WithInInitTree adds a InInitTree check to the ProcessChecker
-func (checker *ProcessChecker) WithInInitTree(check bool) *ProcessChecker {
-	checker.InInitTree = &check
+// WithPrivilegesChanged adds a PrivilegesChanged check to the BinaryPropertiesChecker
+func (checker *BinaryPropertiesChecker) WithPrivilegesChanged(check *ProcessPrivilegesChangedListMatcher) *BinaryPropertiesChecker {
+	checker.PrivilegesChanged = check
 	return checker
 }
 
-// WithEnvironmentVariables adds a EnvironmentVariables check to the ProcessChecker
-func (checker *ProcessChecker) WithEnvironmentVariables(check *EnvVarListMatcher) *ProcessChecker {
-	checker.EnvironmentVariables = check
+// WithFile adds a File check to the BinaryPropertiesChecker
+func (checker *BinaryPropertiesChecker) WithFile(check *FilePropertiesChecker) *BinaryPropertiesChecker {
+	checker.File = check
 	return checker
 }
 
-//FromProcess populates the ProcessChecker using data from a Process field
-func (checker *ProcessChecker) FromProcess(event *tetragon.Process) *ProcessChecker {
+//FromBinaryProperties populates the BinaryPropertiesChecker using data from a BinaryProperties field
+func (checker *BinaryPropertiesChecker) FromBinaryProperties(event *tetragon.BinaryProperties) *BinaryPropertiesChecker {
 	if event == nil {
 		return checker
 	}
-	checker.ExecId = stringmatcher.Full(event.ExecId)
-	if event.Pid != nil {
-		val := event.Pid.Value
-		checker.Pid = &val
-	}
-	if event.Uid != nil {
-		val := event.Uid.Value
-		checker.Uid = &val
-	}
-	checker.Cwd = stringmatcher.Full(event.Cwd)
-	checker.Binary = stringmatcher.Full(event.Binary)
-	checker.Arguments = stringmatcher.Full(event.Arguments)
-	checker.Flags = stringmatcher.Full(event.Flags)
-	// NB: We don't want to match timestamps for now
-	checker.StartTime = nil
-	if event.Auid != nil {
-		val := event.Auid.Value
-		checker.Auid = &val
-	}
-	if event.Pod != nil {
-		checker.Pod = NewPodChecker().FromPod(event.Pod)
-	}
-	checker.Docker = stringmatcher.Full(event.Docker)
-	checker.ParentExecId = stringmatcher.Full(event.ParentExecId)
-	{
-		val := event.Refcnt
-		checker.Refcnt = &val
-	}
-	if event.Cap != nil {
-		checker.Cap = NewCapabilitiesChecker().FromCapabilities(event.Cap)
-	}
-	if event.Ns != nil {
-		checker.Ns = NewNamespacesChecker().FromNamespaces(event.Ns)
-	}
-	if event.Tid != nil {
-		val := event.Tid.Value
-		checker.Tid = &val
-	}
-	if event.ProcessCredentials != nil {
-		checker.ProcessCredentials = NewProcessCredentialsChecker().FromProcessCredentials(event.ProcessCredentials)
-	}
-	if event.BinaryProperties != nil {
-		checker.BinaryProperties = NewBinaryPropertiesChecker().FromBinaryProperties(event.BinaryProperties)
-	}
-	if event.User != nil {
-		checker.User = NewUserRecordChecker().FromUserRecord(event.User)
+	if event.Setuid != nil {
+		val := event.Setuid.Value
+		checker.Setuid = &val
 	}
-	if event.InInitTree != nil {
-		val := event.InInitTree.Value
-		checker.InInitTree = &val
+	if event.Setgid != nil {
+		val := event.Setgid.Value
+		checker.Setgid = &val
 	}
 	{
-		var checks []*EnvVarChecker
-		for _, check := range event.EnvironmentVariables {
-			var convertedCheck *EnvVarChecker
-			if check != nil {
-				convertedCheck = NewEnvVarChecker().FromEnvVar(check)
-			}
+		var checks []*ProcessPrivilegesChangedChecker
+		for _, check := range event.PrivilegesChanged {
+			var convertedCheck *ProcessPrivilegesChangedChecker
+			convertedCheck = NewProcessPrivilegesChangedChecker(check)
 			checks = append(checks, convertedCheck)
 		}
-		lm := NewEnvVarListMatcher().WithOperator(listmatcher.Ordered).
+		lm := NewProcessPrivilegesChangedListMatcher().WithOperator(listmatcher.Ordered).
 			WithValues(checks...)
-		checker.EnvironmentVariables = lm
+		checker.PrivilegesChanged = lm
+	}
+	if event.File != nil {
+		checker.File = NewFilePropertiesChecker().FromFileProperties(event.File)
 	}
 	return checker
 }
 
-// EnvVarListMatcher checks a list of *tetragon.EnvVar fields
-type EnvVarListMatcher struct {
-	Operator listmatcher.Operator `json:"operator"`
-	Values   []*EnvVarChecker     `json:"values"`
+// ProcessPrivilegesChangedListMatcher checks a list of tetragon.ProcessPrivilegesChanged fields
+type ProcessPrivilegesChangedListMatcher struct {
+	Operator listmatcher.Operator               `json:"operator"`
+	Values   []*ProcessPrivilegesChangedChecker `json:"values"`
 }
 
-// NewEnvVarListMatcher creates a new EnvVarListMatcher. The checker defaults to a subset checker unless otherwise specified using WithOperator()
-func NewEnvVarListMatcher() *EnvVarListMatcher {
-	return &EnvVarListMatcher{
+// NewProcessPrivilegesChangedListMatcher creates a new ProcessPrivilegesChangedListMatcher. The checker defaults to a subset checker unless otherwise specified using WithOperator()
+func NewProcessPrivilegesChangedListMatcher() *ProcessPrivilegesChangedListMatcher {
+	return &ProcessPrivilegesChangedListMatcher{
 		Operator: listmatcher.Subset,
 	}
 }
 
-// WithOperator sets the match kind for the EnvVarListMatcher
-func (checker *EnvVarListMatcher) WithOperator(operator listmatcher.Operator) *EnvVarListMatcher {
+// WithOperator sets the match kind for the ProcessPrivilegesChangedListMatcher
+func (checker *ProcessPrivilegesChangedListMatcher) WithOperator(operator listmatcher.Operator) *ProcessPrivilegesChangedListMatcher {
 	checker.Operator = operator
 	return checker
 }
 
-// WithValues sets the checkers that the EnvVarListMatcher should use
-func (checker *EnvVarListMatcher) WithValues(values ...*EnvVarChecker) *EnvVarListMatcher {
+// WithValues sets the checkers that the ProcessPrivilegesChangedListMatcher should use
+func (checker *ProcessPrivilegesChangedListMatcher) WithValues(values ...*ProcessPrivilegesChangedChecker) *ProcessPrivilegesChangedListMatcher {
 	checker.Values = values
 	return checker
 }
 
-// Check checks a list of *tetragon.EnvVar fields
-func (checker *EnvVarListMatcher) Check(values []*tetragon.EnvVar) error {
+// Check checks a list of tetragon.ProcessPrivilegesChanged fields
+func (checker *ProcessPrivilegesChangedListMatcher) Check(values []tetragon.ProcessPrivilegesChanged) error {
 	switch checker.Operator {
 	case listmatcher.Ordered:
 		return checker.orderedCheck(values)
@@ -5002,43 +5246,43 @@ func (checker *EnvVarListMatcher) Check(values []*tetragon.EnvVar) error {
 	}
 }
 
-// orderedCheck checks a list of ordered *tetragon.EnvVar fields
-func (checker *EnvVarListMatcher) orderedCheck(values []*tetragon.EnvVar) error {
-	innerCheck := func(check *EnvVarChecker, value *tetragon.EnvVar) error {
-		if err := check.Check(value); err != nil {
-			return fmt.Errorf("EnvironmentVariables check failed: %w", err)
+// orderedCheck checks a list of ordered tetragon.ProcessPrivilegesChanged fields
+func (checker *ProcessPrivilegesChangedListMatcher) orderedCheck(values []tetragon.ProcessPrivilegesChanged) error {
+	innerCheck := func(check *ProcessPrivilegesChangedChecker, value tetragon.ProcessPrivilegesChanged) error {
+		if err := check.Check(&value); err != nil {
+			return fmt.Errorf("PrivilegesChanged check failed: %w", err)
 		}
 		return nil
 	}
 
 	if len(checker.Values) != len(values) {
-		return fmt.Errorf("EnvVarListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+		return fmt.Errorf("ProcessPrivilegesChangedListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
 	}
 
 	for i, check := range checker.Values {
 		value := values[i]
 		if err := innerCheck(check, value); err != nil {
-			return fmt.Errorf("EnvVarListMatcher: Check failed on element %d: %w", i, err)
+			return fmt.Errorf("ProcessPrivilegesChangedListMatcher: Check failed on element %d: %w", i, err)
 		}
 	}
 
 	return nil
 }
 
-// unorderedCheck checks a list of unordered *tetragon.EnvVar fields
-func (checker *EnvVarListMatcher) unorderedCheck(values []*tetragon.EnvVar) error {
+// unorderedCheck checks a list of unordered tetragon.ProcessPrivilegesChanged fields
+func (checker *ProcessPrivilegesChangedListMatcher) unorderedCheck(values []tetragon.ProcessPrivilegesChanged) error {
 	if len(checker.Values) != len(values) {
-		return fmt.Errorf("EnvVarListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+		return fmt.Errorf("ProcessPrivilegesChangedListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
 	}
 
 	return checker.subsetCheck(values)
 }
 
-// subsetCheck checks a subset of *tetragon.EnvVar fields
-func (checker *EnvVarListMatcher) subsetCheck(values []*tetragon.EnvVar) error {
-	innerCheck := func(check *EnvVarChecker, value *tetragon.EnvVar) error {
-		if err := check.Check(value); err != nil {
-			return fmt.Errorf("EnvironmentVariables check failed: %w", err)
+// subsetCheck checks a subset of tetragon.ProcessPrivilegesChanged fields
+func (checker *ProcessPrivilegesChangedListMatcher) subsetCheck(values []tetragon.ProcessPrivilegesChanged) error {
+	innerCheck := func(check *ProcessPrivilegesChangedChecker, value tetragon.ProcessPrivilegesChanged) error {
+		if err := check.Check(&value); err != nil {
+			return fmt.Errorf("PrivilegesChanged check failed: %w", err)
 		}
 		return nil
 	}
@@ -5057,97 +5301,93 @@ nextCheck:
 	}
 
 	if numMatched < numDesired {
-		return fmt.Errorf("EnvVarListMatcher: Check failed, only matched %d elements but wanted %d", numMatched, numDesired)
+		return fmt.Errorf("ProcessPrivilegesChangedListMatcher: Check failed, only matched %d elements but wanted %d", numMatched, numDesired)
 	}
 
 	return nil
 }
 
-// KprobeSockChecker implements a checker struct to check a KprobeSock field
-type KprobeSockChecker struct {
-	Family   *stringmatcher.StringMatcher `json:"family,omitempty"`
-	Type     *stringmatcher.StringMatcher `json:"type,omitempty"`
-	Protocol *stringmatcher.StringMatcher `json:"protocol,omitempty"`
-	Mark     *uint32                      `json:"mark,omitempty"`
-	Priority *uint32                      `json:"priority,omitempty"`
-	Saddr    *stringmatcher.StringMatcher `json:"saddr,omitempty"`
-	Daddr    *stringmatcher.StringMatcher `json:"daddr,omitempty"`
-	Sport    *uint32                      `json:"sport,omitempty"`
-	Dport    *uint32                      `json:"dport,omitempty"`
-	Cookie   *uint64                      `json:"cookie,omitempty"`
-	State    *stringmatcher.StringMatcher `json:"state,omitempty"`
+// UserRecordChecker implements a checker struct to check a UserRecord field
+type UserRecordChecker struct {
+	Name *stringmatcher.StringMatcher `json:"name,omitempty"`
 }
 
-// NewKprobeSockChecker creates a new KprobeSockChecker
-func NewKprobeSockChecker() *KprobeSockChecker {
-	return &KprobeSockChecker{}
+// NewUserRecordChecker creates a new UserRecordChecker
+func NewUserRecordChecker() *UserRecordChecker {
+	return &UserRecordChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *KprobeSockChecker) GetCheckerType() string {
-	return "KprobeSockChecker"
+func (checker *UserRecordChecker) GetCheckerType() string {
+	return "UserRecordChecker"
 }
 
-// Check checks a KprobeSock field
-func (checker *KprobeSockChecker) Check(event *tetragon.KprobeSock) error {
+// Check checks a UserRecord field
+func (checker *UserRecordChecker) Check(event *tetragon.UserRecord) error {
 	if event == nil {
-		return fmt.Errorf("%s: KprobeSock field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: UserRecord field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Family != nil {
-			if err := checker.Family.Match(event.Family); err != nil {
-				return fmt.Errorf("Family check failed: %w", err)
-			}
-		}
-		if checker.Type != nil {
-			if err := checker.Type.Match(event.Type); err != nil {
-				return fmt.Errorf("Type check failed: %w", err)
-			}
-		}
-		if checker.Protocol != nil {
-			if err := checker.Protocol.Match(event.Protocol); err != nil {
-				return fmt.Errorf("Protocol check failed: %w", err)
-			}
-		}
-		if checker.Mark != nil {
-			if *checker.Mark != event.Mark {
-				return fmt.Errorf("Mark has value %d which does not match expected value %d", event.Mark, *checker.Mark)
-			}
-		}
-		if checker.Priority != nil {
-			if *checker.Priority != event.Priority {
-				return fmt.Errorf("Priority has value %d which does not match expected value %d", event.Priority, *checker.Priority)
-			}
-		}
-		if checker.Saddr != nil {
-			if err := checker.Saddr.Match(event.Saddr); err != nil {
-				return fmt.Errorf("Saddr check failed: %w", err)
-			}
-		}
-		if checker.Daddr != nil {
-			if err := checker.Daddr.Match(event.Daddr); err != nil {
-				return fmt.Errorf("Daddr check failed: %w", err)
-			}
-		}
-		if checker.Sport != nil {
-			if *checker.Sport != event.Sport {
-				return fmt.Errorf("Sport has value %d which does not match expected value %d", event.Sport, *checker.Sport)
-			}
-		}
-		if checker.Dport != nil {
-			if *checker.Dport != event.Dport {
-				return fmt.Errorf("Dport has value %d which does not match expected value %d", event.Dport, *checker.Dport)
+		if checker.Name != nil {
+			if err := checker.Name.Match(event.Name); err != nil {
+				return fmt.Errorf("Name check failed: %w", err)
 			}
 		}
-		if checker.Cookie != nil {
-			if *checker.Cookie != event.Cookie {
-				return fmt.Errorf("Cookie has value %d which does not match expected value %d", event.Cookie, *checker.Cookie)
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
+}
+
+// WithName adds a Name check to the UserRecordChecker
+func (checker *UserRecordChecker) WithName(check *stringmatcher.StringMatcher) *UserRecordChecker {
+	checker.Name = check
+	return checker
+}
+
+//FromUserRecord populates the UserRecordChecker using data from a UserRecord field
+func (checker *UserRecordChecker) FromUserRecord(event *tetragon.UserRecord) *UserRecordChecker {
+	if event == nil {
+		return checker
+	}
+	checker.Name = stringmatcher.Full(event.Name)
+	return checker
+}
+
+// EnvVarChecker implements a checker struct to check a EnvVar field
+type EnvVarChecker struct {
+	Key   *stringmatcher.StringMatcher `json:"Key,omitempty"`
+	Value *stringmatcher.StringMatcher `json:"Value,omitempty"`
+}
+
+// NewEnvVarChecker creates a new EnvVarChecker
+func NewEnvVarChecker() *EnvVarChecker {
+	return &EnvVarChecker{}
+}
+
+// Get the type of the checker as a string
+func (checker *EnvVarChecker) GetCheckerType() string {
+	return "EnvVarChecker"
+}
+
+// Check checks a EnvVar field
+func (checker *EnvVarChecker) Check(event *tetragon.EnvVar) error {
+	if event == nil {
+		return fmt.Errorf("%s: EnvVar field is nil", CheckerLogPrefix(checker))
+	}
+
+	fieldChecks := func() error {
+		if checker.Key != nil {
+			if err := checker.Key.Match(event.Key); err != nil {
+				return fmt.Errorf("Key check failed: %w", err)
 			}
 		}
-		if checker.State != nil {
-			if err := checker.State.Match(event.State); err != nil {
-				return fmt.Errorf("State check failed: %w", err)
+		if checker.Value != nil {
+			if err := checker.Value.Match(event.Value); err != nil {
+				return fmt.Errorf("Value check failed: %w", err)
 			}
 		}
 		return nil
@@ -5158,203 +5398,279 @@ func (checker *KprobeSockChecker) Check(event *tetragon.KprobeSock) error {
 	return nil
 }
 
-// WithFamily adds a Family check to the KprobeSockChecker
-func (checker *KprobeSockChecker) WithFamily(check *stringmatcher.StringMatcher) *KprobeSockChecker {
-	checker.Family = check
+// WithKey adds a Key check to the EnvVarChecker
+func (checker *EnvVarChecker) WithKey(check *stringmatcher.StringMatcher) *EnvVarChecker {
+	checker.Key = check
 	return checker
 }
 
-// WithType adds a Type check to the KprobeSockChecker
-func (checker *KprobeSockChecker) WithType(check *stringmatcher.StringMatcher) *KprobeSockChecker {
-	checker.Type = check
+// WithValue adds a Value check to the EnvVarChecker
+func (checker *EnvVarChecker) WithValue(check *stringmatcher.StringMatcher) *EnvVarChecker {
+	checker.Value = check
 	return checker
 }
 
-// WithProtocol adds a Protocol check to the KprobeSockChecker
-func (checker *KprobeSockChecker) WithProtocol(check *stringmatcher.StringMatcher) *KprobeSockChecker {
-	checker.Protocol = check
+//FromEnvVar populates the EnvVarChecker using data from a EnvVar field
+func (checker *EnvVarChecker) FromEnvVar(event *tetragon.EnvVar) *EnvVarChecker {
+	if event == nil {
+		return checker
+	}
+	checker.Key = stringmatcher.Full(event.Key)
+	checker.Value = stringmatcher.Full(event.Value)
 	return checker
 }
 
-// WithMark adds a Mark check to the KprobeSockChecker
-func (checker *KprobeSockChecker) WithMark(check uint32) *KprobeSockChecker {
-	checker.Mark = &check
-	return checker
+// ProcessTruncatedChecker implements a checker struct to check a ProcessTruncated field
+type ProcessTruncatedChecker struct {
+	ArgumentsLength            *uint32 `json:"argumentsLength,omitempty"`
+	EnvironmentVariablesLength *uint32 `json:"environmentVariablesLength,omitempty"`
+	CwdLength                  *uint32 `json:"cwdLength,omitempty"`
 }
 
-// WithPriority adds a Priority check to the KprobeSockChecker
-func (checker *KprobeSockChecker) WithPriority(check uint32) *KprobeSockChecker {
-	checker.Priority = &check
-	return checker
+// NewProcessTruncatedChecker creates a new ProcessTruncatedChecker
+func NewProcessTruncatedChecker() *ProcessTruncatedChecker {
+	return &ProcessTruncatedChecker{}
 }
 
-// WithSaddr adds a Saddr check to the KprobeSockChecker
-func (checker *KprobeSockChecker) WithSaddr(check *stringmatcher.StringMatcher) *KprobeSockChecker {
-	checker.Saddr = check
-	return checker
+// Get the type of the checker as a string
+func (checker *ProcessTruncatedChecker) GetCheckerType() string {
+	return "ProcessTruncatedChecker"
 }
 
-// WithDaddr adds a Daddr check to the KprobeSockChecker
-func (checker *KprobeSockChecker) WithDaddr(check *stringmatcher.StringMatcher) *KprobeSockChecker {
-	checker.Daddr = check
-	return checker
-}
+// Check checks a ProcessTruncated field
+func (checker *ProcessTruncatedChecker) Check(event *tetragon.ProcessTruncated) error {
+	if event == nil {
+		return fmt.Errorf("%s: ProcessTruncated field is nil", CheckerLogPrefix(checker))
+	}
 
-// WithSport adds a Sport check to the KprobeSockChecker
-func (checker *KprobeSockChecker) WithSport(check uint32) *KprobeSockChecker {
-	checker.Sport = &check
-	return checker
+	fieldChecks := func() error {
+		if checker.ArgumentsLength != nil {
+			if *checker.ArgumentsLength != event.ArgumentsLength {
+				return fmt.Errorf("ArgumentsLength has value %d which does not match expected value %d", event.ArgumentsLength, *checker.ArgumentsLength)
+			}
+		}
+		if checker.EnvironmentVariablesLength != nil {
+			if *checker.EnvironmentVariablesLength != event.EnvironmentVariablesLength {
+				return fmt.Errorf("EnvironmentVariablesLength has value %d which does not match expected value %d", event.EnvironmentVariablesLength, *checker.EnvironmentVariablesLength)
+			}
+		}
+		if checker.CwdLength != nil {
+			if *checker.CwdLength != event.CwdLength {
+				return fmt.Errorf("CwdLength has value %d which does not match expected value %d", event.CwdLength, *checker.CwdLength)
+			}
+		}
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
 }
 
-// WithDport adds a Dport check to the KprobeSockChecker
-func (checker *KprobeSockChecker) WithDport(check uint32) *KprobeSockChecker {
-	checker.Dport = &check
+// WithArgumentsLength adds a ArgumentsLength check to the ProcessTruncatedChecker
+func (checker *ProcessTruncatedChecker) WithArgumentsLength(check uint32) *ProcessTruncatedChecker {
+	checker.ArgumentsLength = &check
 	return checker
 }
 
-// WithCookie adds a Cookie check to the KprobeSockChecker
-func (checker *KprobeSockChecker) WithCookie(check uint64) *KprobeSockChecker {
-	checker.Cookie = &check
+// WithEnvironmentVariablesLength adds a EnvironmentVariablesLength check to the ProcessTruncatedChecker
+func (checker *ProcessTruncatedChecker) WithEnvironmentVariablesLength(check uint32) *ProcessTruncatedChecker {
+	checker.EnvironmentVariablesLength = &check
 	return checker
 }
 
-// WithState adds a State check to the KprobeSockChecker
-func (checker *KprobeSockChecker) WithState(check *stringmatcher.StringMatcher) *KprobeSockChecker {
-	checker.State = check
+// WithCwdLength adds a CwdLength check to the ProcessTruncatedChecker
+func (checker *ProcessTruncatedChecker) WithCwdLength(check uint32) *ProcessTruncatedChecker {
+	checker.CwdLength = &check
 	return checker
 }
 
-//FromKprobeSock populates the KprobeSockChecker using data from a KprobeSock field
-func (checker *KprobeSockChecker) FromKprobeSock(event *tetragon.KprobeSock) *KprobeSockChecker {
+//FromProcessTruncated populates the ProcessTruncatedChecker using data from a ProcessTruncated field
+func (checker *ProcessTruncatedChecker) FromProcessTruncated(event *tetragon.ProcessTruncated) *ProcessTruncatedChecker {
 	if event == nil {
 		return checker
 	}
-	checker.Family = stringmatcher.Full(event.Family)
-	checker.Type = stringmatcher.Full(event.Type)
-	checker.Protocol = stringmatcher.Full(event.Protocol)
-	{
-		val := event.Mark
-		checker.Mark = &val
-	}
-	{
-		val := event.Priority
-		checker.Priority = &val
-	}
-	checker.Saddr = stringmatcher.Full(event.Saddr)
-	checker.Daddr = stringmatcher.Full(event.Daddr)
 	{
-		val := event.Sport
-		checker.Sport = &val
+		val := event.ArgumentsLength
+		checker.ArgumentsLength = &val
 	}
 	{
-		val := event.Dport
-		checker.Dport = &val
+		val := event.EnvironmentVariablesLength
+		checker.EnvironmentVariablesLength = &val
 	}
 	{
-		val := event.Cookie
-		checker.Cookie = &val
+		val := event.CwdLength
+		checker.CwdLength = &val
 	}
-	checker.State = stringmatcher.Full(event.State)
 	return checker
 }
 
-// KprobeSkbChecker implements a checker struct to check a KprobeSkb field
-type KprobeSkbChecker struct {
-	Hash        *uint32                      `json:"hash,omitempty"`
-	Len         *uint32                      `json:"len,omitempty"`
-	Priority    *uint32                      `json:"priority,omitempty"`
-	Mark        *uint32                      `json:"mark,omitempty"`
-	Saddr       *stringmatcher.StringMatcher `json:"saddr,omitempty"`
-	Daddr       *stringmatcher.StringMatcher `json:"daddr,omitempty"`
-	Sport       *uint32                      `json:"sport,omitempty"`
-	Dport       *uint32                      `json:"dport,omitempty"`
-	Proto       *uint32                      `json:"proto,omitempty"`
-	SecPathLen  *uint32                      `json:"secPathLen,omitempty"`
-	SecPathOlen *uint32                      `json:"secPathOlen,omitempty"`
-	Protocol    *stringmatcher.StringMatcher `json:"protocol,omitempty"`
-	Family      *stringmatcher.StringMatcher `json:"family,omitempty"`
+// ProcessChecker implements a checker struct to check a Process field
+type ProcessChecker struct {
+	ExecId               *stringmatcher.StringMatcher       `json:"execId,omitempty"`
+	Pid                  *uint32                            `json:"pid,omitempty"`
+	Uid                  *uint32                            `json:"uid,omitempty"`
+	Cwd                  *stringmatcher.StringMatcher       `json:"cwd,omitempty"`
+	Binary               *stringmatcher.StringMatcher       `json:"binary,omitempty"`
+	Arguments            *stringmatcher.StringMatcher       `json:"arguments,omitempty"`
+	Flags                *stringmatcher.StringMatcher       `json:"flags,omitempty"`
+	StartTime            *timestampmatcher.TimestampMatcher `json:"startTime,omitempty"`
+	Auid                 *uint32                            `json:"auid,omitempty"`
+	Pod                  *PodChecker                        `json:"pod,omitempty"`
+	Docker               *stringmatcher.StringMatcher       `json:"docker,omitempty"`
+	ParentExecId         *stringmatcher.StringMatcher       `json:"parentExecId,omitempty"`
+	Refcnt               *uint32                            `json:"refcnt,omitempty"`
+	Cap                  *CapabilitiesChecker               `json:"cap,omitempty"`
+	Ns                   *NamespacesChecker                 `json:"ns,omitempty"`
+	Tid                  *uint32                            `json:"tid,omitempty"`
+	ProcessCredentials   *ProcessCredentialsChecker         `json:"processCredentials,omitempty"`
+	BinaryProperties     *BinaryPropertiesChecker           `json:"binaryProperties,omitempty"`
+	User                 *UserRecordChecker                 `json:"user,omitempty"`
+	InInitTree           *bool                              `json:"inInitTree,omitempty"`
+	EnvironmentVariables *EnvVarListMatcher                 `json:"environmentVariables,omitempty"`
+	Truncated            *ProcessTruncatedChecker           `json:"truncated,omitempty"`
 }
 
-// NewKprobeSkbChecker creates a new KprobeSkbChecker
-func NewKprobeSkbChecker() *KprobeSkbChecker {
-	return &KprobeSkbChecker{}
+// NewProcessChecker creates a new ProcessChecker
+func NewProcessChecker() *ProcessChecker {
+	return &ProcessChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *KprobeSkbChecker) GetCheckerType() string {
-	return "KprobeSkbChecker"
+func (checker *ProcessChecker) GetCheckerType() string {
+	return "ProcessChecker"
 }
 
-// Check checks a KprobeSkb field
-func (checker *KprobeSkbChecker) Check(event *tetragon.KprobeSkb) error {
+// Check checks a Process field
+func (checker *ProcessChecker) Check(event *tetragon.Process) error {
 	if event == nil {
-		return fmt.Errorf("%s: KprobeSkb field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: Process field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Hash != nil {
-			if *checker.Hash != event.Hash {
-				return fmt.Errorf("Hash has value %d which does not match expected value %d", event.Hash, *checker.Hash)
+		if checker.ExecId != nil {
+			if err := checker.ExecId.Match(event.ExecId); err != nil {
+				return fmt.Errorf("ExecId check failed: %w", err)
 			}
 		}
-		if checker.Len != nil {
-			if *checker.Len != event.Len {
-				return fmt.Errorf("Len has value %d which does not match expected value %d", event.Len, *checker.Len)
+		if checker.Pid != nil {
+			if event.Pid == nil {
+				return fmt.Errorf("Pid is nil and does not match expected value %v", *checker.Pid)
+			}
+			if *checker.Pid != event.Pid.Value {
+				return fmt.Errorf("Pid has value %v which does not match expected value %v", event.Pid.Value, *checker.Pid)
 			}
 		}
-		if checker.Priority != nil {
-			if *checker.Priority != event.Priority {
-				return fmt.Errorf("Priority has value %d which does not match expected value %d", event.Priority, *checker.Priority)
+		if checker.Uid != nil {
+			if event.Uid == nil {
+				return fmt.Errorf("Uid is nil and does not match expected value %v", *checker.Uid)
+			}
+			if *checker.Uid != event.Uid.Value {
+				return fmt.Errorf("Uid has value %v which does not match expected value %v", event.Uid.Value, *checker.Uid)
 			}
 		}
-		if checker.Mark != nil {
-			if *checker.Mark != event.Mark {
-				return fmt.Errorf("Mark has value %d which does not match expected value %d", event.Mark, *checker.Mark)
+		if checker.Cwd != nil {
+			if err := checker.Cwd.Match(event.Cwd); err != nil {
+				return fmt.Errorf("Cwd check failed: %w", err)
 			}
 		}
-		if checker.Saddr != nil {
-			if err := checker.Saddr.Match(event.Saddr); err != nil {
-				return fmt.Errorf("Saddr check failed: %w", err)
+		if checker.Binary != nil {
+			if err := checker.Binary.Match(event.Binary); err != nil {
+				return fmt.Errorf("Binary check failed: %w", err)
 			}
 		}
-		if checker.Daddr != nil {
-			if err := checker.Daddr.Match(event.Daddr); err != nil {
-				return fmt.Errorf("Daddr check failed: %w", err)
+		if checker.Arguments != nil {
+			if err := checker.Arguments.Match(event.Arguments); err != nil {
+				return fmt.Errorf("Arguments check failed: %w", err)
 			}
 		}
-		if checker.Sport != nil {
-			if *checker.Sport != event.Sport {
-				return fmt.Errorf("Sport has value %d which does not match expected value %d", event.Sport, *checker.Sport)
+		if checker.Flags != nil {
+			if err := checker.Flags.Match(event.Flags); err != nil {
+				return fmt.Errorf("Flags check failed: %w", err)
 			}
 		}
-		if checker.Dport != nil {
-			if *checker.Dport != event.Dport {
-				return fmt.Errorf("Dport has value %d which does not match expected value %d", event.Dport, *checker.Dport)
+		if checker.StartTime != nil {
+			if err := checker.StartTime.Match(event.StartTime); err != nil {
+				return fmt.Errorf("StartTime check failed: %w", err)
 			}
 		}
-		if checker.Proto != nil {
-			if *checker.Proto != event.Proto {
-				return fmt.Errorf("Proto has value %d which does not match expected value %d", event.Proto, *checker.Proto)
+		if checker.Auid != nil {
+			if event.Auid == nil {
+				return fmt.Errorf("Auid is nil and does not match expected value %v", *checker.Auid)
+			}
+			if *checker.Auid != event.Auid.Value {
+				return fmt.Errorf("Auid has value %v which does not match expected value %v", event.Auid.Value, *checker.Auid)
 			}
 		}
-		if checker.SecPathLen != nil {
-			if *checker.SecPathLen != event.SecPathLen {
-				return fmt.Errorf("SecPathLen has value %d which does not match expected value %d", event.SecPathLen, *checker.SecPathLen)
+		if checker.Pod != nil {
+			if err := checker.Pod.Check(event.Pod); err != nil {
+				return fmt.Errorf("Pod check failed: %w", err)
 			}
 		}
-		if checker.SecPathOlen != nil {
-			if *checker.SecPathOlen != event.SecPathOlen {
-				return fmt.Errorf("SecPathOlen has value %d which does not match expected value %d", event.SecPathOlen, *checker.SecPathOlen)
+		if checker.Docker != nil {
+			if err := checker.Docker.Match(event.Docker); err != nil {
+				return fmt.Errorf("Docker check failed: %w", err)
 			}
 		}
-		if checker.Protocol != nil {
-			if err := checker.Protocol.Match(event.Protocol); err != nil {
-				return fmt.Errorf("Protocol check failed: %w", err)
+		if checker.ParentExecId != nil {
+			if err := checker.ParentExecId.Match(event.ParentExecId); err != nil {
+				return fmt.Errorf("ParentExecId check failed: %w", err)
 			}
 		}
-		if checker.Family != nil {
-			if err := checker.Family.Match(event.Family); err != nil {
-				return fmt.Errorf("Family check failed: %w", err)
+		if checker.Refcnt != nil {
+			if *checker.Refcnt != event.Refcnt {
+				return fmt.Errorf("Refcnt has value %d which does not match expected value %d", event.Refcnt, *checker.Refcnt)
+			}
+		}
+		if checker.Cap != nil {
+			if err := checker.Cap.Check(event.Cap); err != nil {
+				return fmt.Errorf("Cap check failed: %w", err)
+			}
+		}
+		if checker.Ns != nil {
+			if err := checker.Ns.Check(event.Ns); err != nil {
+				return fmt.Errorf("Ns check failed: %w", err)
+			}
+		}
+		if checker.Tid != nil {
+			if event.Tid == nil {
+				return fmt.Errorf("Tid is nil and does not match expected value %v", *checker.Tid)
+			}
+			if *checker.Tid != event.Tid.Value {
+				return fmt.Errorf("Tid has value %v which does not match expected value %v", event.Tid.Value, *checker.Tid)
+			}
+		}
+		if checker.ProcessCredentials != nil {
+			if err := checker.ProcessCredentials.Check(event.ProcessCredentials); err != nil {
+				return fmt.Errorf("ProcessCredentials check failed: %w", err)
+			}
+		}
+		if checker.BinaryProperties != nil {
+			if err := checker.BinaryProperties.Check(event.BinaryProperties); err != nil {
+				return fmt.Errorf("BinaryProperties check failed: %w", err)
+			}
+		}
+		if checker.User != nil {
+			if err := checker.User.Check(event.User); err != nil {
+				return fmt.Errorf("User check failed: %w", err)
+			}
+		}
+		if checker.InInitTree != nil {
+			if event.InInitTree == nil {
+				return fmt.Errorf("InInitTree is nil and does not match expected value %v", *checker.InInitTree)
+			}
+			if *checker.InInitTree != event.InInitTree.Value {
+				return fmt.Errorf("InInitTree has value %v which does not match expected value %v", event.InInitTree.Value, *checker.InInitTree)
+			}
+		}
+		if checker.EnvironmentVariables != nil {
+			if err := checker.EnvironmentVariables.Check(event.EnvironmentVariables); err != nil {
+				return fmt.Errorf("EnvironmentVariables check failed: %w", err)
+			}
+		}
+		if checker.Truncated != nil {
+			if err := checker.Truncated.Check(event.Truncated); err != nil {
+				return fmt.Errorf("Truncated check failed: %w", err)
 			}
 		}
 		return nil
@@ -5365,393 +5681,398 @@ func (checker *KprobeSkbChecker) Check(event *tetragon.KprobeSkb) error {
 	return nil
 }
 
-// WithHash adds a Hash check to the KprobeSkbChecker
-func (checker *KprobeSkbChecker) WithHash(check uint32) *KprobeSkbChecker {
-	checker.Hash = &check
+// WithExecId adds a ExecId check to the ProcessChecker
+func (checker *ProcessChecker) WithExecId(check *stringmatcher.StringMatcher) *ProcessChecker {
+	checker.ExecId = check
 	return checker
 }
 
-// WithLen adds a Len check to the KprobeSkbChecker
-func (checker *KprobeSkbChecker) WithLen(check uint32) *KprobeSkbChecker {
-	checker.Len = &check
+// WithPid adds a Pid check to the ProcessChecker
+func (checker *ProcessChecker) WithPid(check uint32) *ProcessChecker {
+	checker.Pid = &check
 	return checker
 }
 
-// WithPriority adds a Priority check to the KprobeSkbChecker
-func (checker *KprobeSkbChecker) WithPriority(check uint32) *KprobeSkbChecker {
-	checker.Priority = &check
+// WithUid adds a Uid check to the ProcessChecker
+func (checker *ProcessChecker) WithUid(check uint32) *ProcessChecker {
+	checker.Uid = &check
 	return checker
 }
 
-// WithMark adds a Mark check to the KprobeSkbChecker
-func (checker *KprobeSkbChecker) WithMark(check uint32) *KprobeSkbChecker {
-	checker.Mark = &check
+// WithCwd adds a Cwd check to the ProcessChecker
+func (checker *ProcessChecker) WithCwd(check *stringmatcher.StringMatcher) *ProcessChecker {
+	checker.Cwd = check
 	return checker
 }
 
-// WithSaddr adds a Saddr check to the KprobeSkbChecker
-func (checker *KprobeSkbChecker) WithSaddr(check *stringmatcher.StringMatcher) *KprobeSkbChecker {
-	checker.Saddr = check
+// WithBinary adds a Binary check to the ProcessChecker
+func (checker *ProcessChecker) WithBinary(check *stringmatcher.StringMatcher) *ProcessChecker {
+	checker.Binary = check
 	return checker
 }
 
-// WithDaddr adds a Daddr check to the KprobeSkbChecker
-func (checker *KprobeSkbChecker) WithDaddr(check *stringmatcher.StringMatcher) *KprobeSkbChecker {
-	checker.Daddr = check
+// WithArguments adds a Arguments check to the ProcessChecker
+func (checker *ProcessChecker) WithArguments(check *stringmatcher.StringMatcher) *ProcessChecker {
+	checker.Arguments = check
 	return checker
 }
 
-// WithSport adds a Sport check to the KprobeSkbChecker
-func (checker *KprobeSkbChecker) WithSport(check uint32) *KprobeSkbChecker {
-	checker.Sport = &check
+// WithFlags adds a Flags check to the ProcessChecker
+func (checker *ProcessChecker) WithFlags(check *stringmatcher.StringMatcher) *ProcessChecker {
+	checker.Flags = check
 	return checker
 }
 
-// WithDport adds a Dport check to the KprobeSkbChecker
-func (checker *KprobeSkbChecker) WithDport(check uint32) *KprobeSkbChecker {
-	checker.Dport = &check
+// WithStartTime adds a StartTime check to the ProcessChecker
+func (checker *ProcessChecker) WithStartTime(check *timestampmatcher.TimestampMatcher) *ProcessChecker {
+	checker.StartTime = check
 	return checker
 }
 
-// WithProto adds a Proto check to the KprobeSkbChecker
-func (checker *KprobeSkbChecker) WithProto(check uint32) *KprobeSkbChecker {
-	checker.Proto = &check
+// WithAuid adds a Auid check to the ProcessChecker
+func (checker *ProcessChecker) WithAuid(check uint32) *ProcessChecker {
+	checker.Auid = &check
 	return checker
 }
 
-// WithSecPathLen adds a SecPathLen check to the KprobeSkbChecker
-func (checker *KprobeSkbChecker) WithSecPathLen(check uint32) *KprobeSkbChecker {
-	checker.SecPathLen = &check
+// WithPod adds a Pod check to the ProcessChecker
+func (checker *ProcessChecker) WithPod(check *PodChecker) *ProcessChecker {
+	checker.Pod = check
 	return checker
 }
 
-// WithSecPathOlen adds a SecPathOlen check to the KprobeSkbChecker
-func (checker *KprobeSkbChecker) WithSecPathOlen(check uint32) *KprobeSkbChecker {
-	checker.SecPathOlen = &check
+// WithDocker adds a Docker check to the ProcessChecker
+func (checker *ProcessChecker) WithDocker(check *stringmatcher.StringMatcher) *ProcessChecker {
+	checker.Docker = check
 	return checker
 }
 
-// WithProtocol adds a Protocol check to the KprobeSkbChecker
-func (checker *KprobeSkbChecker) WithProtocol(check *stringmatcher.StringMatcher) *KprobeSkbChecker {
-	checker.Protocol = check
+// WithParentExecId adds a ParentExecId check to the ProcessChecker
+func (checker *ProcessChecker) WithParentExecId(check *stringmatcher.StringMatcher) *ProcessChecker {
+	checker.ParentExecId = check
 	return checker
 }
 
-// WithFamily adds a Family check to the KprobeSkbChecker
-func (checker *KprobeSkbChecker) WithFamily(check *stringmatcher.StringMatcher) *KprobeSkbChecker {
-	checker.Family = check
+// WithRefcnt adds a Refcnt check to the ProcessChecker
+func (checker *ProcessChecker) WithRefcnt(check uint32) *ProcessChecker {
+	checker.Refcnt = &check
 	return checker
 }
 
-//FromKprobeSkb populates the KprobeSkbChecker using data from a KprobeSkb field
-func (checker *KprobeSkbChecker) FromKprobeSkb(event *tetragon.KprobeSkb) *KprobeSkbChecker {
-	if event == nil {
-		return checker
-	}
-	{
-		val := event.Hash
-		checker.Hash = &val
-	}
-	{
-		val := event.Len
-		checker.Len = &val
-	}
-	{
-		val := event.Priority
-		checker.Priority = &val
-	}
-	{
-		val := event.Mark
-		checker.Mark = &val
-	}
-	checker.Saddr = stringmatcher.Full(event.Saddr)
-	checker.Daddr = stringmatcher.Full(event.Daddr)
-	{
-		val := event.Sport
-		checker.Sport = &val
-	}
-	{
-		val := event.Dport
-		checker.Dport = &val
-	}
-	{
-		val := event.Proto
-		checker.Proto = &val
-	}
-	{
-		val := event.SecPathLen
-		checker.SecPathLen = &val
-	}
-	{
-		val := event.SecPathOlen
-		checker.SecPathOlen = &val
-	}
-	checker.Protocol = stringmatcher.Full(event.Protocol)
-	checker.Family = stringmatcher.Full(event.Family)
+// WithCap adds a Cap check to the ProcessChecker
+func (checker *ProcessChecker) WithCap(check *CapabilitiesChecker) *ProcessChecker {
+	checker.Cap = check
 	return checker
 }
 
-// KprobeSockaddrChecker implements a checker struct to check a KprobeSockaddr field
-type KprobeSockaddrChecker struct {
-	Family *stringmatcher.StringMatcher `json:"family,omitempty"`
-	Addr   *stringmatcher.StringMatcher `json:"addr,omitempty"`
-	Port   *uint32                      `json:"port,omitempty"`
+// WithNs adds a Ns check to the ProcessChecker
+func (checker *ProcessChecker) WithNs(check *NamespacesChecker) *ProcessChecker {
+	checker.Ns = check
+	return checker
 }
 
-// NewKprobeSockaddrChecker creates a new KprobeSockaddrChecker
-func NewKprobeSockaddrChecker() *KprobeSockaddrChecker {
-	return &KprobeSockaddrChecker{}
+// WithTid adds a Tid check to the ProcessChecker
+func (checker *ProcessChecker) WithTid(check uint32) *ProcessChecker {
+	checker.Tid = &check
+	return checker
 }
 
-// Get the type of the checker as a string
-func (checker *KprobeSockaddrChecker) GetCheckerType() string {
-	return "KprobeSockaddrChecker"
+// WithProcessCredentials adds a ProcessCredentials check to the ProcessChecker
+func (checker *ProcessChecker) WithProcessCredentials(check *ProcessCredentialsChecker) *ProcessChecker {
+	checker.ProcessCredentials = check
+	return checker
 }
 
-// Check checks a KprobeSockaddr field
-func (checker *KprobeSockaddrChecker) Check(event *tetragon.KprobeSockaddr) error {
-	if event == nil {
-		return fmt.Errorf("%s: KprobeSockaddr field is nil", CheckerLogPrefix(checker))
-	}
+// WithBinaryProperties adds a BinaryProperties check to the ProcessChecker
+func (checker *ProcessChecker) WithBinaryProperties(check *BinaryPropertiesChecker) *ProcessChecker {
+	checker.BinaryProperties = check
+	return checker
+}
 
-	fieldChecks := func() error {
-		if checker.Family != nil {
-			if err := checker.Family.Match(event.Family); err != nil {
-				return fmt.Errorf("Family check failed: %w", err)
-			}
-		}
-		if checker.Addr != nil {
-			if err := checker.Addr.Match(event.Addr); err != nil {
-				return fmt.Errorf("Addr check failed: %w", err)
-			}
-		}
-		if checker.Port != nil {
-			if *checker.Port != event.Port {
-				return fmt.Errorf("Port has value %d which does not match expected value %d", event.Port, *checker.Port)
-			}
-		}
-		return nil
-	}
-	if err := fieldChecks(); err != nil {
-		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
-	}
-	return nil
+// WithUser adds a User check to the ProcessChecker
+func (checker *ProcessChecker) WithUser(check *UserRecordChecker) *ProcessChecker {
+	checker.User = check
+	return checker
 }
 
-// WithFamily adds a Family check to the KprobeSockaddrChecker
-func (checker *KprobeSockaddrChecker) WithFamily(check *stringmatcher.StringMatcher) *KprobeSockaddrChecker {
-	checker.Family = check
+// WithInInitTree adds a InInitTree check to the ProcessChecker
+func (checker *ProcessChecker) WithInInitTree(check bool) *ProcessChecker {
+	checker.InInitTree = &check
 	return checker
 }
 
-// WithAddr adds a Addr check to the KprobeSockaddrChecker
-func (checker *KprobeSockaddrChecker) WithAddr(check *stringmatcher.StringMatcher) *KprobeSockaddrChecker {
-	checker.Addr = check
+// WithEnvironmentVariables adds a EnvironmentVariables check to the ProcessChecker
+func (checker *ProcessChecker) WithEnvironmentVariables(check *EnvVarListMatcher) *ProcessChecker {
+	checker.EnvironmentVariables = check
 	return checker
 }
 
-// WithPort adds a Port check to the KprobeSockaddrChecker
-func (checker *KprobeSockaddrChecker) WithPort(check uint32) *KprobeSockaddrChecker {
-	checker.Port = &check
+// WithTruncated adds a Truncated check to the ProcessChecker
+func (checker *ProcessChecker) WithTruncated(check *ProcessTruncatedChecker) *ProcessChecker {
+	checker.Truncated = check
 	return checker
 }
 
-//FromKprobeSockaddr populates the KprobeSockaddrChecker using data from a KprobeSockaddr field
-func (checker *KprobeSockaddrChecker) FromKprobeSockaddr(event *tetragon.KprobeSockaddr) *KprobeSockaddrChecker {
+//FromProcess populates the ProcessChecker using data from a Process field
+func (checker *ProcessChecker) FromProcess(event *tetragon.Process) *ProcessChecker {
 	if event == nil {
 		return checker
 	}
-	checker.Family = stringmatcher.Full(event.Family)
-	checker.Addr = stringmatcher.Full(event.Addr)
+	checker.ExecId = stringmatcher.Full(event.ExecId)
+	if event.Pid != nil {
+		val := event.Pid.Value
+		checker.Pid = &val
+	}
+	if event.Uid != nil {
+		val := event.Uid.Value
+		checker.Uid = &val
+	}
+	checker.Cwd = stringmatcher.Full(event.Cwd)
+	checker.Binary = stringmatcher.Full(event.Binary)
+	checker.Arguments = stringmatcher.Full(event.Arguments)
+	checker.Flags = stringmatcher.Full(event.Flags)
+	// NB: We don't want to match timestamps for now
+	checker.StartTime = nil
+	if event.Auid != nil {
+		val := event.Auid.Value
+		checker.Auid = &val
+	}
+	if event.Pod != nil {
+		checker.Pod = NewPodChecker().FromPod(event.Pod)
+	}
+	checker.Docker = stringmatcher.Full(event.Docker)
+	checker.ParentExecId = stringmatcher.Full(event.ParentExecId)
 	{
-		val := event.Port
-		checker.Port = &val
+		val := event.Refcnt
+		checker.Refcnt = &val
+	}
+	if event.Cap != nil {
+		checker.Cap = NewCapabilitiesChecker().FromCapabilities(event.Cap)
+	}
+	if event.Ns != nil {
+		checker.Ns = NewNamespacesChecker().FromNamespaces(event.Ns)
+	}
+	if event.Tid != nil {
+		val := event.Tid.Value
+		checker.Tid = &val
+	}
+	if event.ProcessCredentials != nil {
+		checker.ProcessCredentials = NewProcessCredentialsChecker().FromProcessCredentials(event.ProcessCredentials)
+	}
+	if event.BinaryProperties != nil {
+		checker.BinaryProperties = NewBinaryPropertiesChecker().FromBinaryProperties(event.BinaryProperties)
+	}
+	if event.User != nil {
+		checker.User = NewUserRecordChecker().FromUserRecord(event.User)
+	}
+	if event.InInitTree != nil {
+		val := event.InInitTree.Value
+		checker.InInitTree = &val
+	}
+	{
+		var checks []*EnvVarChecker
+		for _, check := range event.EnvironmentVariables {
+			var convertedCheck *EnvVarChecker
+			if check != nil {
+				convertedCheck = NewEnvVarChecker().FromEnvVar(check)
+			}
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewEnvVarListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.EnvironmentVariables = lm
+	}
+	if event.Truncated != nil {
+		checker.Truncated = NewProcessTruncatedChecker().FromProcessTruncated(event.Truncated)
 	}
 	return checker
 }
 
-// KprobeNetDevChecker implements a checker struct to check a KprobeNetDev field
-type KprobeNetDevChecker struct {
-	Name *stringmatcher.StringMatcher `json:"name,omitempty"`
+// EnvVarListMatcher checks a list of *tetragon.EnvVar fields
+type EnvVarListMatcher struct {
+	Operator listmatcher.Operator `json:"operator"`
+	Values   []*EnvVarChecker     `json:"values"`
 }
 
-// NewKprobeNetDevChecker creates a new KprobeNetDevChecker
-func NewKprobeNetDevChecker() *KprobeNetDevChecker {
-	return &KprobeNetDevChecker{}
+// NewEnvVarListMatcher creates a new EnvVarListMatcher. The checker defaults to a subset checker unless otherwise specified using WithOperator()
+func NewEnvVarListMatcher() *EnvVarListMatcher {
+	return &EnvVarListMatcher{
+		Operator: listmatcher.Subset,
+	}
 }
 
-// Get the type of the checker as a string
-func (checker *KprobeNetDevChecker) GetCheckerType() string {
-	return "KprobeNetDevChecker"
+// WithOperator sets the match kind for the EnvVarListMatcher
+func (checker *EnvVarListMatcher) WithOperator(operator listmatcher.Operator) *EnvVarListMatcher {
+	checker.Operator = operator
+	return checker
 }
 
-// Check checks a KprobeNetDev field
-func (checker *KprobeNetDevChecker) Check(event *tetragon.KprobeNetDev) error {
-	if event == nil {
-		return fmt.Errorf("%s: KprobeNetDev field is nil", CheckerLogPrefix(checker))
+// WithValues sets the checkers that the EnvVarListMatcher should use
+func (checker *EnvVarListMatcher) WithValues(values ...*EnvVarChecker) *EnvVarListMatcher {
+	checker.Values = values
+	return checker
+}
+
+// Check checks a list of *tetragon.EnvVar fields
+func (checker *EnvVarListMatcher) Check(values []*tetragon.EnvVar) error {
+	switch checker.Operator {
+	case listmatcher.Ordered:
+		return checker.orderedCheck(values)
+	case listmatcher.Unordered:
+		return checker.unorderedCheck(values)
+	case listmatcher.Subset:
+		return checker.subsetCheck(values)
+	default:
+		return fmt.Errorf("Unhandled ListMatcher operator %s", checker.Operator)
 	}
+}
 
-	fieldChecks := func() error {
-		if checker.Name != nil {
-			if err := checker.Name.Match(event.Name); err != nil {
-				return fmt.Errorf("Name check failed: %w", err)
-			}
+// orderedCheck checks a list of ordered *tetragon.EnvVar fields
+func (checker *EnvVarListMatcher) orderedCheck(values []*tetragon.EnvVar) error {
+	innerCheck := func(check *EnvVarChecker, value *tetragon.EnvVar) error {
+		if err := check.Check(value); err != nil {
+			return fmt.Errorf("EnvironmentVariables check failed: %w", err)
 		}
 		return nil
 	}
-	if err := fieldChecks(); err != nil {
-		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
-	}
-	return nil
-}
 
-// WithName adds a Name check to the KprobeNetDevChecker
-func (checker *KprobeNetDevChecker) WithName(check *stringmatcher.StringMatcher) *KprobeNetDevChecker {
-	checker.Name = check
-	return checker
-}
+	if len(checker.Values) != len(values) {
+		return fmt.Errorf("EnvVarListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+	}
 
-//FromKprobeNetDev populates the KprobeNetDevChecker using data from a KprobeNetDev field
-func (checker *KprobeNetDevChecker) FromKprobeNetDev(event *tetragon.KprobeNetDev) *KprobeNetDevChecker {
-	if event == nil {
-		return checker
+	for i, check := range checker.Values {
+		value := values[i]
+		if err := innerCheck(check, value); err != nil {
+			return fmt.Errorf("EnvVarListMatcher: Check failed on element %d: %w", i, err)
+		}
 	}
-	checker.Name = stringmatcher.Full(event.Name)
-	return checker
-}
 
-// KprobePathChecker implements a checker struct to check a KprobePath field
-type KprobePathChecker struct {
-	Mount      *stringmatcher.StringMatcher `json:"mount,omitempty"`
-	Path       *stringmatcher.StringMatcher `json:"path,omitempty"`
-	Flags      *stringmatcher.StringMatcher `json:"flags,omitempty"`
-	Permission *stringmatcher.StringMatcher `json:"permission,omitempty"`
+	return nil
 }
 
-// NewKprobePathChecker creates a new KprobePathChecker
-func NewKprobePathChecker() *KprobePathChecker {
-	return &KprobePathChecker{}
-}
+// unorderedCheck checks a list of unordered *tetragon.EnvVar fields
+func (checker *EnvVarListMatcher) unorderedCheck(values []*tetragon.EnvVar) error {
+	if len(checker.Values) != len(values) {
+		return fmt.Errorf("EnvVarListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+	}
 
-// Get the type of the checker as a string
-func (checker *KprobePathChecker) GetCheckerType() string {
-	return "KprobePathChecker"
+	return checker.subsetCheck(values)
 }
 
-// Check checks a KprobePath field
-func (checker *KprobePathChecker) Check(event *tetragon.KprobePath) error {
-	if event == nil {
-		return fmt.Errorf("%s: KprobePath field is nil", CheckerLogPrefix(checker))
-	}
-
-	fieldChecks := func() error {
-		if checker.Mount != nil {
-			if err := checker.Mount.Match(event.Mount); err != nil {
-				return fmt.Errorf("Mount check failed: %w", err)
-			}
-		}
-		if checker.Path != nil {
-			if err := checker.Path.Match(event.Path); err != nil {
-				return fmt.Errorf("Path check failed: %w", err)
-			}
-		}
-		if checker.Flags != nil {
-			if err := checker.Flags.Match(event.Flags); err != nil {
-				return fmt.Errorf("Flags check failed: %w", err)
-			}
-		}
-		if checker.Permission != nil {
-			if err := checker.Permission.Match(event.Permission); err != nil {
-				return fmt.Errorf("Permission check failed: %w", err)
-			}
+// subsetCheck checks a subset of *tetragon.EnvVar fields
+func (checker *EnvVarListMatcher) subsetCheck(values []*tetragon.EnvVar) error {
+	innerCheck := func(check *EnvVarChecker, value *tetragon.EnvVar) error {
+		if err := check.Check(value); err != nil {
+			return fmt.Errorf("EnvironmentVariables check failed: %w", err)
 		}
 		return nil
 	}
-	if err := fieldChecks(); err != nil {
-		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
-	}
-	return nil
-}
-
-// WithMount adds a Mount check to the KprobePathChecker
-func (checker *KprobePathChecker) WithMount(check *stringmatcher.StringMatcher) *KprobePathChecker {
-	checker.Mount = check
-	return checker
-}
-
-// WithPath adds a Path check to the KprobePathChecker
-func (checker *KprobePathChecker) WithPath(check *stringmatcher.StringMatcher) *KprobePathChecker {
-	checker.Path = check
-	return checker
-}
 
-// WithFlags adds a Flags check to the KprobePathChecker
-func (checker *KprobePathChecker) WithFlags(check *stringmatcher.StringMatcher) *KprobePathChecker {
-	checker.Flags = check
-	return checker
-}
+	numDesired := len(checker.Values)
+	numMatched := 0
 
-// WithPermission adds a Permission check to the KprobePathChecker
-func (checker *KprobePathChecker) WithPermission(check *stringmatcher.StringMatcher) *KprobePathChecker {
-	checker.Permission = check
-	return checker
-}
+nextCheck:
+	for _, check := range checker.Values {
+		for _, value := range values {
+			if err := innerCheck(check, value); err == nil {
+				numMatched += 1
+				continue nextCheck
+			}
+		}
+	}
 
-//FromKprobePath populates the KprobePathChecker using data from a KprobePath field
-func (checker *KprobePathChecker) FromKprobePath(event *tetragon.KprobePath) *KprobePathChecker {
-	if event == nil {
-		return checker
+	if numMatched < numDesired {
+		return fmt.Errorf("EnvVarListMatcher: Check failed, only matched %d elements but wanted %d", numMatched, numDesired)
 	}
-	checker.Mount = stringmatcher.Full(event.Mount)
-	checker.Path = stringmatcher.Full(event.Path)
-	checker.Flags = stringmatcher.Full(event.Flags)
-	checker.Permission = stringmatcher.Full(event.Permission)
-	return checker
+
+	return nil
 }
 
-// KprobeFileChecker implements a checker struct to check a KprobeFile field
-type KprobeFileChecker struct {
-	Mount      *stringmatcher.StringMatcher `json:"mount,omitempty"`
-	Path       *stringmatcher.StringMatcher `json:"path,omitempty"`
-	Flags      *stringmatcher.StringMatcher `json:"flags,omitempty"`
-	Permission *stringmatcher.StringMatcher `json:"permission,omitempty"`
+// KprobeSockChecker implements a checker struct to check a KprobeSock field
+type KprobeSockChecker struct {
+	Family   *stringmatcher.StringMatcher `json:"family,omitempty"`
+	Type     *stringmatcher.StringMatcher `json:"type,omitempty"`
+	Protocol *stringmatcher.StringMatcher `json:"protocol,omitempty"`
+	Mark     *uint32                      `json:"mark,omitempty"`
+	Priority *uint32                      `json:"priority,omitempty"`
+	Saddr    *stringmatcher.StringMatcher `json:"saddr,omitempty"`
+	Daddr    *stringmatcher.StringMatcher `json:"daddr,omitempty"`
+	Sport    *uint32                      `json:"sport,omitempty"`
+	Dport    *uint32                      `json:"dport,omitempty"`
+	Cookie   *uint64                      `json:"cookie,omitempty"`
+	State    *stringmatcher.StringMatcher `json:"state,omitempty"`
 }
 
-// NewKprobeFileChecker creates a new KprobeFileChecker
-func NewKprobeFileChecker() *KprobeFileChecker {
-	return &KprobeFileChecker{}
+// NewKprobeSockChecker creates a new KprobeSockChecker
+func NewKprobeSockChecker() *KprobeSockChecker {
+	return &KprobeSockChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *KprobeFileChecker) GetCheckerType() string {
-	return "KprobeFileChecker"
+func (checker *KprobeSockChecker) GetCheckerType() string {
+	return "KprobeSockChecker"
 }
 
-// Check checks a KprobeFile field
-func (checker *KprobeFileChecker) Check(event *tetragon.KprobeFile) error {
+// Check checks a KprobeSock field
+func (checker *KprobeSockChecker) Check(event *tetragon.KprobeSock) error {
 	if event == nil {
-		return fmt.Errorf("%s: KprobeFile field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: KprobeSock field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Mount != nil {
-			if err := checker.Mount.Match(event.Mount); err != nil {
-				return fmt.Errorf("Mount check failed: %w", err)
+		if checker.Family != nil {
+			if err := checker.Family.Match(event.Family); err != nil {
+				return fmt.Errorf("Family check failed: %w", err)
 			}
 		}
-		if checker.Path != nil {
-			if err := checker.Path.Match(event.Path); err != nil {
-				return fmt.Errorf("Path check failed: %w", err)
+		if checker.Type != nil {
+			if err := checker.Type.Match(event.Type); err != nil {
+				return fmt.Errorf("Type check failed: %w", err)
 			}
 		}
-		if checker.Flags != nil {
-			if err := checker.Flags.Match(event.Flags); err != nil {
-				return fmt.Errorf("Flags check failed: %w", err)
+		if checker.Protocol != nil {
+			if err := checker.Protocol.Match(event.Protocol); err != nil {
+				return fmt.Errorf("Protocol check failed: %w", err)
 			}
 		}
-		if checker.Permission != nil {
-			if err := checker.Permission.Match(event.Permission); err != nil {
-				return fmt.Errorf("Permission check failed: %w", err)
+		if checker.Mark != nil {
+			if *checker.Mark != event.Mark {
+				return fmt.Errorf("Mark has value %d which does not match expected value %d", event.Mark, *checker.Mark)
+			}
+		}
+		if checker.Priority != nil {
+			if *checker.Priority != event.Priority {
+				return fmt.Errorf("Priority has value %d which does not match expected value %d", event.Priority, *checker.Priority)
+			}
+		}
+		if checker.Saddr != nil {
+			if err := checker.Saddr.Match(event.Saddr); err != nil {
+				return fmt.Errorf("Saddr check failed: %w", err)
+			}
+		}
+		if checker.Daddr != nil {
+			if err := checker.Daddr.Match(event.Daddr); err != nil {
+				return fmt.Errorf("Daddr check failed: %w", err)
+			}
+		}
+		if checker.Sport != nil {
+			if *checker.Sport != event.Sport {
+				return fmt.Errorf("Sport has value %d which does not match expected value %d", event.Sport, *checker.Sport)
+			}
+		}
+		if checker.Dport != nil {
+			if *checker.Dport != event.Dport {
+				return fmt.Errorf("Dport has value %d which does not match expected value %d", event.Dport, *checker.Dport)
+			}
+		}
+		if checker.Cookie != nil {
+			if *checker.Cookie != event.Cookie {
+				return fmt.Errorf("Cookie has value %d which does not match expected value %d", event.Cookie, *checker.Cookie)
+			}
+		}
+		if checker.State != nil {
+			if err := checker.State.Match(event.State); err != nil {
+				return fmt.Errorf("State check failed: %w", err)
 			}
 		}
 		return nil
@@ -5762,145 +6083,203 @@ func (checker *KprobeFileChecker) Check(event *tetragon.KprobeFile) error {
 	return nil
 }
 
-// WithMount adds a Mount check to the KprobeFileChecker
-func (checker *KprobeFileChecker) WithMount(check *stringmatcher.StringMatcher) *KprobeFileChecker {
-	checker.Mount = check
+// WithFamily adds a Family check to the KprobeSockChecker
+func (checker *KprobeSockChecker) WithFamily(check *stringmatcher.StringMatcher) *KprobeSockChecker {
+	checker.Family = check
 	return checker
 }
 
-// WithPath adds a Path check to the KprobeFileChecker
-func (checker *KprobeFileChecker) WithPath(check *stringmatcher.StringMatcher) *KprobeFileChecker {
-	checker.Path = check
+// WithType adds a Type check to the KprobeSockChecker
+func (checker *KprobeSockChecker) WithType(check *stringmatcher.StringMatcher) *KprobeSockChecker {
+	checker.Type = check
 	return checker
 }
 
-// WithFlags adds a Flags check to the KprobeFileChecker
-func (checker *KprobeFileChecker) WithFlags(check *stringmatcher.StringMatcher) *KprobeFileChecker {
-	checker.Flags = check
+// WithProtocol adds a Protocol check to the KprobeSockChecker
+func (checker *KprobeSockChecker) WithProtocol(check *stringmatcher.StringMatcher) *KprobeSockChecker {
+	checker.Protocol = check
 	return checker
 }
 
-// WithPermission adds a Permission check to the KprobeFileChecker
-func (checker *KprobeFileChecker) WithPermission(check *stringmatcher.StringMatcher) *KprobeFileChecker {
-	checker.Permission = check
+// WithMark adds a Mark check to the KprobeSockChecker
+func (checker *KprobeSockChecker) WithMark(check uint32) *KprobeSockChecker {
+	checker.Mark = &check
 	return checker
 }
 
-//FromKprobeFile populates the KprobeFileChecker using data from a KprobeFile field
-func (checker *KprobeFileChecker) FromKprobeFile(event *tetragon.KprobeFile) *KprobeFileChecker {
-	if event == nil {
-		return checker
-	}
-	checker.Mount = stringmatcher.Full(event.Mount)
-	checker.Path = stringmatcher.Full(event.Path)
-	checker.Flags = stringmatcher.Full(event.Flags)
-	checker.Permission = stringmatcher.Full(event.Permission)
+// WithPriority adds a Priority check to the KprobeSockChecker
+func (checker *KprobeSockChecker) WithPriority(check uint32) *KprobeSockChecker {
+	checker.Priority = &check
 	return checker
 }
 
-// KprobeTruncatedBytesChecker implements a checker struct to check a KprobeTruncatedBytes field
-type KprobeTruncatedBytesChecker struct {
-	BytesArg *bytesmatcher.BytesMatcher `json:"bytesArg,omitempty"`
-	OrigSize *uint64                    `json:"origSize,omitempty"`
+// WithSaddr adds a Saddr check to the KprobeSockChecker
+func (checker *KprobeSockChecker) WithSaddr(check *stringmatcher.StringMatcher) *KprobeSockChecker {
+	checker.Saddr = check
+	return checker
 }
 
-// NewKprobeTruncatedBytesChecker creates a new KprobeTruncatedBytesChecker
-func NewKprobeTruncatedBytesChecker() *KprobeTruncatedBytesChecker {
-	return &KprobeTruncatedBytesChecker{}
+// WithDaddr adds a Daddr check to the KprobeSockChecker
+func (checker *KprobeSockChecker) WithDaddr(check *stringmatcher.StringMatcher) *KprobeSockChecker {
+	checker.Daddr = check
+	return checker
 }
 
-// Get the type of the checker as a string
-func (checker *KprobeTruncatedBytesChecker) GetCheckerType() string {
-	return "KprobeTruncatedBytesChecker"
+// WithSport adds a Sport check to the KprobeSockChecker
+func (checker *KprobeSockChecker) WithSport(check uint32) *KprobeSockChecker {
+	checker.Sport = &check
+	return checker
 }
 
-// Check checks a KprobeTruncatedBytes field
-func (checker *KprobeTruncatedBytesChecker) Check(event *tetragon.KprobeTruncatedBytes) error {
-	if event == nil {
-		return fmt.Errorf("%s: KprobeTruncatedBytes field is nil", CheckerLogPrefix(checker))
-	}
-
-	fieldChecks := func() error {
-		if checker.BytesArg != nil {
-			if err := checker.BytesArg.Match(event.BytesArg); err != nil {
-				return fmt.Errorf("BytesArg check failed: %w", err)
-			}
-		}
-		if checker.OrigSize != nil {
-			if *checker.OrigSize != event.OrigSize {
-				return fmt.Errorf("OrigSize has value %d which does not match expected value %d", event.OrigSize, *checker.OrigSize)
-			}
-		}
-		return nil
-	}
-	if err := fieldChecks(); err != nil {
-		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
-	}
-	return nil
+// WithDport adds a Dport check to the KprobeSockChecker
+func (checker *KprobeSockChecker) WithDport(check uint32) *KprobeSockChecker {
+	checker.Dport = &check
+	return checker
 }
 
-// WithBytesArg adds a BytesArg check to the KprobeTruncatedBytesChecker
-func (checker *KprobeTruncatedBytesChecker) WithBytesArg(check *bytesmatcher.BytesMatcher) *KprobeTruncatedBytesChecker {
-	checker.BytesArg = check
+// WithCookie adds a Cookie check to the KprobeSockChecker
+func (checker *KprobeSockChecker) WithCookie(check uint64) *KprobeSockChecker {
+	checker.Cookie = &check
 	return checker
 }
 
-// WithOrigSize adds a OrigSize check to the KprobeTruncatedBytesChecker
-func (checker *KprobeTruncatedBytesChecker) WithOrigSize(check uint64) *KprobeTruncatedBytesChecker {
-	checker.OrigSize = &check
+// WithState adds a State check to the KprobeSockChecker
+func (checker *KprobeSockChecker) WithState(check *stringmatcher.StringMatcher) *KprobeSockChecker {
+	checker.State = check
 	return checker
 }
 
-//FromKprobeTruncatedBytes populates the KprobeTruncatedBytesChecker using data from a KprobeTruncatedBytes field
-func (checker *KprobeTruncatedBytesChecker) FromKprobeTruncatedBytes(event *tetragon.KprobeTruncatedBytes) *KprobeTruncatedBytesChecker {
+//FromKprobeSock populates the KprobeSockChecker using data from a KprobeSock field
+func (checker *KprobeSockChecker) FromKprobeSock(event *tetragon.KprobeSock) *KprobeSockChecker {
 	if event == nil {
 		return checker
 	}
-	checker.BytesArg = bytesmatcher.Full(event.BytesArg)
+	checker.Family = stringmatcher.Full(event.Family)
+	checker.Type = stringmatcher.Full(event.Type)
+	checker.Protocol = stringmatcher.Full(event.Protocol)
 	{
-		val := event.OrigSize
-		checker.OrigSize = &val
+		val := event.Mark
+		checker.Mark = &val
+	}
+	{
+		val := event.Priority
+		checker.Priority = &val
+	}
+	checker.Saddr = stringmatcher.Full(event.Saddr)
+	checker.Daddr = stringmatcher.Full(event.Daddr)
+	{
+		val := event.Sport
+		checker.Sport = &val
+	}
+	{
+		val := event.Dport
+		checker.Dport = &val
+	}
+	{
+		val := event.Cookie
+		checker.Cookie = &val
 	}
+	checker.State = stringmatcher.Full(event.State)
 	return checker
 }
 
-// KprobeCredChecker implements a checker struct to check a KprobeCred field
-type KprobeCredChecker struct {
-	Permitted   *CapabilitiesTypeListMatcher `json:"permitted,omitempty"`
-	Effective   *CapabilitiesTypeListMatcher `json:"effective,omitempty"`
-	Inheritable *CapabilitiesTypeListMatcher `json:"inheritable,omitempty"`
+// KprobeSkbChecker implements a checker struct to check a KprobeSkb field
+type KprobeSkbChecker struct {
+	Hash        *uint32                      `json:"hash,omitempty"`
+	Len         *uint32                      `json:"len,omitempty"`
+	Priority    *uint32                      `json:"priority,omitempty"`
+	Mark        *uint32                      `json:"mark,omitempty"`
+	Saddr       *stringmatcher.StringMatcher `json:"saddr,omitempty"`
+	Daddr       *stringmatcher.StringMatcher `json:"daddr,omitempty"`
+	Sport       *uint32                      `json:"sport,omitempty"`
+	Dport       *uint32                      `json:"dport,omitempty"`
+	Proto       *uint32                      `json:"proto,omitempty"`
+	SecPathLen  *uint32                      `json:"secPathLen,omitempty"`
+	SecPathOlen *uint32                      `json:"secPathOlen,omitempty"`
+	Protocol    *stringmatcher.StringMatcher `json:"protocol,omitempty"`
+	Family      *stringmatcher.StringMatcher `json:"family,omitempty"`
 }
 
-// NewKprobeCredChecker creates a new KprobeCredChecker
-func NewKprobeCredChecker() *KprobeCredChecker {
-	return &KprobeCredChecker{}
+// NewKprobeSkbChecker creates a new KprobeSkbChecker
+func NewKprobeSkbChecker() *KprobeSkbChecker {
+	return &KprobeSkbChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *KprobeCredChecker) GetCheckerType() string {
-	return "KprobeCredChecker"
+func (checker *KprobeSkbChecker) GetCheckerType() string {
+	return "KprobeSkbChecker"
 }
 
-// Check checks a KprobeCred field
-func (checker *KprobeCredChecker) Check(event *tetragon.KprobeCred) error {
+// Check checks a KprobeSkb field
+func (checker *KprobeSkbChecker) Check(event *tetragon.KprobeSkb) error {
 	if event == nil {
-		return fmt.Errorf("%s: KprobeCred field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: KprobeSkb field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Permitted != nil {
-			if err := checker.Permitted.Check(event.Permitted); err != nil {
-				return fmt.Errorf("Permitted check failed: %w", err)
+		if checker.Hash != nil {
+			if *checker.Hash != event.Hash {
+				return fmt.Errorf("Hash has value %d which does not match expected value %d", event.Hash, *checker.Hash)
 			}
 		}
-		if checker.Effective != nil {
-			if err := checker.Effective.Check(event.Effective); err != nil {
-				return fmt.Errorf("Effective check failed: %w", err)
+		if checker.Len != nil {
+			if *checker.Len != event.Len {
+				return fmt.Errorf("Len has value %d which does not match expected value %d", event.Len, *checker.Len)
 			}
 		}
-		if checker.Inheritable != nil {
-			if err := checker.Inheritable.Check(event.Inheritable); err != nil {
-				return fmt.Errorf("Inheritable check failed: %w", err)
+		if checker.Priority != nil {
+			if *checker.Priority != event.Priority {
+				return fmt.Errorf("Priority has value %d which does not match expected value %d", event.Priority, *checker.Priority)
+			}
+		}
+		if checker.Mark != nil {
+			if *checker.Mark != event.Mark {
+				return fmt.Errorf("Mark has value %d which does not match expected value %d", event.Mark, *checker.Mark)
+			}
+		}
+		if checker.Saddr != nil {
+			if err := checker.Saddr.Match(event.Saddr); err != nil {
+				return fmt.Errorf("Saddr check failed: %w", err)
+			}
+		}
+		if checker.Daddr != nil {
+			if err := checker.Daddr.Match(event.Daddr); err != nil {
+				return fmt.Errorf("Daddr check failed: %w", err)
+			}
+		}
+		if checker.Sport != nil {
+			if *checker.Sport != event.Sport {
+				return fmt.Errorf("Sport has value %d which does not match expected value %d", event.Sport, *checker.Sport)
+			}
+		}
+		if checker.Dport != nil {
+			if *checker.Dport != event.Dport {
+				return fmt.Errorf("Dport has value %d which does not match expected value %d", event.Dport, *checker.Dport)
+			}
+		}
+		if checker.Proto != nil {
+			if *checker.Proto != event.Proto {
+				return fmt.Errorf("Proto has value %d which does not match expected value %d", event.Proto, *checker.Proto)
+			}
+		}
+		if checker.SecPathLen != nil {
+			if *checker.SecPathLen != event.SecPathLen {
+				return fmt.Errorf("SecPathLen has value %d which does not match expected value %d", event.SecPathLen, *checker.SecPathLen)
+			}
+		}
+		if checker.SecPathOlen != nil {
+			if *checker.SecPathOlen != event.SecPathOlen {
+				return fmt.Errorf("SecPathOlen has value %d which does not match expected value %d", event.SecPathOlen, *checker.SecPathOlen)
+			}
+		}
+		if checker.Protocol != nil {
+			if err := checker.Protocol.Match(event.Protocol); err != nil {
+				return fmt.Errorf("Protocol check failed: %w", err)
+			}
+		}
+		if checker.Family != nil {
+			if err := checker.Family.Match(event.Family); err != nil {
+				return fmt.Errorf("Family check failed: %w", err)
 			}
 		}
 		return nil
@@ -5911,175 +6290,169 @@ func (checker *KprobeCredChecker) Check(event *tetragon.KprobeCred) error {
 	return nil
 }
 
-// WithPermitted adds a Permitted check to the KprobeCredChecker
-func (checker *KprobeCredChecker) WithPermitted(check *CapabilitiesTypeListMatcher) *KprobeCredChecker {
-	checker.Permitted = check
+// WithHash adds a Hash check to the KprobeSkbChecker
+func (checker *KprobeSkbChecker) WithHash(check uint32) *KprobeSkbChecker {
+	checker.Hash = &check
 	return checker
 }
 
-// WithEffective adds a Effective check to the KprobeCredChecker
-func (checker *KprobeCredChecker) WithEffective(check *CapabilitiesTypeListMatcher) *KprobeCredChecker {
-	checker.Effective = check
+// WithLen adds a Len check to the KprobeSkbChecker
+func (checker *KprobeSkbChecker) WithLen(check uint32) *KprobeSkbChecker {
+	checker.Len = &check
 	return checker
 }
 
-// WithInheritable adds a Inheritable check to the KprobeCredChecker
-func (checker *KprobeCredChecker) WithInheritable(check *CapabilitiesTypeListMatcher) *KprobeCredChecker {
-	checker.Inheritable = check
+// WithPriority adds a Priority check to the KprobeSkbChecker
+func (checker *KprobeSkbChecker) WithPriority(check uint32) *KprobeSkbChecker {
+	checker.Priority = &check
 	return checker
 }
 
-//FromKprobeCred populates the KprobeCredChecker using data from a KprobeCred field
-func (checker *KprobeCredChecker) FromKprobeCred(event *tetragon.KprobeCred) *KprobeCredChecker {
-	if event == nil {
-		return checker
-	}
-	{
-		var checks []*CapabilitiesTypeChecker
-		for _, check := range event.Permitted {
-			var convertedCheck *CapabilitiesTypeChecker
-			convertedCheck = NewCapabilitiesTypeChecker(check)
-			checks = append(checks, convertedCheck)
-		}
-		lm := NewCapabilitiesTypeListMatcher().WithOperator(listmatcher.Ordered).
-			WithValues(checks...)
-		checker.Permitted = lm
-	}
-	{
-		var checks []*CapabilitiesTypeChecker
-		for _, check := range event.Effective {
-			var convertedCheck *CapabilitiesTypeChecker
-			convertedCheck = NewCapabilitiesTypeChecker(check)
-			checks = append(checks, convertedCheck)
-		}
-		lm := NewCapabilitiesTypeListMatcher().WithOperator(listmatcher.Ordered).
-			WithValues(checks...)
-		checker.Effective = lm
-	}
-	{
-		var checks []*CapabilitiesTypeChecker
-		for _, check := range event.Inheritable {
-			var convertedCheck *CapabilitiesTypeChecker
-			convertedCheck = NewCapabilitiesTypeChecker(check)
-			checks = append(checks, convertedCheck)
-		}
-		lm := NewCapabilitiesTypeListMatcher().WithOperator(listmatcher.Ordered).
-			WithValues(checks...)
-		checker.Inheritable = lm
-	}
+// WithMark adds a Mark check to the KprobeSkbChecker
+func (checker *KprobeSkbChecker) WithMark(check uint32) *KprobeSkbChecker {
+	checker.Mark = &check
 	return checker
 }
 
-// KprobeLinuxBinprmChecker implements a checker struct to check a KprobeLinuxBinprm field
-type KprobeLinuxBinprmChecker struct {
-	Path       *stringmatcher.StringMatcher `json:"path,omitempty"`
-	Flags      *stringmatcher.StringMatcher `json:"flags,omitempty"`
-	Permission *stringmatcher.StringMatcher `json:"permission,omitempty"`
+// WithSaddr adds a Saddr check to the KprobeSkbChecker
+func (checker *KprobeSkbChecker) WithSaddr(check *stringmatcher.StringMatcher) *KprobeSkbChecker {
+	checker.Saddr = check
+	return checker
 }
 
-// NewKprobeLinuxBinprmChecker creates a new KprobeLinuxBinprmChecker
-func NewKprobeLinuxBinprmChecker() *KprobeLinuxBinprmChecker {
-	return &KprobeLinuxBinprmChecker{}
+// WithDaddr adds a Daddr check to the KprobeSkbChecker
+func (checker *KprobeSkbChecker) WithDaddr(check *stringmatcher.StringMatcher) *KprobeSkbChecker {
+	checker.Daddr = check
+	return checker
 }
 
-// Get the type of the checker as a string
-func (checker *KprobeLinuxBinprmChecker) GetCheckerType() string {
-	return "KprobeLinuxBinprmChecker"
+// WithSport adds a Sport check to the KprobeSkbChecker
+func (checker *KprobeSkbChecker) WithSport(check uint32) *KprobeSkbChecker {
+	checker.Sport = &check
+	return checker
 }
 
-// Check checks a KprobeLinuxBinprm field
-func (checker *KprobeLinuxBinprmChecker) Check(event *tetragon.KprobeLinuxBinprm) error {
-	if event == nil {
-		return fmt.Errorf("%s: KprobeLinuxBinprm field is nil", CheckerLogPrefix(checker))
-	}
+// WithDport adds a Dport check to the KprobeSkbChecker
+func (checker *KprobeSkbChecker) WithDport(check uint32) *KprobeSkbChecker {
+	checker.Dport = &check
+	return checker
+}
 
-	fieldChecks := func() error {
-		if checker.Path != nil {
-			if err := checker.Path.Match(event.Path); err != nil {
-				return fmt.Errorf("Path check failed: %w", err)
-			}
-		}
-		if checker.Flags != nil {
-			if err := checker.Flags.Match(event.Flags); err != nil {
-				return fmt.Errorf("Flags check failed: %w", err)
-			}
-		}
-		if checker.Permission != nil {
-			if err := checker.Permission.Match(event.Permission); err != nil {
-				return fmt.Errorf("Permission check failed: %w", err)
-			}
-		}
-		return nil
-	}
-	if err := fieldChecks(); err != nil {
-		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
-	}
-	return nil
+// WithProto adds a Proto check to the KprobeSkbChecker
+func (checker *KprobeSkbChecker) WithProto(check uint32) *KprobeSkbChecker {
+	checker.Proto = &check
+	return checker
 }
 
-// WithPath adds a Path check to the KprobeLinuxBinprmChecker
-func (checker *KprobeLinuxBinprmChecker) WithPath(check *stringmatcher.StringMatcher) *KprobeLinuxBinprmChecker {
-	checker.Path = check
+// WithSecPathLen adds a SecPathLen check to the KprobeSkbChecker
+func (checker *KprobeSkbChecker) WithSecPathLen(check uint32) *KprobeSkbChecker {
+	checker.SecPathLen = &check
 	return checker
 }
 
-// WithFlags adds a Flags check to the KprobeLinuxBinprmChecker
-func (checker *KprobeLinuxBinprmChecker) WithFlags(check *stringmatcher.StringMatcher) *KprobeLinuxBinprmChecker {
-	checker.Flags = check
+// WithSecPathOlen adds a SecPathOlen check to the KprobeSkbChecker
+func (checker *KprobeSkbChecker) WithSecPathOlen(check uint32) *KprobeSkbChecker {
+	checker.SecPathOlen = &check
 	return checker
 }
 
-// WithPermission adds a Permission check to the KprobeLinuxBinprmChecker
-func (checker *KprobeLinuxBinprmChecker) WithPermission(check *stringmatcher.StringMatcher) *KprobeLinuxBinprmChecker {
-	checker.Permission = check
+// WithProtocol adds a Protocol check to the KprobeSkbChecker
+func (checker *KprobeSkbChecker) WithProtocol(check *stringmatcher.StringMatcher) *KprobeSkbChecker {
+	checker.Protocol = check
 	return checker
 }
 
-//FromKprobeLinuxBinprm populates the KprobeLinuxBinprmChecker using data from a KprobeLinuxBinprm field
-func (checker *KprobeLinuxBinprmChecker) FromKprobeLinuxBinprm(event *tetragon.KprobeLinuxBinprm) *KprobeLinuxBinprmChecker {
+// WithFamily adds a Family check to the KprobeSkbChecker
+func (checker *KprobeSkbChecker) WithFamily(check *stringmatcher.StringMatcher) *KprobeSkbChecker {
+	checker.Family = check
+	return checker
+}
+
+//FromKprobeSkb populates the KprobeSkbChecker using data from a KprobeSkb field
+func (checker *KprobeSkbChecker) FromKprobeSkb(event *tetragon.KprobeSkb) *KprobeSkbChecker {
 	if event == nil {
 		return checker
 	}
-	checker.Path = stringmatcher.Full(event.Path)
-	checker.Flags = stringmatcher.Full(event.Flags)
-	checker.Permission = stringmatcher.Full(event.Permission)
+	{
+		val := event.Hash
+		checker.Hash = &val
+	}
+	{
+		val := event.Len
+		checker.Len = &val
+	}
+	{
+		val := event.Priority
+		checker.Priority = &val
+	}
+	{
+		val := event.Mark
+		checker.Mark = &val
+	}
+	checker.Saddr = stringmatcher.Full(event.Saddr)
+	checker.Daddr = stringmatcher.Full(event.Daddr)
+	{
+		val := event.Sport
+		checker.Sport = &val
+	}
+	{
+		val := event.Dport
+		checker.Dport = &val
+	}
+	{
+		val := event.Proto
+		checker.Proto = &val
+	}
+	{
+		val := event.SecPathLen
+		checker.SecPathLen = &val
+	}
+	{
+		val := event.SecPathOlen
+		checker.SecPathOlen = &val
+	}
+	checker.Protocol = stringmatcher.Full(event.Protocol)
+	checker.Family = stringmatcher.Full(event.Family)
 	return checker
 }
 
-// KprobeCapabilityChecker implements a checker struct to check a KprobeCapability field
-type KprobeCapabilityChecker struct {
-	Value *int32                       `json:"value,omitempty"`
-	Name  *stringmatcher.StringMatcher `json:"name,omitempty"`
+// KprobeSockaddrChecker implements a checker struct to check a KprobeSockaddr field
+type KprobeSockaddrChecker struct {
+	Family *stringmatcher.StringMatcher `json:"family,omitempty"`
+	Addr   *stringmatcher.StringMatcher `json:"addr,omitempty"`
+	Port   *uint32                      `json:"port,omitempty"`
 }
 
-// NewKprobeCapabilityChecker creates a new KprobeCapabilityChecker
-func NewKprobeCapabilityChecker() *KprobeCapabilityChecker {
-	return &KprobeCapabilityChecker{}
+// NewKprobeSockaddrChecker creates a new KprobeSockaddrChecker
+func NewKprobeSockaddrChecker() *KprobeSockaddrChecker {
+	return &KprobeSockaddrChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *KprobeCapabilityChecker) GetCheckerType() string {
-	return "KprobeCapabilityChecker"
+func (checker *KprobeSockaddrChecker) GetCheckerType() string {
+	return "KprobeSockaddrChecker"
 }
 
-// Check checks a KprobeCapability field
-func (checker *KprobeCapabilityChecker) Check(event *tetragon.KprobeCapability) error {
+// Check checks a KprobeSockaddr field
+func (checker *KprobeSockaddrChecker) Check(event *tetragon.KprobeSockaddr) error {
 	if event == nil {
-		return fmt.Errorf("%s: KprobeCapability field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: KprobeSockaddr field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Value != nil {
-			if event.Value == nil {
-				return fmt.Errorf("Value is nil and does not match expected value %v", *checker.Value)
+		if checker.Family != nil {
+			if err := checker.Family.Match(event.Family); err != nil {
+				return fmt.Errorf("Family check failed: %w", err)
 			}
-			if *checker.Value != event.Value.Value {
-				return fmt.Errorf("Value has value %v which does not match expected value %v", event.Value.Value, *checker.Value)
+		}
+		if checker.Addr != nil {
+			if err := checker.Addr.Match(event.Addr); err != nil {
+				return fmt.Errorf("Addr check failed: %w", err)
 			}
 		}
-		if checker.Name != nil {
-			if err := checker.Name.Match(event.Name); err != nil {
-				return fmt.Errorf("Name check failed: %w", err)
+		if checker.Port != nil {
+			if *checker.Port != event.Port {
+				return fmt.Errorf("Port has value %d which does not match expected value %d", event.Port, *checker.Port)
 			}
 		}
 		return nil
@@ -6090,83 +6463,63 @@ func (checker *KprobeCapabilityChecker) Check(event *tetragon.KprobeCapability)
 	return nil
 }
 
-// WithValue adds a Value check to the KprobeCapabilityChecker
-func (checker *KprobeCapabilityChecker) WithValue(check int32) *KprobeCapabilityChecker {
-	checker.Value = &check
+// WithFamily adds a Family check to the KprobeSockaddrChecker
+func (checker *KprobeSockaddrChecker) WithFamily(check *stringmatcher.StringMatcher) *KprobeSockaddrChecker {
+	checker.Family = check
 	return checker
 }
 
-// WithName adds a Name check to the KprobeCapabilityChecker
-func (checker *KprobeCapabilityChecker) WithName(check *stringmatcher.StringMatcher) *KprobeCapabilityChecker {
-	checker.Name = check
+// WithAddr adds a Addr check to the KprobeSockaddrChecker
+func (checker *KprobeSockaddrChecker) WithAddr(check *stringmatcher.StringMatcher) *KprobeSockaddrChecker {
+	checker.Addr = check
 	return checker
 }
 
-//FromKprobeCapability populates the KprobeCapabilityChecker using data from a KprobeCapability field
-func (checker *KprobeCapabilityChecker) FromKprobeCapability(event *tetragon.KprobeCapability) *KprobeCapabilityChecker {
+// WithPort adds a Port check to the KprobeSockaddrChecker
+func (checker *KprobeSockaddrChecker) WithPort(check uint32) *KprobeSockaddrChecker {
+	checker.Port = &check
+	return checker
+}
+
+//FromKprobeSockaddr populates the KprobeSockaddrChecker using data from a KprobeSockaddr field
+func (checker *KprobeSockaddrChecker) FromKprobeSockaddr(event *tetragon.KprobeSockaddr) *KprobeSockaddrChecker {
 	if event == nil {
 		return checker
 	}
-	if event.Value != nil {
-		val := event.Value.Value
-		checker.Value = &val
+	checker.Family = stringmatcher.Full(event.Family)
+	checker.Addr = stringmatcher.Full(event.Addr)
+	{
+		val := event.Port
+		checker.Port = &val
 	}
-	checker.Name = stringmatcher.Full(event.Name)
 	return checker
 }
 
-// KprobeUserNamespaceChecker implements a checker struct to check a KprobeUserNamespace field
-type KprobeUserNamespaceChecker struct {
-	Level *int32            `json:"level,omitempty"`
-	Owner *uint32           `json:"owner,omitempty"`
-	Group *uint32           `json:"group,omitempty"`
-	Ns    *NamespaceChecker `json:"ns,omitempty"`
+// KprobeNetDevChecker implements a checker struct to check a KprobeNetDev field
+type KprobeNetDevChecker struct {
+	Name *stringmatcher.StringMatcher `json:"name,omitempty"`
 }
 
-// NewKprobeUserNamespaceChecker creates a new KprobeUserNamespaceChecker
-func NewKprobeUserNamespaceChecker() *KprobeUserNamespaceChecker {
-	return &KprobeUserNamespaceChecker{}
+// NewKprobeNetDevChecker creates a new KprobeNetDevChecker
+func NewKprobeNetDevChecker() *KprobeNetDevChecker {
+	return &KprobeNetDevChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *KprobeUserNamespaceChecker) GetCheckerType() string {
-	return "KprobeUserNamespaceChecker"
+func (checker *KprobeNetDevChecker) GetCheckerType() string {
+	return "KprobeNetDevChecker"
 }
 
-// Check checks a KprobeUserNamespace field
-func (checker *KprobeUserNamespaceChecker) Check(event *tetragon.KprobeUserNamespace) error {
+// Check checks a KprobeNetDev field
+func (checker *KprobeNetDevChecker) Check(event *tetragon.KprobeNetDev) error {
 	if event == nil {
-		return fmt.Errorf("%s: KprobeUserNamespace field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: KprobeNetDev field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Level != nil {
-			if event.Level == nil {
-				return fmt.Errorf("Level is nil and does not match expected value %v", *checker.Level)
-			}
-			if *checker.Level != event.Level.Value {
-				return fmt.Errorf("Level has value %v which does not match expected value %v", event.Level.Value, *checker.Level)
-			}
-		}
-		if checker.Owner != nil {
-			if event.Owner == nil {
-				return fmt.Errorf("Owner is nil and does not match expected value %v", *checker.Owner)
-			}
-			if *checker.Owner != event.Owner.Value {
-				return fmt.Errorf("Owner has value %v which does not match expected value %v", event.Owner.Value, *checker.Owner)
-			}
-		}
-		if checker.Group != nil {
-			if event.Group == nil {
-				return fmt.Errorf("Group is nil and does not match expected value %v", *checker.Group)
-			}
-			if *checker.Group != event.Group.Value {
-				return fmt.Errorf("Group has value %v which does not match expected value %v", event.Group.Value, *checker.Group)
-			}
-		}
-		if checker.Ns != nil {
-			if err := checker.Ns.Check(event.Ns); err != nil {
-				return fmt.Errorf("Ns check failed: %w", err)
+		if checker.Name != nil {
+			if err := checker.Name.Match(event.Name); err != nil {
+				return fmt.Errorf("Name check failed: %w", err)
 			}
 		}
 		return nil
@@ -6177,90 +6530,64 @@ func (checker *KprobeUserNamespaceChecker) Check(event *tetragon.KprobeUserNames
 	return nil
 }
 
-// WithLevel adds a Level check to the KprobeUserNamespaceChecker
-func (checker *KprobeUserNamespaceChecker) WithLevel(check int32) *KprobeUserNamespaceChecker {
-	checker.Level = &check
+// WithName adds a Name check to the KprobeNetDevChecker
+func (checker *KprobeNetDevChecker) WithName(check *stringmatcher.StringMatcher) *KprobeNetDevChecker {
+	checker.Name = check
 	return checker
 }
 
-// WithOwner adds a Owner check to the KprobeUserNamespaceChecker
-func (checker *KprobeUserNamespaceChecker) WithOwner(check uint32) *KprobeUserNamespaceChecker {
-	checker.Owner = &check
+//FromKprobeNetDev populates the KprobeNetDevChecker using data from a KprobeNetDev field
+func (checker *KprobeNetDevChecker) FromKprobeNetDev(event *tetragon.KprobeNetDev) *KprobeNetDevChecker {
+	if event == nil {
+		return checker
+	}
+	checker.Name = stringmatcher.Full(event.Name)
 	return checker
 }
 
-// WithGroup adds a Group check to the KprobeUserNamespaceChecker
-func (checker *KprobeUserNamespaceChecker) WithGroup(check uint32) *KprobeUserNamespaceChecker {
-	checker.Group = &check
-	return checker
+// KprobePathChecker implements a checker struct to check a KprobePath field
+type KprobePathChecker struct {
+	Mount      *stringmatcher.StringMatcher `json:"mount,omitempty"`
+	Path       *stringmatcher.StringMatcher `json:"path,omitempty"`
+	Flags      *stringmatcher.StringMatcher `json:"flags,omitempty"`
+	Permission *stringmatcher.StringMatcher `json:"permission,omitempty"`
 }
 
-// WithNs adds a Ns check to the KprobeUserNamespaceChecker
-func (checker *KprobeUserNamespaceChecker) WithNs(check *NamespaceChecker) *KprobeUserNamespaceChecker {
-	checker.Ns = check
-	return checker
+// NewKprobePathChecker creates a new KprobePathChecker
+func NewKprobePathChecker() *KprobePathChecker {
+	return &KprobePathChecker{}
 }
 
-//FromKprobeUserNamespace populates the KprobeUserNamespaceChecker using data from a KprobeUserNamespace field
-func (checker *KprobeUserNamespaceChecker) FromKprobeUserNamespace(event *tetragon.KprobeUserNamespace) *KprobeUserNamespaceChecker {
+// Get the type of the checker as a string
+func (checker *KprobePathChecker) GetCheckerType() string {
+	return "KprobePathChecker"
+}
+
+// Check checks a KprobePath field
+func (checker *KprobePathChecker) Check(event *tetragon.KprobePath) error {
 	if event == nil {
-		return checker
-	}
-	if event.Level != nil {
-		val := event.Level.Value
-		checker.Level = &val
-	}
-	if event.Owner != nil {
-		val := event.Owner.Value
-		checker.Owner = &val
-	}
-	if event.Group != nil {
-		val := event.Group.Value
-		checker.Group = &val
-	}
-	if event.Ns != nil {
-		checker.Ns = NewNamespaceChecker().FromNamespace(event.Ns)
-	}
-	return checker
-}
-
-// KprobeBpfAttrChecker implements a checker struct to check a KprobeBpfAttr field
-type KprobeBpfAttrChecker struct {
-	ProgType *stringmatcher.StringMatcher `json:"ProgType,omitempty"`
-	InsnCnt  *uint32                      `json:"InsnCnt,omitempty"`
-	ProgName *stringmatcher.StringMatcher `json:"ProgName,omitempty"`
-}
-
-// NewKprobeBpfAttrChecker creates a new KprobeBpfAttrChecker
-func NewKprobeBpfAttrChecker() *KprobeBpfAttrChecker {
-	return &KprobeBpfAttrChecker{}
-}
-
-// Get the type of the checker as a string
-func (checker *KprobeBpfAttrChecker) GetCheckerType() string {
-	return "KprobeBpfAttrChecker"
-}
-
-// Check checks a KprobeBpfAttr field
-func (checker *KprobeBpfAttrChecker) Check(event *tetragon.KprobeBpfAttr) error {
-	if event == nil {
-		return fmt.Errorf("%s: KprobeBpfAttr field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: KprobePath field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.ProgType != nil {
-			if err := checker.ProgType.Match(event.ProgType); err != nil {
-				return fmt.Errorf("ProgType check failed: %w", err)
+		if checker.Mount != nil {
+			if err := checker.Mount.Match(event.Mount); err != nil {
+				return fmt.Errorf("Mount check failed: %w", err)
 			}
 		}
-		if checker.InsnCnt != nil {
-			if *checker.InsnCnt != event.InsnCnt {
-				return fmt.Errorf("InsnCnt has value %d which does not match expected value %d", event.InsnCnt, *checker.InsnCnt)
+		if checker.Path != nil {
+			if err := checker.Path.Match(event.Path); err != nil {
+				return fmt.Errorf("Path check failed: %w", err)
 			}
 		}
-		if checker.ProgName != nil {
-			if err := checker.ProgName.Match(event.ProgName); err != nil {
-				return fmt.Errorf("ProgName check failed: %w", err)
+		if checker.Flags != nil {
+			if err := checker.Flags.Match(event.Flags); err != nil {
+				return fmt.Errorf("Flags check failed: %w", err)
+			}
+		}
+		if checker.Permission != nil {
+			if err := checker.Permission.Match(event.Permission); err != nil {
+				return fmt.Errorf("Permission check failed: %w", err)
 			}
 		}
 		return nil
@@ -6271,75 +6598,85 @@ func (checker *KprobeBpfAttrChecker) Check(event *tetragon.KprobeBpfAttr) error
 	return nil
 }
 
-// WithProgType adds a ProgType check to the KprobeBpfAttrChecker
-func (checker *KprobeBpfAttrChecker) WithProgType(check *stringmatcher.StringMatcher) *KprobeBpfAttrChecker {
-	checker.ProgType = check
+// WithMount adds a Mount check to the KprobePathChecker
+func (checker *KprobePathChecker) WithMount(check *stringmatcher.StringMatcher) *KprobePathChecker {
+	checker.Mount = check
 	return checker
 }
 
-// WithInsnCnt adds a InsnCnt check to the KprobeBpfAttrChecker
-func (checker *KprobeBpfAttrChecker) WithInsnCnt(check uint32) *KprobeBpfAttrChecker {
-	checker.InsnCnt = &check
+// WithPath adds a Path check to the KprobePathChecker
+func (checker *KprobePathChecker) WithPath(check *stringmatcher.StringMatcher) *KprobePathChecker {
+	checker.Path = check
 	return checker
 }
 
-// WithProgName adds a ProgName check to the KprobeBpfAttrChecker
-func (checker *KprobeBpfAttrChecker) WithProgName(check *stringmatcher.StringMatcher) *KprobeBpfAttrChecker {
-	checker.ProgName = check
+// WithFlags adds a Flags check to the KprobePathChecker
+func (checker *KprobePathChecker) WithFlags(check *stringmatcher.StringMatcher) *KprobePathChecker {
+	checker.Flags = check
 	return checker
 }
 
-//FromKprobeBpfAttr populates the KprobeBpfAttrChecker using data from a KprobeBpfAttr field
-func (checker *KprobeBpfAttrChecker) FromKprobeBpfAttr(event *tetragon.KprobeBpfAttr) *KprobeBpfAttrChecker {
+// WithPermission adds a Permission check to the KprobePathChecker
+func (checker *KprobePathChecker) WithPermission(check *stringmatcher.StringMatcher) *KprobePathChecker {
+	checker.Permission = check
+	return checker
+}
+
+//FromKprobePath populates the KprobePathChecker using data from a KprobePath field
+func (checker *KprobePathChecker) FromKprobePath(event *tetragon.KprobePath) *KprobePathChecker {
 	if event == nil {
 		return checker
 	}
-	checker.ProgType = stringmatcher.Full(event.ProgType)
-	{
-		val := event.InsnCnt
-		checker.InsnCnt = &val
-	}
-	checker.ProgName = stringmatcher.Full(event.ProgName)
+	checker.Mount = stringmatcher.Full(event.Mount)
+	checker.Path = stringmatcher.Full(event.Path)
+	checker.Flags = stringmatcher.Full(event.Flags)
+	checker.Permission = stringmatcher.Full(event.Permission)
 	return checker
 }
 
-// KprobeBpfProgChecker implements a checker struct to check a KprobeBpfProg field
-type KprobeBpfProgChecker struct {
-	ProgType *stringmatcher.StringMatcher `json:"ProgType,omitempty"`
-	InsnCnt  *uint32                      `json:"InsnCnt,omitempty"`
-	ProgName *stringmatcher.StringMatcher `json:"ProgName,omitempty"`
+// KprobeFileChecker implements a checker struct to check a KprobeFile field
+type KprobeFileChecker struct {
+	Mount      *stringmatcher.StringMatcher `json:"mount,omitempty"`
+	Path       *stringmatcher.StringMatcher `json:"path,omitempty"`
+	Flags      *stringmatcher.StringMatcher `json:"flags,omitempty"`
+	Permission *stringmatcher.StringMatcher `json:"permission,omitempty"`
 }
 
-// NewKprobeBpfProgChecker creates a new KprobeBpfProgChecker
-func NewKprobeBpfProgChecker() *KprobeBpfProgChecker {
-	return &KprobeBpfProgChecker{}
+// NewKprobeFileChecker creates a new KprobeFileChecker
+func NewKprobeFileChecker() *KprobeFileChecker {
+	return &KprobeFileChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *KprobeBpfProgChecker) GetCheckerType() string {
-	return "KprobeBpfProgChecker"
+func (checker *KprobeFileChecker) GetCheckerType() string {
+	return "KprobeFileChecker"
 }
 
-// Check checks a KprobeBpfProg field
-func (checker *KprobeBpfProgChecker) Check(event *tetragon.KprobeBpfProg) error {
+// Check checks a KprobeFile field
+func (checker *KprobeFileChecker) Check(event *tetragon.KprobeFile) error {
 	if event == nil {
-		return fmt.Errorf("%s: KprobeBpfProg field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: KprobeFile field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.ProgType != nil {
-			if err := checker.ProgType.Match(event.ProgType); err != nil {
-				return fmt.Errorf("ProgType check failed: %w", err)
+		if checker.Mount != nil {
+			if err := checker.Mount.Match(event.Mount); err != nil {
+				return fmt.Errorf("Mount check failed: %w", err)
 			}
 		}
-		if checker.InsnCnt != nil {
-			if *checker.InsnCnt != event.InsnCnt {
-				return fmt.Errorf("InsnCnt has value %d which does not match expected value %d", event.InsnCnt, *checker.InsnCnt)
+		if checker.Path != nil {
+			if err := checker.Path.Match(event.Path); err != nil {
+				return fmt.Errorf("Path check failed: %w", err)
 			}
 		}
-		if checker.ProgName != nil {
-			if err := checker.ProgName.Match(event.ProgName); err != nil {
-				return fmt.Errorf("ProgName check failed: %w", err)
+		if checker.Flags != nil {
+			if err := checker.Flags.Match(event.Flags); err != nil {
+				return fmt.Errorf("Flags check failed: %w", err)
+			}
+		}
+		if checker.Permission != nil {
+			if err := checker.Permission.Match(event.Permission); err != nil {
+				return fmt.Errorf("Permission check failed: %w", err)
 			}
 		}
 		return nil
@@ -6350,81 +6687,73 @@ func (checker *KprobeBpfProgChecker) Check(event *tetragon.KprobeBpfProg) error
 	return nil
 }
 
-// WithProgType adds a ProgType check to the KprobeBpfProgChecker
-func (checker *KprobeBpfProgChecker) WithProgType(check *stringmatcher.StringMatcher) *KprobeBpfProgChecker {
-	checker.ProgType = check
+// WithMount adds a Mount check to the KprobeFileChecker
+func (checker *KprobeFileChecker) WithMount(check *stringmatcher.StringMatcher) *KprobeFileChecker {
+	checker.Mount = check
 	return checker
 }
 
-// WithInsnCnt adds a InsnCnt check to the KprobeBpfProgChecker
-func (checker *KprobeBpfProgChecker) WithInsnCnt(check uint32) *KprobeBpfProgChecker {
-	checker.InsnCnt = &check
+// WithPath adds a Path check to the KprobeFileChecker
+func (checker *KprobeFileChecker) WithPath(check *stringmatcher.StringMatcher) *KprobeFileChecker {
+	checker.Path = check
 	return checker
 }
 
-// WithProgName adds a ProgName check to the KprobeBpfProgChecker
-func (checker *KprobeBpfProgChecker) WithProgName(check *stringmatcher.StringMatcher) *KprobeBpfProgChecker {
-	checker.ProgName = check
+// WithFlags adds a Flags check to the KprobeFileChecker
+func (checker *KprobeFileChecker) WithFlags(check *stringmatcher.StringMatcher) *KprobeFileChecker {
+	checker.Flags = check
 	return checker
 }
 
-//FromKprobeBpfProg populates the KprobeBpfProgChecker using data from a KprobeBpfProg field
-func (checker *KprobeBpfProgChecker) FromKprobeBpfProg(event *tetragon.KprobeBpfProg) *KprobeBpfProgChecker {
+// WithPermission adds a Permission check to the KprobeFileChecker
+func (checker *KprobeFileChecker) WithPermission(check *stringmatcher.StringMatcher) *KprobeFileChecker {
+	checker.Permission = check
+	return checker
+}
+
+//FromKprobeFile populates the KprobeFileChecker using data from a KprobeFile field
+func (checker *KprobeFileChecker) FromKprobeFile(event *tetragon.KprobeFile) *KprobeFileChecker {
 	if event == nil {
 		return checker
 	}
-	checker.ProgType = stringmatcher.Full(event.ProgType)
-	{
-		val := event.InsnCnt
-		checker.InsnCnt = &val
-	}
-	checker.ProgName = stringmatcher.Full(event.ProgName)
+	checker.Mount = stringmatcher.Full(event.Mount)
+	checker.Path = stringmatcher.Full(event.Path)
+	checker.Flags = stringmatcher.Full(event.Flags)
+	checker.Permission = stringmatcher.Full(event.Permission)
 	return checker
 }
 
-// KprobePerfEventChecker implements a checker struct to check a KprobePerfEvent field
-type KprobePerfEventChecker struct {
-	KprobeFunc  *stringmatcher.StringMatcher `json:"KprobeFunc,omitempty"`
-	Type        *stringmatcher.StringMatcher `json:"Type,omitempty"`
-	Config      *uint64                      `json:"Config,omitempty"`
-	ProbeOffset *uint64                      `json:"ProbeOffset,omitempty"`
+// KprobeTruncatedBytesChecker implements a checker struct to check a KprobeTruncatedBytes field
+type KprobeTruncatedBytesChecker struct {
+	BytesArg *bytesmatcher.BytesMatcher `json:"bytesArg,omitempty"`
+	OrigSize *uint64                    `json:"origSize,omitempty"`
 }
 
-// NewKprobePerfEventChecker creates a new KprobePerfEventChecker
-func NewKprobePerfEventChecker() *KprobePerfEventChecker {
-	return &KprobePerfEventChecker{}
+// NewKprobeTruncatedBytesChecker creates a new KprobeTruncatedBytesChecker
+func NewKprobeTruncatedBytesChecker() *KprobeTruncatedBytesChecker {
+	return &KprobeTruncatedBytesChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *KprobePerfEventChecker) GetCheckerType() string {
-	return "KprobePerfEventChecker"
+func (checker *KprobeTruncatedBytesChecker) GetCheckerType() string {
+	return "KprobeTruncatedBytesChecker"
 }
 
-// Check checks a KprobePerfEvent field
-func (checker *KprobePerfEventChecker) Check(event *tetragon.KprobePerfEvent) error {
+// Check checks a KprobeTruncatedBytes field
+func (checker *KprobeTruncatedBytesChecker) Check(event *tetragon.KprobeTruncatedBytes) error {
 	if event == nil {
-		return fmt.Errorf("%s: KprobePerfEvent field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: KprobeTruncatedBytes field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.KprobeFunc != nil {
-			if err := checker.KprobeFunc.Match(event.KprobeFunc); err != nil {
-				return fmt.Errorf("KprobeFunc check failed: %w", err)
-			}
-		}
-		if checker.Type != nil {
-			if err := checker.Type.Match(event.Type); err != nil {
-				return fmt.Errorf("Type check failed: %w", err)
-			}
-		}
-		if checker.Config != nil {
-			if *checker.Config != event.Config {
-				return fmt.Errorf("Config has value %d which does not match expected value %d", event.Config, *checker.Config)
+		if checker.BytesArg != nil {
+			if err := checker.BytesArg.Match(event.BytesArg); err != nil {
+				return fmt.Errorf("BytesArg check failed: %w", err)
 			}
 		}
-		if checker.ProbeOffset != nil {
-			if *checker.ProbeOffset != event.ProbeOffset {
-				return fmt.Errorf("ProbeOffset has value %d which does not match expected value %d", event.ProbeOffset, *checker.ProbeOffset)
+		if checker.OrigSize != nil {
+			if *checker.OrigSize != event.OrigSize {
+				return fmt.Errorf("OrigSize has value %d which does not match expected value %d", event.OrigSize, *checker.OrigSize)
 			}
 		}
 		return nil
@@ -6435,97 +6764,68 @@ func (checker *KprobePerfEventChecker) Check(event *tetragon.KprobePerfEvent) er
 	return nil
 }
 
-// WithKprobeFunc adds a KprobeFunc check to the KprobePerfEventChecker
-func (checker *KprobePerfEventChecker) WithKprobeFunc(check *stringmatcher.StringMatcher) *KprobePerfEventChecker {
-	checker.KprobeFunc = check
-	return checker
-}
-
-// WithType adds a Type check to the KprobePerfEventChecker
-func (checker *KprobePerfEventChecker) WithType(check *stringmatcher.StringMatcher) *KprobePerfEventChecker {
-	checker.Type = check
-	return checker
-}
-
-// WithConfig adds a Config check to the KprobePerfEventChecker
-func (checker *KprobePerfEventChecker) WithConfig(check uint64) *KprobePerfEventChecker {
-	checker.Config = &check
+// WithBytesArg adds a BytesArg check to the KprobeTruncatedBytesChecker
+func (checker *KprobeTruncatedBytesChecker) WithBytesArg(check *bytesmatcher.BytesMatcher) *KprobeTruncatedBytesChecker {
+	checker.BytesArg = check
 	return checker
 }
 
-// WithProbeOffset adds a ProbeOffset check to the KprobePerfEventChecker
-func (checker *KprobePerfEventChecker) WithProbeOffset(check uint64) *KprobePerfEventChecker {
-	checker.ProbeOffset = &check
+// WithOrigSize adds a OrigSize check to the KprobeTruncatedBytesChecker
+func (checker *KprobeTruncatedBytesChecker) WithOrigSize(check uint64) *KprobeTruncatedBytesChecker {
+	checker.OrigSize = &check
 	return checker
 }
 
-//FromKprobePerfEvent populates the KprobePerfEventChecker using data from a KprobePerfEvent field
-func (checker *KprobePerfEventChecker) FromKprobePerfEvent(event *tetragon.KprobePerfEvent) *KprobePerfEventChecker {
+//FromKprobeTruncatedBytes populates the KprobeTruncatedBytesChecker using data from a KprobeTruncatedBytes field
+func (checker *KprobeTruncatedBytesChecker) FromKprobeTruncatedBytes(event *tetragon.KprobeTruncatedBytes) *KprobeTruncatedBytesChecker {
 	if event == nil {
 		return checker
 	}
-	checker.KprobeFunc = stringmatcher.Full(event.KprobeFunc)
-	checker.Type = stringmatcher.Full(event.Type)
-	{
-		val := event.Config
-		checker.Config = &val
-	}
+	checker.BytesArg = bytesmatcher.Full(event.BytesArg)
 	{
-		val := event.ProbeOffset
-		checker.ProbeOffset = &val
+		val := event.OrigSize
+		checker.OrigSize = &val
 	}
 	return checker
 }
 
-// KprobeBpfMapChecker implements a checker struct to check a KprobeBpfMap field
-type KprobeBpfMapChecker struct {
-	MapType    *stringmatcher.StringMatcher `json:"MapType,omitempty"`
-	KeySize    *uint32                      `json:"KeySize,omitempty"`
-	ValueSize  *uint32                      `json:"ValueSize,omitempty"`
-	MaxEntries *uint32                      `json:"MaxEntries,omitempty"`
-	MapName    *stringmatcher.StringMatcher `json:"MapName,omitempty"`
+// KprobeCredChecker implements a checker struct to check a KprobeCred field
+type KprobeCredChecker struct {
+	Permitted   *CapabilitiesTypeListMatcher `json:"permitted,omitempty"`
+	Effective   *CapabilitiesTypeListMatcher `json:"effective,omitempty"`
+	Inheritable *CapabilitiesTypeListMatcher `json:"inheritable,omitempty"`
 }
 
-// NewKprobeBpfMapChecker creates a new KprobeBpfMapChecker
-func NewKprobeBpfMapChecker() *KprobeBpfMapChecker {
-	return &KprobeBpfMapChecker{}
+// NewKprobeCredChecker creates a new KprobeCredChecker
+func NewKprobeCredChecker() *KprobeCredChecker {
+	return &KprobeCredChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *KprobeBpfMapChecker) GetCheckerType() string {
-	return "KprobeBpfMapChecker"
+func (checker *KprobeCredChecker) GetCheckerType() string {
+	return "KprobeCredChecker"
 }
 
-// Check checks a KprobeBpfMap field
-func (checker *KprobeBpfMapChecker) Check(event *tetragon.KprobeBpfMap) error {
+// Check checks a KprobeCred field
+func (checker *KprobeCredChecker) Check(event *tetragon.KprobeCred) error {
 	if event == nil {
-		return fmt.Errorf("%s: KprobeBpfMap field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: KprobeCred field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.MapType != nil {
-			if err := checker.MapType.Match(event.MapType); err != nil {
-				return fmt.Errorf("MapType check failed: %w", err)
-			}
-		}
-		if checker.KeySize != nil {
-			if *checker.KeySize != event.KeySize {
-				return fmt.Errorf("KeySize has value %d which does not match expected value %d", event.KeySize, *checker.KeySize)
-			}
-		}
-		if checker.ValueSize != nil {
-			if *checker.ValueSize != event.ValueSize {
-				return fmt.Errorf("ValueSize has value %d which does not match expected value %d", event.ValueSize, *checker.ValueSize)
+		if checker.Permitted != nil {
+			if err := checker.Permitted.Check(event.Permitted); err != nil {
+				return fmt.Errorf("Permitted check failed: %w", err)
 			}
 		}
-		if checker.MaxEntries != nil {
-			if *checker.MaxEntries != event.MaxEntries {
-				return fmt.Errorf("MaxEntries has value %d which does not match expected value %d", event.MaxEntries, *checker.MaxEntries)
+		if checker.Effective != nil {
+			if err := checker.Effective.Check(event.Effective); err != nil {
+				return fmt.Errorf("Effective check failed: %w", err)
 			}
 		}
-		if checker.MapName != nil {
-			if err := checker.MapName.Match(event.MapName); err != nil {
-				return fmt.Errorf("MapName check failed: %w", err)
+		if checker.Inheritable != nil {
+			if err := checker.Inheritable.Check(event.Inheritable); err != nil {
+				return fmt.Errorf("Inheritable check failed: %w", err)
 			}
 		}
 		return nil
@@ -6536,83 +6836,102 @@ func (checker *KprobeBpfMapChecker) Check(event *tetragon.KprobeBpfMap) error {
 	return nil
 }
 
-// WithMapType adds a MapType check to the KprobeBpfMapChecker
-func (checker *KprobeBpfMapChecker) WithMapType(check *stringmatcher.StringMatcher) *KprobeBpfMapChecker {
-	checker.MapType = check
-	return checker
-}
-
-// WithKeySize adds a KeySize check to the KprobeBpfMapChecker
-func (checker *KprobeBpfMapChecker) WithKeySize(check uint32) *KprobeBpfMapChecker {
-	checker.KeySize = &check
-	return checker
-}
-
-// WithValueSize adds a ValueSize check to the KprobeBpfMapChecker
-func (checker *KprobeBpfMapChecker) WithValueSize(check uint32) *KprobeBpfMapChecker {
-	checker.ValueSize = &check
+// WithPermitted adds a Permitted check to the KprobeCredChecker
+func (checker *KprobeCredChecker) WithPermitted(check *CapabilitiesTypeListMatcher) *KprobeCredChecker {
+	checker.Permitted = check
 	return checker
 }
 
-// WithMaxEntries adds a MaxEntries check to the KprobeBpfMapChecker
-func (checker *KprobeBpfMapChecker) WithMaxEntries(check uint32) *KprobeBpfMapChecker {
-	checker.MaxEntries = &check
+// WithEffective adds a Effective check to the KprobeCredChecker
+func (checker *KprobeCredChecker) WithEffective(check *CapabilitiesTypeListMatcher) *KprobeCredChecker {
+	checker.Effective = check
 	return checker
 }
 
-// WithMapName adds a MapName check to the KprobeBpfMapChecker
-func (checker *KprobeBpfMapChecker) WithMapName(check *stringmatcher.StringMatcher) *KprobeBpfMapChecker {
-	checker.MapName = check
+// WithInheritable adds a Inheritable check to the KprobeCredChecker
+func (checker *KprobeCredChecker) WithInheritable(check *CapabilitiesTypeListMatcher) *KprobeCredChecker {
+	checker.Inheritable = check
 	return checker
 }
 
-//FromKprobeBpfMap populates the KprobeBpfMapChecker using data from a KprobeBpfMap field
-func (checker *KprobeBpfMapChecker) FromKprobeBpfMap(event *tetragon.KprobeBpfMap) *KprobeBpfMapChecker {
+//FromKprobeCred populates the KprobeCredChecker using data from a KprobeCred field
+func (checker *KprobeCredChecker) FromKprobeCred(event *tetragon.KprobeCred) *KprobeCredChecker {
 	if event == nil {
 		return checker
 	}
-	checker.MapType = stringmatcher.Full(event.MapType)
 	{
-		val := event.KeySize
-		checker.KeySize = &val
+		var checks []*CapabilitiesTypeChecker
+		for _, check := range event.Permitted {
+			var convertedCheck *CapabilitiesTypeChecker
+			convertedCheck = NewCapabilitiesTypeChecker(check)
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewCapabilitiesTypeListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Permitted = lm
 	}
 	{
-		val := event.ValueSize
-		checker.ValueSize = &val
+		var checks []*CapabilitiesTypeChecker
+		for _, check := range event.Effective {
+			var convertedCheck *CapabilitiesTypeChecker
+			convertedCheck = NewCapabilitiesTypeChecker(check)
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewCapabilitiesTypeListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Effective = lm
 	}
 	{
-		val := event.MaxEntries
-		checker.MaxEntries = &val
+		var checks []*CapabilitiesTypeChecker
+		for _, check := range event.Inheritable {
+			var convertedCheck *CapabilitiesTypeChecker
+			convertedCheck = NewCapabilitiesTypeChecker(check)
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewCapabilitiesTypeListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Inheritable = lm
 	}
-	checker.MapName = stringmatcher.Full(event.MapName)
 	return checker
 }
 
-// KprobeErrorChecker implements a checker struct to check a KprobeError field
-type KprobeErrorChecker struct {
-	Message *stringmatcher.StringMatcher `json:"Message,omitempty"`
+// KprobeLinuxBinprmChecker implements a checker struct to check a KprobeLinuxBinprm field
+type KprobeLinuxBinprmChecker struct {
+	Path       *stringmatcher.StringMatcher `json:"path,omitempty"`
+	Flags      *stringmatcher.StringMatcher `json:"flags,omitempty"`
+	Permission *stringmatcher.StringMatcher `json:"permission,omitempty"`
 }
 
-// NewKprobeErrorChecker creates a new KprobeErrorChecker
-func NewKprobeErrorChecker() *KprobeErrorChecker {
-	return &KprobeErrorChecker{}
+// NewKprobeLinuxBinprmChecker creates a new KprobeLinuxBinprmChecker
+func NewKprobeLinuxBinprmChecker() *KprobeLinuxBinprmChecker {
+	return &KprobeLinuxBinprmChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *KprobeErrorChecker) GetCheckerType() string {
-	return "KprobeErrorChecker"
+func (checker *KprobeLinuxBinprmChecker) GetCheckerType() string {
+	return "KprobeLinuxBinprmChecker"
 }
 
-// Check checks a KprobeError field
-func (checker *KprobeErrorChecker) Check(event *tetragon.KprobeError) error {
+// Check checks a KprobeLinuxBinprm field
+func (checker *KprobeLinuxBinprmChecker) Check(event *tetragon.KprobeLinuxBinprm) error {
 	if event == nil {
-		return fmt.Errorf("%s: KprobeError field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: KprobeLinuxBinprm field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Message != nil {
-			if err := checker.Message.Match(event.Message); err != nil {
-				return fmt.Errorf("Message check failed: %w", err)
+		if checker.Path != nil {
+			if err := checker.Path.Match(event.Path); err != nil {
+				return fmt.Errorf("Path check failed: %w", err)
+			}
+		}
+		if checker.Flags != nil {
+			if err := checker.Flags.Match(event.Flags); err != nil {
+				return fmt.Errorf("Flags check failed: %w", err)
+			}
+		}
+		if checker.Permission != nil {
+			if err := checker.Permission.Match(event.Permission); err != nil {
+				return fmt.Errorf("Permission check failed: %w", err)
 			}
 		}
 		return nil
@@ -6623,52 +6942,69 @@ func (checker *KprobeErrorChecker) Check(event *tetragon.KprobeError) error {
 	return nil
 }
 
-// WithMessage adds a Message check to the KprobeErrorChecker
-func (checker *KprobeErrorChecker) WithMessage(check *stringmatcher.StringMatcher) *KprobeErrorChecker {
-	checker.Message = check
+// WithPath adds a Path check to the KprobeLinuxBinprmChecker
+func (checker *KprobeLinuxBinprmChecker) WithPath(check *stringmatcher.StringMatcher) *KprobeLinuxBinprmChecker {
+	checker.Path = check
 	return checker
 }
 
-//FromKprobeError populates the KprobeErrorChecker using data from a KprobeError field
-func (checker *KprobeErrorChecker) FromKprobeError(event *tetragon.KprobeError) *KprobeErrorChecker {
+// WithFlags adds a Flags check to the KprobeLinuxBinprmChecker
+func (checker *KprobeLinuxBinprmChecker) WithFlags(check *stringmatcher.StringMatcher) *KprobeLinuxBinprmChecker {
+	checker.Flags = check
+	return checker
+}
+
+// WithPermission adds a Permission check to the KprobeLinuxBinprmChecker
+func (checker *KprobeLinuxBinprmChecker) WithPermission(check *stringmatcher.StringMatcher) *KprobeLinuxBinprmChecker {
+	checker.Permission = check
+	return checker
+}
+
+//FromKprobeLinuxBinprm populates the KprobeLinuxBinprmChecker using data from a KprobeLinuxBinprm field
+func (checker *KprobeLinuxBinprmChecker) FromKprobeLinuxBinprm(event *tetragon.KprobeLinuxBinprm) *KprobeLinuxBinprmChecker {
 	if event == nil {
 		return checker
 	}
-	checker.Message = stringmatcher.Full(event.Message)
+	checker.Path = stringmatcher.Full(event.Path)
+	checker.Flags = stringmatcher.Full(event.Flags)
+	checker.Permission = stringmatcher.Full(event.Permission)
 	return checker
 }
 
-// SyscallIdChecker implements a checker struct to check a SyscallId field
-type SyscallIdChecker struct {
-	Id  *uint32                      `json:"id,omitempty"`
-	Abi *stringmatcher.StringMatcher `json:"abi,omitempty"`
+// KprobeCapabilityChecker implements a checker struct to check a KprobeCapability field
+type KprobeCapabilityChecker struct {
+	Value *int32                       `json:"value,omitempty"`
+	Name  *stringmatcher.StringMatcher `json:"name,omitempty"`
 }
 
-// NewSyscallIdChecker creates a new SyscallIdChecker
-func NewSyscallIdChecker() *SyscallIdChecker {
-	return &SyscallIdChecker{}
+// NewKprobeCapabilityChecker creates a new KprobeCapabilityChecker
+func NewKprobeCapabilityChecker() *KprobeCapabilityChecker {
+	return &KprobeCapabilityChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *SyscallIdChecker) GetCheckerType() string {
-	return "SyscallIdChecker"
+func (checker *KprobeCapabilityChecker) GetCheckerType() string {
+	return "KprobeCapabilityChecker"
 }
 
-// Check checks a SyscallId field
-func (checker *SyscallIdChecker) Check(event *tetragon.SyscallId) error {
+// Check checks a KprobeCapability field
+func (checker *KprobeCapabilityChecker) Check(event *tetragon.KprobeCapability) error {
 	if event == nil {
-		return fmt.Errorf("%s: SyscallId field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: KprobeCapability field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Id != nil {
-			if *checker.Id != event.Id {
-				return fmt.Errorf("Id has value %d which does not match expected value %d", event.Id, *checker.Id)
+		if checker.Value != nil {
+			if event.Value == nil {
+				return fmt.Errorf("Value is nil and does not match expected value %v", *checker.Value)
+			}
+			if *checker.Value != event.Value.Value {
+				return fmt.Errorf("Value has value %v which does not match expected value %v", event.Value.Value, *checker.Value)
 			}
 		}
-		if checker.Abi != nil {
-			if err := checker.Abi.Match(event.Abi); err != nil {
-				return fmt.Errorf("Abi check failed: %w", err)
+		if checker.Name != nil {
+			if err := checker.Name.Match(event.Name); err != nil {
+				return fmt.Errorf("Name check failed: %w", err)
 			}
 		}
 		return nil
@@ -6679,176 +7015,765 @@ func (checker *SyscallIdChecker) Check(event *tetragon.SyscallId) error {
 	return nil
 }
 
-// WithId adds a Id check to the SyscallIdChecker
-func (checker *SyscallIdChecker) WithId(check uint32) *SyscallIdChecker {
-	checker.Id = &check
+// WithValue adds a Value check to the KprobeCapabilityChecker
+func (checker *KprobeCapabilityChecker) WithValue(check int32) *KprobeCapabilityChecker {
+	checker.Value = &check
 	return checker
 }
 
-// WithAbi adds a Abi check to the SyscallIdChecker
-func (checker *SyscallIdChecker) WithAbi(check *stringmatcher.StringMatcher) *SyscallIdChecker {
-	checker.Abi = check
-	return checker
-}
+// WithName adds a Name check to the KprobeCapabilityChecker
+func (checker *KprobeCapabilityChecker) WithName(check *stringmatcher.StringMatcher) *KprobeCapabilityChecker {
+	checker.Name = check
+	return checker
+}
 
-//FromSyscallId populates the SyscallIdChecker using data from a SyscallId field
-func (checker *SyscallIdChecker) FromSyscallId(event *tetragon.SyscallId) *SyscallIdChecker {
+//FromKprobeCapability populates the KprobeCapabilityChecker using data from a KprobeCapability field
+func (checker *KprobeCapabilityChecker) FromKprobeCapability(event *tetragon.KprobeCapability) *KprobeCapabilityChecker {
 	if event == nil {
 		return checker
 	}
-	{
-		val := event.Id
-		checker.Id = &val
+	if event.Value != nil {
+		val := event.Value.Value
+		checker.Value = &val
 	}
-	checker.Abi = stringmatcher.Full(event.Abi)
+	checker.Name = stringmatcher.Full(event.Name)
 	return checker
 }
 
-// KprobeArgumentChecker implements a checker struct to check a KprobeArgument field
-type KprobeArgumentChecker struct {
-	StringArg             *stringmatcher.StringMatcher `json:"stringArg,omitempty"`
-	IntArg                *int32                       `json:"intArg,omitempty"`
-	SkbArg                *KprobeSkbChecker            `json:"skbArg,omitempty"`
-	SizeArg               *uint64                      `json:"sizeArg,omitempty"`
-	BytesArg              *bytesmatcher.BytesMatcher   `json:"bytesArg,omitempty"`
-	PathArg               *KprobePathChecker           `json:"pathArg,omitempty"`
-	FileArg               *KprobeFileChecker           `json:"fileArg,omitempty"`
-	TruncatedBytesArg     *KprobeTruncatedBytesChecker `json:"truncatedBytesArg,omitempty"`
-	SockArg               *KprobeSockChecker           `json:"sockArg,omitempty"`
-	CredArg               *KprobeCredChecker           `json:"credArg,omitempty"`
-	LongArg               *int64                       `json:"longArg,omitempty"`
-	BpfAttrArg            *KprobeBpfAttrChecker        `json:"bpfAttrArg,omitempty"`
-	PerfEventArg          *KprobePerfEventChecker      `json:"perfEventArg,omitempty"`
-	BpfMapArg             *KprobeBpfMapChecker         `json:"bpfMapArg,omitempty"`
-	UintArg               *uint32                      `json:"uintArg,omitempty"`
-	UserNamespaceArg      *KprobeUserNamespaceChecker  `json:"userNamespaceArg,omitempty"`
-	CapabilityArg         *KprobeCapabilityChecker     `json:"capabilityArg,omitempty"`
-	ProcessCredentialsArg *ProcessCredentialsChecker   `json:"processCredentialsArg,omitempty"`
-	UserNsArg             *UserNamespaceChecker        `json:"userNsArg,omitempty"`
-	ModuleArg             *KernelModuleChecker         `json:"moduleArg,omitempty"`
-	KernelCapTArg         *stringmatcher.StringMatcher `json:"kernelCapTArg,omitempty"`
-	CapInheritableArg     *stringmatcher.StringMatcher `json:"capInheritableArg,omitempty"`
-	CapPermittedArg       *stringmatcher.StringMatcher `json:"capPermittedArg,omitempty"`
-	CapEffectiveArg       *stringmatcher.StringMatcher `json:"capEffectiveArg,omitempty"`
-	LinuxBinprmArg        *KprobeLinuxBinprmChecker    `json:"linuxBinprmArg,omitempty"`
-	NetDevArg             *KprobeNetDevChecker         `json:"netDevArg,omitempty"`
-	BpfCmdArg             *BpfCmdChecker               `json:"bpfCmdArg,omitempty"`
-	SyscallId             *SyscallIdChecker            `json:"syscallId,omitempty"`
-	SockaddrArg           *KprobeSockaddrChecker       `json:"sockaddrArg,omitempty"`
-	BpfProgArg            *KprobeBpfProgChecker        `json:"bpfProgArg,omitempty"`
-	ErrorArg              *KprobeErrorChecker          `json:"errorArg,omitempty"`
-	Label                 *stringmatcher.StringMatcher `json:"label,omitempty"`
+// KprobeUserNamespaceChecker implements a checker struct to check a KprobeUserNamespace field
+type KprobeUserNamespaceChecker struct {
+	Level *int32            `json:"level,omitempty"`
+	Owner *uint32           `json:"owner,omitempty"`
+	Group *uint32           `json:"group,omitempty"`
+	Ns    *NamespaceChecker `json:"ns,omitempty"`
 }
 
-// NewKprobeArgumentChecker creates a new KprobeArgumentChecker
-func NewKprobeArgumentChecker() *KprobeArgumentChecker {
-	return &KprobeArgumentChecker{}
+// NewKprobeUserNamespaceChecker creates a new KprobeUserNamespaceChecker
+func NewKprobeUserNamespaceChecker() *KprobeUserNamespaceChecker {
+	return &KprobeUserNamespaceChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *KprobeArgumentChecker) GetCheckerType() string {
-	return "KprobeArgumentChecker"
+func (checker *KprobeUserNamespaceChecker) GetCheckerType() string {
+	return "KprobeUserNamespaceChecker"
 }
 
-// Check checks a KprobeArgument field
-func (checker *KprobeArgumentChecker) Check(event *tetragon.KprobeArgument) error {
+// Check checks a KprobeUserNamespace field
+func (checker *KprobeUserNamespaceChecker) Check(event *tetragon.KprobeUserNamespace) error {
 	if event == nil {
-		return fmt.Errorf("%s: KprobeArgument field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: KprobeUserNamespace field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.StringArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_StringArg:
-				if err := checker.StringArg.Match(event.StringArg); err != nil {
-					return fmt.Errorf("StringArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: StringArg check failed: %T is not a StringArg", event)
+		if checker.Level != nil {
+			if event.Level == nil {
+				return fmt.Errorf("Level is nil and does not match expected value %v", *checker.Level)
 			}
-		}
-		if checker.IntArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_IntArg:
-				if *checker.IntArg != event.IntArg {
-					return fmt.Errorf("IntArg has value %d which does not match expected value %d", event.IntArg, *checker.IntArg)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: IntArg check failed: %T is not a IntArg", event)
+			if *checker.Level != event.Level.Value {
+				return fmt.Errorf("Level has value %v which does not match expected value %v", event.Level.Value, *checker.Level)
 			}
 		}
-		if checker.SkbArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_SkbArg:
-				if err := checker.SkbArg.Check(event.SkbArg); err != nil {
-					return fmt.Errorf("SkbArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: SkbArg check failed: %T is not a SkbArg", event)
+		if checker.Owner != nil {
+			if event.Owner == nil {
+				return fmt.Errorf("Owner is nil and does not match expected value %v", *checker.Owner)
 			}
-		}
-		if checker.SizeArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_SizeArg:
-				if *checker.SizeArg != event.SizeArg {
-					return fmt.Errorf("SizeArg has value %d which does not match expected value %d", event.SizeArg, *checker.SizeArg)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: SizeArg check failed: %T is not a SizeArg", event)
+			if *checker.Owner != event.Owner.Value {
+				return fmt.Errorf("Owner has value %v which does not match expected value %v", event.Owner.Value, *checker.Owner)
 			}
 		}
-		if checker.BytesArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_BytesArg:
-				if err := checker.BytesArg.Match(event.BytesArg); err != nil {
-					return fmt.Errorf("BytesArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: BytesArg check failed: %T is not a BytesArg", event)
+		if checker.Group != nil {
+			if event.Group == nil {
+				return fmt.Errorf("Group is nil and does not match expected value %v", *checker.Group)
+			}
+			if *checker.Group != event.Group.Value {
+				return fmt.Errorf("Group has value %v which does not match expected value %v", event.Group.Value, *checker.Group)
 			}
 		}
-		if checker.PathArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_PathArg:
-				if err := checker.PathArg.Check(event.PathArg); err != nil {
-					return fmt.Errorf("PathArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: PathArg check failed: %T is not a PathArg", event)
+		if checker.Ns != nil {
+			if err := checker.Ns.Check(event.Ns); err != nil {
+				return fmt.Errorf("Ns check failed: %w", err)
 			}
 		}
-		if checker.FileArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_FileArg:
-				if err := checker.FileArg.Check(event.FileArg); err != nil {
-					return fmt.Errorf("FileArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: FileArg check failed: %T is not a FileArg", event)
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
+}
+
+// WithLevel adds a Level check to the KprobeUserNamespaceChecker
+func (checker *KprobeUserNamespaceChecker) WithLevel(check int32) *KprobeUserNamespaceChecker {
+	checker.Level = &check
+	return checker
+}
+
+// WithOwner adds a Owner check to the KprobeUserNamespaceChecker
+func (checker *KprobeUserNamespaceChecker) WithOwner(check uint32) *KprobeUserNamespaceChecker {
+	checker.Owner = &check
+	return checker
+}
+
+// WithGroup adds a Group check to the KprobeUserNamespaceChecker
+func (checker *KprobeUserNamespaceChecker) WithGroup(check uint32) *KprobeUserNamespaceChecker {
+	checker.Group = &check
+	return checker
+}
+
+// WithNs adds a Ns check to the KprobeUserNamespaceChecker
+func (checker *KprobeUserNamespaceChecker) WithNs(check *NamespaceChecker) *KprobeUserNamespaceChecker {
+	checker.Ns = check
+	return checker
+}
+
+//FromKprobeUserNamespace populates the KprobeUserNamespaceChecker using data from a KprobeUserNamespace field
+func (checker *KprobeUserNamespaceChecker) FromKprobeUserNamespace(event *tetragon.KprobeUserNamespace) *KprobeUserNamespaceChecker {
+	if event == nil {
+		return checker
+	}
+	if event.Level != nil {
+		val := event.Level.Value
+		checker.Level = &val
+	}
+	if event.Owner != nil {
+		val := event.Owner.Value
+		checker.Owner = &val
+	}
+	if event.Group != nil {
+		val := event.Group.Value
+		checker.Group = &val
+	}
+	if event.Ns != nil {
+		checker.Ns = NewNamespaceChecker().FromNamespace(event.Ns)
+	}
+	return checker
+}
+
+// KprobeBpfAttrChecker implements a checker struct to check a KprobeBpfAttr field
+type KprobeBpfAttrChecker struct {
+	ProgType *stringmatcher.StringMatcher `json:"ProgType,omitempty"`
+	InsnCnt  *uint32                      `json:"InsnCnt,omitempty"`
+	ProgName *stringmatcher.StringMatcher `json:"ProgName,omitempty"`
+}
+
+// NewKprobeBpfAttrChecker creates a new KprobeBpfAttrChecker
+func NewKprobeBpfAttrChecker() *KprobeBpfAttrChecker {
+	return &KprobeBpfAttrChecker{}
+}
+
+// Get the type of the checker as a string
+func (checker *KprobeBpfAttrChecker) GetCheckerType() string {
+	return "KprobeBpfAttrChecker"
+}
+
+// Check checks a KprobeBpfAttr field
+func (checker *KprobeBpfAttrChecker) Check(event *tetragon.KprobeBpfAttr) error {
+	if event == nil {
+		return fmt.Errorf("%s: KprobeBpfAttr field is nil", CheckerLogPrefix(checker))
+	}
+
+	fieldChecks := func() error {
+		if checker.ProgType != nil {
+			if err := checker.ProgType.Match(event.ProgType); err != nil {
+				return fmt.Errorf("ProgType check failed: %w", err)
 			}
 		}
-		if checker.TruncatedBytesArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_TruncatedBytesArg:
-				if err := checker.TruncatedBytesArg.Check(event.TruncatedBytesArg); err != nil {
-					return fmt.Errorf("TruncatedBytesArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: TruncatedBytesArg check failed: %T is not a TruncatedBytesArg", event)
+		if checker.InsnCnt != nil {
+			if *checker.InsnCnt != event.InsnCnt {
+				return fmt.Errorf("InsnCnt has value %d which does not match expected value %d", event.InsnCnt, *checker.InsnCnt)
 			}
 		}
-		if checker.SockArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_SockArg:
-				if err := checker.SockArg.Check(event.SockArg); err != nil {
-					return fmt.Errorf("SockArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: SockArg check failed: %T is not a SockArg", event)
+		if checker.ProgName != nil {
+			if err := checker.ProgName.Match(event.ProgName); err != nil {
+				return fmt.Errorf("ProgName check failed: %w", err)
 			}
 		}
-		if checker.CredArg != nil {
-			switch event := event.Arg.(type) {
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
+}
+
+// WithProgType adds a ProgType check to the KprobeBpfAttrChecker
+func (checker *KprobeBpfAttrChecker) WithProgType(check *stringmatcher.StringMatcher) *KprobeBpfAttrChecker {
+	checker.ProgType = check
+	return checker
+}
+
+// WithInsnCnt adds a InsnCnt check to the KprobeBpfAttrChecker
+func (checker *KprobeBpfAttrChecker) WithInsnCnt(check uint32) *KprobeBpfAttrChecker {
+	checker.InsnCnt = &check
+	return checker
+}
+
+// WithProgName adds a ProgName check to the KprobeBpfAttrChecker
+func (checker *KprobeBpfAttrChecker) WithProgName(check *stringmatcher.StringMatcher) *KprobeBpfAttrChecker {
+	checker.ProgName = check
+	return checker
+}
+
+//FromKprobeBpfAttr populates the KprobeBpfAttrChecker using data from a KprobeBpfAttr field
+func (checker *KprobeBpfAttrChecker) FromKprobeBpfAttr(event *tetragon.KprobeBpfAttr) *KprobeBpfAttrChecker {
+	if event == nil {
+		return checker
+	}
+	checker.ProgType = stringmatcher.Full(event.ProgType)
+	{
+		val := event.InsnCnt
+		checker.InsnCnt = &val
+	}
+	checker.ProgName = stringmatcher.Full(event.ProgName)
+	return checker
+}
+
+// KprobeBpfProgChecker implements a checker struct to check a KprobeBpfProg field
+type KprobeBpfProgChecker struct {
+	ProgType *stringmatcher.StringMatcher `json:"ProgType,omitempty"`
+	InsnCnt  *uint32                      `json:"InsnCnt,omitempty"`
+	ProgName *stringmatcher.StringMatcher `json:"ProgName,omitempty"`
+}
+
+// NewKprobeBpfProgChecker creates a new KprobeBpfProgChecker
+func NewKprobeBpfProgChecker() *KprobeBpfProgChecker {
+	return &KprobeBpfProgChecker{}
+}
+
+// Get the type of the checker as a string
+func (checker *KprobeBpfProgChecker) GetCheckerType() string {
+	return "KprobeBpfProgChecker"
+}
+
+// Check checks a KprobeBpfProg field
+func (checker *KprobeBpfProgChecker) Check(event *tetragon.KprobeBpfProg) error {
+	if event == nil {
+		return fmt.Errorf("%s: KprobeBpfProg field is nil", CheckerLogPrefix(checker))
+	}
+
+	fieldChecks := func() error {
+		if checker.ProgType != nil {
+			if err := checker.ProgType.Match(event.ProgType); err != nil {
+				return fmt.Errorf("ProgType check failed: %w", err)
+			}
+		}
+		if checker.InsnCnt != nil {
+			if *checker.InsnCnt != event.InsnCnt {
+				return fmt.Errorf("InsnCnt has value %d which does not match expected value %d", event.InsnCnt, *checker.InsnCnt)
+			}
+		}
+		if checker.ProgName != nil {
+			if err := checker.ProgName.Match(event.ProgName); err != nil {
+				return fmt.Errorf("ProgName check failed: %w", err)
+			}
+		}
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
+}
+
+// WithProgType adds a ProgType check to the KprobeBpfProgChecker
+func (checker *KprobeBpfProgChecker) WithProgType(check *stringmatcher.StringMatcher) *KprobeBpfProgChecker {
+	checker.ProgType = check
+	return checker
+}
+
+// WithInsnCnt adds a InsnCnt check to the KprobeBpfProgChecker
+func (checker *KprobeBpfProgChecker) WithInsnCnt(check uint32) *KprobeBpfProgChecker {
+	checker.InsnCnt = &check
+	return checker
+}
+
+// WithProgName adds a ProgName check to the KprobeBpfProgChecker
+func (checker *KprobeBpfProgChecker) WithProgName(check *stringmatcher.StringMatcher) *KprobeBpfProgChecker {
+	checker.ProgName = check
+	return checker
+}
+
+//FromKprobeBpfProg populates the KprobeBpfProgChecker using data from a KprobeBpfProg field
+func (checker *KprobeBpfProgChecker) FromKprobeBpfProg(event *tetragon.KprobeBpfProg) *KprobeBpfProgChecker {
+	if event == nil {
+		return checker
+	}
+	checker.ProgType = stringmatcher.Full(event.ProgType)
+	{
+		val := event.InsnCnt
+		checker.InsnCnt = &val
+	}
+	checker.ProgName = stringmatcher.Full(event.ProgName)
+	return checker
+}
+
+// KprobePerfEventChecker implements a checker struct to check a KprobePerfEvent field
+type KprobePerfEventChecker struct {
+	KprobeFunc  *stringmatcher.StringMatcher `json:"KprobeFunc,omitempty"`
+	Type        *stringmatcher.StringMatcher `json:"Type,omitempty"`
+	Config      *uint64                      `json:"Config,omitempty"`
+	ProbeOffset *uint64                      `json:"ProbeOffset,omitempty"`
+}
+
+// NewKprobePerfEventChecker creates a new KprobePerfEventChecker
+func NewKprobePerfEventChecker() *KprobePerfEventChecker {
+	return &KprobePerfEventChecker{}
+}
+
+// Get the type of the checker as a string
+func (checker *KprobePerfEventChecker) GetCheckerType() string {
+	return "KprobePerfEventChecker"
+}
+
+// Check checks a KprobePerfEvent field
+func (checker *KprobePerfEventChecker) Check(event *tetragon.KprobePerfEvent) error {
+	if event == nil {
+		return fmt.Errorf("%s: KprobePerfEvent field is nil", CheckerLogPrefix(checker))
+	}
+
+	fieldChecks := func() error {
+		if checker.KprobeFunc != nil {
+			if err := checker.KprobeFunc.Match(event.KprobeFunc); err != nil {
+				return fmt.Errorf("KprobeFunc check failed: %w", err)
+			}
+		}
+		if checker.Type != nil {
+			if err := checker.Type.Match(event.Type); err != nil {
+				return fmt.Errorf("Type check failed: %w", err)
+			}
+		}
+		if checker.Config != nil {
+			if *checker.Config != event.Config {
+				return fmt.Errorf("Config has value %d which does not match expected value %d", event.Config, *checker.Config)
+			}
+		}
+		if checker.ProbeOffset != nil {
+			if *checker.ProbeOffset != event.ProbeOffset {
+				return fmt.Errorf("ProbeOffset has value %d which does not match expected value %d", event.ProbeOffset, *checker.ProbeOffset)
+			}
+		}
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
+}
+
+// WithKprobeFunc adds a KprobeFunc check to the KprobePerfEventChecker
+func (checker *KprobePerfEventChecker) WithKprobeFunc(check *stringmatcher.StringMatcher) *KprobePerfEventChecker {
+	checker.KprobeFunc = check
+	return checker
+}
+
+// WithType adds a Type check to the KprobePerfEventChecker
+func (checker *KprobePerfEventChecker) WithType(check *stringmatcher.StringMatcher) *KprobePerfEventChecker {
+	checker.Type = check
+	return checker
+}
+
+// WithConfig adds a Config check to the KprobePerfEventChecker
+func (checker *KprobePerfEventChecker) WithConfig(check uint64) *KprobePerfEventChecker {
+	checker.Config = &check
+	return checker
+}
+
+// WithProbeOffset adds a ProbeOffset check to the KprobePerfEventChecker
+func (checker *KprobePerfEventChecker) WithProbeOffset(check uint64) *KprobePerfEventChecker {
+	checker.ProbeOffset = &check
+	return checker
+}
+
+//FromKprobePerfEvent populates the KprobePerfEventChecker using data from a KprobePerfEvent field
+func (checker *KprobePerfEventChecker) FromKprobePerfEvent(event *tetragon.KprobePerfEvent) *KprobePerfEventChecker {
+	if event == nil {
+		return checker
+	}
+	checker.KprobeFunc = stringmatcher.Full(event.KprobeFunc)
+	checker.Type = stringmatcher.Full(event.Type)
+	{
+		val := event.Config
+		checker.Config = &val
+	}
+	{
+		val := event.ProbeOffset
+		checker.ProbeOffset = &val
+	}
+	return checker
+}
+
+// KprobeBpfMapChecker implements a checker struct to check a KprobeBpfMap field
+type KprobeBpfMapChecker struct {
+	MapType    *stringmatcher.StringMatcher `json:"MapType,omitempty"`
+	KeySize    *uint32                      `json:"KeySize,omitempty"`
+	ValueSize  *uint32                      `json:"ValueSize,omitempty"`
+	MaxEntries *uint32                      `json:"MaxEntries,omitempty"`
+	MapName    *stringmatcher.StringMatcher `json:"MapName,omitempty"`
+}
+
+// NewKprobeBpfMapChecker creates a new KprobeBpfMapChecker
+func NewKprobeBpfMapChecker() *KprobeBpfMapChecker {
+	return &KprobeBpfMapChecker{}
+}
+
+// Get the type of the checker as a string
+func (checker *KprobeBpfMapChecker) GetCheckerType() string {
+	return "KprobeBpfMapChecker"
+}
+
+// Check checks a KprobeBpfMap field
+func (checker *KprobeBpfMapChecker) Check(event *tetragon.KprobeBpfMap) error {
+	if event == nil {
+		return fmt.Errorf("%s: KprobeBpfMap field is nil", CheckerLogPrefix(checker))
+	}
+
+	fieldChecks := func() error {
+		if checker.MapType != nil {
+			if err := checker.MapType.Match(event.MapType); err != nil {
+				return fmt.Errorf("MapType check failed: %w", err)
+			}
+		}
+		if checker.KeySize != nil {
+			if *checker.KeySize != event.KeySize {
+				return fmt.Errorf("KeySize has value %d which does not match expected value %d", event.KeySize, *checker.KeySize)
+			}
+		}
+		if checker.ValueSize != nil {
+			if *checker.ValueSize != event.ValueSize {
+				return fmt.Errorf("ValueSize has value %d which does not match expected value %d", event.ValueSize, *checker.ValueSize)
+			}
+		}
+		if checker.MaxEntries != nil {
+			if *checker.MaxEntries != event.MaxEntries {
+				return fmt.Errorf("MaxEntries has value %d which does not match expected value %d", event.MaxEntries, *checker.MaxEntries)
+			}
+		}
+		if checker.MapName != nil {
+			if err := checker.MapName.Match(event.MapName); err != nil {
+				return fmt.Errorf("MapName check failed: %w", err)
+			}
+		}
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
+}
+
+// WithMapType adds a MapType check to the KprobeBpfMapChecker
+func (checker *KprobeBpfMapChecker) WithMapType(check *stringmatcher.StringMatcher) *KprobeBpfMapChecker {
+	checker.MapType = check
+	return checker
+}
+
+// WithKeySize adds a KeySize check to the KprobeBpfMapChecker
+func (checker *KprobeBpfMapChecker) WithKeySize(check uint32) *KprobeBpfMapChecker {
+	checker.KeySize = &check
+	return checker
+}
+
+// WithValueSize adds a ValueSize check to the KprobeBpfMapChecker
+func (checker *KprobeBpfMapChecker) WithValueSize(check uint32) *KprobeBpfMapChecker {
+	checker.ValueSize = &check
+	return checker
+}
+
+// WithMaxEntries adds a MaxEntries check to the KprobeBpfMapChecker
+func (checker *KprobeBpfMapChecker) WithMaxEntries(check uint32) *KprobeBpfMapChecker {
+	checker.MaxEntries = &check
+	return checker
+}
+
+// WithMapName adds a MapName check to the KprobeBpfMapChecker
+func (checker *KprobeBpfMapChecker) WithMapName(check *stringmatcher.StringMatcher) *KprobeBpfMapChecker {
+	checker.MapName = check
+	return checker
+}
+
+//FromKprobeBpfMap populates the KprobeBpfMapChecker using data from a KprobeBpfMap field
+func (checker *KprobeBpfMapChecker) FromKprobeBpfMap(event *tetragon.KprobeBpfMap) *KprobeBpfMapChecker {
+	if event == nil {
+		return checker
+	}
+	checker.MapType = stringmatcher.Full(event.MapType)
+	{
+		val := event.KeySize
+		checker.KeySize = &val
+	}
+	{
+		val := event.ValueSize
+		checker.ValueSize = &val
+	}
+	{
+		val := event.MaxEntries
+		checker.MaxEntries = &val
+	}
+	checker.MapName = stringmatcher.Full(event.MapName)
+	return checker
+}
+
+// KprobeErrorChecker implements a checker struct to check a KprobeError field
+type KprobeErrorChecker struct {
+	Message *stringmatcher.StringMatcher `json:"Message,omitempty"`
+}
+
+// NewKprobeErrorChecker creates a new KprobeErrorChecker
+func NewKprobeErrorChecker() *KprobeErrorChecker {
+	return &KprobeErrorChecker{}
+}
+
+// Get the type of the checker as a string
+func (checker *KprobeErrorChecker) GetCheckerType() string {
+	return "KprobeErrorChecker"
+}
+
+// Check checks a KprobeError field
+func (checker *KprobeErrorChecker) Check(event *tetragon.KprobeError) error {
+	if event == nil {
+		return fmt.Errorf("%s: KprobeError field is nil", CheckerLogPrefix(checker))
+	}
+
+	fieldChecks := func() error {
+		if checker.Message != nil {
+			if err := checker.Message.Match(event.Message); err != nil {
+				return fmt.Errorf("Message check failed: %w", err)
+			}
+		}
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
+}
+
+// WithMessage adds a Message check to the KprobeErrorChecker
+func (checker *KprobeErrorChecker) WithMessage(check *stringmatcher.StringMatcher) *KprobeErrorChecker {
+	checker.Message = check
+	return checker
+}
+
+//FromKprobeError populates the KprobeErrorChecker using data from a KprobeError field
+func (checker *KprobeErrorChecker) FromKprobeError(event *tetragon.KprobeError) *KprobeErrorChecker {
+	if event == nil {
+		return checker
+	}
+	checker.Message = stringmatcher.Full(event.Message)
+	return checker
+}
+
+// SyscallIdChecker implements a checker struct to check a SyscallId field
+type SyscallIdChecker struct {
+	Id  *uint32                      `json:"id,omitempty"`
+	Abi *stringmatcher.StringMatcher `json:"abi,omitempty"`
+}
+
+// NewSyscallIdChecker creates a new SyscallIdChecker
+func NewSyscallIdChecker() *SyscallIdChecker {
+	return &SyscallIdChecker{}
+}
+
+// Get the type of the checker as a string
+func (checker *SyscallIdChecker) GetCheckerType() string {
+	return "SyscallIdChecker"
+}
+
+// Check checks a SyscallId field
+func (checker *SyscallIdChecker) Check(event *tetragon.SyscallId) error {
+	if event == nil {
+		return fmt.Errorf("%s: SyscallId field is nil", CheckerLogPrefix(checker))
+	}
+
+	fieldChecks := func() error {
+		if checker.Id != nil {
+			if *checker.Id != event.Id {
+				return fmt.Errorf("Id has value %d which does not match expected value %d", event.Id, *checker.Id)
+			}
+		}
+		if checker.Abi != nil {
+			if err := checker.Abi.Match(event.Abi); err != nil {
+				return fmt.Errorf("Abi check failed: %w", err)
+			}
+		}
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
+}
+
+// WithId adds a Id check to the SyscallIdChecker
+func (checker *SyscallIdChecker) WithId(check uint32) *SyscallIdChecker {
+	checker.Id = &check
+	return checker
+}
+
+// WithAbi adds a Abi check to the SyscallIdChecker
+func (checker *SyscallIdChecker) WithAbi(check *stringmatcher.StringMatcher) *SyscallIdChecker {
+	checker.Abi = check
+	return checker
+}
+
+//FromSyscallId populates the SyscallIdChecker using data from a SyscallId field
+func (checker *SyscallIdChecker) FromSyscallId(event *tetragon.SyscallId) *SyscallIdChecker {
+	if event == nil {
+		return checker
+	}
+	{
+		val := event.Id
+		checker.Id = &val
+	}
+	checker.Abi = stringmatcher.Full(event.Abi)
+	return checker
+}
+
+// KprobeArgumentChecker implements a checker struct to check a KprobeArgument field
+type KprobeArgumentChecker struct {
+	StringArg             *stringmatcher.StringMatcher `json:"stringArg,omitempty"`
+	IntArg                *int32                       `json:"intArg,omitempty"`
+	SkbArg                *KprobeSkbChecker            `json:"skbArg,omitempty"`
+	SizeArg               *uint64                      `json:"sizeArg,omitempty"`
+	BytesArg              *bytesmatcher.BytesMatcher   `json:"bytesArg,omitempty"`
+	PathArg               *KprobePathChecker           `json:"pathArg,omitempty"`
+	FileArg               *KprobeFileChecker           `json:"fileArg,omitempty"`
+	TruncatedBytesArg     *KprobeTruncatedBytesChecker `json:"truncatedBytesArg,omitempty"`
+	SockArg               *KprobeSockChecker           `json:"sockArg,omitempty"`
+	CredArg               *KprobeCredChecker           `json:"credArg,omitempty"`
+	LongArg               *int64                       `json:"longArg,omitempty"`
+	BpfAttrArg            *KprobeBpfAttrChecker        `json:"bpfAttrArg,omitempty"`
+	PerfEventArg          *KprobePerfEventChecker      `json:"perfEventArg,omitempty"`
+	BpfMapArg             *KprobeBpfMapChecker         `json:"bpfMapArg,omitempty"`
+	UintArg               *uint32                      `json:"uintArg,omitempty"`
+	UserNamespaceArg      *KprobeUserNamespaceChecker  `json:"userNamespaceArg,omitempty"`
+	CapabilityArg         *KprobeCapabilityChecker     `json:"capabilityArg,omitempty"`
+	ProcessCredentialsArg *ProcessCredentialsChecker   `json:"processCredentialsArg,omitempty"`
+	UserNsArg             *UserNamespaceChecker        `json:"userNsArg,omitempty"`
+	ModuleArg             *KernelModuleChecker         `json:"moduleArg,omitempty"`
+	KernelCapTArg         *stringmatcher.StringMatcher `json:"kernelCapTArg,omitempty"`
+	CapInheritableArg     *stringmatcher.StringMatcher `json:"capInheritableArg,omitempty"`
+	CapPermittedArg       *stringmatcher.StringMatcher `json:"capPermittedArg,omitempty"`
+	CapEffectiveArg       *stringmatcher.StringMatcher `json:"capEffectiveArg,omitempty"`
+	LinuxBinprmArg        *KprobeLinuxBinprmChecker    `json:"linuxBinprmArg,omitempty"`
+	NetDevArg             *KprobeNetDevChecker         `json:"netDevArg,omitempty"`
+	BpfCmdArg             *BpfCmdChecker               `json:"bpfCmdArg,omitempty"`
+	SyscallId             *SyscallIdChecker            `json:"syscallId,omitempty"`
+	SockaddrArg           *KprobeSockaddrChecker       `json:"sockaddrArg,omitempty"`
+	BpfProgArg            *KprobeBpfProgChecker        `json:"bpfProgArg,omitempty"`
+	ErrorArg              *KprobeErrorChecker          `json:"errorArg,omitempty"`
+	Label                 *stringmatcher.StringMatcher `json:"label,omitempty"`
+}
+
+// NewKprobeArgumentChecker creates a new KprobeArgumentChecker
+func NewKprobeArgumentChecker() *KprobeArgumentChecker {
+	return &KprobeArgumentChecker{}
+}
+
+// Get the type of the checker as a string
+func (checker *KprobeArgumentChecker) GetCheckerType() string {
+	return "KprobeArgumentChecker"
+}
+
+// Check checks a KprobeArgument field
+func (checker *KprobeArgumentChecker) Check(event *tetragon.KprobeArgument) error {
+	if event == nil {
+		return fmt.Errorf("%s: KprobeArgument field is nil", CheckerLogPrefix(checker))
+	}
+
+	fieldChecks := func() error {
+		if checker.StringArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_StringArg:
+				if err := checker.StringArg.Match(event.StringArg); err != nil {
+					return fmt.Errorf("StringArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: StringArg check failed: %T is not a StringArg", event)
+			}
+		}
+		if checker.IntArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_IntArg:
+				if *checker.IntArg != event.IntArg {
+					return fmt.Errorf("IntArg has value %d which does not match expected value %d", event.IntArg, *checker.IntArg)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: IntArg check failed: %T is not a IntArg", event)
+			}
+		}
+		if checker.SkbArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_SkbArg:
+				if err := checker.SkbArg.Check(event.SkbArg); err != nil {
+					return fmt.Errorf("SkbArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: SkbArg check failed: %T is not a SkbArg", event)
+			}
+		}
+		if checker.SizeArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_SizeArg:
+				if *checker.SizeArg != event.SizeArg {
+					return fmt.Errorf("SizeArg has value %d which does not match expected value %d", event.SizeArg, *checker.SizeArg)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: SizeArg check failed: %T is not a SizeArg", event)
+			}
+		}
+		if checker.BytesArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_BytesArg:
+				if err := checker.BytesArg.Match(event.BytesArg); err != nil {
+					return fmt.Errorf("BytesArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: BytesArg check failed: %T is not a BytesArg", event)
+			}
+		}
+		if checker.PathArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_PathArg:
+				if err := checker.PathArg.Check(event.PathArg); err != nil {
+					return fmt.Errorf("PathArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: PathArg check failed: %T is not a PathArg", event)
+			}
+		}
+		if checker.FileArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_FileArg:
+				if err := checker.FileArg.Check(event.FileArg); err != nil {
+					return fmt.Errorf("FileArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: FileArg check failed: %T is not a FileArg", event)
+			}
+		}
+		if checker.TruncatedBytesArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_TruncatedBytesArg:
+				if err := checker.TruncatedBytesArg.Check(event.TruncatedBytesArg); err != nil {
+					return fmt.Errorf("TruncatedBytesArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: TruncatedBytesArg check failed: %T is not a TruncatedBytesArg", event)
+			}
+		}
+		if checker.SockArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_SockArg:
+				if err := checker.SockArg.Check(event.SockArg); err != nil {
+					return fmt.Errorf("SockArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: SockArg check failed: %T is not a SockArg", event)
+			}
+		}
+		if checker.CredArg != nil {
+			switch event := event.Arg.(type) {
 			case *tetragon.KprobeArgument_CredArg:
 				if err := checker.CredArg.Check(event.CredArg); err != nil {
 					return fmt.Errorf("CredArg check failed: %w", err)
@@ -6857,219 +7782,647 @@ func (checker *KprobeArgumentChecker) Check(event *tetragon.KprobeArgument) erro
 				return fmt.Errorf("KprobeArgumentChecker: CredArg check failed: %T is not a CredArg", event)
 			}
 		}
-		if checker.LongArg != nil {
+		if checker.LongArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_LongArg:
+				if *checker.LongArg != event.LongArg {
+					return fmt.Errorf("LongArg has value %d which does not match expected value %d", event.LongArg, *checker.LongArg)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: LongArg check failed: %T is not a LongArg", event)
+			}
+		}
+		if checker.BpfAttrArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_BpfAttrArg:
+				if err := checker.BpfAttrArg.Check(event.BpfAttrArg); err != nil {
+					return fmt.Errorf("BpfAttrArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: BpfAttrArg check failed: %T is not a BpfAttrArg", event)
+			}
+		}
+		if checker.PerfEventArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_PerfEventArg:
+				if err := checker.PerfEventArg.Check(event.PerfEventArg); err != nil {
+					return fmt.Errorf("PerfEventArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: PerfEventArg check failed: %T is not a PerfEventArg", event)
+			}
+		}
+		if checker.BpfMapArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_BpfMapArg:
+				if err := checker.BpfMapArg.Check(event.BpfMapArg); err != nil {
+					return fmt.Errorf("BpfMapArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: BpfMapArg check failed: %T is not a BpfMapArg", event)
+			}
+		}
+		if checker.UintArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_UintArg:
+				if *checker.UintArg != event.UintArg {
+					return fmt.Errorf("UintArg has value %d which does not match expected value %d", event.UintArg, *checker.UintArg)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: UintArg check failed: %T is not a UintArg", event)
+			}
+		}
+		if checker.UserNamespaceArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_UserNamespaceArg:
+				if err := checker.UserNamespaceArg.Check(event.UserNamespaceArg); err != nil {
+					return fmt.Errorf("UserNamespaceArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: UserNamespaceArg check failed: %T is not a UserNamespaceArg", event)
+			}
+		}
+		if checker.CapabilityArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_CapabilityArg:
+				if err := checker.CapabilityArg.Check(event.CapabilityArg); err != nil {
+					return fmt.Errorf("CapabilityArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: CapabilityArg check failed: %T is not a CapabilityArg", event)
+			}
+		}
+		if checker.ProcessCredentialsArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_ProcessCredentialsArg:
+				if err := checker.ProcessCredentialsArg.Check(event.ProcessCredentialsArg); err != nil {
+					return fmt.Errorf("ProcessCredentialsArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: ProcessCredentialsArg check failed: %T is not a ProcessCredentialsArg", event)
+			}
+		}
+		if checker.UserNsArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_UserNsArg:
+				if err := checker.UserNsArg.Check(event.UserNsArg); err != nil {
+					return fmt.Errorf("UserNsArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: UserNsArg check failed: %T is not a UserNsArg", event)
+			}
+		}
+		if checker.ModuleArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_ModuleArg:
+				if err := checker.ModuleArg.Check(event.ModuleArg); err != nil {
+					return fmt.Errorf("ModuleArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: ModuleArg check failed: %T is not a ModuleArg", event)
+			}
+		}
+		if checker.KernelCapTArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_KernelCapTArg:
+				if err := checker.KernelCapTArg.Match(event.KernelCapTArg); err != nil {
+					return fmt.Errorf("KernelCapTArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: KernelCapTArg check failed: %T is not a KernelCapTArg", event)
+			}
+		}
+		if checker.CapInheritableArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_CapInheritableArg:
+				if err := checker.CapInheritableArg.Match(event.CapInheritableArg); err != nil {
+					return fmt.Errorf("CapInheritableArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: CapInheritableArg check failed: %T is not a CapInheritableArg", event)
+			}
+		}
+		if checker.CapPermittedArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_CapPermittedArg:
+				if err := checker.CapPermittedArg.Match(event.CapPermittedArg); err != nil {
+					return fmt.Errorf("CapPermittedArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: CapPermittedArg check failed: %T is not a CapPermittedArg", event)
+			}
+		}
+		if checker.CapEffectiveArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_CapEffectiveArg:
+				if err := checker.CapEffectiveArg.Match(event.CapEffectiveArg); err != nil {
+					return fmt.Errorf("CapEffectiveArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: CapEffectiveArg check failed: %T is not a CapEffectiveArg", event)
+			}
+		}
+		if checker.LinuxBinprmArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_LinuxBinprmArg:
+				if err := checker.LinuxBinprmArg.Check(event.LinuxBinprmArg); err != nil {
+					return fmt.Errorf("LinuxBinprmArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: LinuxBinprmArg check failed: %T is not a LinuxBinprmArg", event)
+			}
+		}
+		if checker.NetDevArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_NetDevArg:
+				if err := checker.NetDevArg.Check(event.NetDevArg); err != nil {
+					return fmt.Errorf("NetDevArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: NetDevArg check failed: %T is not a NetDevArg", event)
+			}
+		}
+		if checker.BpfCmdArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_BpfCmdArg:
+				if err := checker.BpfCmdArg.Check(&event.BpfCmdArg); err != nil {
+					return fmt.Errorf("BpfCmdArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: BpfCmdArg check failed: %T is not a BpfCmdArg", event)
+			}
+		}
+		if checker.SyscallId != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_SyscallId:
+				if err := checker.SyscallId.Check(event.SyscallId); err != nil {
+					return fmt.Errorf("SyscallId check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: SyscallId check failed: %T is not a SyscallId", event)
+			}
+		}
+		if checker.SockaddrArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_SockaddrArg:
+				if err := checker.SockaddrArg.Check(event.SockaddrArg); err != nil {
+					return fmt.Errorf("SockaddrArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: SockaddrArg check failed: %T is not a SockaddrArg", event)
+			}
+		}
+		if checker.BpfProgArg != nil {
+			switch event := event.Arg.(type) {
+			case *tetragon.KprobeArgument_BpfProgArg:
+				if err := checker.BpfProgArg.Check(event.BpfProgArg); err != nil {
+					return fmt.Errorf("BpfProgArg check failed: %w", err)
+				}
+			default:
+				return fmt.Errorf("KprobeArgumentChecker: BpfProgArg check failed: %T is not a BpfProgArg", event)
+			}
+		}
+		if checker.ErrorArg != nil {
 			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_LongArg:
-				if *checker.LongArg != event.LongArg {
-					return fmt.Errorf("LongArg has value %d which does not match expected value %d", event.LongArg, *checker.LongArg)
+			case *tetragon.KprobeArgument_ErrorArg:
+				if err := checker.ErrorArg.Check(event.ErrorArg); err != nil {
+					return fmt.Errorf("ErrorArg check failed: %w", err)
 				}
 			default:
-				return fmt.Errorf("KprobeArgumentChecker: LongArg check failed: %T is not a LongArg", event)
+				return fmt.Errorf("KprobeArgumentChecker: ErrorArg check failed: %T is not a ErrorArg", event)
+			}
+		}
+		if checker.Label != nil {
+			if err := checker.Label.Match(event.Label); err != nil {
+				return fmt.Errorf("Label check failed: %w", err)
 			}
 		}
-		if checker.BpfAttrArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_BpfAttrArg:
-				if err := checker.BpfAttrArg.Check(event.BpfAttrArg); err != nil {
-					return fmt.Errorf("BpfAttrArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: BpfAttrArg check failed: %T is not a BpfAttrArg", event)
-			}
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
+}
+
+// WithStringArg adds a StringArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithStringArg(check *stringmatcher.StringMatcher) *KprobeArgumentChecker {
+	checker.StringArg = check
+	return checker
+}
+
+// WithIntArg adds a IntArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithIntArg(check int32) *KprobeArgumentChecker {
+	checker.IntArg = &check
+	return checker
+}
+
+// WithSkbArg adds a SkbArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithSkbArg(check *KprobeSkbChecker) *KprobeArgumentChecker {
+	checker.SkbArg = check
+	return checker
+}
+
+// WithSizeArg adds a SizeArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithSizeArg(check uint64) *KprobeArgumentChecker {
+	checker.SizeArg = &check
+	return checker
+}
+
+// WithBytesArg adds a BytesArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithBytesArg(check *bytesmatcher.BytesMatcher) *KprobeArgumentChecker {
+	checker.BytesArg = check
+	return checker
+}
+
+// WithPathArg adds a PathArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithPathArg(check *KprobePathChecker) *KprobeArgumentChecker {
+	checker.PathArg = check
+	return checker
+}
+
+// WithFileArg adds a FileArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithFileArg(check *KprobeFileChecker) *KprobeArgumentChecker {
+	checker.FileArg = check
+	return checker
+}
+
+// WithTruncatedBytesArg adds a TruncatedBytesArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithTruncatedBytesArg(check *KprobeTruncatedBytesChecker) *KprobeArgumentChecker {
+	checker.TruncatedBytesArg = check
+	return checker
+}
+
+// WithSockArg adds a SockArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithSockArg(check *KprobeSockChecker) *KprobeArgumentChecker {
+	checker.SockArg = check
+	return checker
+}
+
+// WithCredArg adds a CredArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithCredArg(check *KprobeCredChecker) *KprobeArgumentChecker {
+	checker.CredArg = check
+	return checker
+}
+
+// WithLongArg adds a LongArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithLongArg(check int64) *KprobeArgumentChecker {
+	checker.LongArg = &check
+	return checker
+}
+
+// WithBpfAttrArg adds a BpfAttrArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithBpfAttrArg(check *KprobeBpfAttrChecker) *KprobeArgumentChecker {
+	checker.BpfAttrArg = check
+	return checker
+}
+
+// WithPerfEventArg adds a PerfEventArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithPerfEventArg(check *KprobePerfEventChecker) *KprobeArgumentChecker {
+	checker.PerfEventArg = check
+	return checker
+}
+
+// WithBpfMapArg adds a BpfMapArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithBpfMapArg(check *KprobeBpfMapChecker) *KprobeArgumentChecker {
+	checker.BpfMapArg = check
+	return checker
+}
+
+// WithUintArg adds a UintArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithUintArg(check uint32) *KprobeArgumentChecker {
+	checker.UintArg = &check
+	return checker
+}
+
+// WithUserNamespaceArg adds a UserNamespaceArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithUserNamespaceArg(check *KprobeUserNamespaceChecker) *KprobeArgumentChecker {
+	checker.UserNamespaceArg = check
+	return checker
+}
+
+// WithCapabilityArg adds a CapabilityArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithCapabilityArg(check *KprobeCapabilityChecker) *KprobeArgumentChecker {
+	checker.CapabilityArg = check
+	return checker
+}
+
+// WithProcessCredentialsArg adds a ProcessCredentialsArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithProcessCredentialsArg(check *ProcessCredentialsChecker) *KprobeArgumentChecker {
+	checker.ProcessCredentialsArg = check
+	return checker
+}
+
+// WithUserNsArg adds a UserNsArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithUserNsArg(check *UserNamespaceChecker) *KprobeArgumentChecker {
+	checker.UserNsArg = check
+	return checker
+}
+
+// WithModuleArg adds a ModuleArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithModuleArg(check *KernelModuleChecker) *KprobeArgumentChecker {
+	checker.ModuleArg = check
+	return checker
+}
+
+// WithKernelCapTArg adds a KernelCapTArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithKernelCapTArg(check *stringmatcher.StringMatcher) *KprobeArgumentChecker {
+	checker.KernelCapTArg = check
+	return checker
+}
+
+// WithCapInheritableArg adds a CapInheritableArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithCapInheritableArg(check *stringmatcher.StringMatcher) *KprobeArgumentChecker {
+	checker.CapInheritableArg = check
+	return checker
+}
+
+// WithCapPermittedArg adds a CapPermittedArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithCapPermittedArg(check *stringmatcher.StringMatcher) *KprobeArgumentChecker {
+	checker.CapPermittedArg = check
+	return checker
+}
+
+// WithCapEffectiveArg adds a CapEffectiveArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithCapEffectiveArg(check *stringmatcher.StringMatcher) *KprobeArgumentChecker {
+	checker.CapEffectiveArg = check
+	return checker
+}
+
+// WithLinuxBinprmArg adds a LinuxBinprmArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithLinuxBinprmArg(check *KprobeLinuxBinprmChecker) *KprobeArgumentChecker {
+	checker.LinuxBinprmArg = check
+	return checker
+}
+
+// WithNetDevArg adds a NetDevArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithNetDevArg(check *KprobeNetDevChecker) *KprobeArgumentChecker {
+	checker.NetDevArg = check
+	return checker
+}
+
+// WithBpfCmdArg adds a BpfCmdArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithBpfCmdArg(check tetragon.BpfCmd) *KprobeArgumentChecker {
+	wrappedCheck := BpfCmdChecker(check)
+	checker.BpfCmdArg = &wrappedCheck
+	return checker
+}
+
+// WithSyscallId adds a SyscallId check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithSyscallId(check *SyscallIdChecker) *KprobeArgumentChecker {
+	checker.SyscallId = check
+	return checker
+}
+
+// WithSockaddrArg adds a SockaddrArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithSockaddrArg(check *KprobeSockaddrChecker) *KprobeArgumentChecker {
+	checker.SockaddrArg = check
+	return checker
+}
+
+// WithBpfProgArg adds a BpfProgArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithBpfProgArg(check *KprobeBpfProgChecker) *KprobeArgumentChecker {
+	checker.BpfProgArg = check
+	return checker
+}
+
+// WithErrorArg adds a ErrorArg check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithErrorArg(check *KprobeErrorChecker) *KprobeArgumentChecker {
+	checker.ErrorArg = check
+	return checker
+}
+
+// WithLabel adds a Label check to the KprobeArgumentChecker
+func (checker *KprobeArgumentChecker) WithLabel(check *stringmatcher.StringMatcher) *KprobeArgumentChecker {
+	checker.Label = check
+	return checker
+}
+
+//FromKprobeArgument populates the KprobeArgumentChecker using data from a KprobeArgument field
+func (checker *KprobeArgumentChecker) FromKprobeArgument(event *tetragon.KprobeArgument) *KprobeArgumentChecker {
+	if event == nil {
+		return checker
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_StringArg:
+		checker.StringArg = stringmatcher.Full(event.StringArg)
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_IntArg:
+		{
+			val := event.IntArg
+			checker.IntArg = &val
+		}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_SkbArg:
+		if event.SkbArg != nil {
+			checker.SkbArg = NewKprobeSkbChecker().FromKprobeSkb(event.SkbArg)
+		}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_SizeArg:
+		{
+			val := event.SizeArg
+			checker.SizeArg = &val
+		}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_BytesArg:
+		checker.BytesArg = bytesmatcher.Full(event.BytesArg)
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_PathArg:
+		if event.PathArg != nil {
+			checker.PathArg = NewKprobePathChecker().FromKprobePath(event.PathArg)
+		}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_FileArg:
+		if event.FileArg != nil {
+			checker.FileArg = NewKprobeFileChecker().FromKprobeFile(event.FileArg)
+		}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_TruncatedBytesArg:
+		if event.TruncatedBytesArg != nil {
+			checker.TruncatedBytesArg = NewKprobeTruncatedBytesChecker().FromKprobeTruncatedBytes(event.TruncatedBytesArg)
 		}
-		if checker.PerfEventArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_PerfEventArg:
-				if err := checker.PerfEventArg.Check(event.PerfEventArg); err != nil {
-					return fmt.Errorf("PerfEventArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: PerfEventArg check failed: %T is not a PerfEventArg", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_SockArg:
+		if event.SockArg != nil {
+			checker.SockArg = NewKprobeSockChecker().FromKprobeSock(event.SockArg)
 		}
-		if checker.BpfMapArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_BpfMapArg:
-				if err := checker.BpfMapArg.Check(event.BpfMapArg); err != nil {
-					return fmt.Errorf("BpfMapArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: BpfMapArg check failed: %T is not a BpfMapArg", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_CredArg:
+		if event.CredArg != nil {
+			checker.CredArg = NewKprobeCredChecker().FromKprobeCred(event.CredArg)
 		}
-		if checker.UintArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_UintArg:
-				if *checker.UintArg != event.UintArg {
-					return fmt.Errorf("UintArg has value %d which does not match expected value %d", event.UintArg, *checker.UintArg)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: UintArg check failed: %T is not a UintArg", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_LongArg:
+		{
+			val := event.LongArg
+			checker.LongArg = &val
 		}
-		if checker.UserNamespaceArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_UserNamespaceArg:
-				if err := checker.UserNamespaceArg.Check(event.UserNamespaceArg); err != nil {
-					return fmt.Errorf("UserNamespaceArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: UserNamespaceArg check failed: %T is not a UserNamespaceArg", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_BpfAttrArg:
+		if event.BpfAttrArg != nil {
+			checker.BpfAttrArg = NewKprobeBpfAttrChecker().FromKprobeBpfAttr(event.BpfAttrArg)
 		}
-		if checker.CapabilityArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_CapabilityArg:
-				if err := checker.CapabilityArg.Check(event.CapabilityArg); err != nil {
-					return fmt.Errorf("CapabilityArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: CapabilityArg check failed: %T is not a CapabilityArg", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_PerfEventArg:
+		if event.PerfEventArg != nil {
+			checker.PerfEventArg = NewKprobePerfEventChecker().FromKprobePerfEvent(event.PerfEventArg)
 		}
-		if checker.ProcessCredentialsArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_ProcessCredentialsArg:
-				if err := checker.ProcessCredentialsArg.Check(event.ProcessCredentialsArg); err != nil {
-					return fmt.Errorf("ProcessCredentialsArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: ProcessCredentialsArg check failed: %T is not a ProcessCredentialsArg", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_BpfMapArg:
+		if event.BpfMapArg != nil {
+			checker.BpfMapArg = NewKprobeBpfMapChecker().FromKprobeBpfMap(event.BpfMapArg)
 		}
-		if checker.UserNsArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_UserNsArg:
-				if err := checker.UserNsArg.Check(event.UserNsArg); err != nil {
-					return fmt.Errorf("UserNsArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: UserNsArg check failed: %T is not a UserNsArg", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_UintArg:
+		{
+			val := event.UintArg
+			checker.UintArg = &val
 		}
-		if checker.ModuleArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_ModuleArg:
-				if err := checker.ModuleArg.Check(event.ModuleArg); err != nil {
-					return fmt.Errorf("ModuleArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: ModuleArg check failed: %T is not a ModuleArg", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_UserNamespaceArg:
+		if event.UserNamespaceArg != nil {
+			checker.UserNamespaceArg = NewKprobeUserNamespaceChecker().FromKprobeUserNamespace(event.UserNamespaceArg)
 		}
-		if checker.KernelCapTArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_KernelCapTArg:
-				if err := checker.KernelCapTArg.Match(event.KernelCapTArg); err != nil {
-					return fmt.Errorf("KernelCapTArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: KernelCapTArg check failed: %T is not a KernelCapTArg", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_CapabilityArg:
+		if event.CapabilityArg != nil {
+			checker.CapabilityArg = NewKprobeCapabilityChecker().FromKprobeCapability(event.CapabilityArg)
 		}
-		if checker.CapInheritableArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_CapInheritableArg:
-				if err := checker.CapInheritableArg.Match(event.CapInheritableArg); err != nil {
-					return fmt.Errorf("CapInheritableArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: CapInheritableArg check failed: %T is not a CapInheritableArg", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_ProcessCredentialsArg:
+		if event.ProcessCredentialsArg != nil {
+			checker.ProcessCredentialsArg = NewProcessCredentialsChecker().FromProcessCredentials(event.ProcessCredentialsArg)
 		}
-		if checker.CapPermittedArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_CapPermittedArg:
-				if err := checker.CapPermittedArg.Match(event.CapPermittedArg); err != nil {
-					return fmt.Errorf("CapPermittedArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: CapPermittedArg check failed: %T is not a CapPermittedArg", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_UserNsArg:
+		if event.UserNsArg != nil {
+			checker.UserNsArg = NewUserNamespaceChecker().FromUserNamespace(event.UserNsArg)
 		}
-		if checker.CapEffectiveArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_CapEffectiveArg:
-				if err := checker.CapEffectiveArg.Match(event.CapEffectiveArg); err != nil {
-					return fmt.Errorf("CapEffectiveArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: CapEffectiveArg check failed: %T is not a CapEffectiveArg", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_ModuleArg:
+		if event.ModuleArg != nil {
+			checker.ModuleArg = NewKernelModuleChecker().FromKernelModule(event.ModuleArg)
 		}
-		if checker.LinuxBinprmArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_LinuxBinprmArg:
-				if err := checker.LinuxBinprmArg.Check(event.LinuxBinprmArg); err != nil {
-					return fmt.Errorf("LinuxBinprmArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: LinuxBinprmArg check failed: %T is not a LinuxBinprmArg", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_KernelCapTArg:
+		checker.KernelCapTArg = stringmatcher.Full(event.KernelCapTArg)
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_CapInheritableArg:
+		checker.CapInheritableArg = stringmatcher.Full(event.CapInheritableArg)
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_CapPermittedArg:
+		checker.CapPermittedArg = stringmatcher.Full(event.CapPermittedArg)
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_CapEffectiveArg:
+		checker.CapEffectiveArg = stringmatcher.Full(event.CapEffectiveArg)
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_LinuxBinprmArg:
+		if event.LinuxBinprmArg != nil {
+			checker.LinuxBinprmArg = NewKprobeLinuxBinprmChecker().FromKprobeLinuxBinprm(event.LinuxBinprmArg)
 		}
-		if checker.NetDevArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_NetDevArg:
-				if err := checker.NetDevArg.Check(event.NetDevArg); err != nil {
-					return fmt.Errorf("NetDevArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: NetDevArg check failed: %T is not a NetDevArg", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_NetDevArg:
+		if event.NetDevArg != nil {
+			checker.NetDevArg = NewKprobeNetDevChecker().FromKprobeNetDev(event.NetDevArg)
 		}
-		if checker.BpfCmdArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_BpfCmdArg:
-				if err := checker.BpfCmdArg.Check(&event.BpfCmdArg); err != nil {
-					return fmt.Errorf("BpfCmdArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: BpfCmdArg check failed: %T is not a BpfCmdArg", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_BpfCmdArg:
+		checker.BpfCmdArg = NewBpfCmdChecker(event.BpfCmdArg)
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_SyscallId:
+		if event.SyscallId != nil {
+			checker.SyscallId = NewSyscallIdChecker().FromSyscallId(event.SyscallId)
+		}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_SockaddrArg:
+		if event.SockaddrArg != nil {
+			checker.SockaddrArg = NewKprobeSockaddrChecker().FromKprobeSockaddr(event.SockaddrArg)
 		}
-		if checker.SyscallId != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_SyscallId:
-				if err := checker.SyscallId.Check(event.SyscallId); err != nil {
-					return fmt.Errorf("SyscallId check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: SyscallId check failed: %T is not a SyscallId", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_BpfProgArg:
+		if event.BpfProgArg != nil {
+			checker.BpfProgArg = NewKprobeBpfProgChecker().FromKprobeBpfProg(event.BpfProgArg)
 		}
-		if checker.SockaddrArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_SockaddrArg:
-				if err := checker.SockaddrArg.Check(event.SockaddrArg); err != nil {
-					return fmt.Errorf("SockaddrArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: SockaddrArg check failed: %T is not a SockaddrArg", event)
-			}
+	}
+	switch event := event.Arg.(type) {
+	case *tetragon.KprobeArgument_ErrorArg:
+		if event.ErrorArg != nil {
+			checker.ErrorArg = NewKprobeErrorChecker().FromKprobeError(event.ErrorArg)
 		}
-		if checker.BpfProgArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_BpfProgArg:
-				if err := checker.BpfProgArg.Check(event.BpfProgArg); err != nil {
-					return fmt.Errorf("BpfProgArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: BpfProgArg check failed: %T is not a BpfProgArg", event)
+	}
+	checker.Label = stringmatcher.Full(event.Label)
+	return checker
+}
+
+// KernelModuleChecker implements a checker struct to check a KernelModule field
+type KernelModuleChecker struct {
+	Name        *stringmatcher.StringMatcher `json:"name,omitempty"`
+	SignatureOk *bool                        `json:"signatureOk,omitempty"`
+	Tainted     *TaintedBitsTypeListMatcher  `json:"tainted,omitempty"`
+}
+
+// NewKernelModuleChecker creates a new KernelModuleChecker
+func NewKernelModuleChecker() *KernelModuleChecker {
+	return &KernelModuleChecker{}
+}
+
+// Get the type of the checker as a string
+func (checker *KernelModuleChecker) GetCheckerType() string {
+	return "KernelModuleChecker"
+}
+
+// Check checks a KernelModule field
+func (checker *KernelModuleChecker) Check(event *tetragon.KernelModule) error {
+	if event == nil {
+		return fmt.Errorf("%s: KernelModule field is nil", CheckerLogPrefix(checker))
+	}
+
+	fieldChecks := func() error {
+		if checker.Name != nil {
+			if err := checker.Name.Match(event.Name); err != nil {
+				return fmt.Errorf("Name check failed: %w", err)
 			}
 		}
-		if checker.ErrorArg != nil {
-			switch event := event.Arg.(type) {
-			case *tetragon.KprobeArgument_ErrorArg:
-				if err := checker.ErrorArg.Check(event.ErrorArg); err != nil {
-					return fmt.Errorf("ErrorArg check failed: %w", err)
-				}
-			default:
-				return fmt.Errorf("KprobeArgumentChecker: ErrorArg check failed: %T is not a ErrorArg", event)
+		if checker.SignatureOk != nil {
+			if event.SignatureOk == nil {
+				return fmt.Errorf("SignatureOk is nil and does not match expected value %v", *checker.SignatureOk)
+			}
+			if *checker.SignatureOk != event.SignatureOk.Value {
+				return fmt.Errorf("SignatureOk has value %v which does not match expected value %v", event.SignatureOk.Value, *checker.SignatureOk)
 			}
 		}
-		if checker.Label != nil {
-			if err := checker.Label.Match(event.Label); err != nil {
-				return fmt.Errorf("Label check failed: %w", err)
+		if checker.Tainted != nil {
+			if err := checker.Tainted.Check(event.Tainted); err != nil {
+				return fmt.Errorf("Tainted check failed: %w", err)
 			}
 		}
 		return nil
@@ -7080,424 +8433,358 @@ func (checker *KprobeArgumentChecker) Check(event *tetragon.KprobeArgument) erro
 	return nil
 }
 
-// WithStringArg adds a StringArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithStringArg(check *stringmatcher.StringMatcher) *KprobeArgumentChecker {
-	checker.StringArg = check
-	return checker
-}
-
-// WithIntArg adds a IntArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithIntArg(check int32) *KprobeArgumentChecker {
-	checker.IntArg = &check
-	return checker
-}
-
-// WithSkbArg adds a SkbArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithSkbArg(check *KprobeSkbChecker) *KprobeArgumentChecker {
-	checker.SkbArg = check
-	return checker
-}
-
-// WithSizeArg adds a SizeArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithSizeArg(check uint64) *KprobeArgumentChecker {
-	checker.SizeArg = &check
+// WithName adds a Name check to the KernelModuleChecker
+func (checker *KernelModuleChecker) WithName(check *stringmatcher.StringMatcher) *KernelModuleChecker {
+	checker.Name = check
 	return checker
 }
 
-// WithBytesArg adds a BytesArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithBytesArg(check *bytesmatcher.BytesMatcher) *KprobeArgumentChecker {
-	checker.BytesArg = check
+// WithSignatureOk adds a SignatureOk check to the KernelModuleChecker
+func (checker *KernelModuleChecker) WithSignatureOk(check bool) *KernelModuleChecker {
+	checker.SignatureOk = &check
 	return checker
 }
 
-// WithPathArg adds a PathArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithPathArg(check *KprobePathChecker) *KprobeArgumentChecker {
-	checker.PathArg = check
+// WithTainted adds a Tainted check to the KernelModuleChecker
+func (checker *KernelModuleChecker) WithTainted(check *TaintedBitsTypeListMatcher) *KernelModuleChecker {
+	checker.Tainted = check
 	return checker
 }
 
-// WithFileArg adds a FileArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithFileArg(check *KprobeFileChecker) *KprobeArgumentChecker {
-	checker.FileArg = check
+//FromKernelModule populates the KernelModuleChecker using data from a KernelModule field
+func (checker *KernelModuleChecker) FromKernelModule(event *tetragon.KernelModule) *KernelModuleChecker {
+	if event == nil {
+		return checker
+	}
+	checker.Name = stringmatcher.Full(event.Name)
+	if event.SignatureOk != nil {
+		val := event.SignatureOk.Value
+		checker.SignatureOk = &val
+	}
+	{
+		var checks []*TaintedBitsTypeChecker
+		for _, check := range event.Tainted {
+			var convertedCheck *TaintedBitsTypeChecker
+			convertedCheck = NewTaintedBitsTypeChecker(check)
+			checks = append(checks, convertedCheck)
+		}
+		lm := NewTaintedBitsTypeListMatcher().WithOperator(listmatcher.Ordered).
+			WithValues(checks...)
+		checker.Tainted = lm
+	}
 	return checker
 }
 
-// WithTruncatedBytesArg adds a TruncatedBytesArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithTruncatedBytesArg(check *KprobeTruncatedBytesChecker) *KprobeArgumentChecker {
-	checker.TruncatedBytesArg = check
-	return checker
+// TaintedBitsTypeListMatcher checks a list of tetragon.TaintedBitsType fields
+type TaintedBitsTypeListMatcher struct {
+	Operator listmatcher.Operator      `json:"operator"`
+	Values   []*TaintedBitsTypeChecker `json:"values"`
 }
 
-// WithSockArg adds a SockArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithSockArg(check *KprobeSockChecker) *KprobeArgumentChecker {
-	checker.SockArg = check
-	return checker
+// NewTaintedBitsTypeListMatcher creates a new TaintedBitsTypeListMatcher. The checker defaults to a subset checker unless otherwise specified using WithOperator()
+func NewTaintedBitsTypeListMatcher() *TaintedBitsTypeListMatcher {
+	return &TaintedBitsTypeListMatcher{
+		Operator: listmatcher.Subset,
+	}
 }
 
-// WithCredArg adds a CredArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithCredArg(check *KprobeCredChecker) *KprobeArgumentChecker {
-	checker.CredArg = check
+// WithOperator sets the match kind for the TaintedBitsTypeListMatcher
+func (checker *TaintedBitsTypeListMatcher) WithOperator(operator listmatcher.Operator) *TaintedBitsTypeListMatcher {
+	checker.Operator = operator
 	return checker
 }
 
-// WithLongArg adds a LongArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithLongArg(check int64) *KprobeArgumentChecker {
-	checker.LongArg = &check
+// WithValues sets the checkers that the TaintedBitsTypeListMatcher should use
+func (checker *TaintedBitsTypeListMatcher) WithValues(values ...*TaintedBitsTypeChecker) *TaintedBitsTypeListMatcher {
+	checker.Values = values
 	return checker
 }
 
-// WithBpfAttrArg adds a BpfAttrArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithBpfAttrArg(check *KprobeBpfAttrChecker) *KprobeArgumentChecker {
-	checker.BpfAttrArg = check
-	return checker
+// Check checks a list of tetragon.TaintedBitsType fields
+func (checker *TaintedBitsTypeListMatcher) Check(values []tetragon.TaintedBitsType) error {
+	switch checker.Operator {
+	case listmatcher.Ordered:
+		return checker.orderedCheck(values)
+	case listmatcher.Unordered:
+		return checker.unorderedCheck(values)
+	case listmatcher.Subset:
+		return checker.subsetCheck(values)
+	default:
+		return fmt.Errorf("Unhandled ListMatcher operator %s", checker.Operator)
+	}
 }
 
-// WithPerfEventArg adds a PerfEventArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithPerfEventArg(check *KprobePerfEventChecker) *KprobeArgumentChecker {
-	checker.PerfEventArg = check
-	return checker
-}
+// orderedCheck checks a list of ordered tetragon.TaintedBitsType fields
+func (checker *TaintedBitsTypeListMatcher) orderedCheck(values []tetragon.TaintedBitsType) error {
+	innerCheck := func(check *TaintedBitsTypeChecker, value tetragon.TaintedBitsType) error {
+		if err := check.Check(&value); err != nil {
+			return fmt.Errorf("Tainted check failed: %w", err)
+		}
+		return nil
+	}
 
-// WithBpfMapArg adds a BpfMapArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithBpfMapArg(check *KprobeBpfMapChecker) *KprobeArgumentChecker {
-	checker.BpfMapArg = check
-	return checker
-}
+	if len(checker.Values) != len(values) {
+		return fmt.Errorf("TaintedBitsTypeListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+	}
 
-// WithUintArg adds a UintArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithUintArg(check uint32) *KprobeArgumentChecker {
-	checker.UintArg = &check
-	return checker
-}
+	for i, check := range checker.Values {
+		value := values[i]
+		if err := innerCheck(check, value); err != nil {
+			return fmt.Errorf("TaintedBitsTypeListMatcher: Check failed on element %d: %w", i, err)
+		}
+	}
 
-// WithUserNamespaceArg adds a UserNamespaceArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithUserNamespaceArg(check *KprobeUserNamespaceChecker) *KprobeArgumentChecker {
-	checker.UserNamespaceArg = check
-	return checker
+	return nil
 }
 
-// WithCapabilityArg adds a CapabilityArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithCapabilityArg(check *KprobeCapabilityChecker) *KprobeArgumentChecker {
-	checker.CapabilityArg = check
-	return checker
-}
+// unorderedCheck checks a list of unordered tetragon.TaintedBitsType fields
+func (checker *TaintedBitsTypeListMatcher) unorderedCheck(values []tetragon.TaintedBitsType) error {
+	if len(checker.Values) != len(values) {
+		return fmt.Errorf("TaintedBitsTypeListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+	}
 
-// WithProcessCredentialsArg adds a ProcessCredentialsArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithProcessCredentialsArg(check *ProcessCredentialsChecker) *KprobeArgumentChecker {
-	checker.ProcessCredentialsArg = check
-	return checker
+	return checker.subsetCheck(values)
 }
 
-// WithUserNsArg adds a UserNsArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithUserNsArg(check *UserNamespaceChecker) *KprobeArgumentChecker {
-	checker.UserNsArg = check
-	return checker
-}
+// subsetCheck checks a subset of tetragon.TaintedBitsType fields
+func (checker *TaintedBitsTypeListMatcher) subsetCheck(values []tetragon.TaintedBitsType) error {
+	innerCheck := func(check *TaintedBitsTypeChecker, value tetragon.TaintedBitsType) error {
+		if err := check.Check(&value); err != nil {
+			return fmt.Errorf("Tainted check failed: %w", err)
+		}
+		return nil
+	}
 
-// WithModuleArg adds a ModuleArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithModuleArg(check *KernelModuleChecker) *KprobeArgumentChecker {
-	checker.ModuleArg = check
-	return checker
-}
+	numDesired := len(checker.Values)
+	numMatched := 0
 
-// WithKernelCapTArg adds a KernelCapTArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithKernelCapTArg(check *stringmatcher.StringMatcher) *KprobeArgumentChecker {
-	checker.KernelCapTArg = check
-	return checker
-}
+nextCheck:
+	for _, check := range checker.Values {
+		for _, value := range values {
+			if err := innerCheck(check, value); err == nil {
+				numMatched += 1
+				continue nextCheck
+			}
+		}
+	}
 
-// WithCapInheritableArg adds a CapInheritableArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithCapInheritableArg(check *stringmatcher.StringMatcher) *KprobeArgumentChecker {
-	checker.CapInheritableArg = check
-	return checker
-}
+	if numMatched < numDesired {
+		return fmt.Errorf("TaintedBitsTypeListMatcher: Check failed, only matched %d elements but wanted %d", numMatched, numDesired)
+	}
 
-// WithCapPermittedArg adds a CapPermittedArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithCapPermittedArg(check *stringmatcher.StringMatcher) *KprobeArgumentChecker {
-	checker.CapPermittedArg = check
-	return checker
+	return nil
 }
 
-// WithCapEffectiveArg adds a CapEffectiveArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithCapEffectiveArg(check *stringmatcher.StringMatcher) *KprobeArgumentChecker {
-	checker.CapEffectiveArg = check
-	return checker
+// DnsAnswerChecker implements a checker struct to check a DnsAnswer field
+type DnsAnswerChecker struct {
+	Name *stringmatcher.StringMatcher `json:"name,omitempty"`
+	Type *stringmatcher.StringMatcher `json:"type,omitempty"`
+	Ttl  *uint32                      `json:"ttl,omitempty"`
+	Data *stringmatcher.StringMatcher `json:"data,omitempty"`
 }
 
-// WithLinuxBinprmArg adds a LinuxBinprmArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithLinuxBinprmArg(check *KprobeLinuxBinprmChecker) *KprobeArgumentChecker {
-	checker.LinuxBinprmArg = check
-	return checker
+// NewDnsAnswerChecker creates a new DnsAnswerChecker
+func NewDnsAnswerChecker() *DnsAnswerChecker {
+	return &DnsAnswerChecker{}
 }
 
-// WithNetDevArg adds a NetDevArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithNetDevArg(check *KprobeNetDevChecker) *KprobeArgumentChecker {
-	checker.NetDevArg = check
-	return checker
+// Get the type of the checker as a string
+func (checker *DnsAnswerChecker) GetCheckerType() string {
+	return "DnsAnswerChecker"
 }
 
-// WithBpfCmdArg adds a BpfCmdArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithBpfCmdArg(check tetragon.BpfCmd) *KprobeArgumentChecker {
-	wrappedCheck := BpfCmdChecker(check)
-	checker.BpfCmdArg = &wrappedCheck
-	return checker
-}
+// Check checks a DnsAnswer field
+func (checker *DnsAnswerChecker) Check(event *tetragon.DnsAnswer) error {
+	if event == nil {
+		return fmt.Errorf("%s: DnsAnswer field is nil", CheckerLogPrefix(checker))
+	}
 
-// WithSyscallId adds a SyscallId check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithSyscallId(check *SyscallIdChecker) *KprobeArgumentChecker {
-	checker.SyscallId = check
-	return checker
+	fieldChecks := func() error {
+		if checker.Name != nil {
+			if err := checker.Name.Match(event.Name); err != nil {
+				return fmt.Errorf("Name check failed: %w", err)
+			}
+		}
+		if checker.Type != nil {
+			if err := checker.Type.Match(event.Type); err != nil {
+				return fmt.Errorf("Type check failed: %w", err)
+			}
+		}
+		if checker.Ttl != nil {
+			if *checker.Ttl != event.Ttl {
+				return fmt.Errorf("Ttl has value %d which does not match expected value %d", event.Ttl, *checker.Ttl)
+			}
+		}
+		if checker.Data != nil {
+			if err := checker.Data.Match(event.Data); err != nil {
+				return fmt.Errorf("Data check failed: %w", err)
+			}
+		}
+		return nil
+	}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
+	}
+	return nil
 }
 
-// WithSockaddrArg adds a SockaddrArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithSockaddrArg(check *KprobeSockaddrChecker) *KprobeArgumentChecker {
-	checker.SockaddrArg = check
+// WithName adds a Name check to the DnsAnswerChecker
+func (checker *DnsAnswerChecker) WithName(check *stringmatcher.StringMatcher) *DnsAnswerChecker {
+	checker.Name = check
 	return checker
 }
 
-// WithBpfProgArg adds a BpfProgArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithBpfProgArg(check *KprobeBpfProgChecker) *KprobeArgumentChecker {
-	checker.BpfProgArg = check
+// WithType adds a Type check to the DnsAnswerChecker
+func (checker *DnsAnswerChecker) WithType(check *stringmatcher.StringMatcher) *DnsAnswerChecker {
+	checker.Type = check
 	return checker
 }
 
-// WithErrorArg adds a ErrorArg check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithErrorArg(check *KprobeErrorChecker) *KprobeArgumentChecker {
-	checker.ErrorArg = check
+// WithTtl adds a Ttl check to the DnsAnswerChecker
+func (checker *DnsAnswerChecker) WithTtl(check uint32) *DnsAnswerChecker {
+	checker.Ttl = &check
 	return checker
 }
 
-// WithLabel adds a Label check to the KprobeArgumentChecker
-func (checker *KprobeArgumentChecker) WithLabel(check *stringmatcher.StringMatcher) *KprobeArgumentChecker {
-	checker.Label = check
+// WithData adds a Data check to the DnsAnswerChecker
+func (checker *DnsAnswerChecker) WithData(check *stringmatcher.StringMatcher) *DnsAnswerChecker {
+	checker.Data = check
 	return checker
 }
 
-//FromKprobeArgument populates the KprobeArgumentChecker using data from a KprobeArgument field
-func (checker *KprobeArgumentChecker) FromKprobeArgument(event *tetragon.KprobeArgument) *KprobeArgumentChecker {
+//FromDnsAnswer populates the DnsAnswerChecker using data from a DnsAnswer field
+func (checker *DnsAnswerChecker) FromDnsAnswer(event *tetragon.DnsAnswer) *DnsAnswerChecker {
 	if event == nil {
 		return checker
 	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_StringArg:
-		checker.StringArg = stringmatcher.Full(event.StringArg)
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_IntArg:
-		{
-			val := event.IntArg
-			checker.IntArg = &val
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_SkbArg:
-		if event.SkbArg != nil {
-			checker.SkbArg = NewKprobeSkbChecker().FromKprobeSkb(event.SkbArg)
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_SizeArg:
-		{
-			val := event.SizeArg
-			checker.SizeArg = &val
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_BytesArg:
-		checker.BytesArg = bytesmatcher.Full(event.BytesArg)
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_PathArg:
-		if event.PathArg != nil {
-			checker.PathArg = NewKprobePathChecker().FromKprobePath(event.PathArg)
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_FileArg:
-		if event.FileArg != nil {
-			checker.FileArg = NewKprobeFileChecker().FromKprobeFile(event.FileArg)
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_TruncatedBytesArg:
-		if event.TruncatedBytesArg != nil {
-			checker.TruncatedBytesArg = NewKprobeTruncatedBytesChecker().FromKprobeTruncatedBytes(event.TruncatedBytesArg)
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_SockArg:
-		if event.SockArg != nil {
-			checker.SockArg = NewKprobeSockChecker().FromKprobeSock(event.SockArg)
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_CredArg:
-		if event.CredArg != nil {
-			checker.CredArg = NewKprobeCredChecker().FromKprobeCred(event.CredArg)
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_LongArg:
-		{
-			val := event.LongArg
-			checker.LongArg = &val
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_BpfAttrArg:
-		if event.BpfAttrArg != nil {
-			checker.BpfAttrArg = NewKprobeBpfAttrChecker().FromKprobeBpfAttr(event.BpfAttrArg)
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_PerfEventArg:
-		if event.PerfEventArg != nil {
-			checker.PerfEventArg = NewKprobePerfEventChecker().FromKprobePerfEvent(event.PerfEventArg)
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_BpfMapArg:
-		if event.BpfMapArg != nil {
-			checker.BpfMapArg = NewKprobeBpfMapChecker().FromKprobeBpfMap(event.BpfMapArg)
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_UintArg:
-		{
-			val := event.UintArg
-			checker.UintArg = &val
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_UserNamespaceArg:
-		if event.UserNamespaceArg != nil {
-			checker.UserNamespaceArg = NewKprobeUserNamespaceChecker().FromKprobeUserNamespace(event.UserNamespaceArg)
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_CapabilityArg:
-		if event.CapabilityArg != nil {
-			checker.CapabilityArg = NewKprobeCapabilityChecker().FromKprobeCapability(event.CapabilityArg)
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_ProcessCredentialsArg:
-		if event.ProcessCredentialsArg != nil {
-			checker.ProcessCredentialsArg = NewProcessCredentialsChecker().FromProcessCredentials(event.ProcessCredentialsArg)
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_UserNsArg:
-		if event.UserNsArg != nil {
-			checker.UserNsArg = NewUserNamespaceChecker().FromUserNamespace(event.UserNsArg)
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_ModuleArg:
-		if event.ModuleArg != nil {
-			checker.ModuleArg = NewKernelModuleChecker().FromKernelModule(event.ModuleArg)
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_KernelCapTArg:
-		checker.KernelCapTArg = stringmatcher.Full(event.KernelCapTArg)
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_CapInheritableArg:
-		checker.CapInheritableArg = stringmatcher.Full(event.CapInheritableArg)
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_CapPermittedArg:
-		checker.CapPermittedArg = stringmatcher.Full(event.CapPermittedArg)
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_CapEffectiveArg:
-		checker.CapEffectiveArg = stringmatcher.Full(event.CapEffectiveArg)
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_LinuxBinprmArg:
-		if event.LinuxBinprmArg != nil {
-			checker.LinuxBinprmArg = NewKprobeLinuxBinprmChecker().FromKprobeLinuxBinprm(event.LinuxBinprmArg)
-		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_NetDevArg:
-		if event.NetDevArg != nil {
-			checker.NetDevArg = NewKprobeNetDevChecker().FromKprobeNetDev(event.NetDevArg)
-		}
+	checker.Name = stringmatcher.Full(event.Name)
+	checker.Type = stringmatcher.Full(event.Type)
+	{
+		val := event.Ttl
+		checker.Ttl = &val
 	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_BpfCmdArg:
-		checker.BpfCmdArg = NewBpfCmdChecker(event.BpfCmdArg)
+	checker.Data = stringmatcher.Full(event.Data)
+	return checker
+}
+
+// HttpHeaderChecker implements a checker struct to check a HttpHeader field
+type HttpHeaderChecker struct {
+	Name  *stringmatcher.StringMatcher `json:"name,omitempty"`
+	Value *stringmatcher.StringMatcher `json:"value,omitempty"`
+}
+
+// NewHttpHeaderChecker creates a new HttpHeaderChecker
+func NewHttpHeaderChecker() *HttpHeaderChecker {
+	return &HttpHeaderChecker{}
+}
+
+// Get the type of the checker as a string
+func (checker *HttpHeaderChecker) GetCheckerType() string {
+	return "HttpHeaderChecker"
+}
+
+// Check checks a HttpHeader field
+func (checker *HttpHeaderChecker) Check(event *tetragon.HttpHeader) error {
+	if event == nil {
+		return fmt.Errorf("%s: HttpHeader field is nil", CheckerLogPrefix(checker))
 	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_SyscallId:
-		if event.SyscallId != nil {
-			checker.SyscallId = NewSyscallIdChecker().FromSyscallId(event.SyscallId)
+
+	fieldChecks := func() error {
+		if checker.Name != nil {
+			if err := checker.Name.Match(event.Name); err != nil {
+				return fmt.Errorf("Name check failed: %w", err)
+			}
 		}
-	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_SockaddrArg:
-		if event.SockaddrArg != nil {
-			checker.SockaddrArg = NewKprobeSockaddrChecker().FromKprobeSockaddr(event.SockaddrArg)
+		if checker.Value != nil {
+			if err := checker.Value.Match(event.Value); err != nil {
+				return fmt.Errorf("Value check failed: %w", err)
+			}
 		}
+		return nil
 	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_BpfProgArg:
-		if event.BpfProgArg != nil {
-			checker.BpfProgArg = NewKprobeBpfProgChecker().FromKprobeBpfProg(event.BpfProgArg)
-		}
+	if err := fieldChecks(); err != nil {
+		return fmt.Errorf("%s: %w", CheckerLogPrefix(checker), err)
 	}
-	switch event := event.Arg.(type) {
-	case *tetragon.KprobeArgument_ErrorArg:
-		if event.ErrorArg != nil {
-			checker.ErrorArg = NewKprobeErrorChecker().FromKprobeError(event.ErrorArg)
-		}
+	return nil
+}
+
+// WithName adds a Name check to the HttpHeaderChecker
+func (checker *HttpHeaderChecker) WithName(check *stringmatcher.StringMatcher) *HttpHeaderChecker {
+	checker.Name = check
+	return checker
+}
+
+// WithValue adds a Value check to the HttpHeaderChecker
+func (checker *HttpHeaderChecker) WithValue(check *stringmatcher.StringMatcher) *HttpHeaderChecker {
+	checker.Value = check
+	return checker
+}
+
+//FromHttpHeader populates the HttpHeaderChecker using data from a HttpHeader field
+func (checker *HttpHeaderChecker) FromHttpHeader(event *tetragon.HttpHeader) *HttpHeaderChecker {
+	if event == nil {
+		return checker
 	}
-	checker.Label = stringmatcher.Full(event.Label)
+	checker.Name = stringmatcher.Full(event.Name)
+	checker.Value = stringmatcher.Full(event.Value)
 	return checker
 }
 
-// KernelModuleChecker implements a checker struct to check a KernelModule field
-type KernelModuleChecker struct {
-	Name        *stringmatcher.StringMatcher `json:"name,omitempty"`
-	SignatureOk *bool                        `json:"signatureOk,omitempty"`
-	Tainted     *TaintedBitsTypeListMatcher  `json:"tainted,omitempty"`
+// HttpMessageChecker implements a checker struct to check a HttpMessage field
+type HttpMessageChecker struct {
+	Method     *stringmatcher.StringMatcher `json:"method,omitempty"`
+	Path       *stringmatcher.StringMatcher `json:"path,omitempty"`
+	StatusCode *uint32                      `json:"statusCode,omitempty"`
+	Reason     *stringmatcher.StringMatcher `json:"reason,omitempty"`
+	Version    *stringmatcher.StringMatcher `json:"version,omitempty"`
+	Headers    *HttpHeaderListMatcher       `json:"headers,omitempty"`
 }
 
-// NewKernelModuleChecker creates a new KernelModuleChecker
-func NewKernelModuleChecker() *KernelModuleChecker {
-	return &KernelModuleChecker{}
+// NewHttpMessageChecker creates a new HttpMessageChecker
+func NewHttpMessageChecker() *HttpMessageChecker {
+	return &HttpMessageChecker{}
 }
 
 // Get the type of the checker as a string
-func (checker *KernelModuleChecker) GetCheckerType() string {
-	return "KernelModuleChecker"
+func (checker *HttpMessageChecker) GetCheckerType() string {
+	return "HttpMessageChecker"
 }
 
-// Check checks a KernelModule field
-func (checker *KernelModuleChecker) Check(event *tetragon.KernelModule) error {
+// Check checks a HttpMessage field
+func (checker *HttpMessageChecker) Check(event *tetragon.HttpMessage) error {
 	if event == nil {
-		return fmt.Errorf("%s: KernelModule field is nil", CheckerLogPrefix(checker))
+		return fmt.Errorf("%s: HttpMessage field is nil", CheckerLogPrefix(checker))
 	}
 
 	fieldChecks := func() error {
-		if checker.Name != nil {
-			if err := checker.Name.Match(event.Name); err != nil {
-				return fmt.Errorf("Name check failed: %w", err)
+		if checker.Method != nil {
+			if err := checker.Method.Match(event.Method); err != nil {
+				return fmt.Errorf("Method check failed: %w", err)
 			}
 		}
-		if checker.SignatureOk != nil {
-			if event.SignatureOk == nil {
-				return fmt.Errorf("SignatureOk is nil and does not match expected value %v", *checker.SignatureOk)
+		if checker.Path != nil {
+			if err := checker.Path.Match(event.Path); err != nil {
+				return fmt.Errorf("Path check failed: %w", err)
 			}
-			if *checker.SignatureOk != event.SignatureOk.Value {
-				return fmt.Errorf("SignatureOk has value %v which does not match expected value %v", event.SignatureOk.Value, *checker.SignatureOk)
+		}
+		if checker.StatusCode != nil {
+			if *checker.StatusCode != event.StatusCode {
+				return fmt.Errorf("StatusCode has value %d which does not match expected value %d", event.StatusCode, *checker.StatusCode)
 			}
 		}
-		if checker.Tainted != nil {
-			if err := checker.Tainted.Check(event.Tainted); err != nil {
-				return fmt.Errorf("Tainted check failed: %w", err)
+		if checker.Reason != nil {
+			if err := checker.Reason.Match(event.Reason); err != nil {
+				return fmt.Errorf("Reason check failed: %w", err)
+			}
+		}
+		if checker.Version != nil {
+			if err := checker.Version.Match(event.Version); err != nil {
+				return fmt.Errorf("Version check failed: %w", err)
+			}
+		}
+		if checker.Headers != nil {
+			if err := checker.Headers.Check(event.Headers); err != nil {
+				return fmt.Errorf("Headers check failed: %w", err)
 			}
 		}
 		return nil
@@ -7508,75 +8795,98 @@ func (checker *KernelModuleChecker) Check(event *tetragon.KernelModule) error {
 	return nil
 }
 
-// WithName adds a Name check to the KernelModuleChecker
-func (checker *KernelModuleChecker) WithName(check *stringmatcher.StringMatcher) *KernelModuleChecker {
-	checker.Name = check
+// WithMethod adds a Method check to the HttpMessageChecker
+func (checker *HttpMessageChecker) WithMethod(check *stringmatcher.StringMatcher) *HttpMessageChecker {
+	checker.Method = check
 	return checker
 }
 
-// WithSignatureOk adds a SignatureOk check to the KernelModuleChecker
-func (checker *KernelModuleChecker) WithSignatureOk(check bool) *KernelModuleChecker {
-	checker.SignatureOk = &check
+// WithPath adds a Path check to the HttpMessageChecker
+func (checker *HttpMessageChecker) WithPath(check *stringmatcher.StringMatcher) *HttpMessageChecker {
+	checker.Path = check
 	return checker
 }
 
-// WithTainted adds a Tainted check to the KernelModuleChecker
-func (checker *KernelModuleChecker) WithTainted(check *TaintedBitsTypeListMatcher) *KernelModuleChecker {
-	checker.Tainted = check
+// WithStatusCode adds a StatusCode check to the HttpMessageChecker
+func (checker *HttpMessageChecker) WithStatusCode(check uint32) *HttpMessageChecker {
+	checker.StatusCode = &check
 	return checker
 }
 
-//FromKernelModule populates the KernelModuleChecker using data from a KernelModule field
-func (checker *KernelModuleChecker) FromKernelModule(event *tetragon.KernelModule) *KernelModuleChecker {
+// WithReason adds a Reason check to the HttpMessageChecker
+func (checker *HttpMessageChecker) WithReason(check *stringmatcher.StringMatcher) *HttpMessageChecker {
+	checker.Reason = check
+	return checker
+}
+
+// WithVersion adds a Version check to the HttpMessageChecker
+func (checker *HttpMessageChecker) WithVersion(check *stringmatcher.StringMatcher) *HttpMessageChecker {
+	checker.Version = check
+	return checker
+}
+
+// WithHeaders adds a Headers check to the HttpMessageChecker
+func (checker *HttpMessageChecker) WithHeaders(check *HttpHeaderListMatcher) *HttpMessageChecker {
+	checker.Headers = check
+	return checker
+}
+
+//FromHttpMessage populates the HttpMessageChecker using data from a HttpMessage field
+func (checker *HttpMessageChecker) FromHttpMessage(event *tetragon.HttpMessage) *HttpMessageChecker {
 	if event == nil {
 		return checker
 	}
-	checker.Name = stringmatcher.Full(event.Name)
-	if event.SignatureOk != nil {
-		val := event.SignatureOk.Value
-		checker.SignatureOk = &val
+	checker.Method = stringmatcher.Full(event.Method)
+	checker.Path = stringmatcher.Full(event.Path)
+	{
+		val := event.StatusCode
+		checker.StatusCode = &val
 	}
+	checker.Reason = stringmatcher.Full(event.Reason)
+	checker.Version = stringmatcher.Full(event.Version)
 	{
-		var checks []*TaintedBitsTypeChecker
-		for _, check := range event.Tainted {
-			var convertedCheck *TaintedBitsTypeChecker
-			convertedCheck = NewTaintedBitsTypeChecker(check)
+		var checks []*HttpHeaderChecker
+		for _, check := range event.Headers {
+			var convertedCheck *HttpHeaderChecker
+			if check != nil {
+				convertedCheck = NewHttpHeaderChecker().FromHttpHeader(check)
+			}
 			checks = append(checks, convertedCheck)
 		}
-		lm := NewTaintedBitsTypeListMatcher().WithOperator(listmatcher.Ordered).
+		lm := NewHttpHeaderListMatcher().WithOperator(listmatcher.Ordered).
 			WithValues(checks...)
-		checker.Tainted = lm
+		checker.Headers = lm
 	}
 	return checker
 }
 
-// TaintedBitsTypeListMatcher checks a list of tetragon.TaintedBitsType fields
-type TaintedBitsTypeListMatcher struct {
-	Operator listmatcher.Operator      `json:"operator"`
-	Values   []*TaintedBitsTypeChecker `json:"values"`
+// HttpHeaderListMatcher checks a list of *tetragon.HttpHeader fields
+type HttpHeaderListMatcher struct {
+	Operator listmatcher.Operator `json:"operator"`
+	Values   []*HttpHeaderChecker `json:"values"`
 }
 
-// NewTaintedBitsTypeListMatcher creates a new TaintedBitsTypeListMatcher. The checker defaults to a subset checker unless otherwise specified using WithOperator()
-func NewTaintedBitsTypeListMatcher() *TaintedBitsTypeListMatcher {
-	return &TaintedBitsTypeListMatcher{
+// NewHttpHeaderListMatcher creates a new HttpHeaderListMatcher. The checker defaults to a subset checker unless otherwise specified using WithOperator()
+func NewHttpHeaderListMatcher() *HttpHeaderListMatcher {
+	return &HttpHeaderListMatcher{
 		Operator: listmatcher.Subset,
 	}
 }
 
-// WithOperator sets the match kind for the TaintedBitsTypeListMatcher
-func (checker *TaintedBitsTypeListMatcher) WithOperator(operator listmatcher.Operator) *TaintedBitsTypeListMatcher {
+// WithOperator sets the match kind for the HttpHeaderListMatcher
+func (checker *HttpHeaderListMatcher) WithOperator(operator listmatcher.Operator) *HttpHeaderListMatcher {
 	checker.Operator = operator
 	return checker
 }
 
-// WithValues sets the checkers that the TaintedBitsTypeListMatcher should use
-func (checker *TaintedBitsTypeListMatcher) WithValues(values ...*TaintedBitsTypeChecker) *TaintedBitsTypeListMatcher {
+// WithValues sets the checkers that the HttpHeaderListMatcher should use
+func (checker *HttpHeaderListMatcher) WithValues(values ...*HttpHeaderChecker) *HttpHeaderListMatcher {
 	checker.Values = values
 	return checker
 }
 
-// Check checks a list of tetragon.TaintedBitsType fields
-func (checker *TaintedBitsTypeListMatcher) Check(values []tetragon.TaintedBitsType) error {
+// Check checks a list of *tetragon.HttpHeader fields
+func (checker *HttpHeaderListMatcher) Check(values []*tetragon.HttpHeader) error {
 	switch checker.Operator {
 	case listmatcher.Ordered:
 		return checker.orderedCheck(values)
@@ -7589,43 +8899,43 @@ func (checker *TaintedBitsTypeListMatcher) Check(values []tetragon.TaintedBitsTy
 	}
 }
 
-// orderedCheck checks a list of ordered tetragon.TaintedBitsType fields
-func (checker *TaintedBitsTypeListMatcher) orderedCheck(values []tetragon.TaintedBitsType) error {
-	innerCheck := func(check *TaintedBitsTypeChecker, value tetragon.TaintedBitsType) error {
-		if err := check.Check(&value); err != nil {
-			return fmt.Errorf("Tainted check failed: %w", err)
+// orderedCheck checks a list of ordered *tetragon.HttpHeader fields
+func (checker *HttpHeaderListMatcher) orderedCheck(values []*tetragon.HttpHeader) error {
+	innerCheck := func(check *HttpHeaderChecker, value *tetragon.HttpHeader) error {
+		if err := check.Check(value); err != nil {
+			return fmt.Errorf("Headers check failed: %w", err)
 		}
 		return nil
 	}
 
 	if len(checker.Values) != len(values) {
-		return fmt.Errorf("TaintedBitsTypeListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+		return fmt.Errorf("HttpHeaderListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
 	}
 
 	for i, check := range checker.Values {
 		value := values[i]
 		if err := innerCheck(check, value); err != nil {
-			return fmt.Errorf("TaintedBitsTypeListMatcher: Check failed on element %d: %w", i, err)
+			return fmt.Errorf("HttpHeaderListMatcher: Check failed on element %d: %w", i, err)
 		}
 	}
 
 	return nil
 }
 
-// unorderedCheck checks a list of unordered tetragon.TaintedBitsType fields
-func (checker *TaintedBitsTypeListMatcher) unorderedCheck(values []tetragon.TaintedBitsType) error {
+// unorderedCheck checks a list of unordered *tetragon.HttpHeader fields
+func (checker *HttpHeaderListMatcher) unorderedCheck(values []*tetragon.HttpHeader) error {
 	if len(checker.Values) != len(values) {
-		return fmt.Errorf("TaintedBitsTypeListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
+		return fmt.Errorf("HttpHeaderListMatcher: Wanted %d elements, got %d", len(checker.Values), len(values))
 	}
 
 	return checker.subsetCheck(values)
 }
 
-// subsetCheck checks a subset of tetragon.TaintedBitsType fields
-func (checker *TaintedBitsTypeListMatcher) subsetCheck(values []tetragon.TaintedBitsType) error {
-	innerCheck := func(check *TaintedBitsTypeChecker, value tetragon.TaintedBitsType) error {
-		if err := check.Check(&value); err != nil {
-			return fmt.Errorf("Tainted check failed: %w", err)
+// subsetCheck checks a subset of *tetragon.HttpHeader fields
+func (checker *HttpHeaderListMatcher) subsetCheck(values []*tetragon.HttpHeader) error {
+	innerCheck := func(check *HttpHeaderChecker, value *tetragon.HttpHeader) error {
+		if err := check.Check(value); err != nil {
+			return fmt.Errorf("Headers check failed: %w", err)
 		}
 		return nil
 	}
@@ -7644,7 +8954,7 @@ nextCheck:
 	}
 
 	if numMatched < numDesired {
-		return fmt.Errorf("TaintedBitsTypeListMatcher: Check failed, only matched %d elements but wanted %d", numMatched, numDesired)
+		return fmt.Errorf("HttpHeaderListMatcher: Check failed, only matched %d elements but wanted %d", numMatched, numDesired)
 	}
 
 	return nil
@@ -8057,6 +9367,162 @@ func (enum *TaintedBitsTypeChecker) Check(val *tetragon.TaintedBitsType) error {
 	return nil
 }
 
+// FlowDirectionChecker checks a tetragon.FlowDirection
+type FlowDirectionChecker tetragon.FlowDirection
+
+// MarshalJSON implements json.Marshaler interface
+func (enum FlowDirectionChecker) MarshalJSON() ([]byte, error) {
+	if name, ok := tetragon.FlowDirection_name[int32(enum)]; ok {
+		name = strings.TrimPrefix(name, "FLOW_DIRECTION_")
+		return json.Marshal(name)
+	}
+
+	return nil, fmt.Errorf("Unknown FlowDirection %d", enum)
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (enum *FlowDirectionChecker) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := yaml.UnmarshalStrict(b, &str); err != nil {
+		return err
+	}
+
+	// Convert to uppercase if not already
+	str = strings.ToUpper(str)
+
+	// Look up the value from the enum values map
+	if n, ok := tetragon.FlowDirection_value[str]; ok {
+		*enum = FlowDirectionChecker(n)
+	} else if n, ok := tetragon.FlowDirection_value["FLOW_DIRECTION_"+str]; ok {
+		*enum = FlowDirectionChecker(n)
+	} else {
+		return fmt.Errorf("Unknown FlowDirection %s", str)
+	}
+
+	return nil
+}
+
+// NewFlowDirectionChecker creates a new FlowDirectionChecker
+func NewFlowDirectionChecker(val tetragon.FlowDirection) *FlowDirectionChecker {
+	enum := FlowDirectionChecker(val)
+	return &enum
+}
+
+// Check checks a FlowDirection against the checker
+func (enum *FlowDirectionChecker) Check(val *tetragon.FlowDirection) error {
+	if val == nil {
+		return fmt.Errorf("FlowDirectionChecker: FlowDirection is nil and does not match expected value %s", tetragon.FlowDirection(*enum))
+	}
+	if *enum != FlowDirectionChecker(*val) {
+		return fmt.Errorf("FlowDirectionChecker: FlowDirection has value %s which does not match expected value %s", (*val), tetragon.FlowDirection(*enum))
+	}
+	return nil
+}
+
+// TlsLibraryChecker checks a tetragon.TlsLibrary
+type TlsLibraryChecker tetragon.TlsLibrary
+
+// MarshalJSON implements json.Marshaler interface
+func (enum TlsLibraryChecker) MarshalJSON() ([]byte, error) {
+	if name, ok := tetragon.TlsLibrary_name[int32(enum)]; ok {
+		name = strings.TrimPrefix(name, "TLS_LIBRARY_")
+		return json.Marshal(name)
+	}
+
+	return nil, fmt.Errorf("Unknown TlsLibrary %d", enum)
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (enum *TlsLibraryChecker) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := yaml.UnmarshalStrict(b, &str); err != nil {
+		return err
+	}
+
+	// Convert to uppercase if not already
+	str = strings.ToUpper(str)
+
+	// Look up the value from the enum values map
+	if n, ok := tetragon.TlsLibrary_value[str]; ok {
+		*enum = TlsLibraryChecker(n)
+	} else if n, ok := tetragon.TlsLibrary_value["TLS_LIBRARY_"+str]; ok {
+		*enum = TlsLibraryChecker(n)
+	} else {
+		return fmt.Errorf("Unknown TlsLibrary %s", str)
+	}
+
+	return nil
+}
+
+// NewTlsLibraryChecker creates a new TlsLibraryChecker
+func NewTlsLibraryChecker(val tetragon.TlsLibrary) *TlsLibraryChecker {
+	enum := TlsLibraryChecker(val)
+	return &enum
+}
+
+// Check checks a TlsLibrary against the checker
+func (enum *TlsLibraryChecker) Check(val *tetragon.TlsLibrary) error {
+	if val == nil {
+		return fmt.Errorf("TlsLibraryChecker: TlsLibrary is nil and does not match expected value %s", tetragon.TlsLibrary(*enum))
+	}
+	if *enum != TlsLibraryChecker(*val) {
+		return fmt.Errorf("TlsLibraryChecker: TlsLibrary has value %s which does not match expected value %s", (*val), tetragon.TlsLibrary(*enum))
+	}
+	return nil
+}
+
+// TlsDirectionChecker checks a tetragon.TlsDirection
+type TlsDirectionChecker tetragon.TlsDirection
+
+// MarshalJSON implements json.Marshaler interface
+func (enum TlsDirectionChecker) MarshalJSON() ([]byte, error) {
+	if name, ok := tetragon.TlsDirection_name[int32(enum)]; ok {
+		name = strings.TrimPrefix(name, "TLS_DIRECTION_")
+		return json.Marshal(name)
+	}
+
+	return nil, fmt.Errorf("Unknown TlsDirection %d", enum)
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface
+func (enum *TlsDirectionChecker) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := yaml.UnmarshalStrict(b, &str); err != nil {
+		return err
+	}
+
+	// Convert to uppercase if not already
+	str = strings.ToUpper(str)
+
+	// Look up the value from the enum values map
+	if n, ok := tetragon.TlsDirection_value[str]; ok {
+		*enum = TlsDirectionChecker(n)
+	} else if n, ok := tetragon.TlsDirection_value["TLS_DIRECTION_"+str]; ok {
+		*enum = TlsDirectionChecker(n)
+	} else {
+		return fmt.Errorf("Unknown TlsDirection %s", str)
+	}
+
+	return nil
+}
+
+// NewTlsDirectionChecker creates a new TlsDirectionChecker
+func NewTlsDirectionChecker(val tetragon.TlsDirection) *TlsDirectionChecker {
+	enum := TlsDirectionChecker(val)
+	return &enum
+}
+
+// Check checks a TlsDirection against the checker
+func (enum *TlsDirectionChecker) Check(val *tetragon.TlsDirection) error {
+	if val == nil {
+		return fmt.Errorf("TlsDirectionChecker: TlsDirection is nil and does not match expected value %s", tetragon.TlsDirection(*enum))
+	}
+	if *enum != TlsDirectionChecker(*val) {
+		return fmt.Errorf("TlsDirectionChecker: TlsDirection has value %s which does not match expected value %s", (*val), tetragon.TlsDirection(*enum))
+	}
+	return nil
+}
+
 // ThrottleTypeChecker checks a tetragon.ThrottleType
 type ThrottleTypeChecker tetragon.ThrottleType
 
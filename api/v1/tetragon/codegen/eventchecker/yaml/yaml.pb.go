@@ -140,17 +140,20 @@ func (conf *EventCheckerConf) WriteYamlFile(file string) error {
 }
 
 type eventCheckerHelper struct {
-	ProcessExec       *eventchecker.ProcessExecChecker       `json:"exec,omitempty"`
-	ProcessExit       *eventchecker.ProcessExitChecker       `json:"exit,omitempty"`
-	ProcessKprobe     *eventchecker.ProcessKprobeChecker     `json:"kprobe,omitempty"`
-	ProcessTracepoint *eventchecker.ProcessTracepointChecker `json:"tracepoint,omitempty"`
-	ProcessUprobe     *eventchecker.ProcessUprobeChecker     `json:"uprobe,omitempty"`
-	ProcessUsdt       *eventchecker.ProcessUsdtChecker       `json:"usdt,omitempty"`
-	ProcessLsm        *eventchecker.ProcessLsmChecker        `json:"lsm,omitempty"`
-	Test              *eventchecker.TestChecker              `json:"test,omitempty"`
-	ProcessLoader     *eventchecker.ProcessLoaderChecker     `json:"loader,omitempty"`
-	RateLimitInfo     *eventchecker.RateLimitInfoChecker     `json:"rateLimitInfo,omitempty"`
-	ProcessThrottle   *eventchecker.ProcessThrottleChecker   `json:"throttle,omitempty"`
+	ProcessExec        *eventchecker.ProcessExecChecker        `json:"exec,omitempty"`
+	ProcessExit        *eventchecker.ProcessExitChecker        `json:"exit,omitempty"`
+	ProcessKprobe      *eventchecker.ProcessKprobeChecker      `json:"kprobe,omitempty"`
+	ProcessTracepoint  *eventchecker.ProcessTracepointChecker  `json:"tracepoint,omitempty"`
+	ProcessUprobe      *eventchecker.ProcessUprobeChecker      `json:"uprobe,omitempty"`
+	ProcessUsdt        *eventchecker.ProcessUsdtChecker        `json:"usdt,omitempty"`
+	ProcessLsm         *eventchecker.ProcessLsmChecker         `json:"lsm,omitempty"`
+	Test               *eventchecker.TestChecker               `json:"test,omitempty"`
+	ProcessLoader      *eventchecker.ProcessLoaderChecker      `json:"loader,omitempty"`
+	ProcessNetworkFlow *eventchecker.ProcessNetworkFlowChecker `json:"networkFlow,omitempty"`
+	ProcessDns         *eventchecker.ProcessDnsChecker         `json:"dns,omitempty"`
+	ProcessTls         *eventchecker.ProcessTlsChecker         `json:"tls,omitempty"`
+	RateLimitInfo      *eventchecker.RateLimitInfoChecker      `json:"rateLimitInfo,omitempty"`
+	ProcessThrottle    *eventchecker.ProcessThrottleChecker    `json:"throttle,omitempty"`
 }
 
 // EventChecker is a wrapper around the EventChecker interface to help unmarshaling
@@ -219,6 +222,24 @@ func (checker *EventChecker) UnmarshalJSON(b []byte) error {
 		}
 		eventChecker = helper.ProcessLoader
 	}
+	if helper.ProcessNetworkFlow != nil {
+		if eventChecker != nil {
+			return fmt.Errorf("EventChecker: cannot define more than one checker, got %T but already had %T", helper.ProcessNetworkFlow, eventChecker)
+		}
+		eventChecker = helper.ProcessNetworkFlow
+	}
+	if helper.ProcessDns != nil {
+		if eventChecker != nil {
+			return fmt.Errorf("EventChecker: cannot define more than one checker, got %T but already had %T", helper.ProcessDns, eventChecker)
+		}
+		eventChecker = helper.ProcessDns
+	}
+	if helper.ProcessTls != nil {
+		if eventChecker != nil {
+			return fmt.Errorf("EventChecker: cannot define more than one checker, got %T but already had %T", helper.ProcessTls, eventChecker)
+		}
+		eventChecker = helper.ProcessTls
+	}
 	if helper.RateLimitInfo != nil {
 		if eventChecker != nil {
 			return fmt.Errorf("EventChecker: cannot define more than one checker, got %T but already had %T", helper.RateLimitInfo, eventChecker)
@@ -257,6 +278,12 @@ func (checker EventChecker) MarshalJSON() ([]byte, error) {
 		helper.Test = c
 	case *eventchecker.ProcessLoaderChecker:
 		helper.ProcessLoader = c
+	case *eventchecker.ProcessNetworkFlowChecker:
+		helper.ProcessNetworkFlow = c
+	case *eventchecker.ProcessDnsChecker:
+		helper.ProcessDns = c
+	case *eventchecker.ProcessTlsChecker:
+		helper.ProcessTls = c
 	case *eventchecker.RateLimitInfoChecker:
 		helper.RateLimitInfo = c
 	case *eventchecker.ProcessThrottleChecker:
@@ -41,6 +41,12 @@ func ResponseTypeString(response *tetragon.GetEventsResponse) (string, error) {
 		return tetragon.EventType_PROCESS_LSM.String(), nil
 	case *tetragon.GetEventsResponse_ProcessUsdt:
 		return tetragon.EventType_PROCESS_USDT.String(), nil
+	case *tetragon.GetEventsResponse_ProcessNetworkFlow:
+		return tetragon.EventType_PROCESS_NETWORK_FLOW.String(), nil
+	case *tetragon.GetEventsResponse_ProcessDns:
+		return tetragon.EventType_PROCESS_DNS.String(), nil
+	case *tetragon.GetEventsResponse_ProcessTls:
+		return tetragon.EventType_PROCESS_TLS.String(), nil
 	case *tetragon.GetEventsResponse_Test:
 		return tetragon.EventType_TEST.String(), nil
 	case *tetragon.GetEventsResponse_RateLimitInfo:
@@ -83,6 +89,12 @@ func ResponseInnerGetProcess(event tetragon.IsGetEventsResponse_Event) *tetragon
 		return ev.ProcessLsm.Process
 	case *tetragon.GetEventsResponse_ProcessLoader:
 		return ev.ProcessLoader.Process
+	case *tetragon.GetEventsResponse_ProcessNetworkFlow:
+		return ev.ProcessNetworkFlow.Process
+	case *tetragon.GetEventsResponse_ProcessDns:
+		return ev.ProcessDns.Process
+	case *tetragon.GetEventsResponse_ProcessTls:
+		return ev.ProcessTls.Process
 
 	}
 	return nil
@@ -130,6 +142,12 @@ func ResponseInnerGetParent(event tetragon.IsGetEventsResponse_Event) *tetragon.
 		return ev.ProcessLsm.Parent
 	case *tetragon.GetEventsResponse_ProcessLoader:
 		return ev.ProcessLoader.Parent
+	case *tetragon.GetEventsResponse_ProcessNetworkFlow:
+		return ev.ProcessNetworkFlow.Parent
+	case *tetragon.GetEventsResponse_ProcessDns:
+		return ev.ProcessDns.Parent
+	case *tetragon.GetEventsResponse_ProcessTls:
+		return ev.ProcessTls.Parent
 
 	}
 	return nil
@@ -168,6 +186,12 @@ func ResponseInnerGetAncestors(event tetragon.IsGetEventsResponse_Event) []*tetr
 		return ev.ProcessLsm.Ancestors
 	case *tetragon.GetEventsResponse_ProcessLoader:
 		return ev.ProcessLoader.Ancestors
+	case *tetragon.GetEventsResponse_ProcessNetworkFlow:
+		return ev.ProcessNetworkFlow.Ancestors
+	case *tetragon.GetEventsResponse_ProcessDns:
+		return ev.ProcessDns.Ancestors
+	case *tetragon.GetEventsResponse_ProcessTls:
+		return ev.ProcessTls.Ancestors
 
 	}
 	return nil
@@ -177,17 +201,20 @@ func ResponseInnerGetAncestors(event tetragon.IsGetEventsResponse_Event) []*tetr
 // protobuf messages (e.g. &tetragon.ProcessExec{}).
 func ResponseTypeMap() map[string]proto.Message {
 	return map[string]proto.Message{
-		"process_exec":       &tetragon.ProcessExec{},
-		"process_exit":       &tetragon.ProcessExit{},
-		"process_kprobe":     &tetragon.ProcessKprobe{},
-		"process_tracepoint": &tetragon.ProcessTracepoint{},
-		"process_loader":     &tetragon.ProcessLoader{},
-		"process_uprobe":     &tetragon.ProcessUprobe{},
-		"process_throttle":   &tetragon.ProcessThrottle{},
-		"process_lsm":        &tetragon.ProcessLsm{},
-		"process_usdt":       &tetragon.ProcessUsdt{},
-		"test":               &tetragon.Test{},
-		"rate_limit_info":    &tetragon.RateLimitInfo{},
+		"process_exec":         &tetragon.ProcessExec{},
+		"process_exit":         &tetragon.ProcessExit{},
+		"process_kprobe":       &tetragon.ProcessKprobe{},
+		"process_tracepoint":   &tetragon.ProcessTracepoint{},
+		"process_loader":       &tetragon.ProcessLoader{},
+		"process_uprobe":       &tetragon.ProcessUprobe{},
+		"process_throttle":     &tetragon.ProcessThrottle{},
+		"process_lsm":          &tetragon.ProcessLsm{},
+		"process_usdt":         &tetragon.ProcessUsdt{},
+		"process_network_flow": &tetragon.ProcessNetworkFlow{},
+		"process_dns":          &tetragon.ProcessDns{},
+		"process_tls":          &tetragon.ProcessTls{},
+		"test":                 &tetragon.Test{},
+		"rate_limit_info":      &tetragon.RateLimitInfo{},
 	}
 }
 
@@ -213,6 +240,12 @@ func ProcessEventMapTuple(response *tetragon.GetEventsResponse) (string, any, an
 		return "process_lsm", response.GetProcessLsm(), (*tetragon.ProcessLsm)(nil)
 	case *tetragon.GetEventsResponse_ProcessUsdt:
 		return "process_usdt", response.GetProcessUsdt(), (*tetragon.ProcessUsdt)(nil)
+	case *tetragon.GetEventsResponse_ProcessNetworkFlow:
+		return "process_network_flow", response.GetProcessNetworkFlow(), (*tetragon.ProcessNetworkFlow)(nil)
+	case *tetragon.GetEventsResponse_ProcessDns:
+		return "process_dns", response.GetProcessDns(), (*tetragon.ProcessDns)(nil)
+	case *tetragon.GetEventsResponse_ProcessTls:
+		return "process_tls", response.GetProcessTls(), (*tetragon.ProcessTls)(nil)
 	case *tetragon.GetEventsResponse_Test:
 		return "test", response.GetTest(), (*tetragon.Test)(nil)
 	case *tetragon.GetEventsResponse_RateLimitInfo:
@@ -225,16 +258,19 @@ func ProcessEventMapTuple(response *tetragon.GetEventsResponse) (string, any, an
 // ProcessEventMapEmpty returns a map from event field names (e.g. "process_exec") with nil as value
 func ProcessEventMapEmpty() map[string]any {
 	return map[string]any{
-		"process_exec":       (*tetragon.ProcessExec)(nil),
-		"process_exit":       (*tetragon.ProcessExit)(nil),
-		"process_kprobe":     (*tetragon.ProcessKprobe)(nil),
-		"process_tracepoint": (*tetragon.ProcessTracepoint)(nil),
-		"process_loader":     (*tetragon.ProcessLoader)(nil),
-		"process_uprobe":     (*tetragon.ProcessUprobe)(nil),
-		"process_throttle":   (*tetragon.ProcessThrottle)(nil),
-		"process_lsm":        (*tetragon.ProcessLsm)(nil),
-		"process_usdt":       (*tetragon.ProcessUsdt)(nil),
-		"test":               (*tetragon.Test)(nil),
-		"rate_limit_info":    (*tetragon.RateLimitInfo)(nil),
+		"process_exec":         (*tetragon.ProcessExec)(nil),
+		"process_exit":         (*tetragon.ProcessExit)(nil),
+		"process_kprobe":       (*tetragon.ProcessKprobe)(nil),
+		"process_tracepoint":   (*tetragon.ProcessTracepoint)(nil),
+		"process_loader":       (*tetragon.ProcessLoader)(nil),
+		"process_uprobe":       (*tetragon.ProcessUprobe)(nil),
+		"process_throttle":     (*tetragon.ProcessThrottle)(nil),
+		"process_lsm":          (*tetragon.ProcessLsm)(nil),
+		"process_usdt":         (*tetragon.ProcessUsdt)(nil),
+		"process_network_flow": (*tetragon.ProcessNetworkFlow)(nil),
+		"process_dns":          (*tetragon.ProcessDns)(nil),
+		"process_tls":          (*tetragon.ProcessTls)(nil),
+		"test":                 (*tetragon.Test)(nil),
+		"rate_limit_info":      (*tetragon.RateLimitInfo)(nil),
 	}
 }
@@ -28,6 +28,8 @@ const (
 	FineGuidanceSensors_DeleteTracingPolicy_FullMethodName    = "/tetragon.FineGuidanceSensors/DeleteTracingPolicy"
 	FineGuidanceSensors_ListTracingPolicies_FullMethodName    = "/tetragon.FineGuidanceSensors/ListTracingPolicies"
 	FineGuidanceSensors_ConfigureTracingPolicy_FullMethodName = "/tetragon.FineGuidanceSensors/ConfigureTracingPolicy"
+	FineGuidanceSensors_ValidateTracingPolicy_FullMethodName  = "/tetragon.FineGuidanceSensors/ValidateTracingPolicy"
+	FineGuidanceSensors_GetPolicyImpactReport_FullMethodName  = "/tetragon.FineGuidanceSensors/GetPolicyImpactReport"
 	FineGuidanceSensors_EnableTracingPolicy_FullMethodName    = "/tetragon.FineGuidanceSensors/EnableTracingPolicy"
 	FineGuidanceSensors_DisableTracingPolicy_FullMethodName   = "/tetragon.FineGuidanceSensors/DisableTracingPolicy"
 	FineGuidanceSensors_ListSensors_FullMethodName            = "/tetragon.FineGuidanceSensors/ListSensors"
@@ -38,8 +40,10 @@ const (
 	FineGuidanceSensors_GetVersion_FullMethodName             = "/tetragon.FineGuidanceSensors/GetVersion"
 	FineGuidanceSensors_GetInfo_FullMethodName                = "/tetragon.FineGuidanceSensors/GetInfo"
 	FineGuidanceSensors_RuntimeHook_FullMethodName            = "/tetragon.FineGuidanceSensors/RuntimeHook"
+	FineGuidanceSensors_GetProcessTree_FullMethodName         = "/tetragon.FineGuidanceSensors/GetProcessTree"
 	FineGuidanceSensors_GetDebug_FullMethodName               = "/tetragon.FineGuidanceSensors/GetDebug"
 	FineGuidanceSensors_SetDebug_FullMethodName               = "/tetragon.FineGuidanceSensors/SetDebug"
+	FineGuidanceSensors_GetBugtoolFiles_FullMethodName        = "/tetragon.FineGuidanceSensors/GetBugtoolFiles"
 )
 
 // FineGuidanceSensorsClient is the client API for FineGuidanceSensors service.
@@ -59,6 +63,14 @@ type FineGuidanceSensorsClient interface {
 	// If multiple changes are requested and an error is encountered, the resulting state might have
 	// partial updates applied. In other words, the configuring a tracing policy is not atomic.
 	ConfigureTracingPolicy(ctx context.Context, in *ConfigureTracingPolicyRequest, opts ...grpc.CallOption) (*ConfigureTracingPolicyResponse, error)
+	// ValidateTracingPolicy checks whether a tracing policy can be loaded on the
+	// node, by running it through the sensor pipeline up to and including the
+	// BPF verifier. Nothing is attached and the agent state is not modified.
+	ValidateTracingPolicy(ctx context.Context, in *ValidateTracingPolicyRequest, opts ...grpc.CallOption) (*ValidateTracingPolicyResponse, error)
+	// GetPolicyImpactReport returns what a tracing policy in monitor mode
+	// would have enforced, to help deciding whether to switch it to enforce
+	// mode.
+	GetPolicyImpactReport(ctx context.Context, in *GetPolicyImpactReportRequest, opts ...grpc.CallOption) (*GetPolicyImpactReportResponse, error)
 	// Deprecated: Do not use.
 	EnableTracingPolicy(ctx context.Context, in *EnableTracingPolicyRequest, opts ...grpc.CallOption) (*EnableTracingPolicyResponse, error)
 	// Deprecated: Do not use.
@@ -75,8 +87,14 @@ type FineGuidanceSensorsClient interface {
 	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*GetVersionResponse, error)
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
 	RuntimeHook(ctx context.Context, in *RuntimeHookRequest, opts ...grpc.CallOption) (*RuntimeHookResponse, error)
+	// GetProcessTree returns the ancestors and descendants of processes in the
+	// process cache of the agent.
+	GetProcessTree(ctx context.Context, in *GetProcessTreeRequest, opts ...grpc.CallOption) (*GetProcessTreeResponse, error)
 	GetDebug(ctx context.Context, in *GetDebugRequest, opts ...grpc.CallOption) (*GetDebugResponse, error)
 	SetDebug(ctx context.Context, in *SetDebugRequest, opts ...grpc.CallOption) (*SetDebugResponse, error)
+	// GetBugtoolFiles streams the debug information of the agent that bugtool
+	// collects, for bugtool to run outside of the agent container.
+	GetBugtoolFiles(ctx context.Context, in *GetBugtoolFilesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetBugtoolFilesResponse], error)
 }
 
 type fineGuidanceSensorsClient struct {
@@ -156,6 +174,26 @@ func (c *fineGuidanceSensorsClient) ConfigureTracingPolicy(ctx context.Context,
 	return out, nil
 }
 
+func (c *fineGuidanceSensorsClient) ValidateTracingPolicy(ctx context.Context, in *ValidateTracingPolicyRequest, opts ...grpc.CallOption) (*ValidateTracingPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateTracingPolicyResponse)
+	err := c.cc.Invoke(ctx, FineGuidanceSensors_ValidateTracingPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fineGuidanceSensorsClient) GetPolicyImpactReport(ctx context.Context, in *GetPolicyImpactReportRequest, opts ...grpc.CallOption) (*GetPolicyImpactReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPolicyImpactReportResponse)
+	err := c.cc.Invoke(ctx, FineGuidanceSensors_GetPolicyImpactReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *fineGuidanceSensorsClient) EnableTracingPolicy(ctx context.Context, in *EnableTracingPolicyRequest, opts ...grpc.CallOption) (*EnableTracingPolicyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
@@ -262,6 +300,16 @@ func (c *fineGuidanceSensorsClient) RuntimeHook(ctx context.Context, in *Runtime
 	return out, nil
 }
 
+func (c *fineGuidanceSensorsClient) GetProcessTree(ctx context.Context, in *GetProcessTreeRequest, opts ...grpc.CallOption) (*GetProcessTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProcessTreeResponse)
+	err := c.cc.Invoke(ctx, FineGuidanceSensors_GetProcessTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fineGuidanceSensorsClient) GetDebug(ctx context.Context, in *GetDebugRequest, opts ...grpc.CallOption) (*GetDebugResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDebugResponse)
@@ -282,6 +330,25 @@ func (c *fineGuidanceSensorsClient) SetDebug(ctx context.Context, in *SetDebugRe
 	return out, nil
 }
 
+func (c *fineGuidanceSensorsClient) GetBugtoolFiles(ctx context.Context, in *GetBugtoolFilesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetBugtoolFilesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &FineGuidanceSensors_ServiceDesc.Streams[1], FineGuidanceSensors_GetBugtoolFiles_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetBugtoolFilesRequest, GetBugtoolFilesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FineGuidanceSensors_GetBugtoolFilesClient = grpc.ServerStreamingClient[GetBugtoolFilesResponse]
+
 // FineGuidanceSensorsServer is the server API for FineGuidanceSensors service.
 // All implementations must embed UnimplementedFineGuidanceSensorsServer
 // for forward compatibility.
@@ -299,6 +366,14 @@ type FineGuidanceSensorsServer interface {
 	// If multiple changes are requested and an error is encountered, the resulting state might have
 	// partial updates applied. In other words, the configuring a tracing policy is not atomic.
 	ConfigureTracingPolicy(context.Context, *ConfigureTracingPolicyRequest) (*ConfigureTracingPolicyResponse, error)
+	// ValidateTracingPolicy checks whether a tracing policy can be loaded on the
+	// node, by running it through the sensor pipeline up to and including the
+	// BPF verifier. Nothing is attached and the agent state is not modified.
+	ValidateTracingPolicy(context.Context, *ValidateTracingPolicyRequest) (*ValidateTracingPolicyResponse, error)
+	// GetPolicyImpactReport returns what a tracing policy in monitor mode
+	// would have enforced, to help deciding whether to switch it to enforce
+	// mode.
+	GetPolicyImpactReport(context.Context, *GetPolicyImpactReportRequest) (*GetPolicyImpactReportResponse, error)
 	// Deprecated: Do not use.
 	EnableTracingPolicy(context.Context, *EnableTracingPolicyRequest) (*EnableTracingPolicyResponse, error)
 	// Deprecated: Do not use.
@@ -315,8 +390,14 @@ type FineGuidanceSensorsServer interface {
 	GetVersion(context.Context, *GetVersionRequest) (*GetVersionResponse, error)
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
 	RuntimeHook(context.Context, *RuntimeHookRequest) (*RuntimeHookResponse, error)
+	// GetProcessTree returns the ancestors and descendants of processes in the
+	// process cache of the agent.
+	GetProcessTree(context.Context, *GetProcessTreeRequest) (*GetProcessTreeResponse, error)
 	GetDebug(context.Context, *GetDebugRequest) (*GetDebugResponse, error)
 	SetDebug(context.Context, *SetDebugRequest) (*SetDebugResponse, error)
+	// GetBugtoolFiles streams the debug information of the agent that bugtool
+	// collects, for bugtool to run outside of the agent container.
+	GetBugtoolFiles(*GetBugtoolFilesRequest, grpc.ServerStreamingServer[GetBugtoolFilesResponse]) error
 	mustEmbedUnimplementedFineGuidanceSensorsServer()
 }
 
@@ -345,6 +426,12 @@ func (UnimplementedFineGuidanceSensorsServer) ListTracingPolicies(context.Contex
 func (UnimplementedFineGuidanceSensorsServer) ConfigureTracingPolicy(context.Context, *ConfigureTracingPolicyRequest) (*ConfigureTracingPolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConfigureTracingPolicy not implemented")
 }
+func (UnimplementedFineGuidanceSensorsServer) ValidateTracingPolicy(context.Context, *ValidateTracingPolicyRequest) (*ValidateTracingPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateTracingPolicy not implemented")
+}
+func (UnimplementedFineGuidanceSensorsServer) GetPolicyImpactReport(context.Context, *GetPolicyImpactReportRequest) (*GetPolicyImpactReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPolicyImpactReport not implemented")
+}
 func (UnimplementedFineGuidanceSensorsServer) EnableTracingPolicy(context.Context, *EnableTracingPolicyRequest) (*EnableTracingPolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnableTracingPolicy not implemented")
 }
@@ -375,12 +462,18 @@ func (UnimplementedFineGuidanceSensorsServer) GetInfo(context.Context, *GetInfoR
 func (UnimplementedFineGuidanceSensorsServer) RuntimeHook(context.Context, *RuntimeHookRequest) (*RuntimeHookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RuntimeHook not implemented")
 }
+func (UnimplementedFineGuidanceSensorsServer) GetProcessTree(context.Context, *GetProcessTreeRequest) (*GetProcessTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProcessTree not implemented")
+}
 func (UnimplementedFineGuidanceSensorsServer) GetDebug(context.Context, *GetDebugRequest) (*GetDebugResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDebug not implemented")
 }
 func (UnimplementedFineGuidanceSensorsServer) SetDebug(context.Context, *SetDebugRequest) (*SetDebugResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetDebug not implemented")
 }
+func (UnimplementedFineGuidanceSensorsServer) GetBugtoolFiles(*GetBugtoolFilesRequest, grpc.ServerStreamingServer[GetBugtoolFilesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method GetBugtoolFiles not implemented")
+}
 func (UnimplementedFineGuidanceSensorsServer) mustEmbedUnimplementedFineGuidanceSensorsServer() {}
 func (UnimplementedFineGuidanceSensorsServer) testEmbeddedByValue()                             {}
 
@@ -503,6 +596,42 @@ func _FineGuidanceSensors_ConfigureTracingPolicy_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FineGuidanceSensors_ValidateTracingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateTracingPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineGuidanceSensorsServer).ValidateTracingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FineGuidanceSensors_ValidateTracingPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineGuidanceSensorsServer).ValidateTracingPolicy(ctx, req.(*ValidateTracingPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FineGuidanceSensors_GetPolicyImpactReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPolicyImpactReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineGuidanceSensorsServer).GetPolicyImpactReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FineGuidanceSensors_GetPolicyImpactReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineGuidanceSensorsServer).GetPolicyImpactReport(ctx, req.(*GetPolicyImpactReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FineGuidanceSensors_EnableTracingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EnableTracingPolicyRequest)
 	if err := dec(in); err != nil {
@@ -683,6 +812,24 @@ func _FineGuidanceSensors_RuntimeHook_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FineGuidanceSensors_GetProcessTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProcessTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FineGuidanceSensorsServer).GetProcessTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: FineGuidanceSensors_GetProcessTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FineGuidanceSensorsServer).GetProcessTree(ctx, req.(*GetProcessTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FineGuidanceSensors_GetDebug_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDebugRequest)
 	if err := dec(in); err != nil {
@@ -719,6 +866,17 @@ func _FineGuidanceSensors_SetDebug_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FineGuidanceSensors_GetBugtoolFiles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetBugtoolFilesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FineGuidanceSensorsServer).GetBugtoolFiles(m, &grpc.GenericServerStream[GetBugtoolFilesRequest, GetBugtoolFilesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type FineGuidanceSensors_GetBugtoolFilesServer = grpc.ServerStreamingServer[GetBugtoolFilesResponse]
+
 // FineGuidanceSensors_ServiceDesc is the grpc.ServiceDesc for FineGuidanceSensors service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -746,6 +904,14 @@ var FineGuidanceSensors_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConfigureTracingPolicy",
 			Handler:    _FineGuidanceSensors_ConfigureTracingPolicy_Handler,
 		},
+		{
+			MethodName: "ValidateTracingPolicy",
+			Handler:    _FineGuidanceSensors_ValidateTracingPolicy_Handler,
+		},
+		{
+			MethodName: "GetPolicyImpactReport",
+			Handler:    _FineGuidanceSensors_GetPolicyImpactReport_Handler,
+		},
 		{
 			MethodName: "EnableTracingPolicy",
 			Handler:    _FineGuidanceSensors_EnableTracingPolicy_Handler,
@@ -786,6 +952,10 @@ var FineGuidanceSensors_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RuntimeHook",
 			Handler:    _FineGuidanceSensors_RuntimeHook_Handler,
 		},
+		{
+			MethodName: "GetProcessTree",
+			Handler:    _FineGuidanceSensors_GetProcessTree_Handler,
+		},
 		{
 			MethodName: "GetDebug",
 			Handler:    _FineGuidanceSensors_GetDebug_Handler,
@@ -801,6 +971,11 @@ var FineGuidanceSensors_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _FineGuidanceSensors_GetEvents_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetBugtoolFiles",
+			Handler:       _FineGuidanceSensors_GetBugtoolFiles_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "tetragon/sensors.proto",
 }
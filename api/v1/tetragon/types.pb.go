@@ -251,6 +251,84 @@ func (event *ProcessLoader) SetAncestors(ps []*Process) {
 	event.Ancestors = ps
 }
 
+// Encapsulate implements the Event interface.
+// Returns the event wrapped by its GetEventsResponse_* type.
+func (event *ProcessNetworkFlow) Encapsulate() IsGetEventsResponse_Event {
+	return &GetEventsResponse_ProcessNetworkFlow{
+		ProcessNetworkFlow: event,
+	}
+}
+
+// SetProcess implements the ProcessEvent interface.
+// Sets the Process field of an event.
+func (event *ProcessNetworkFlow) SetProcess(p *Process) {
+	event.Process = p
+}
+
+// SetParent implements the ParentEvent interface.
+// Sets the Parent field of an event.
+func (event *ProcessNetworkFlow) SetParent(p *Process) {
+	event.Parent = p
+}
+
+// SetAncestors implements the AncestorEvent interface.
+// Sets the Ancestor field of an event.
+func (event *ProcessNetworkFlow) SetAncestors(ps []*Process) {
+	event.Ancestors = ps
+}
+
+// Encapsulate implements the Event interface.
+// Returns the event wrapped by its GetEventsResponse_* type.
+func (event *ProcessDns) Encapsulate() IsGetEventsResponse_Event {
+	return &GetEventsResponse_ProcessDns{
+		ProcessDns: event,
+	}
+}
+
+// SetProcess implements the ProcessEvent interface.
+// Sets the Process field of an event.
+func (event *ProcessDns) SetProcess(p *Process) {
+	event.Process = p
+}
+
+// SetParent implements the ParentEvent interface.
+// Sets the Parent field of an event.
+func (event *ProcessDns) SetParent(p *Process) {
+	event.Parent = p
+}
+
+// SetAncestors implements the AncestorEvent interface.
+// Sets the Ancestor field of an event.
+func (event *ProcessDns) SetAncestors(ps []*Process) {
+	event.Ancestors = ps
+}
+
+// Encapsulate implements the Event interface.
+// Returns the event wrapped by its GetEventsResponse_* type.
+func (event *ProcessTls) Encapsulate() IsGetEventsResponse_Event {
+	return &GetEventsResponse_ProcessTls{
+		ProcessTls: event,
+	}
+}
+
+// SetProcess implements the ProcessEvent interface.
+// Sets the Process field of an event.
+func (event *ProcessTls) SetProcess(p *Process) {
+	event.Process = p
+}
+
+// SetParent implements the ParentEvent interface.
+// Sets the Parent field of an event.
+func (event *ProcessTls) SetParent(p *Process) {
+	event.Parent = p
+}
+
+// SetAncestors implements the AncestorEvent interface.
+// Sets the Ancestor field of an event.
+func (event *ProcessTls) SetAncestors(ps []*Process) {
+	event.Ancestors = ps
+}
+
 // Encapsulate implements the Event interface.
 // Returns the event wrapped by its GetEventsResponse_* type.
 func (event *RateLimitInfo) Encapsulate() IsGetEventsResponse_Event {
@@ -292,6 +370,12 @@ func UnwrapGetEventsResponse(response *GetEventsResponse) interface{} {
 		return ev.Test
 	case *GetEventsResponse_ProcessLoader:
 		return ev.ProcessLoader
+	case *GetEventsResponse_ProcessNetworkFlow:
+		return ev.ProcessNetworkFlow
+	case *GetEventsResponse_ProcessDns:
+		return ev.ProcessDns
+	case *GetEventsResponse_ProcessTls:
+		return ev.ProcessTls
 	case *GetEventsResponse_RateLimitInfo:
 		return ev.RateLimitInfo
 	case *GetEventsResponse_ProcessThrottle:
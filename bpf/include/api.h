@@ -239,6 +239,7 @@ static int BPF_FUNC(fib_lookup, void *ctx, struct bpf_fib_lookup *params, uint32
 /* Current Process Info */
 static uint64_t BPF_FUNC(get_current_task);
 static uint64_t BPF_FUNC(get_current_task_btf);
+static uint64_t BPF_FUNC(task_pt_regs, struct task_struct *task);
 static uint64_t BPF_FUNC(get_current_cgroup_id);
 static uint64_t BPF_FUNC(get_current_ancestor_cgroup_id, int ancestor_level);
 static uint64_t BPF_FUNC(get_current_uid_gid);
@@ -251,6 +252,7 @@ static int BPF_FUNC(override_return, void *regs, uint64_t rc);
 static long BPF_FUNC(get_stackid, void *ctx, void *map, uint64_t flags);
 static long BPF_FUNC(loop, __u32 nr_loops, void *callback_fn, void *callback_ctx, __u64 flags);
 static __u64 BPF_FUNC(get_attach_cookie, void *ctx);
+static long BPF_FUNC(get_func_ret, void *ctx, __u64 *value);
 
 /* Perf and Rignbuffer */
 static int BPF_FUNC(perf_event_output, void *ctx, void *map, uint64_t flags, void *data, uint64_t size);
@@ -23,6 +23,13 @@ struct {
 	__uint(type, BPF_MAP_TYPE_RINGBUF);
 	__uint(max_entries, 4096); // This will be resized in user space.
 } tg_rb_events SEC(".maps");
+
+// Events of policies with a high priority are sent through their own ring
+// buffer, so they are not lost when the one above is full.
+struct {
+	__uint(type, BPF_MAP_TYPE_RINGBUF);
+	__uint(max_entries, 4096); // This will be resized in user space.
+} tg_rb_events_high SEC(".maps");
 #endif
 
 #endif // __BPF_EVENT_H
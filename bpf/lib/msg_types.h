@@ -37,6 +37,12 @@ enum msg_ops {
 
 	MSG_OP_GENERIC_USDT = 28,
 
+	MSG_OP_FLOW = 29,
+
+	MSG_OP_DNS = 30,
+
+	MSG_OP_TLS = 31,
+
 	MSG_OP_MAX,
 };
 
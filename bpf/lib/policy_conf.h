@@ -11,8 +11,16 @@ enum {
 	POLICY_MODE_MONITOR_ONLY = 2,
 };
 
+// NB: values should match the ones defined in go (NormalPriority, HighPriority)
+enum {
+	POLICY_PRIORITY_NORMAL = 0,
+	POLICY_PRIORITY_HIGH = 1,
+	POLICY_PRIORITY_MAX,
+};
+
 struct policy_conf {
 	__u8 mode;
+	__u8 priority;
 } __attribute__((packed));
 
 struct {
@@ -22,6 +22,7 @@ enum policy_actions {
 	POLICY_MONITOR_OVERRIDE = 5, /* policy did not overrode a return value because it was in monitor mode */
 	POLICY_NOTIFY_ENFORCER = 6, /* policy notified the enforcer */
 	POLICY_MONITOR_NOTIFY_ENFORCER = 7, /* policy did not notify the enforcer because it was in monitor mode */
+	POLICY_SAMPLED_OUT = 8, /* policy did not post an event because it was sampled out */
 	POLICY_NACTIONS_,
 };
 
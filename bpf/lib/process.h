@@ -585,6 +585,8 @@ _Static_assert(sizeof(struct execve_map_value) % 8 == 0,
 
 struct kernel_stats {
 	__u64 sent_failed[256][SENT_FAILED_MAX];
+	/* events that failed to be sent, per priority class */
+	__u64 sent_failed_prio[POLICY_PRIORITY_MAX];
 };
 
 struct {
@@ -594,6 +596,23 @@ struct {
 	__uint(max_entries, 1);
 } tg_stats_map SEC(".maps");
 
+/* event_priority returns the priority class of the policy the program belongs
+ * to. Programs that are not part of a policy use the normal class.
+ */
+FUNC_INLINE __u32
+event_priority(void)
+{
+#if defined(HAS_POLICY_STATS)
+	struct policy_conf *pcnf;
+	__u32 zero = 0;
+
+	pcnf = map_lookup_elem(&policy_conf, &zero);
+	if (pcnf && pcnf->priority == POLICY_PRIORITY_HIGH)
+		return POLICY_PRIORITY_HIGH;
+#endif
+	return POLICY_PRIORITY_NORMAL;
+}
+
 FUNC_INLINE void
 event_output_update_error_metric(u8 msg_op, long err)
 {
@@ -602,6 +621,10 @@ event_output_update_error_metric(u8 msg_op, long err)
 
 	valp = map_lookup_elem(&tg_stats_map, &zero);
 	if (valp) {
+		if (event_priority() == POLICY_PRIORITY_HIGH)
+			lock_add(&valp->sent_failed_prio[POLICY_PRIORITY_HIGH], 1);
+		else
+			lock_add(&valp->sent_failed_prio[POLICY_PRIORITY_NORMAL], 1);
 		switch (err) {
 		case -2: // ENOENT
 			lock_add(&valp->sent_failed[msg_op][SENT_FAILED_ENOENT], 1);
@@ -643,6 +666,14 @@ perf_event_output_metric(void *ctx, u8 msg_op, void *map, u64 flags, void *data,
 }
 
 #ifdef __V511_BPF_PROG
+FUNC_INLINE long
+event_ringbuf_output(__u32 priority, void *data, u64 size)
+{
+	if (priority == POLICY_PRIORITY_HIGH)
+		return ringbuf_output(&tg_rb_events_high, data, size, 0);
+	return ringbuf_output(&tg_rb_events, data, size, 0);
+}
+
 FUNC_INLINE long
 event_output(void *ctx, void *data, u64 size)
 {
@@ -652,7 +683,7 @@ event_output(void *ctx, void *data, u64 size)
 	conf = map_lookup_elem(&tg_conf_map, &zero);
 	if (conf && conf->use_perf_ring_buf)
 		return perf_event_output(ctx, &tcpmon_map, BPF_F_CURRENT_CPU, data, size);
-	return ringbuf_output(&tg_rb_events, data, size, 0);
+	return event_ringbuf_output(event_priority(), data, size);
 }
 
 FUNC_INLINE void
@@ -668,7 +699,7 @@ event_output_metric(void *ctx, u8 msg_op, void *data, u64 size)
 		return;
 	}
 
-	err = ringbuf_output(&tg_rb_events, data, size, 0);
+	err = event_ringbuf_output(event_priority(), data, size);
 
 	if (err < 0) {
 		event_output_update_error_metric(msg_op, err);
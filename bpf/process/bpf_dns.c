@@ -0,0 +1,311 @@
+// SPDX-License-Identifier: (GPL-2.0-only OR BSD-2-Clause)
+/* Copyright Authors of Cilium */
+
+#include "vmlinux.h"
+#include "api.h"
+#include "compiler.h"
+#include "bpf_tracing.h"
+#include "bpf_helpers.h"
+#include "bpf_event.h"
+#include "bpf_task.h"
+#include "bpf_ktime.h"
+#include "types/sock.h"
+
+char _license[] __attribute__((section("license"), used)) = "Dual BSD/GPL";
+
+/* DNS sensor
+ *
+ * The DNS sensor captures the payload of the messages that processes send to
+ * and receive from port 53 (UDP and TCP) and sends it to user space, where it
+ * is parsed. Messages are captured from the socket layer, so they are
+ * attributed to the process doing the lookup:
+ *  - security_socket_sendmsg, for queries
+ *  - sock_recvmsg (entry and return), for responses. The buffer is saved on
+ *    entry since the iov_iter is consumed when data is copied. The peer
+ *    address of unconnected sockets is only known on return, so on entry the
+ *    buffer is only saved for sockets connected to port 53 and for sockets
+ *    that sent a query to port 53.
+ *
+ * Only the first DNS_MAX_SIZE - 1 bytes of each message, and of the first
+ * segment of its buffer, are captured, which always include the header and,
+ * in practice, the question section.
+ */
+
+#define DNS_PORT     53
+#define DNS_MAX_SIZE 512
+
+struct msg_dns {
+	struct msg_common common;
+	struct msg_execve_key current;
+	struct sk_type sock;
+	__u32 size;
+	__u32 orig_size;
+	__u8 response;
+	__u8 pad[7];
+	char data[DNS_MAX_SIZE];
+};
+
+struct dns_recv {
+	struct sock *sk;
+	struct msghdr *msg;
+	unsigned long buf;
+	unsigned long buf_len;
+};
+
+struct {
+	__uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+	__uint(max_entries, 1);
+	__type(key, __u32);
+	__type(value, struct msg_dns);
+} dns_heap SEC(".maps");
+
+struct {
+	__uint(type, BPF_MAP_TYPE_LRU_HASH);
+	__uint(max_entries, 8192);
+	__type(key, __u64);
+	__type(value, struct dns_recv);
+} dns_recv_map SEC(".maps");
+
+/* sockets that sent a query to port 53, whose responses are captured */
+struct {
+	__uint(type, BPF_MAP_TYPE_LRU_HASH);
+	__uint(max_entries, 8192);
+	__type(key, __u64);
+	__type(value, __u8);
+} dns_sock_map SEC(".maps");
+
+FUNC_INLINE bool
+dns_sock(struct sock *sk)
+{
+	struct sock_common *common = (struct sock_common *)sk;
+	__u16 family = 0, protocol = 0;
+
+	probe_read(&family, sizeof(family), _(&common->skc_family));
+	if (family != AF_INET && family != AF_INET6)
+		return false;
+
+	probe_read(&protocol, sizeof(protocol), _(&sk->sk_protocol));
+	if (bpf_core_field_size(sk->sk_protocol) == 4)
+		protocol = protocol >> 8;
+	return protocol == IPPROTO_UDP || protocol == IPPROTO_TCP;
+}
+
+/* dns_peer_port returns the port of the peer, either the port of a connected
+ * socket or the port of the address in msg_name.
+ */
+FUNC_INLINE __u16
+dns_peer_port(struct sock *sk, struct msghdr *msg)
+{
+	struct sock_common *common = (struct sock_common *)sk;
+	struct sockaddr_in *addr;
+	__u16 port = 0;
+
+	probe_read(&port, sizeof(port), _(&common->skc_dport));
+	if (port)
+		return bpf_ntohs(port);
+
+	probe_read(&addr, sizeof(addr), _(&msg->msg_name));
+	if (!addr)
+		return 0;
+	/* sin_port and sin6_port are at the same offset */
+	probe_read(&port, sizeof(port), _(&addr->sin_port));
+	return bpf_ntohs(port);
+}
+
+/* dns_set_peer sets the peer address from msg_name for sockets that are not
+ * connected.
+ */
+FUNC_INLINE void
+dns_set_peer(struct sk_type *sock, struct msghdr *msg)
+{
+	struct sockaddr_in6 *addr6;
+	struct sockaddr_in *addr;
+	__u16 port = 0;
+
+	if (sock->tuple.dport)
+		return;
+
+	probe_read(&addr, sizeof(addr), _(&msg->msg_name));
+	if (!addr)
+		return;
+	probe_read(&port, sizeof(port), _(&addr->sin_port));
+	sock->tuple.dport = bpf_ntohs(port);
+	switch (sock->tuple.family) {
+	case AF_INET:
+		probe_read(&sock->tuple.daddr, IPV4LEN, _(&addr->sin_addr));
+		break;
+	case AF_INET6:
+		addr6 = (struct sockaddr_in6 *)addr;
+		probe_read(&sock->tuple.daddr, IPV6LEN, _(&addr6->sin6_addr));
+		break;
+	}
+}
+
+/* dns_iter_buf returns the user buffer of the first segment of an iov_iter,
+ * and sets len to the length of the segment.
+ */
+FUNC_INLINE unsigned long
+dns_iter_buf(struct msghdr *msg, unsigned long *len)
+{
+	long iter_iovec = -1, iter_ubuf __maybe_unused = -1;
+	struct iov_iter *iter = _(&msg->msg_iter);
+	const struct iovec *iov;
+	unsigned long buf = 0;
+	u8 iter_type = 0;
+
+	if (!bpf_core_field_exists(iter->iter_type))
+		return 0;
+
+	probe_read(&iter_type, sizeof(iter_type), _(&iter->iter_type));
+
+	if (bpf_core_enum_value_exists(enum iter_type, ITER_IOVEC))
+		iter_iovec = bpf_core_enum_value(enum iter_type, ITER_IOVEC);
+#ifdef __V61_BPF_PROG
+	if (bpf_core_enum_value_exists(enum iter_type, ITER_UBUF))
+		iter_ubuf = bpf_core_enum_value(enum iter_type, ITER_UBUF);
+#endif
+
+	*len = 0;
+	if (iter_type == iter_iovec) {
+		probe_read(&iov, sizeof(iov), _(&iter->kvec));
+		probe_read(&buf, sizeof(buf), _(&iov->iov_base));
+		probe_read(len, sizeof(*len), _(&iov->iov_len));
+	}
+#ifdef __V61_BPF_PROG
+	if (iter_type == iter_ubuf) {
+		probe_read(&buf, sizeof(buf), _(&iter->ubuf));
+		probe_read(len, sizeof(*len), _(&iter->count));
+	}
+#endif
+	return buf;
+}
+
+FUNC_INLINE void
+dns_output(void *ctx, struct sock *sk, struct msghdr *msg, unsigned long buf,
+	   unsigned long buf_len, long size, __u8 response)
+{
+	struct execve_map_value *curr;
+	struct msg_dns *e;
+	size_t total;
+	__u32 tgid;
+	long len;
+
+	if (!buf || size <= 0)
+		return;
+
+	tgid = get_current_pid_tgid() >> 32;
+	curr = execve_map_get_noinit(tgid);
+	if (!curr)
+		return;
+
+	e = map_lookup_elem(&dns_heap, &(__u32){ 0 });
+	if (!e)
+		return;
+
+	/* only the first segment of the buffer is read */
+	len = size;
+	if (buf_len < (unsigned long)len)
+		len = buf_len;
+	if (len <= 0)
+		return;
+	if (len >= DNS_MAX_SIZE)
+		len = DNS_MAX_SIZE - 1;
+	asm volatile("%[len] &= 0x1ff;\n"
+		     : [len] "+r"(len));
+	if (probe_read(&e->data[0], len, (char *)buf) < 0)
+		return;
+
+	set_event_from_sock(&e->sock, sk);
+	dns_set_peer(&e->sock, msg);
+	e->current.pid = curr->key.pid;
+	e->current.ktime = curr->key.ktime;
+	e->size = len;
+	e->orig_size = size;
+	e->response = response;
+
+	total = offsetof(struct msg_dns, data) + len;
+	e->common.size = total;
+	e->common.ktime = tg_get_ktime();
+	e->common.op = MSG_OP_DNS;
+	e->common.flags = 0;
+
+	event_output_metric(ctx, MSG_OP_DNS, e, total);
+}
+
+__attribute__((section("kprobe/security_socket_sendmsg"), used)) int
+dns_sendmsg(struct pt_regs *ctx)
+{
+	struct socket *sock = (struct socket *)PT_REGS_PARM1_CORE(ctx);
+	struct msghdr *msg = (struct msghdr *)PT_REGS_PARM2_CORE(ctx);
+	long size = PT_REGS_PARM3_CORE(ctx);
+	unsigned long buf, buf_len;
+	struct sock *sk;
+	__u64 key;
+
+	sk = BPF_CORE_READ(sock, sk);
+	if (!sk || !dns_sock(sk) || dns_peer_port(sk, msg) != DNS_PORT)
+		return 0;
+
+	key = (__u64)sk;
+	map_update_elem(&dns_sock_map, &key, &(__u8){ 1 }, BPF_ANY);
+
+	buf = dns_iter_buf(msg, &buf_len);
+	dns_output(ctx, sk, msg, buf, buf_len, size, 0);
+	return 0;
+}
+
+__attribute__((section("kprobe/sock_recvmsg"), used)) int
+dns_recvmsg(struct pt_regs *ctx)
+{
+	struct socket *sock = (struct socket *)PT_REGS_PARM1_CORE(ctx);
+	struct sock_common *common;
+	struct dns_recv recv = {};
+	__u16 dport = 0;
+	__u64 id, key;
+
+	recv.sk = BPF_CORE_READ(sock, sk);
+	if (!recv.sk || !dns_sock(recv.sk))
+		return 0;
+
+	/* connected sockets must be connected to port 53, other sockets must
+	 * have sent a query to port 53
+	 */
+	common = (struct sock_common *)recv.sk;
+	probe_read(&dport, sizeof(dport), _(&common->skc_dport));
+	if (dport) {
+		if (bpf_ntohs(dport) != DNS_PORT)
+			return 0;
+	} else {
+		key = (__u64)recv.sk;
+		if (!map_lookup_elem(&dns_sock_map, &key))
+			return 0;
+	}
+
+	recv.msg = (struct msghdr *)PT_REGS_PARM2_CORE(ctx);
+	recv.buf = dns_iter_buf(recv.msg, &recv.buf_len);
+	if (!recv.buf || !recv.buf_len)
+		return 0;
+
+	id = get_current_pid_tgid();
+	map_update_elem(&dns_recv_map, &id, &recv, BPF_ANY);
+	return 0;
+}
+
+__attribute__((section("kprobe/sock_recvmsg_ret"), used)) int
+dns_recvmsg_ret(struct pt_regs *ctx)
+{
+	long size = PT_REGS_RC_CORE(ctx);
+	struct dns_recv *recv;
+	__u64 id;
+
+	id = get_current_pid_tgid();
+	recv = map_lookup_elem(&dns_recv_map, &id);
+	if (!recv)
+		return 0;
+
+	/* the peer address in msg_name is set once the message is received */
+	if (dns_peer_port(recv->sk, recv->msg) == DNS_PORT)
+		dns_output(ctx, recv->sk, recv->msg, recv->buf, recv->buf_len, size, 1);
+	map_delete_elem(&dns_recv_map, &id);
+	return 0;
+}
@@ -41,7 +41,47 @@ FUNC(void *ctx)
 	return 0;
 }
 
-#else /* !__BPF_OVERRIDE_RETURN */
+#elif defined(__BPF_LSM_ENFORCER)
+
+/* The LSM hook is set by tetragon dynamically, file_open is a placeholder to
+ * pass contrib/verify/verify.sh test.
+ */
+__attribute__((section("lsm/file_open"), used)) int
+lsm_enforcer(void *ctx)
+{
+	__u64 id = get_current_pid_tgid();
+	struct enforcer_data *data;
+	long ret;
+
+	/* Unlike the other methods, the LSM hook can be reached by other
+	 * syscalls than the one that issued the notification, so only act on
+	 * notifications of the current syscall.
+	 */
+	data = map_lookup_elem(&enforcer_data, &id);
+	if (!data)
+		return 0;
+	if (data->syscall_nr != -1 && data->syscall_nr != enforcer_syscall_nr())
+		return 0;
+
+	ret = do_enforcer(ctx);
+	/* LSM programs can only return 0 or a negative error */
+	if (ret > 0 || ret < -MAX_ERRNO)
+		return 0;
+	return ret;
+}
+
+/* A syscall that fails before reaching the LSM hook, for example openat with
+ * ENOENT, leaves its notification pending. Drop it when the syscall exits, so
+ * that it does not deny a later operation of the same thread.
+ */
+__attribute__((section("raw_tracepoint/sys_exit"), used)) int
+lsm_enforcer_sys_exit(struct bpf_raw_tracepoint_args *ctx)
+{
+	do_enforcer_cleanup();
+	return 0;
+}
+
+#else /* !__BPF_OVERRIDE_RETURN && !__BPF_LSM_ENFORCER */
 
 /* Putting security_task_prctl in here to pass contrib/verify/verify.sh test,
  * in normal run the function is set by tetragon dynamically.
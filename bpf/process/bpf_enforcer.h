@@ -18,6 +18,8 @@ struct enforcer_data {
 	__s16 error;
 	__s16 signal;
 	struct enforcer_act_info act_info;
+	/* syscall that issued the notification, -1 if unknown */
+	__s32 syscall_nr;
 } __attribute__((packed));
 
 struct {
@@ -69,6 +71,36 @@ enforcer_update_missed_notifications(struct enforcer_missed_key *key)
 	}
 }
 
+/* Returns the number of the syscall that the current task is executing, or -1
+ * if it cannot be retrieved, because the kernel does not have the
+ * task_pt_regs helper or the architecture is unknown.
+ */
+FUNC_INLINE __s32 enforcer_syscall_nr(void)
+{
+	struct pt_regs *regs;
+	__s32 nr = -1;
+
+	if (!bpf_core_enum_value_exists(enum bpf_func_id, BPF_FUNC_task_pt_regs))
+		return -1;
+
+	regs = (struct pt_regs *)task_pt_regs((struct task_struct *)get_current_task_btf());
+	if (!regs)
+		return -1;
+#if defined(__TARGET_ARCH_x86)
+	{
+		unsigned long orig_ax;
+
+		if (probe_read(&orig_ax, sizeof(orig_ax), _(&regs->orig_ax)) < 0)
+			return -1;
+		nr = (__s32)orig_ax;
+	}
+#elif defined(__TARGET_ARCH_arm64)
+	if (probe_read(&nr, sizeof(nr), _(&regs->syscallno)) < 0)
+		return -1;
+#endif
+	return nr;
+}
+
 FUNC_INLINE void do_enforcer_cleanup(void)
 {
 	struct enforcer_data *ptr;
@@ -92,6 +124,7 @@ FUNC_INLINE void do_enforcer_action(int error, int signal, struct enforcer_act_i
 		.error = (__s16)error,
 		.signal = (__s16)signal,
 		.act_info = act_info,
+		.syscall_nr = enforcer_syscall_nr(),
 	};
 
 	ptr = map_lookup_elem(&enforcer_data, &id);
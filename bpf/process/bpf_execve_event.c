@@ -51,6 +51,10 @@ struct {
 	__type(value, struct msg_data);
 } data_heap SEC(".maps");
 
+#ifdef __LARGE_BPF_PROG
+volatile const __u8 ARGS_FULL_CAPTURE;
+#endif
+
 FUNC_INLINE __u32
 read_args(void *ctx, struct msg_execve_event *event)
 {
@@ -113,10 +117,21 @@ read_args(void *ctx, struct msg_execve_event *event)
 			size = 0;
 		}
 	} else {
-		size = data_event_bytes(ctx, (struct data_event_desc *)args,
-					(unsigned long)start_stack,
-					args_size,
-					(struct bpf_map_def *)&data_heap);
+#ifdef __LARGE_BPF_PROG
+		/* Send the complete arguments, so that they can be exported
+		 * when they are too long for the regular data events.
+		 */
+		if (ARGS_FULL_CAPTURE)
+			size = data_event_bytes_full(ctx, (struct data_event_desc *)args,
+						     (unsigned long)start_stack,
+						     args_size,
+						     (struct bpf_map_def *)&data_heap);
+		else
+#endif
+			size = data_event_bytes(ctx, (struct data_event_desc *)args,
+						(unsigned long)start_stack,
+						args_size,
+						(struct bpf_map_def *)&data_heap);
 		if (size > 0)
 			p->flags |= EVENT_DATA_ARGS;
 	}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: (GPL-2.0-only OR BSD-2-Clause)
+/* Copyright Authors of Cilium */
+
+#include "vmlinux.h"
+#include "api.h"
+#include "compiler.h"
+#include "bpf_tracing.h"
+#include "bpf_helpers.h"
+#include "bpf_event.h"
+#include "bpf_task.h"
+#include "bpf_ktime.h"
+#include "types/sock.h"
+
+char _license[] __attribute__((section("license"), used)) = "Dual BSD/GPL";
+
+/* Flow sensor
+ *
+ * The flow sensor tracks TCP connections from the moment they are
+ * established (tcp_connect for outgoing connections, inet_csk_accept for
+ * incoming ones) until they are closed (tcp_close). The process that
+ * established the connection owns the flow. The byte and segment counters are
+ * maintained by the kernel in struct tcp_sock, we copy them in the flow entry
+ * each time we see activity on the socket and report them:
+ *  - when the connection is closed
+ *  - on socket activity, if FLOW_REPORT_INTERVAL nanoseconds have passed since
+ *    the last report (if FLOW_REPORT_INTERVAL is not zero)
+ */
+
+enum {
+	FLOW_DIRECTION_UNKNOWN = 0,
+	FLOW_DIRECTION_EGRESS = 1,
+	FLOW_DIRECTION_INGRESS = 2,
+};
+
+struct flow_value {
+	struct msg_execve_key current;
+	__u64 start;
+	__u64 last_report;
+	__u64 bytes_sent;
+	__u64 bytes_received;
+	__u32 segs_out;
+	__u32 segs_in;
+	__u8 direction;
+	__u8 pad[7];
+};
+
+struct msg_flow {
+	struct msg_common common;
+	struct msg_execve_key current;
+	struct sk_type sock;
+	__u64 start;
+	__u64 bytes_sent;
+	__u64 bytes_received;
+	__u32 segs_out;
+	__u32 segs_in;
+	__u8 direction;
+	__u8 end;
+	__u8 pad[6];
+};
+
+volatile const __u64 FLOW_REPORT_INTERVAL;
+
+struct {
+	__uint(type, BPF_MAP_TYPE_LRU_HASH);
+	__uint(max_entries, 32768);
+	__type(key, __u64);
+	__type(value, struct flow_value);
+} flow_map SEC(".maps");
+
+struct {
+	__uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+	__uint(max_entries, 1);
+	__type(key, __u32);
+	__type(value, struct msg_flow);
+} flow_heap SEC(".maps");
+
+FUNC_INLINE void
+flow_start(struct sock *sk, __u8 direction)
+{
+	struct execve_map_value *curr;
+	struct flow_value value = {};
+	__u64 key = (__u64)sk;
+	__u32 tgid;
+
+	tgid = get_current_pid_tgid() >> 32;
+	curr = execve_map_get_noinit(tgid);
+	if (!curr)
+		return;
+
+	value.current.pid = curr->key.pid;
+	value.current.ktime = curr->key.ktime;
+	value.start = tg_get_ktime();
+	value.last_report = value.start;
+	value.direction = direction;
+	map_update_elem(&flow_map, &key, &value, BPF_ANY);
+}
+
+FUNC_INLINE void
+flow_read_counters(struct flow_value *value, struct sock *sk)
+{
+	struct tcp_sock *tp = (struct tcp_sock *)sk;
+
+	value->bytes_received = BPF_CORE_READ(tp, bytes_received);
+	value->bytes_sent = BPF_CORE_READ(tp, bytes_acked);
+	value->segs_in = BPF_CORE_READ(tp, segs_in);
+	value->segs_out = BPF_CORE_READ(tp, segs_out);
+}
+
+FUNC_INLINE void
+flow_report(void *ctx, struct sock *sk, struct flow_value *value, __u8 end)
+{
+	struct msg_flow *msg;
+	size_t total;
+
+	msg = map_lookup_elem(&flow_heap, &(__u32){ 0 });
+	if (!msg)
+		return;
+
+	set_event_from_sock(&msg->sock, sk);
+	msg->current = value->current;
+	msg->start = value->start;
+	msg->bytes_sent = value->bytes_sent;
+	msg->bytes_received = value->bytes_received;
+	msg->segs_out = value->segs_out;
+	msg->segs_in = value->segs_in;
+	msg->direction = value->direction;
+	msg->end = end;
+
+	total = sizeof(struct msg_flow);
+	msg->common.size = total;
+	msg->common.ktime = tg_get_ktime();
+	msg->common.op = MSG_OP_FLOW;
+	msg->common.flags = 0;
+
+	event_output_metric(ctx, MSG_OP_FLOW, msg, total);
+}
+
+FUNC_INLINE void
+flow_activity(void *ctx, struct sock *sk)
+{
+	struct flow_value *value;
+	__u64 key = (__u64)sk;
+	__u64 now;
+
+	value = map_lookup_elem(&flow_map, &key);
+	if (!value)
+		return;
+
+	flow_read_counters(value, sk);
+	if (!FLOW_REPORT_INTERVAL)
+		return;
+
+	now = tg_get_ktime();
+	if (now - value->last_report < FLOW_REPORT_INTERVAL)
+		return;
+	value->last_report = now;
+	flow_report(ctx, sk, value, 0);
+}
+
+__attribute__((section("kprobe/tcp_connect"), used)) int
+flow_tcp_connect(struct pt_regs *ctx)
+{
+	struct sock *sk = (struct sock *)PT_REGS_PARM1_CORE(ctx);
+
+	flow_start(sk, FLOW_DIRECTION_EGRESS);
+	return 0;
+}
+
+__attribute__((section("kprobe/inet_csk_accept"), used)) int
+flow_inet_csk_accept(struct pt_regs *ctx)
+{
+	struct sock *sk = (struct sock *)PT_REGS_RC_CORE(ctx);
+	__u16 protocol = 0;
+
+	if (!sk)
+		return 0;
+
+	/* inet_csk_accept is also used by other connection oriented
+	 * protocols (SCTP, DCCP), we only support TCP for now.
+	 */
+	probe_read(&protocol, sizeof(protocol), _(&sk->sk_protocol));
+	if (bpf_core_field_size(sk->sk_protocol) == 4)
+		protocol = protocol >> 8;
+	if (protocol != IPPROTO_TCP)
+		return 0;
+
+	flow_start(sk, FLOW_DIRECTION_INGRESS);
+	return 0;
+}
+
+__attribute__((section("kprobe/tcp_sendmsg"), used)) int
+flow_tcp_sendmsg(struct pt_regs *ctx)
+{
+	flow_activity(ctx, (struct sock *)PT_REGS_PARM1_CORE(ctx));
+	return 0;
+}
+
+__attribute__((section("kprobe/tcp_cleanup_rbuf"), used)) int
+flow_tcp_cleanup_rbuf(struct pt_regs *ctx)
+{
+	flow_activity(ctx, (struct sock *)PT_REGS_PARM1_CORE(ctx));
+	return 0;
+}
+
+__attribute__((section("kprobe/tcp_close"), used)) int
+flow_tcp_close(struct pt_regs *ctx)
+{
+	struct sock *sk = (struct sock *)PT_REGS_PARM1_CORE(ctx);
+	struct flow_value *value;
+	__u64 key = (__u64)sk;
+
+	value = map_lookup_elem(&flow_map, &key);
+	if (!value)
+		return 0;
+
+	flow_read_counters(value, sk);
+	flow_report(ctx, sk, value, 1);
+	map_delete_elem(&flow_map, &key);
+	return 0;
+}
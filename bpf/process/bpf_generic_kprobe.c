@@ -52,7 +52,10 @@ struct {
 #include "generic_maps.h"
 #include "generic_calls.h"
 
-#ifdef __MULTI_KPROBE
+#if defined(__FENTRY)
+#define MAIN   "fentry/generic_kprobe"
+#define COMMON "fentry"
+#elif defined(__MULTI_KPROBE)
 #define MAIN	 "kprobe.multi/generic_kprobe"
 #define OVERRIDE "kprobe.multi/generic_kprobe_override"
 #define COMMON	 "kprobe.multi"
@@ -174,6 +177,10 @@ generic_kprobe_path(void *ctx)
 }
 #endif
 
+/* fentry programs cannot override the return value, policies with override
+ * actions are attached with kprobes.
+ */
+#ifndef __FENTRY
 __attribute__((section(OVERRIDE), used)) int
 generic_kprobe_override(void *ctx)
 {
@@ -188,6 +195,7 @@ generic_kprobe_override(void *ctx)
 	map_delete_elem(&override_tasks, &id);
 	return 0;
 }
+#endif
 
 /* Putting security_task_prctl in here to pass contrib/verify/verify.sh test,
  * in normal run the function is set by tetragon dynamically.
@@ -39,7 +39,10 @@ struct {
 #include "generic_maps.h"
 #include "generic_calls.h"
 
-#ifdef __MULTI_KPROBE
+#if defined(__FENTRY)
+#define MAIN   "fexit/generic_retkprobe"
+#define COMMON "fexit"
+#elif defined(__MULTI_KPROBE)
 #define MAIN   "kprobe.multi/generic_retkprobe"
 #define COMMON "kprobe.multi"
 #else
@@ -47,11 +50,25 @@ struct {
 #define COMMON "kprobe"
 #endif
 
+#ifdef __FENTRY
+__attribute__((section((MAIN)), used)) int
+generic_retkprobe_event(void *ctx)
+{
+	__u64 ret = 0;
+
+	/* the return value follows the arguments in the fexit context, the
+	 * helper finds it without knowing the number of arguments.
+	 */
+	get_func_ret(ctx, &ret);
+	return generic_retprobe(ctx, (struct bpf_map_def *)&retkprobe_calls, ret);
+}
+#else
 __attribute__((section((MAIN)), used)) int
 BPF_KRETPROBE(generic_retkprobe_event, unsigned long ret)
 {
 	return generic_retprobe(ctx, (struct bpf_map_def *)&retkprobe_calls, ret);
 }
+#endif
 
 #ifdef __LARGE_BPF_PROG
 __attribute__((section(COMMON), used)) int
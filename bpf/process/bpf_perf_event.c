@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: (GPL-2.0-only OR BSD-2-Clause)
+/* Copyright Authors of Cilium */
+
+#include "vmlinux.h"
+#include "api.h"
+#include "compiler.h"
+#include "bpf_tracing.h"
+#include "bpf_helpers.h"
+#include "bpf_event.h"
+#include "bpf_task.h"
+#include "policy_filter.h"
+
+char _license[] __attribute__((section("license"), used)) = "Dual BSD/GPL";
+
+/* perf_event sensor
+ *
+ * The perf_event sensor samples the stacks of the tasks running on each CPU,
+ * at the frequency of the perf event it is attached to. Samples are counted
+ * in perf_counts by cgroup, process and stack ids. User space periodically
+ * reads and clears perf_counts, resolves the stacks from perf_stack_map, and
+ * aggregates them in a stack trace tree.
+ *
+ * The idle task and tasks that the policy does not apply to are not sampled.
+ */
+
+#define PERF_MAX_ENTRIES     16384
+#define PERF_MAX_STACK_DEPTH 127
+
+struct perf_config {
+	__u32 policy_id;
+	__u8 kernel;
+	__u8 user;
+	__u8 pad[2];
+};
+
+struct perf_key {
+	__u64 cgroup_id;
+	__u32 pid;
+	__u32 pad;
+	__u64 ktime;
+	__s32 kernel_stack_id;
+	__s32 user_stack_id;
+};
+
+struct {
+	__uint(type, BPF_MAP_TYPE_ARRAY);
+	__uint(max_entries, 1);
+	__type(key, __u32);
+	__type(value, struct perf_config);
+} perf_config_map SEC(".maps");
+
+struct {
+	__uint(type, BPF_MAP_TYPE_STACK_TRACE);
+	__uint(max_entries, PERF_MAX_ENTRIES);
+	__uint(key_size, sizeof(__u32));
+	__uint(value_size, sizeof(__u64) * PERF_MAX_STACK_DEPTH);
+} perf_stack_map SEC(".maps");
+
+struct {
+	__uint(type, BPF_MAP_TYPE_HASH);
+	__uint(max_entries, PERF_MAX_ENTRIES);
+	__type(key, struct perf_key);
+	__type(value, __u64);
+} perf_counts SEC(".maps");
+
+__attribute__((section("perf_event"), used)) int
+perf_event_sample(struct bpf_perf_event_data *ctx)
+{
+	struct execve_map_value *curr;
+	struct perf_config *config;
+	struct perf_key key = {};
+	__u64 *count, one = 1;
+	__u32 tgid;
+
+	tgid = get_current_pid_tgid() >> 32;
+	if (!tgid)
+		return 0;
+
+	config = map_lookup_elem(&perf_config_map, &(__u32){ 0 });
+	if (!config)
+		return 0;
+	if (!policy_filter_check(config->policy_id))
+		return 0;
+
+	key.pid = tgid;
+	curr = execve_map_get_noinit(tgid);
+	if (curr) {
+		key.pid = curr->key.pid;
+		key.ktime = curr->key.ktime;
+	}
+	key.cgroup_id = tg_get_current_cgroup_id();
+	key.kernel_stack_id = -1;
+	key.user_stack_id = -1;
+	if (config->kernel)
+		key.kernel_stack_id = get_stackid(ctx, &perf_stack_map, 0);
+	if (config->user)
+		key.user_stack_id = get_stackid(ctx, &perf_stack_map, BPF_F_USER_STACK);
+	if (key.kernel_stack_id < 0 && key.user_stack_id < 0)
+		return 0;
+
+	count = map_lookup_elem(&perf_counts, &key);
+	if (count) {
+		__sync_fetch_and_add(count, 1);
+		return 0;
+	}
+	map_update_elem(&perf_counts, &key, &one, BPF_NOEXIST);
+	return 0;
+}
@@ -0,0 +1,361 @@
+// SPDX-License-Identifier: (GPL-2.0-only OR BSD-2-Clause)
+/* Copyright Authors of Cilium */
+
+#include "vmlinux.h"
+#include "api.h"
+#include "compiler.h"
+#include "bpf_tracing.h"
+#include "bpf_helpers.h"
+#include "bpf_event.h"
+#include "bpf_task.h"
+#include "bpf_ktime.h"
+#include "types/sock.h"
+
+char _license[] __attribute__((section("license"), used)) = "Dual BSD/GPL";
+
+/* TLS plaintext sensor
+ *
+ * The TLS sensor captures the data that processes write to and read from TLS
+ * connections, before it is encrypted and after it is decrypted, by probing
+ * the TLS libraries in user space:
+ *  - OpenSSL: SSL_write, SSL_read and their _ex variants. The buffer is saved
+ *    on entry and the data is captured on return, once its size is known.
+ *  - Go crypto/tls: (*Conn).Write and (*Conn).Read. Go functions cannot be
+ *    probed with uretprobes since goroutine stacks can be moved, so user space
+ *    attaches tls_go_ret to each return instruction of the functions instead.
+ *    Calls are tracked per goroutine, since goroutines move between threads.
+ *
+ * TLS libraries do not expose the socket they use, so we report the socket
+ * on which the thread last sent or received data (security_socket_sendmsg,
+ * sock_recvmsg). These are only tracked for processes that use TLS.
+ *
+ * Only the first TLS_MAX_SIZE - 1 bytes of the data are captured.
+ */
+
+#define TLS_MAX_SIZE 4096
+
+enum {
+	TLS_LIBRARY_OPENSSL = 1,
+	TLS_LIBRARY_GO = 2,
+};
+
+enum {
+	TLS_DIRECTION_WRITE = 1,
+	TLS_DIRECTION_READ = 2,
+};
+
+#if defined(__TARGET_ARCH_x86)
+#define GO_PARM2(x) ((x)->bx)
+#define GO_RC(x)    ((x)->ax)
+#define GO_G(x)	    ((x)->r14)
+#elif defined(__TARGET_ARCH_arm64)
+#define GO_PARM2(x) (((struct user_pt_regs *)(x))->regs[1])
+#define GO_RC(x)    (((struct user_pt_regs *)(x))->regs[0])
+#define GO_G(x)	    (((struct user_pt_regs *)(x))->regs[28])
+#endif
+
+#ifdef __V511_BPF_PROG
+#define tls_read_user probe_read_user
+#else
+#define tls_read_user probe_read
+#endif
+
+struct msg_tls {
+	struct msg_common common;
+	struct msg_execve_key current;
+	struct sk_type sock;
+	__u32 size;
+	__u32 orig_size;
+	__u8 library;
+	__u8 direction;
+	__u8 has_sock;
+	__u8 pad[5];
+	char data[TLS_MAX_SIZE];
+};
+
+struct tls_call {
+	unsigned long buf;
+	/* size argument of SSL_write_ex and SSL_read_ex */
+	unsigned long size_ptr;
+	__u8 direction;
+	__u8 pad[7];
+};
+
+struct tls_go_key {
+	__u32 tgid;
+	__u32 pad;
+	/* address of the goroutine (g) */
+	__u64 g;
+};
+
+struct {
+	__uint(type, BPF_MAP_TYPE_PERCPU_ARRAY);
+	__uint(max_entries, 1);
+	__type(key, __u32);
+	__type(value, struct msg_tls);
+} tls_heap SEC(".maps");
+
+/* processes that use TLS, by tgid */
+struct {
+	__uint(type, BPF_MAP_TYPE_LRU_HASH);
+	__uint(max_entries, 8192);
+	__type(key, __u32);
+	__type(value, __u8);
+} tls_procs SEC(".maps");
+
+/* last socket used by each thread of the processes that use TLS */
+struct {
+	__uint(type, BPF_MAP_TYPE_LRU_HASH);
+	__uint(max_entries, 32768);
+	__type(key, __u64);
+	__type(value, __u64);
+} tls_sock_map SEC(".maps");
+
+/* OpenSSL calls in progress, by thread */
+struct {
+	__uint(type, BPF_MAP_TYPE_LRU_HASH);
+	__uint(max_entries, 8192);
+	__type(key, __u64);
+	__type(value, struct tls_call);
+} tls_call_map SEC(".maps");
+
+/* Go calls in progress, by goroutine */
+struct {
+	__uint(type, BPF_MAP_TYPE_LRU_HASH);
+	__uint(max_entries, 8192);
+	__type(key, struct tls_go_key);
+	__type(value, struct tls_call);
+} tls_go_call_map SEC(".maps");
+
+FUNC_INLINE void
+tls_record_sock(struct socket *sock)
+{
+	struct sock_common *common;
+	__u16 family = 0;
+	struct sock *sk;
+	__u64 id, key;
+	__u32 tgid;
+
+	id = get_current_pid_tgid();
+	tgid = id >> 32;
+	if (!map_lookup_elem(&tls_procs, &tgid))
+		return;
+
+	sk = BPF_CORE_READ(sock, sk);
+	if (!sk)
+		return;
+	common = (struct sock_common *)sk;
+	probe_read(&family, sizeof(family), _(&common->skc_family));
+	if (family != AF_INET && family != AF_INET6)
+		return;
+
+	key = (__u64)sk;
+	map_update_elem(&tls_sock_map, &id, &key, BPF_ANY);
+}
+
+FUNC_INLINE void
+tls_output(void *ctx, unsigned long buf, long size, __u8 library, __u8 direction)
+{
+	struct execve_map_value *curr;
+	struct msg_tls *e;
+	size_t total;
+	__u64 id, *sk;
+	long len;
+
+	if (!buf || size <= 0)
+		return;
+
+	id = get_current_pid_tgid();
+	curr = execve_map_get_noinit(id >> 32);
+	if (!curr)
+		return;
+
+	e = map_lookup_elem(&tls_heap, &(__u32){ 0 });
+	if (!e)
+		return;
+
+	len = size;
+	if (len >= TLS_MAX_SIZE)
+		len = TLS_MAX_SIZE - 1;
+	asm volatile("%[len] &= 0xfff;\n"
+		     : [len] "+r"(len));
+	if (tls_read_user(&e->data[0], len, (char *)buf) < 0)
+		return;
+
+	sk = map_lookup_elem(&tls_sock_map, &id);
+	if (sk && *sk) {
+		set_event_from_sock(&e->sock, (struct sock *)*sk);
+		e->has_sock = 1;
+	} else {
+		__builtin_memset(&e->sock, 0, sizeof(e->sock));
+		e->has_sock = 0;
+	}
+	e->current.pid = curr->key.pid;
+	e->current.ktime = curr->key.ktime;
+	e->size = len;
+	e->orig_size = size;
+	e->library = library;
+	e->direction = direction;
+
+	total = offsetof(struct msg_tls, data) + len;
+	e->common.size = total;
+	e->common.ktime = tg_get_ktime();
+	e->common.op = MSG_OP_TLS;
+	e->common.flags = 0;
+
+	event_output_metric(ctx, MSG_OP_TLS, e, total);
+}
+
+FUNC_INLINE void
+tls_mark_proc(__u64 id)
+{
+	__u32 tgid = id >> 32;
+	__u8 one = 1;
+
+	map_update_elem(&tls_procs, &tgid, &one, BPF_ANY);
+}
+
+__attribute__((section("kprobe/security_socket_sendmsg"), used)) int
+tls_sendmsg(struct pt_regs *ctx)
+{
+	tls_record_sock((struct socket *)PT_REGS_PARM1_CORE(ctx));
+	return 0;
+}
+
+__attribute__((section("kprobe/sock_recvmsg"), used)) int
+tls_recvmsg(struct pt_regs *ctx)
+{
+	tls_record_sock((struct socket *)PT_REGS_PARM1_CORE(ctx));
+	return 0;
+}
+
+FUNC_INLINE void
+tls_ssl_enter(struct pt_regs *ctx, __u8 direction, bool ex)
+{
+	struct tls_call call = {};
+	__u64 id;
+
+	id = get_current_pid_tgid();
+	call.buf = PT_REGS_PARM2_CORE(ctx);
+	if (ex)
+		call.size_ptr = PT_REGS_PARM4_CORE(ctx);
+	call.direction = direction;
+	map_update_elem(&tls_call_map, &id, &call, BPF_ANY);
+	tls_mark_proc(id);
+}
+
+__attribute__((section("uprobe/tls_ssl_write"), used)) int
+tls_ssl_write(struct pt_regs *ctx)
+{
+	tls_ssl_enter(ctx, TLS_DIRECTION_WRITE, false);
+	return 0;
+}
+
+__attribute__((section("uprobe/tls_ssl_read"), used)) int
+tls_ssl_read(struct pt_regs *ctx)
+{
+	tls_ssl_enter(ctx, TLS_DIRECTION_READ, false);
+	return 0;
+}
+
+__attribute__((section("uprobe/tls_ssl_write_ex"), used)) int
+tls_ssl_write_ex(struct pt_regs *ctx)
+{
+	tls_ssl_enter(ctx, TLS_DIRECTION_WRITE, true);
+	return 0;
+}
+
+__attribute__((section("uprobe/tls_ssl_read_ex"), used)) int
+tls_ssl_read_ex(struct pt_regs *ctx)
+{
+	tls_ssl_enter(ctx, TLS_DIRECTION_READ, true);
+	return 0;
+}
+
+__attribute__((section("uprobe/tls_ssl_ret"), used)) int
+tls_ssl_ret(struct pt_regs *ctx)
+{
+	int ret = (int)PT_REGS_RC_CORE(ctx);
+	unsigned long buf, size_ptr;
+	struct tls_call *call;
+	__u8 direction;
+	long size = ret;
+	__u64 id;
+
+	id = get_current_pid_tgid();
+	call = map_lookup_elem(&tls_call_map, &id);
+	if (!call)
+		return 0;
+	buf = call->buf;
+	size_ptr = call->size_ptr;
+	direction = call->direction;
+	map_delete_elem(&tls_call_map, &id);
+
+	/* SSL_write_ex and SSL_read_ex return 1 on success and the size in
+	 * their last argument.
+	 */
+	if (size_ptr) {
+		size_t n = 0;
+
+		if (ret != 1 || tls_read_user(&n, sizeof(n), (void *)size_ptr) < 0)
+			return 0;
+		size = n;
+	}
+
+	tls_output(ctx, buf, size, TLS_LIBRARY_OPENSSL, direction);
+	return 0;
+}
+
+FUNC_INLINE void
+tls_go_enter(struct pt_regs *ctx, __u8 direction)
+{
+	struct tls_go_key key = {};
+	struct tls_call call = {};
+	__u64 id;
+
+	id = get_current_pid_tgid();
+	key.tgid = id >> 32;
+	key.g = GO_G(ctx);
+	call.buf = GO_PARM2(ctx);
+	call.direction = direction;
+	map_update_elem(&tls_go_call_map, &key, &call, BPF_ANY);
+	tls_mark_proc(id);
+}
+
+__attribute__((section("uprobe/tls_go_write"), used)) int
+tls_go_write(struct pt_regs *ctx)
+{
+	tls_go_enter(ctx, TLS_DIRECTION_WRITE);
+	return 0;
+}
+
+__attribute__((section("uprobe/tls_go_read"), used)) int
+tls_go_read(struct pt_regs *ctx)
+{
+	tls_go_enter(ctx, TLS_DIRECTION_READ);
+	return 0;
+}
+
+__attribute__((section("uprobe/tls_go_ret"), used)) int
+tls_go_ret(struct pt_regs *ctx)
+{
+	struct tls_go_key key = {};
+	struct tls_call *call;
+	unsigned long buf;
+	__u8 direction;
+	long size;
+
+	key.tgid = get_current_pid_tgid() >> 32;
+	key.g = GO_G(ctx);
+	call = map_lookup_elem(&tls_go_call_map, &key);
+	if (!call)
+		return 0;
+	buf = call->buf;
+	direction = call->direction;
+	map_delete_elem(&tls_go_call_map, &key);
+
+	/* the first result, n, is returned in the first register */
+	size = (long)GO_RC(ctx);
+	tls_output(ctx, buf, size, TLS_LIBRARY_GO, direction);
+	return 0;
+}
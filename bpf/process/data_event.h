@@ -81,6 +81,35 @@ error:
 	return err;
 }
 
+#ifdef __LARGE_BPF_PROG
+/* Number of data events sent by do_bytes_full, which covers the 2MB argument
+ * size limit of the default 8MB stack limit.
+ */
+#define DATA_EVENT_FULL_CNT 64
+
+FUNC_LOCAL long
+do_bytes_full(void *ctx, struct msg_data *msg, unsigned long arg, size_t bytes)
+{
+	size_t rd_bytes = 0;
+	int err = 0, i;
+
+	for (i = 0; i < DATA_EVENT_FULL_CNT; i++) {
+		err = __do_bytes(ctx, msg, arg + rd_bytes, bytes - rd_bytes);
+		if (err < 0)
+			goto error;
+		rd_bytes += err;
+		if (rd_bytes == bytes)
+			return rd_bytes;
+	}
+
+	/* leftover */
+	return rd_bytes;
+error:
+	event_output_update_error_metric(MSG_OP_DATA, err);
+	return err;
+}
+#endif /* __LARGE_BPF_PROG */
+
 FUNC_LOCAL long
 __do_str(void *ctx, struct msg_data *msg, unsigned long arg, bool *done)
 {
@@ -202,6 +231,26 @@ data_event_bytes(void *ctx, struct data_event_desc *desc, unsigned long uptr,
 	return data_event(ctx, desc, uptr, size, heap, do_bytes);
 }
 
+#ifdef __LARGE_BPF_PROG
+/**
+ * data_event_bytes_full - sends data event for large raw data
+ *
+ * @uptr: pointer to data
+ * @size: size of the data
+ *
+ * Same as data_event_bytes, but sends up to DATA_EVENT_FULL_CNT data
+ * events instead of 10, so that data of a few megabytes is not truncated.
+ *
+ * Returns size of struct @desc object or 0 in case of error.
+ */
+FUNC_LOCAL size_t
+data_event_bytes_full(void *ctx, struct data_event_desc *desc,
+		      unsigned long uptr, size_t size, struct bpf_map_def *heap)
+{
+	return data_event(ctx, desc, uptr, size, heap, do_bytes_full);
+}
+#endif /* __LARGE_BPF_PROG */
+
 /**
  * data_event_str - sends data event for string
  *
@@ -500,7 +500,7 @@ FUNC_INLINE long get_pt_regs_arg_syscall(struct pt_regs *ctx, __u16 offset, __u8
 }
 
 // TODO let's unite this with read_reg in bpf/process/uprobe_offload.h
-#if defined(GENERIC_KPROBE) || defined(GENERIC_UPROBE)
+#if (defined(GENERIC_KPROBE) && !defined(__FENTRY)) || defined(GENERIC_UPROBE)
 FUNC_INLINE long get_pt_regs_arg(struct pt_regs *ctx, struct event_config *config, int index)
 {
 	struct config_reg_arg *reg;
@@ -719,6 +719,28 @@ generic_process_event_and_setup(struct pt_regs *ctx, struct bpf_map_def *tailcal
 		return 0;
 
 #ifdef GENERIC_KPROBE
+#ifdef __FENTRY
+	/* fentry programs get the function arguments as an array of u64 */
+	struct bpf_raw_tracepoint_args *raw_args = (struct bpf_raw_tracepoint_args *)ctx;
+
+	if (config->syscall) {
+		struct pt_regs *_ctx;
+		_ctx = (struct pt_regs *)BPF_CORE_READ(raw_args, args[0]);
+		if (!_ctx)
+			return 0;
+		e->a0 = PT_REGS_PARM1_CORE_SYSCALL(_ctx);
+		e->a1 = PT_REGS_PARM2_CORE_SYSCALL(_ctx);
+		e->a2 = PT_REGS_PARM3_CORE_SYSCALL(_ctx);
+		e->a3 = PT_REGS_PARM4_CORE_SYSCALL(_ctx);
+		e->a4 = PT_REGS_PARM5_CORE_SYSCALL(_ctx);
+	} else {
+		e->a0 = BPF_CORE_READ(raw_args, args[0]);
+		e->a1 = BPF_CORE_READ(raw_args, args[1]);
+		e->a2 = BPF_CORE_READ(raw_args, args[2]);
+		e->a3 = BPF_CORE_READ(raw_args, args[3]);
+		e->a4 = BPF_CORE_READ(raw_args, args[4]);
+	}
+#else
 	if (config->syscall) {
 		struct pt_regs *_ctx;
 		_ctx = PT_REGS_SYSCALL_REGS(ctx);
@@ -736,6 +758,7 @@ generic_process_event_and_setup(struct pt_regs *ctx, struct bpf_map_def *tailcal
 		e->a3 = PT_REGS_PARM4_CORE(ctx);
 		e->a4 = PT_REGS_PARM5_CORE(ctx);
 	}
+#endif /* __FENTRY */
 
 	generic_process_init(e, MSG_OP_GENERIC_KPROBE);
 
@@ -927,9 +950,17 @@ do_action(void *ctx, __u32 i, struct selector_action *actions, bool *post, bool
 	case ACTION_POST: {
 		__u64 ratelimit_interval __maybe_unused = actions->act[++i];
 		__u64 ratelimit_scope __maybe_unused = actions->act[++i];
+		__u32 sample_mode __maybe_unused = actions->act[++i];
+		__u32 sample_value __maybe_unused = actions->act[++i];
+		__u32 sample_scope __maybe_unused = actions->act[++i];
+		__u32 sample_id __maybe_unused = actions->act[++i];
 #ifdef __LARGE_BPF_PROG
-		if (rate_limit(ratelimit_interval, ratelimit_scope, e))
+		if (rate_limit(ratelimit_interval, ratelimit_scope, e)) {
 			*post = false;
+		} else if (*post && sample_out(sample_mode, sample_value, sample_scope, sample_id, e)) {
+			*post = false;
+			polacct = POLICY_SAMPLED_OUT;
+		}
 #endif /* __LARGE_BPF_PROG */
 		__u32 kernel_stack_trace = actions->act[++i];
 
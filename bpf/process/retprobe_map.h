@@ -120,9 +120,14 @@ retprobe_map_set_iovec(__u64 id, __u64 tid, __u64 ktime, unsigned long ptr,
 FUNC_INLINE __u64 retprobe_map_get_key(struct pt_regs *ctx)
 {
 	__u64 ret = get_current_pid_tgid();
+	/* fentry/fexit programs have no registers to fallback to, so the
+	 * thread id is used as is.
+	 */
+#ifndef __FENTRY
 	if (ret == (__u64)-22) { // -EINVAL -- current == NULL
 		ret = PT_REGS_FP_CORE(ctx);
 	}
+#endif
 	return ret;
 }
 
@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: (GPL-2.0-only OR BSD-2-Clause)
+/* Copyright Authors of Tetragon */
+
+#ifndef __SAMPLE_MAPS_H__
+#define __SAMPLE_MAPS_H__
+
+/* Sample mode. */
+#define ACTION_SAMPLE_NONE	  0
+#define ACTION_SAMPLE_ONE_IN_N	  1
+#define ACTION_SAMPLE_PROBABILITY 2
+
+/* Sample scope. */
+#define ACTION_SAMPLE_SCOPE_GLOBAL  0
+#define ACTION_SAMPLE_SCOPE_PROCESS 1
+#define ACTION_SAMPLE_SCOPE_CGROUP  2
+
+struct sample_key {
+	__u64 func_id;
+	__u32 sample_id;
+	__u32 pad;
+	__u64 scope_id;
+};
+
+/* Number of events seen for each "1 in N" sampled post action and scope. */
+struct {
+	__uint(type, BPF_MAP_TYPE_LRU_HASH);
+	__uint(max_entries, 1); // Agent is resizing this if the feature is needed during sensor load
+	__type(key, struct sample_key);
+	__type(value, __u64);
+} sample_map SEC(".maps");
+
+#endif /* __SAMPLE_MAPS_H__ */
@@ -26,6 +26,7 @@
 #include "process/bpf_enforcer.h"
 #include "../syscall64.h"
 #include "process/ratelimit_maps.h"
+#include "process/sample_maps.h"
 #include "process/heap.h"
 #include "../bpf_mbset.h"
 #include "bpf_ktime.h"
@@ -2468,6 +2469,58 @@ rate_limit(__u64 ratelimit_interval, __u64 ratelimit_scope, struct msg_generic_k
 }
 #endif
 
+#ifdef __LARGE_BPF_PROG
+/* sample_out returns true if the event must not be posted because it was
+ * sampled out.
+ */
+FUNC_INLINE bool
+sample_out(__u32 mode, __u32 value, __u32 scope, __u32 sample_id, struct msg_generic_kprobe *e)
+{
+	struct sample_key key = {};
+	__u64 *seen, cnt, one = 1;
+
+	switch (mode) {
+	case ACTION_SAMPLE_PROBABILITY:
+		/* value is the probability scaled to the u32 range. */
+		return get_prandom_u32() >= value;
+	case ACTION_SAMPLE_ONE_IN_N:
+		break;
+	default:
+		return false;
+	}
+
+	if (value <= 1)
+		return false;
+
+	key.func_id = e->func_id;
+	key.sample_id = sample_id;
+	switch (scope) {
+	case ACTION_SAMPLE_SCOPE_GLOBAL:
+		key.scope_id = 0;
+		break;
+	case ACTION_SAMPLE_SCOPE_PROCESS:
+		key.scope_id = e->current.pid;
+		break;
+	case ACTION_SAMPLE_SCOPE_CGROUP:
+		key.scope_id = tg_get_current_cgroup_id();
+		break;
+	default:
+		return false;
+	}
+
+	seen = map_lookup_elem(&sample_map, &key);
+	if (!seen) {
+		/* The first event of the scope is posted. */
+		map_update_elem(&sample_map, &key, &one, BPF_ANY);
+		return false;
+	}
+	/* Concurrent updates may lose increments, which is fine for sampling. */
+	cnt = *seen;
+	*seen = cnt + 1;
+	return (cnt % value) != 0;
+}
+#endif
+
 #ifdef __LARGE_BPF_PROG
 struct socket_owner {
 	__u32 pid;
@@ -4,16 +4,26 @@
 package bugtool
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/spf13/cobra"
 
+	"github.com/cilium/tetragon/cmd/tetra/common"
 	"github.com/cilium/tetragon/pkg/bugtool"
+	"github.com/cilium/tetragon/pkg/fieldfilters"
 )
 
 var (
-	outFile     string
-	bpfTool     string
-	gops        string
-	maxRecvSize int
+	outFile            string
+	bpfTool            string
+	gops               string
+	maxRecvSize        int
+	remote             bool
+	sections           []string
+	cpuProfileDuration time.Duration
+	redactionFilters   string
 )
 
 type Command struct {
@@ -43,8 +53,43 @@ func New() *Command {
 	bugtoolCmd.command = &cobra.Command{
 		Use:   "bugtool",
 		Short: "Produce a tar archive with debug information",
-		Run: func(_ *cobra.Command, _ []string) {
-			bugtool.Bugtool(outFile, bpfTool, gops, maxRecvSize, bugtoolCmd.CommandActions, bugtoolCmd.GRPCActions)
+		Long: `Produce a tar archive with debug information.
+
+By default, bugtool runs in the agent container and collects information from
+the local files, tools and agent. With --remote, it collects the process cache,
+tracing policies, BPF map dumps, metrics, pprof profiles and recent logs from
+the agent over gRPC instead, so it can run anywhere the agent gRPC server is
+reachable. The agent applies its redaction filters to the process cache and
+to the text files it returns, and both can be scrubbed further with
+--redaction-filters before being written to the archive.`,
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			if remote {
+				return nil
+			}
+			for _, flag := range []string{"sections", "cpu-profile-duration", "redaction-filters"} {
+				if cmd.Flags().Changed(flag) {
+					return fmt.Errorf("--%s requires --remote", flag)
+				}
+			}
+			return nil
+		},
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if !remote {
+				bugtool.Bugtool(outFile, bpfTool, gops, maxRecvSize, bugtoolCmd.CommandActions, bugtoolCmd.GRPCActions)
+				return nil
+			}
+			redaction, err := fieldfilters.ParseRedactionFilterList(redactionFilters)
+			if err != nil {
+				return err
+			}
+			return bugtool.RemoteBugtool(outFile, &bugtool.RemoteOptions{
+				ServerAddr:         common.ResolveServerAddress(),
+				Timeout:            common.Timeout,
+				MaxRecvSize:        maxRecvSize,
+				Sections:           sections,
+				CPUProfileDuration: cpuProfileDuration,
+				Redaction:          redaction,
+			}, bugtoolCmd.GRPCActions)
 		},
 	}
 
@@ -53,5 +98,9 @@ func New() *Command {
 	flags.StringVar(&bpfTool, "bpftool", "", "Path to bpftool binary")
 	flags.StringVar(&gops, "gops", "", "Path to gops binary")
 	flags.IntVar(&maxRecvSize, "max-recv-size", 10*1024*1024, "Size of gRPC maxRecvSize")
+	flags.BoolVar(&remote, "remote", false, "Collect the debug information from the agent over gRPC")
+	flags.StringSliceVar(&sections, "sections", nil, "Sections to collect with --remote, all by default. One or more of: "+strings.Join(bugtool.RemoteSections, ", "))
+	flags.DurationVar(&cpuProfileDuration, "cpu-profile-duration", 5*time.Second, "Duration of the agent CPU profile of the pprof section with --remote, 0 to disable it. The agent caps it to one minute")
+	flags.StringVar(&redactionFilters, "redaction-filters", "", "Redaction filters applied to the process cache and the text files with --remote, on top of the agent ones, in the format of the agent --redaction-filters flag")
 	return bugtoolCmd
 }
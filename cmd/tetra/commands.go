@@ -9,15 +9,18 @@ import (
 	"github.com/cilium/tetragon/cmd/tetra/explain"
 	"github.com/cilium/tetragon/cmd/tetra/getevents"
 	"github.com/cilium/tetragon/cmd/tetra/info"
+	"github.com/cilium/tetragon/cmd/tetra/processtree"
 	"github.com/cilium/tetragon/cmd/tetra/rthooks"
 	"github.com/cilium/tetragon/cmd/tetra/sensors"
 	"github.com/cilium/tetragon/cmd/tetra/stacktracetree"
 	"github.com/cilium/tetragon/cmd/tetra/status"
+	"github.com/cilium/tetragon/cmd/tetra/verifyexport"
 	"github.com/cilium/tetragon/cmd/tetra/version"
 )
 
 // addBaseCommands adds commands that build and make sense on all platform:
-// getevents, version, sensors, stacktracetree, status, rthooks
+// getevents, version, sensors, stacktracetree, status, rthooks, processtree,
+// verifyexport
 func addBaseCommands(rootCmd *cobra.Command) {
 	rootCmd.AddCommand(getevents.New())
 	rootCmd.AddCommand(version.New())
@@ -27,6 +30,8 @@ func addBaseCommands(rootCmd *cobra.Command) {
 	rootCmd.AddCommand(rthooks.New())
 	rootCmd.AddCommand(explain.New())
 	rootCmd.AddCommand(info.New())
+	rootCmd.AddCommand(processtree.New())
+	rootCmd.AddCommand(verifyexport.New())
 
 	// bugtool technically builds on darwin and windows but makes no sense since
 	// it's supposed to be run on the machine running Tetragon, using
@@ -8,6 +8,9 @@ import (
 	"io"
 	"strings"
 	"text/tabwriter"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
 )
@@ -87,3 +90,100 @@ func PrintTracingPolicies(output io.Writer, policies []*tetragon.TracingPolicySt
 	}
 	w.Flush()
 }
+
+func PrintTracingPolicyValidation(output io.Writer, res *tetragon.ValidateTracingPolicyResponse) {
+	if len(res.Hooks) > 0 {
+		w := tabwriter.NewWriter(output, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "SENSOR\tOBJECT\tLABEL\tATTACH\tINSNS\tERROR")
+		for _, hook := range res.Hooks {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n",
+				hook.Sensor,
+				hook.Object,
+				hook.Label,
+				hook.Attach,
+				hook.VerifiedInstructions,
+				hook.Error)
+		}
+		w.Flush()
+		fmt.Fprintln(output)
+	}
+
+	for _, hook := range res.Hooks {
+		if hook.Error != "" && hook.VerifierLog != "" {
+			fmt.Fprintf(output, "verifier log of %s (%s):\n%s\n\n", hook.Label, hook.Attach, hook.VerifierLog)
+		}
+	}
+
+	if res.Valid {
+		fmt.Fprintf(output, "tracing policy %q is valid, predicted kernel memory: %s\n",
+			res.Name, HumanizeByteCount(int(res.KernelMemoryBytes)))
+		return
+	}
+	stage := strings.TrimPrefix(strings.ToLower(res.FailedStage.String()), "tp_validation_stage_")
+	fmt.Fprintf(output, "tracing policy %q is not valid (%s stage): %s\n", res.Name, stage, res.Error)
+}
+
+// PrintPolicyImpactReport prints what a tracing policy in monitor mode would
+// have enforced, with one table per list of the report.
+func PrintPolicyImpactReport(output io.Writer, res *tetragon.GetPolicyImpactReportResponse) {
+	mode := strings.TrimPrefix(strings.ToLower(res.Mode.String()), "tp_mode_")
+	if res.Count == 0 {
+		fmt.Fprintf(output, "tracing policy %q (mode %s): no event would have been enforced\n", res.Name, mode)
+		printMonitorActions(output, res)
+		return
+	}
+	fmt.Fprintf(output, "tracing policy %q (mode %s): %d events would have been enforced, from %s to %s\n",
+		res.Name, mode, res.Count, formatImpactTime(res.FirstSeen), formatImpactTime(res.LastSeen))
+	printMonitorActions(output, res)
+
+	printImpactEntries(output, "ACTION", res.Actions, func(e *tetragon.PolicyImpactEntry) string {
+		return strings.TrimPrefix(strings.ToLower(e.Value), "kprobe_action_")
+	})
+	printImpactEntries(output, "PROCESS\tPID\tBINARY", res.Processes, func(e *tetragon.PolicyImpactEntry) string {
+		return fmt.Sprintf("%s\t%d\t%s", e.Value, e.Pid, e.Binary)
+	})
+	printImpactEntries(output, "BINARY", res.Binaries, nil)
+	printImpactEntries(output, "POD", res.Pods, nil)
+	printImpactEntries(output, "ARGUMENT", res.Arguments, nil)
+
+	if res.Truncated {
+		fmt.Fprintln(output, "\nsome values were not recorded because the limit of entries was reached")
+	}
+}
+
+// printMonitorActions prints the number of actions the kernel counted as not
+// enforced, which also includes the events that did not reach the agent.
+func printMonitorActions(output io.Writer, res *tetragon.GetPolicyImpactReportResponse) {
+	if res.MonitorActions == 0 {
+		return
+	}
+	fmt.Fprintf(output, "%d actions were not enforced according to the kernel, including sampled out, rate limited or lost events\n",
+		res.MonitorActions)
+}
+
+// printImpactEntries prints a table of entries, whose first columns are given
+// by header and formatted by value, or are the value of the entries if value is
+// nil.
+func printImpactEntries(output io.Writer, header string, entries []*tetragon.PolicyImpactEntry, value func(*tetragon.PolicyImpactEntry) string) {
+	if len(entries) == 0 {
+		return
+	}
+	fmt.Fprintln(output)
+	w := tabwriter.NewWriter(output, 0, 0, 3, ' ', 0)
+	fmt.Fprintf(w, "%s\tCOUNT\tFIRST SEEN\tLAST SEEN\n", header)
+	for _, e := range entries {
+		v := e.Value
+		if value != nil {
+			v = value(e)
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", v, e.Count, formatImpactTime(e.FirstSeen), formatImpactTime(e.LastSeen))
+	}
+	w.Flush()
+}
+
+func formatImpactTime(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return "-"
+	}
+	return t.AsTime().UTC().Format(time.RFC3339)
+}
@@ -4,8 +4,14 @@
 package common
 
 import (
+	"bytes"
 	"strconv"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
 )
 
 func TestHumanizeByteCount(t *testing.T) {
@@ -32,3 +38,37 @@ func TestHumanizeByteCount(t *testing.T) {
 		})
 	}
 }
+
+func TestPrintPolicyImpactReport(t *testing.T) {
+	ts := &timestamppb.Timestamp{Seconds: 1700000000}
+	res := &tetragon.GetPolicyImpactReportResponse{
+		Name:           "block-shadow",
+		Mode:           tetragon.TracingPolicyMode_TP_MODE_MONITOR,
+		Count:          2,
+		MonitorActions: 3,
+		FirstSeen:      ts,
+		LastSeen:       ts,
+		Actions: []*tetragon.PolicyImpactEntry{
+			{Value: "KPROBE_ACTION_SIGKILL", Count: 2, FirstSeen: ts, LastSeen: ts},
+		},
+		Processes: []*tetragon.PolicyImpactEntry{
+			{Value: "execid", Count: 2, FirstSeen: ts, LastSeen: ts, Pid: 42, Binary: "/bin/cat"},
+		},
+	}
+
+	var buf bytes.Buffer
+	PrintPolicyImpactReport(&buf, res)
+	assert.Equal(t, `tracing policy "block-shadow" (mode monitor): 2 events would have been enforced, from 2023-11-14T22:13:20Z to 2023-11-14T22:13:20Z
+3 actions were not enforced according to the kernel, including sampled out, rate limited or lost events
+
+ACTION    COUNT   FIRST SEEN             LAST SEEN
+sigkill   2       2023-11-14T22:13:20Z   2023-11-14T22:13:20Z
+
+PROCESS   PID   BINARY     COUNT   FIRST SEEN             LAST SEEN
+execid    42    /bin/cat   2       2023-11-14T22:13:20Z   2023-11-14T22:13:20Z
+`, buf.String())
+
+	buf.Reset()
+	PrintPolicyImpactReport(&buf, &tetragon.GetPolicyImpactReportResponse{Name: "block-shadow", Mode: tetragon.TracingPolicyMode_TP_MODE_ENFORCE})
+	assert.Equal(t, "tracing policy \"block-shadow\" (mode enforce): no event would have been enforced\n", buf.String())
+}
@@ -133,8 +133,34 @@ func getRequest(includeFields, excludeFields []string, filter *tetragon.Filter)
 	}
 }
 
-func getEvents(ctx context.Context, client tetragon.FineGuidanceSensorsClient) error {
+// resumeCursor tracks the position of the last received event, so that a
+// reconnecting client can resume the stream from there.
+type resumeCursor struct {
+	cursor *tetragon.EventCursor
+}
+
+func (c *resumeCursor) update(res *tetragon.GetEventsResponse) {
+	if c == nil {
+		return
+	}
+	// Prefer the sequence number set by agents running with an event
+	// replay buffer, and fall back to the event time otherwise.
+	if seq := res.GetSequence(); seq != 0 {
+		c.cursor = &tetragon.EventCursor{
+			Position: &tetragon.EventCursor_Sequence{Sequence: seq},
+		}
+	} else if t := res.GetTime(); t != nil {
+		c.cursor = &tetragon.EventCursor{
+			Position: &tetragon.EventCursor_Time{Time: t},
+		}
+	}
+}
+
+func getEvents(ctx context.Context, client tetragon.FineGuidanceSensorsClient, resume *resumeCursor) error {
 	request := getRequest(Options.IncludeFields, Options.ExcludeFields, GetFilter())
+	if resume != nil {
+		request.ResumeFrom = resume.cursor
+	}
 	stream, err := client.GetEvents(ctx, request)
 	if err != nil {
 		return fmt.Errorf("failed to call GetEvents: %w", err)
@@ -151,6 +177,7 @@ func getEvents(ctx context.Context, client tetragon.FineGuidanceSensorsClient) e
 		if err = eventEncoder.Encode(res); err != nil {
 			return fmt.Errorf("failed to encode event %#v: %w", res, err)
 		}
+		resume.update(res)
 	}
 }
 
@@ -171,7 +198,11 @@ redirection of events to the stdin. Examples:
   tetra getevents -F parent
 
   # Include only process and parent.pod fields
-  tetra getevents -f process,parent.pod`,
+  tetra getevents -f process,parent.pod
+
+  # Keep reconnecting, resuming from the last received event if the agent
+  # runs with an event replay buffer (--event-replay-dir)
+  tetra getevents --reconnect`,
 		PreRunE: func(_ *cobra.Command, _ []string) error {
 			if Options.Output != "json" && Options.Output != "compact" {
 				return fmt.Errorf("invalid value for %q flag: %s", common.KeyOutput, Options.Output)
@@ -202,10 +233,16 @@ redirection of events to the stdin. Examples:
 			fi, _ := os.Stdin.Stat()
 			if fi.Mode()&os.ModeNamedPipe != 0 {
 				// read events from stdin
-				return getEvents(context.Background(), newIOReaderClient(os.Stdin, common.Debug))
+				return getEvents(context.Background(), newIOReaderClient(os.Stdin, common.Debug), nil)
 			}
 
 			reconnect := Options.Reconnect
+			// When reconnecting, resume from the last received event so
+			// that events buffered by the agent in the meantime are not lost.
+			var resume *resumeCursor
+			if reconnect {
+				resume = &resumeCursor{}
+			}
 			tryGetEvents := func() error {
 				// connect to server
 				c, err := common.NewClientWithDefaultContextAndAddress()
@@ -213,7 +250,7 @@ redirection of events to the stdin. Examples:
 					return fmt.Errorf("failed create gRPC client: %w", err)
 				}
 				defer c.Close()
-				ret := getEvents(c.SignalCtx, c.Client)
+				ret := getEvents(c.SignalCtx, c.Client, resume)
 				if ctxErr := c.SignalCtx.Err(); ctxErr != nil && errors.Is(ctxErr, context.Canceled) {
 					// we got a signal, so we should not try to reconnect
 					reconnect = false
@@ -263,7 +300,7 @@ redirection of events to the stdin. Examples:
 	flags.StringSliceVar(&Options.PolicyNames, "policy-names", nil, "Get events by tracing policy names")
 	flags.StringSliceVar(&Options.NamespaceRegex, "namespace-regex", nil, "Get events by namespace name regex")
 	flags.StringSliceVar(&Options.CelExpression, "cel-expression", nil, "Get events satisfying the CEL expression")
-	flags.BoolVar(&Options.Reconnect, "reconnect", false, "Keep trying to connect even if an error occurred")
+	flags.BoolVar(&Options.Reconnect, "reconnect", false, "Keep trying to connect even if an error occurred, resuming from the last received event")
 	flags.DurationVar(&Options.ReconnectWait, "reconnect-wait", 2*time.Second, "wait time before attempting to reconnect")
 	return &cmd
 }
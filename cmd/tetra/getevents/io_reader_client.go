@@ -148,3 +148,19 @@ func (i *ioReaderClient) SetDebug(_ context.Context, _ *tetragon.SetDebugRequest
 func (i *ioReaderClient) ConfigureTracingPolicy(_ context.Context, _ *tetragon.ConfigureTracingPolicyRequest, _ ...grpc.CallOption) (*tetragon.ConfigureTracingPolicyResponse, error) {
 	panic("stub")
 }
+
+func (i *ioReaderClient) ValidateTracingPolicy(_ context.Context, _ *tetragon.ValidateTracingPolicyRequest, _ ...grpc.CallOption) (*tetragon.ValidateTracingPolicyResponse, error) {
+	panic("stub")
+}
+
+func (i *ioReaderClient) GetPolicyImpactReport(_ context.Context, _ *tetragon.GetPolicyImpactReportRequest, _ ...grpc.CallOption) (*tetragon.GetPolicyImpactReportResponse, error) {
+	panic("stub")
+}
+
+func (i *ioReaderClient) GetProcessTree(_ context.Context, _ *tetragon.GetProcessTreeRequest, _ ...grpc.CallOption) (*tetragon.GetProcessTreeResponse, error) {
+	panic("stub")
+}
+
+func (i *ioReaderClient) GetBugtoolFiles(_ context.Context, _ *tetragon.GetBugtoolFilesRequest, _ ...grpc.CallOption) (grpc.ServerStreamingClient[tetragon.GetBugtoolFilesResponse], error) {
+	panic("stub")
+}
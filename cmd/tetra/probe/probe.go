@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cilium/tetragon/pkg/bpf"
+	"github.com/cilium/tetragon/pkg/config"
 )
 
 func checkCapSysAdmin() (bool, error) {
@@ -25,6 +26,19 @@ func checkCapSysAdmin() (bool, error) {
 	return caps.Effective&(1<<unix.CAP_SYS_ADMIN) != 0, nil
 }
 
+// kprobeAttachTypes returns the types of programs that kprobe specs can be
+// attached with, see the kprobe-attach-type policy option.
+func kprobeAttachTypes() string {
+	types := []string{"kprobe"}
+	if bpf.HasKprobeMulti() {
+		types = append(types, "kprobe_multi")
+	}
+	if bpf.HasFentry() && config.EnableV61Progs() {
+		types = append(types, "fentry")
+	}
+	return strings.Join(types, ",")
+}
+
 func New() *cobra.Command {
 	cmd := cobra.Command{
 		Use:   "probe",
@@ -40,6 +54,7 @@ func New() *cobra.Command {
 		},
 		Run: func(cmd *cobra.Command, _ []string) {
 			cmd.Println(strings.ReplaceAll(bpf.LogFeatures(), ", ", "\n"))
+			cmd.Println("kprobe_attach_types: " + kprobeAttachTypes())
 		},
 	}
 
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package processtree
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/cmd/tetra/common"
+)
+
+func New() *cobra.Command {
+	var (
+		execID string
+		pid    uint32
+		pod    string
+		depth  uint32
+		output string
+	)
+
+	ret := &cobra.Command{
+		Use:   "process-tree",
+		Short: "Print the lineage of processes from the agent process cache",
+		Long: `Print the ancestors and the descendants of a process, or of the processes of
+a pod, as seen by the process cache of the agent. Use the JSON output format
+for full output, including refcount operations.`,
+		Example: `tetra process-tree --exec-id OjIwMjcwMzk0NjU1NjQyOjEyMzQ=
+tetra process-tree --pid 1234 --depth 2
+tetra process-tree --pod default/xwing`,
+		Args: cobra.ExactArgs(0),
+		PreRunE: func(cmd *cobra.Command, _ []string) error {
+			if output != "json" && output != "text" {
+				return fmt.Errorf("invalid value for %q flag: %s", common.KeyOutput, output)
+			}
+			if pod != "" && !strings.Contains(pod, "/") {
+				return fmt.Errorf("invalid value for %q flag: %s, expected <namespace>/<name>", "pod", pod)
+			}
+			flags := cmd.Flags()
+			if !flags.Changed("exec-id") && !flags.Changed("pid") && !flags.Changed("pod") {
+				return errors.New("one of --exec-id, --pid or --pod is required")
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			req := &tetragon.GetProcessTreeRequest{MaxDepth: depth}
+			switch {
+			case execID != "":
+				req.Selector = &tetragon.GetProcessTreeRequest_ExecId{ExecId: execID}
+			case pod != "":
+				namespace, name, _ := strings.Cut(pod, "/")
+				req.Selector = &tetragon.GetProcessTreeRequest_Pod{
+					Pod: &tetragon.ProcessTreePod{Namespace: namespace, Name: name},
+				}
+			default:
+				req.Selector = &tetragon.GetProcessTreeRequest_Pid{Pid: pid}
+			}
+
+			c, err := common.NewClientWithDefaultContextAndAddress()
+			if err != nil {
+				return fmt.Errorf("failed create gRPC client: %w", err)
+			}
+			defer c.Close()
+
+			res, err := c.Client.GetProcessTree(c.Ctx, req)
+			if err != nil {
+				return fmt.Errorf("failed to get process tree: %w", err)
+			}
+
+			switch output {
+			case "json":
+				b, err := res.MarshalJSON()
+				if err != nil {
+					return fmt.Errorf("failed to generate json: %w", err)
+				}
+				cmd.Println(string(b))
+			case "text":
+				PrintTree(cmd.OutOrStdout(), res)
+			}
+			return nil
+		},
+	}
+
+	flags := ret.Flags()
+	flags.StringVar(&execID, "exec-id", "", "Exec ID of the process")
+	flags.Uint32Var(&pid, "pid", 0, "PID of the process, the most recently started process is used if several have this PID")
+	flags.StringVar(&pod, "pod", "", "Pod of the processes, as <namespace>/<name>")
+	flags.Uint32Var(&depth, "depth", 0, "Maximum depth of the descendants, 0 means no limit")
+	flags.StringVarP(&output, common.KeyOutput, "o", "text", "Output format. text or json")
+	ret.MarkFlagsMutuallyExclusive("exec-id", "pid", "pod")
+	return ret
+}
+
+// PrintTree writes the process tree as text, with the ancestors of the
+// selected process above it.
+func PrintTree(w io.Writer, res *tetragon.GetProcessTreeResponse) {
+	roots := res.Roots
+	// Ancestors are ordered from the parent to the oldest one, so wrap the
+	// selected process in each of them in turn.
+	for _, ancestor := range res.Ancestors {
+		roots = []*tetragon.ProcessTreeNode{{
+			Process:  ancestor.Process,
+			ExitTime: ancestor.ExitTime,
+			Children: roots,
+		}}
+	}
+	for _, root := range roots {
+		fmt.Fprintln(w, nodeString(root))
+		printChildren(w, root, "")
+	}
+}
+
+func printChildren(w io.Writer, node *tetragon.ProcessTreeNode, prefix string) {
+	for i, child := range node.Children {
+		connector, childPrefix := "├─ ", "│  "
+		if i == len(node.Children)-1 {
+			connector, childPrefix = "└─ ", "   "
+		}
+		fmt.Fprintln(w, prefix+connector+nodeString(child))
+		printChildren(w, child, prefix+childPrefix)
+	}
+}
+
+func nodeString(node *tetragon.ProcessTreeNode) string {
+	proc := node.GetProcess().GetProcess()
+	var sb strings.Builder
+	sb.WriteString(proc.GetBinary())
+	if args := proc.GetArguments(); args != "" {
+		sb.WriteString(" " + args)
+	}
+	fmt.Fprintf(&sb, " (pid=%d exec_id=%s", proc.GetPid().GetValue(), proc.GetExecId())
+	if pod := proc.GetPod(); pod != nil {
+		fmt.Fprintf(&sb, " pod=%s/%s", pod.GetNamespace(), pod.GetName())
+	}
+	if start := proc.GetStartTime(); start != nil {
+		sb.WriteString(" start=" + start.AsTime().Format(time.RFC3339Nano))
+	}
+	if exit := node.GetExitTime(); exit != nil {
+		sb.WriteString(" exit=" + exit.AsTime().Format(time.RFC3339Nano))
+	}
+	fmt.Fprintf(&sb, " refcnt=%d)", node.GetProcess().GetRefcnt().GetValue())
+	return sb.String()
+}
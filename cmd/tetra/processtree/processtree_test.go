@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package processtree
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+func node(binary string, pid uint32, children ...*tetragon.ProcessTreeNode) *tetragon.ProcessTreeNode {
+	return &tetragon.ProcessTreeNode{
+		Process: &tetragon.ProcessInternal{
+			Process: &tetragon.Process{
+				Binary: binary,
+				ExecId: binary,
+				Pid:    &wrapperspb.UInt32Value{Value: pid},
+			},
+			Refcnt: &wrapperspb.UInt32Value{Value: 1},
+		},
+		Children: children,
+	}
+}
+
+func TestPrintTree(t *testing.T) {
+	ls := node("ls", 202, node("cat", 203))
+	ls.ExitTime = &timestamppb.Timestamp{Seconds: 1}
+	res := &tetragon.GetProcessTreeResponse{
+		Ancestors: []*tetragon.ProcessTreeNode{node("containerd", 100), node("init", 1)},
+		Roots:     []*tetragon.ProcessTreeNode{node("sh", 200, ls, node("curl", 201))},
+	}
+
+	var sb strings.Builder
+	PrintTree(&sb, res)
+	assert.Equal(t, `init (pid=1 exec_id=init refcnt=1)
+└─ containerd (pid=100 exec_id=containerd refcnt=1)
+   └─ sh (pid=200 exec_id=sh refcnt=1)
+      ├─ ls (pid=202 exec_id=ls exit=1970-01-01T00:00:01Z refcnt=1)
+      │  └─ cat (pid=203 exec_id=cat refcnt=1)
+      └─ curl (pid=201 exec_id=curl refcnt=1)
+`, sb.String())
+}
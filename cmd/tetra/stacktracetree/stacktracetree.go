@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
@@ -34,9 +35,55 @@ func New() *cobra.Command {
 	}
 	sttCmd.AddCommand(sttPrintCmd)
 
+	var format, outFile string
+	sttExportCmd := &cobra.Command{
+		Use:   "export <tree-name>",
+		Short: "Export stacktrace tree as a pprof profile or folded stacks",
+		Long: `Export stacktrace tree as a gzipped pprof profile, which can be opened with
+"go tool pprof", or as folded stacks, which flamegraph tools take as input.`,
+		Example: `tetra stacktrace-tree export cpu-profile/my-app-cpu --out-file cpu.pb.gz
+tetra stacktrace-tree export cpu-profile/my-app-cpu --format folded | flamegraph.pl > cpu.svg`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if _, ok := sttExportFormats[format]; !ok {
+				return fmt.Errorf("invalid format %q, expected pprof or folded", format)
+			}
+			return nil
+		},
+		RunE: func(_ *cobra.Command, args []string) error {
+			c, err := common.NewClientWithDefaultContextAndAddress()
+			if err != nil {
+				return fmt.Errorf("failed create gRPC client: %w", err)
+			}
+			defer c.Close()
+			return sttExport(c.Ctx, c.Client, args[0], sttExportFormats[format], outFile)
+		},
+	}
+	flags := sttExportCmd.Flags()
+	flags.StringVar(&format, "format", "pprof", "Export format (pprof|folded)")
+	flags.StringVar(&outFile, "out-file", "", "File to write to, standard output if empty")
+	sttCmd.AddCommand(sttExportCmd)
+
 	return sttCmd
 }
 
+var sttExportFormats = map[string]tetragon.StackTraceTreeFormat{
+	"pprof":  tetragon.StackTraceTreeFormat_STACK_TRACE_TREE_FORMAT_PPROF,
+	"folded": tetragon.StackTraceTreeFormat_STACK_TRACE_TREE_FORMAT_FOLDED,
+}
+
+func sttExport(ctx context.Context, client tetragon.FineGuidanceSensorsClient, stt string, format tetragon.StackTraceTreeFormat, outFile string) error {
+	res, err := client.GetStackTraceTree(ctx, &tetragon.GetStackTraceTreeRequest{Name: stt, Format: format})
+	if err != nil {
+		return fmt.Errorf("failed to export stt %s: %w", stt, err)
+	}
+	if outFile == "" {
+		_, err = os.Stdout.Write(res.GetData())
+		return err
+	}
+	return os.WriteFile(outFile, res.GetData(), 0o644)
+}
+
 func sttPrint(ctx context.Context, client tetragon.FineGuidanceSensorsClient, stt string) {
 	res, err := client.GetStackTraceTree(ctx, &tetragon.GetStackTraceTreeRequest{Name: stt})
 	if err != nil {
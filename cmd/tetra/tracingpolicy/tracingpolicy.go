@@ -6,14 +6,19 @@
 package tracingpolicy
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/cmd/tetra/common"
 	"github.com/cilium/tetragon/cmd/tetra/tracingpolicy/generate"
+	"github.com/cilium/tetragon/pkg/btf"
+	"github.com/cilium/tetragon/pkg/policysig"
 	"github.com/cilium/tetragon/pkg/tracingpolicy"
 )
 
@@ -107,6 +112,169 @@ func tpAddCmd() *cobra.Command {
 	return ret
 }
 
+func tpPayloadCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "payload <yaml_file>",
+		Short: "print the payload of a tracing policy to be signed",
+		Long: `Print the payload of a tracing policy to be signed.
+
+The payload is a canonical JSON document holding the apiVersion, kind, name,
+namespace and spec of the policy. Sign it with cosign and store the signature
+in the policy annotations or in a sidecar file, for example:
+
+  tetra tracingpolicy payload policy.yaml > policy.payload
+  cosign sign-blob --key cosign.key --output-signature policy.yaml.sig policy.payload`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			tp, err := tracingpolicy.FromFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to parse tracing policy %s: %w", args[0], err)
+			}
+			payload, err := policysig.Payload(tp)
+			if err != nil {
+				return err
+			}
+			_, err = os.Stdout.Write(payload)
+			return err
+		},
+	}
+}
+
+func tpValidateCmd() *cobra.Command {
+	var output string
+	ret := &cobra.Command{
+		Use:   "validate <yaml_file>",
+		Short: "validate a tracing policy",
+		Long: `Validate a tracing policy against the kernel of the agent.
+
+The policy goes through the same steps as when it is added, up to and including
+loading its BPF programs into the verifier, but nothing is attached and the
+agent state is not modified.`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if output != "json" && output != "text" {
+				return fmt.Errorf("invalid value for %q flag: %s", common.KeyOutput, output)
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := common.NewClientWithDefaultContextAndAddress()
+			if err != nil {
+				return fmt.Errorf("failed create gRPC client: %w", err)
+			}
+			defer c.Close()
+
+			yamlb, err := os.ReadFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read yaml file %s: %w", args[0], err)
+			}
+
+			res, err := c.Client.ValidateTracingPolicy(c.Ctx, &tetragon.ValidateTracingPolicyRequest{
+				Yaml: string(yamlb),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to validate tracing policy: %w", err)
+			}
+
+			switch output {
+			case "json":
+				b, err := res.MarshalJSON()
+				if err != nil {
+					return fmt.Errorf("failed to generate json: %w", err)
+				}
+				cmd.Println(string(b))
+			case "text":
+				common.PrintTracingPolicyValidation(cmd.OutOrStdout(), res)
+			}
+
+			if !res.Valid {
+				return fmt.Errorf("tracing policy %q is not valid", args[0])
+			}
+			return nil
+		},
+	}
+	flags := ret.Flags()
+	flags.StringVarP(&output, common.KeyOutput, "o", "text", "Output format. text or json")
+	return ret
+}
+
+func tpCheckCmd() *cobra.Command {
+	var output, btfDir string
+	ret := &cobra.Command{
+		Use:   "check <yaml_file>",
+		Short: "check a tracing policy against the BTF of multiple kernels",
+		Long: `Check a tracing policy against the BTF of multiple kernels.
+
+Every file of the directory passed with --btf-dir is the vmlinux BTF of a
+kernel, named after the file without its optional .btf extension, for example
+5.15.0-91-generic or 6.1.btf. A <name>.kallsyms file next to it is used as the
+kallsyms snapshot of that kernel, if present. For every hook of the policy and
+every kernel, the command reports whether the hook would load (ok), or why it
+would not (missing symbol, arg type mismatch, unsupported feature). Kernels
+can be of any architecture: syscalls are checked for the architecture (amd64
+or arm64) detected from the syscall functions of each kernel's BTF.
+
+The check only reads files, so it needs neither root nor BPF, and does not
+connect to the agent.`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if output != "json" && output != "text" {
+				return fmt.Errorf("invalid value for %q flag: %s", common.KeyOutput, output)
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tp, err := tracingpolicy.FromFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to parse tracing policy %s: %w", args[0], err)
+			}
+			kernels, err := btf.LoadCompatKernels(btfDir)
+			if err != nil {
+				return err
+			}
+			checks, err := btf.CheckPolicyCompat(tp.TpSpec(), kernels)
+			if err != nil {
+				return fmt.Errorf("failed to check tracing policy: %w", err)
+			}
+
+			switch output {
+			case "json":
+				b, err := json.Marshal(checks)
+				if err != nil {
+					return fmt.Errorf("failed to generate json: %w", err)
+				}
+				cmd.Println(string(b))
+			case "text":
+				printCompatChecks(cmd.OutOrStdout(), checks)
+			}
+
+			for _, c := range checks {
+				if c.Result != btf.CompatOK {
+					return fmt.Errorf("tracing policy %q is not compatible with all kernels", args[0])
+				}
+			}
+			return nil
+		},
+	}
+	flags := ret.Flags()
+	flags.StringVarP(&output, common.KeyOutput, "o", "text", "Output format. text or json")
+	flags.StringVar(&btfDir, "btf-dir", "", "Directory of the vmlinux BTF files of the kernels to check against")
+	ret.MarkFlagRequired("btf-dir")
+	return ret
+}
+
+// printCompatChecks prints the results of a compatibility check, one line per
+// hook and kernel.
+func printCompatChecks(output io.Writer, checks []btf.CompatCheck) {
+	// tabwriter config imitates kubectl default output, i.e. 3 spaces padding
+	w := tabwriter.NewWriter(output, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "HOOK\tKERNEL\tRESULT\tDETAIL")
+	for _, c := range checks {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", c.Hook, c.Kernel, c.Result, c.Detail)
+	}
+	w.Flush()
+}
+
 func tpDelCmd() *cobra.Command {
 	var namespace string
 	ret := &cobra.Command{
@@ -260,6 +428,57 @@ func tpSetModeCmd() *cobra.Command {
 	return ret
 }
 
+func tpImpactCmd() *cobra.Command {
+	var output string
+	ret := &cobra.Command{
+		Use:   "impact <name>",
+		Short: "report what a tracing policy in monitor mode would have enforced",
+		Long: `Report what a tracing policy in monitor mode would have enforced.
+
+The agent records, for the policies in monitor mode, the events whose sigkill,
+signal, override or notify enforcer actions were not applied. The report lists
+the actions, processes, binaries, pods and argument values of these events, with
+their counts and first and last seen times, to help deciding whether the policy
+can be switched to enforce mode.`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if output != "json" && output != "text" {
+				return fmt.Errorf("invalid value for %q flag: %s", common.KeyOutput, output)
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := common.NewClientWithDefaultContextAndAddress()
+			if err != nil {
+				return fmt.Errorf("failed create gRPC client: %w", err)
+			}
+			defer c.Close()
+
+			res, err := c.Client.GetPolicyImpactReport(c.Ctx, &tetragon.GetPolicyImpactReportRequest{
+				Name: args[0],
+			})
+			if err != nil {
+				return fmt.Errorf("failed to get the impact report of tracing policy: %w", err)
+			}
+
+			switch output {
+			case "json":
+				b, err := res.MarshalJSON()
+				if err != nil {
+					return fmt.Errorf("failed to generate json: %w", err)
+				}
+				cmd.Println(string(b))
+			case "text":
+				common.PrintPolicyImpactReport(cmd.OutOrStdout(), res)
+			}
+			return nil
+		},
+	}
+	flags := ret.Flags()
+	flags.StringVarP(&output, common.KeyOutput, "o", "text", "Output format. text or json")
+	return ret
+}
+
 func New() *cobra.Command {
 	tpCmd := &cobra.Command{
 		Use:     "tracingpolicy",
@@ -270,11 +489,15 @@ func New() *cobra.Command {
 	tpCmd.AddCommand(
 		tpModifyCmd(),
 		tpAddCmd(),
+		tpPayloadCmd(),
+		tpValidateCmd(),
+		tpCheckCmd(),
 		tpDelCmd(),
 		tpEnableCmd(),
 		tpDisableCmd(),
 		tpListCmd(),
 		tpSetModeCmd(),
+		tpImpactCmd(),
 		generate.New(),
 	)
 
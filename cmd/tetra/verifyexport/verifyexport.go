@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package verifyexport
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cilium/tetragon/pkg/exporter/integrity"
+)
+
+func New() *cobra.Command {
+	var (
+		keyFile   string
+		noBackups bool
+	)
+
+	ret := &cobra.Command{
+		Use:   "verify-export <file>",
+		Short: "Verify the integrity of a JSON export file and its rotated backups",
+		Long: `Verify the chain of records of a JSON export written by an agent running with
+--export-file-integrity. Rotated backups of the file, compressed or not, are
+verified along with it, oldest first, to detect records that were modified,
+removed or reordered, and rotated files that were truncated.
+
+If the agent uses an HMAC key, the same key must be passed with --key-file.`,
+		Example: `tetra verify-export /var/run/cilium/tetragon/tetragon.log --key-file export.key`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var key []byte
+			if keyFile != "" {
+				var err error
+				key, err = integrity.ReadKey(keyFile)
+				if err != nil {
+					return fmt.Errorf("failed to read key: %w", err)
+				}
+			}
+
+			files := []string{args[0]}
+			if !noBackups {
+				var err error
+				files, err = integrity.Files(args[0])
+				if err != nil {
+					return fmt.Errorf("failed to list export files: %w", err)
+				}
+				if len(files) == 0 {
+					return fmt.Errorf("no export file found for %s", args[0])
+				}
+			}
+
+			res, err := integrity.Verify(files, key)
+			if err != nil {
+				return err
+			}
+			for _, p := range res.Problems {
+				level := "error"
+				if p.Warning {
+					level = "warning"
+				}
+				cmd.Printf("%s: %s\n", level, p)
+			}
+			cmd.Printf("verified %d records in %d files\n", res.Records, len(res.Files))
+			if !res.OK() {
+				return errors.New("export integrity verification failed")
+			}
+			return nil
+		},
+	}
+
+	flags := ret.Flags()
+	flags.StringVar(&keyFile, "key-file", "", "File holding the HMAC key of the export")
+	flags.BoolVar(&noBackups, "no-backups", false, "Only verify the given file, not its rotated backups")
+	return ret
+}
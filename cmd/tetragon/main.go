@@ -33,6 +33,8 @@ import (
 	"github.com/cilium/tetragon/pkg/defaults"
 	"github.com/cilium/tetragon/pkg/encoder"
 	"github.com/cilium/tetragon/pkg/exporter"
+	"github.com/cilium/tetragon/pkg/exporter/integrity"
+	"github.com/cilium/tetragon/pkg/exporter/otlp"
 	"github.com/cilium/tetragon/pkg/fieldfilters"
 	"github.com/cilium/tetragon/pkg/fileutils"
 	"github.com/cilium/tetragon/pkg/filters"
@@ -41,17 +43,22 @@ import (
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/manager"
 	"github.com/cilium/tetragon/pkg/metrics"
+	"github.com/cilium/tetragon/pkg/metrics/definedmetrics"
 	"github.com/cilium/tetragon/pkg/metricsconfig"
 	"github.com/cilium/tetragon/pkg/observer"
 	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/pidfile"
+	"github.com/cilium/tetragon/pkg/policysig"
 	"github.com/cilium/tetragon/pkg/process"
 	"github.com/cilium/tetragon/pkg/ratelimit"
 	"github.com/cilium/tetragon/pkg/reader/node"
+	"github.com/cilium/tetragon/pkg/replay"
 	"github.com/cilium/tetragon/pkg/rthooks"
 	"github.com/cilium/tetragon/pkg/sensors/base"
 	"github.com/cilium/tetragon/pkg/sensors/exec/procevents"
 	"github.com/cilium/tetragon/pkg/server"
+	"github.com/cilium/tetragon/pkg/stacktracetree"
+	sttManager "github.com/cilium/tetragon/pkg/stt"
 	"github.com/cilium/tetragon/pkg/tracingpolicy"
 	"github.com/cilium/tetragon/pkg/unixlisten"
 	"github.com/cilium/tetragon/pkg/version"
@@ -221,6 +228,10 @@ func tetragonExecuteCtx(ctx context.Context, cancel context.CancelFunc, ready fu
 	}
 	option.Config.TracingPolicyDir = filepath.Clean(option.Config.TracingPolicyDir)
 
+	if err := policysig.InitFromOptions(); err != nil {
+		logger.Fatal(log, "Failed to setup tracing policy signature verification", logfields.Error, err)
+	}
+
 	if option.Config.RBSize != 0 && option.Config.RBSizeTotal != 0 {
 		logger.Fatal(log, "Can't specify --rb-size and --rb-size-total together")
 	}
@@ -397,9 +408,16 @@ func tetragonExecuteCtx(ctx context.Context, cancel context.CancelFunc, ready fu
 	if option.Config.MetricsServer != "" {
 		go metricsconfig.EnableMetrics(option.Config.MetricsServer)
 		metricsconfig.InitAllMetrics(metricsconfig.GetRegistry())
+		if option.Config.EventMetricsFile != "" {
+			if err := definedmetrics.InitMetrics(ctx, metricsconfig.GetRegistry(), option.Config.EventMetricsFile); err != nil {
+				return fmt.Errorf("failed to initialize metrics from %s: %w", option.Config.EventMetricsFile, err)
+			}
+		}
 		go metrics.StartPodDeleteHandler()
 		// Handler must be registered before the watcher is started
 		metrics.RegisterPodDeleteHandler()
+	} else if option.Config.EventMetricsFile != "" {
+		log.Warn("Ignoring --" + option.KeyEventMetricsFile + " since the metrics server is disabled")
 	}
 
 	// Probe runtime configuration and do not fail on errors
@@ -437,6 +455,16 @@ func tetragonExecuteCtx(ctx context.Context, cancel context.CancelFunc, ready fu
 			} else {
 				node.SetNodeLabels(k8sNode.Labels)
 			}
+			// tracing policies with a node selector are loaded or
+			// unloaded when the labels of the node change.
+			err = controllerManager.AddNodeLabelsHandler(func(labels map[string]string) {
+				if err := observer.GetSensorManager().SetNodeLabels(ctx, labels); err != nil {
+					log.Warn("Failed to update tracing policies after node labels change", logfields.Error, err)
+				}
+			})
+			if err != nil {
+				log.Warn("Failed to watch local Kubernetes node. node_labels field and node selectors will not be updated", logfields.Error, err)
+			}
 		} else {
 			podAccessor = watcher.NewFakeK8sWatcher(nil)
 		}
@@ -481,6 +509,21 @@ func tetragonExecuteCtx(ctx context.Context, cancel context.CancelFunc, ready fu
 	if err = loadInitialSensor(ctx); err != nil {
 		return err
 	}
+	if option.Config.EnableNetworkFlows {
+		if err = loadFlowSensor(ctx); err != nil {
+			return fmt.Errorf("failed to load network flow sensor: %w", err)
+		}
+	}
+	if option.Config.EnableDnsEvents {
+		if err = loadDnsSensor(ctx); err != nil {
+			return fmt.Errorf("failed to load dns sensor: %w", err)
+		}
+	}
+	if option.Config.EnableTlsPlaintext {
+		if err = loadTlsSensor(ctx, obs); err != nil {
+			return fmt.Errorf("failed to load tls plaintext sensor: %w", err)
+		}
+	}
 	observer.GetSensorManager().LogSensorsAndProbes(ctx)
 	defer func() {
 		observer.RemoveSensors(ctx)
@@ -495,6 +538,20 @@ func tetragonExecuteCtx(ctx context.Context, cancel context.CancelFunc, ready fu
 	if err != nil {
 		return err
 	}
+	if option.Config.EventReplayDir != "" {
+		replayBuf, err := replay.New(option.Config.EventReplayDir,
+			int64(option.Config.EventReplayMaxSizeMB)*1024*1024,
+			option.Config.EventReplayMaxAge)
+		if err != nil {
+			return fmt.Errorf("failed to open event replay buffer: %w", err)
+		}
+		defer replayBuf.Close()
+		pm.EnableReplay(replayBuf)
+	}
+	pm.Server.SetBugtoolCollector(bugtool.NewCollector(bpf.MapPrefixPath(), metricsconfig.GetRegistry(),
+		func(ctx context.Context) (*tetragon.ListTracingPoliciesResponse, error) {
+			return pm.Server.ListTracingPolicies(ctx, &tetragon.ListTracingPoliciesRequest{})
+		}))
 	if err = Serve(ctx, option.Config.ServerAddress, pm.Server); err != nil {
 		return err
 	}
@@ -503,12 +560,18 @@ func tetragonExecuteCtx(ctx context.Context, cancel context.CancelFunc, ready fu
 			return err
 		}
 	}
+	if option.Config.ExportOTLPEndpoint != "" {
+		if err = startOTLPExporter(ctx, pm.Server); err != nil {
+			return err
+		}
+	}
 
 	if option.Config.HealthServerAddress != "" {
 		health.StartHealthServer(ctx, option.Config.HealthServerAddress, option.Config.HealthServerInterval)
 	}
 
 	log.Info("Exporter configuration", "enabled", option.Config.ExportFilename != "", "fileName", option.Config.ExportFilename)
+	log.Info("OTLP exporter configuration", "enabled", option.Config.ExportOTLPEndpoint != "", "endpoint", option.Config.ExportOTLPEndpoint)
 	obs.AddListener(pm)
 	saveInitInfo()
 
@@ -518,7 +581,8 @@ func tetragonExecuteCtx(ctx context.Context, cancel context.CancelFunc, ready fu
 	if option.K8SControlPlaneEnabled() && option.Config.EnableTracingPolicyCRD {
 		// add informers for all resources
 		log.Info("Enabling policy informers")
-		err := crdwatcher.AddTracingPolicyInformer(ctx, controllerManager, observer.GetSensorManager())
+		err := crdwatcher.AddTracingPolicyInformer(ctx, controllerManager, observer.GetSensorManager(),
+			option.Config.TracingPolicyStatusUpdatePeriod)
 		if err != nil {
 			return err
 		}
@@ -594,6 +658,11 @@ func loadTpFromDir(ctx context.Context, dir string) error {
 			return nil
 		}
 
+		// signatures are loaded together with their policy file
+		if policysig.IsSidecarFile(file) {
+			return nil
+		}
+
 		return addTracingPolicy(ctx, file)
 	})
 
@@ -611,6 +680,10 @@ func addTracingPolicy(ctx context.Context, file string) error {
 		return err
 	}
 
+	if err := policysig.LoadSidecarFiles(tp, f); err != nil {
+		return err
+	}
+
 	err = observer.GetSensorManager().AddTracingPolicy(ctx, tp)
 	if err != nil {
 		return err
@@ -668,12 +741,34 @@ func getObserverDir() string {
 	return bpf.MapPrefixPath()
 }
 
-func startExporter(ctx context.Context, server *server.Server) error {
+// getExportRequest returns the request used by exporters to subscribe to
+// events, with the configured filters, field filters and aggregation options.
+func getExportRequest() (*tetragon.GetEventsRequest, error) {
 	allowList, denyList, err := getExportFilters()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	fieldFilters, err := getFieldFilters()
+	if err != nil {
+		return nil, err
+	}
+	var aggregationOptions *tetragon.AggregationOptions
+	if option.Config.EnableExportAggregation {
+		aggregationOptions = &tetragon.AggregationOptions{
+			WindowSize:        durationpb.New(option.Config.ExportAggregationWindowSize),
+			ChannelBufferSize: option.Config.ExportAggregationBufferSize,
+			GroupBy:           option.Config.ExportAggregationGroupBy,
+			DistinctFields:    option.Config.ExportAggregationDistinct,
+			MaxGroups:         option.Config.ExportAggregationMaxGroups,
+			MaxDistinctValues: option.Config.ExportAggregationMaxValues,
+		}
+	}
+	log.Info("Configured field filters", "fieldFilters", fieldFilters)
+	return &tetragon.GetEventsRequest{AllowList: allowList, DenyList: denyList, AggregationOptions: aggregationOptions, FieldFilters: fieldFilters}, nil
+}
+
+func startExporter(ctx context.Context, server *server.Server) error {
+	req, err := getExportRequest()
 	if err != nil {
 		return err
 	}
@@ -705,6 +800,32 @@ func startExporter(ctx context.Context, server *server.Server) error {
 		logsDir = filepath.Dir(option.Config.ExportFilename)
 	}
 
+	// Track how many bytes are written to the event export location
+	encoderWriter := exporter.NewExportedBytesTotalWriter(writer)
+	var exportEncoder exporter.ExportEncoder = encoder.NewProtojsonEncoder(encoderWriter)
+	var rotator integrity.Rotator = writer
+	if option.Config.ExportFileIntegrity {
+		var key []byte
+		if option.Config.ExportFileIntegrityKeyFile != "" {
+			key, err = integrity.ReadKey(option.Config.ExportFileIntegrityKeyFile)
+			if err != nil {
+				return fmt.Errorf("failed to read export file integrity key: %w", err)
+			}
+		}
+		integrityEncoder, err := integrity.NewEncoder(option.Config.ExportFilename, encoderWriter, writer, integrity.Options{
+			Key:      key,
+			MaxSize:  int64(option.Config.ExportFileMaxSizeMB) * 1024 * 1024,
+			NodeName: node.GetNodeNameForExport(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to set up export file integrity: %w", err)
+		}
+		log.Info("Export file integrity enabled", "hmac", len(key) > 0)
+		// Rotate through the integrity encoder so that it writes a
+		// checkpoint first.
+		exportEncoder, rotator = integrityEncoder, integrityEncoder
+	}
+
 	if option.Config.ExportFileRotationInterval < 0 {
 		// Passed an invalid interval let's error out
 		return fmt.Errorf("frequency '%s' at which to rotate JSON export files is negative", option.Config.ExportFileRotationInterval.String())
@@ -720,7 +841,7 @@ func startExporter(ctx context.Context, server *server.Server) error {
 					return
 				case <-ticker.C:
 					log.Info("Rotating JSON logs export", "file", logFile, "directory", logsDir)
-					if rotationErr := writer.Rotate(); rotationErr != nil {
+					if rotationErr := rotator.Rotate(); rotationErr != nil {
 						log.Warn("Failed to rotate JSON export file", "file", option.Config.ExportFilename, logfields.Error, rotationErr)
 					}
 				}
@@ -728,24 +849,47 @@ func startExporter(ctx context.Context, server *server.Server) error {
 		}()
 	}
 
-	// Track how many bytes are written to the event export location
-	encoderWriter := exporter.NewExportedBytesTotalWriter(writer)
-	encoder := encoder.NewProtojsonEncoder(encoderWriter)
 	var rateLimiter *ratelimit.RateLimiter
 	if option.Config.ExportRateLimit >= 0 {
-		rateLimiter = ratelimit.NewRateLimiter(ctx, 1*time.Minute, option.Config.ExportRateLimit, encoder)
+		rateLimiter = ratelimit.NewRateLimiter(ctx, 1*time.Minute, option.Config.ExportRateLimit, exportEncoder)
 	}
-	var aggregationOptions *tetragon.AggregationOptions
-	if option.Config.EnableExportAggregation {
-		aggregationOptions = &tetragon.AggregationOptions{
-			WindowSize:        durationpb.New(option.Config.ExportAggregationWindowSize),
-			ChannelBufferSize: option.Config.ExportAggregationBufferSize,
-		}
+	log.Info("Starting JSON exporter", "logger", writer, "request", req)
+	exporter := exporter.NewExporter(ctx, req, server, exportEncoder, writer, rateLimiter)
+	return exporter.Start()
+}
+
+func startOTLPExporter(ctx context.Context, server *server.Server) error {
+	req, err := getExportRequest()
+	if err != nil {
+		return err
 	}
-	req := tetragon.GetEventsRequest{AllowList: allowList, DenyList: denyList, AggregationOptions: aggregationOptions, FieldFilters: fieldFilters}
-	log.Info("Configured field filters", "fieldFilters", fieldFilters)
-	log.Info("Starting JSON exporter", "logger", writer, "request", &req)
-	exporter := exporter.NewExporter(ctx, &req, server, encoder, writer, rateLimiter)
+	headers, err := otlp.ParseHeaders(option.Config.ExportOTLPHeaders)
+	if err != nil {
+		return err
+	}
+	encoder, err := otlp.NewEncoder(otlp.Options{
+		Endpoint:     option.Config.ExportOTLPEndpoint,
+		Protocol:     option.Config.ExportOTLPProtocol,
+		Insecure:     option.Config.ExportOTLPInsecure,
+		Headers:      headers,
+		Timeout:      option.Config.ExportOTLPTimeout,
+		BatchSize:    option.Config.ExportOTLPBatchSize,
+		BatchTimeout: option.Config.ExportOTLPBatchTimeout,
+		QueueSize:    option.Config.ExportOTLPQueueSize,
+		MaxRetries:   option.Config.ExportOTLPMaxRetries,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+	var rateLimiter *ratelimit.RateLimiter
+	if option.Config.ExportRateLimit >= 0 {
+		rateLimiter = ratelimit.NewRateLimiter(ctx, 1*time.Minute, option.Config.ExportRateLimit, encoder)
+	}
+	log.Info("Starting OTLP exporter",
+		"endpoint", option.Config.ExportOTLPEndpoint,
+		"protocol", option.Config.ExportOTLPProtocol,
+		"request", req)
+	exporter := exporter.NewExporter(ctx, req, server, encoder, encoder, rateLimiter)
 	return exporter.Start()
 }
 
@@ -855,5 +999,22 @@ func servePprof(addr string) error {
 	mux.HandleFunc("/debug/pprof/profile", pprofhttp.Profile)
 	mux.HandleFunc("/debug/pprof/symbol", pprofhttp.Symbol)
 	mux.HandleFunc("/debug/pprof/trace", pprofhttp.Trace)
+	mux.HandleFunc("/debug/stacktrace-tree/{name...}", serveStackTraceTree)
 	return http.ListenAndServe(addr, mux)
 }
+
+// serveStackTraceTree writes a stack trace tree, e.g. the one of a perf event
+// policy, as a pprof profile.
+func serveStackTraceTree(w http.ResponseWriter, r *http.Request) {
+	root, err := sttManager.GetManager().ToProto(r.PathValue("name"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	fname := strings.ReplaceAll(r.PathValue("name"), "/", "_") + ".pb.gz"
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fname))
+	if err := stacktracetree.ToPprof(root).Write(w); err != nil {
+		logger.GetLogger().Warn("Failed to write stack trace tree profile", logfields.Error, err)
+	}
+}
@@ -4,14 +4,18 @@
 package main
 
 import (
+	"context"
+
 	"github.com/cilium/tetragon/pkg/alignchecker"
 	"github.com/cilium/tetragon/pkg/btf"
 	"github.com/cilium/tetragon/pkg/checkprocfs"
 	"github.com/cilium/tetragon/pkg/config"
 	"github.com/cilium/tetragon/pkg/defaults"
+	"github.com/cilium/tetragon/pkg/observer"
 	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/reader/namespace"
 	"github.com/cilium/tetragon/pkg/reader/proc"
+	"github.com/cilium/tetragon/pkg/sensors/tracing"
 
 	"github.com/spf13/viper"
 )
@@ -46,3 +50,38 @@ func setNetNSDir() {
 		defaults.NetnsDir = viper.GetString(option.KeyNetnsDir)
 	}
 }
+
+func loadFlowSensor(ctx context.Context) error {
+	mgr := observer.GetSensorManager()
+	flowSensor := tracing.GetFlowSensor()
+
+	if err := mgr.AddSensor(ctx, flowSensor.Name, flowSensor); err != nil {
+		return err
+	}
+	return mgr.EnableSensor(ctx, flowSensor.Name)
+}
+
+func loadDnsSensor(ctx context.Context) error {
+	mgr := observer.GetSensorManager()
+	dnsSensor := tracing.GetDnsSensor()
+
+	if err := mgr.AddSensor(ctx, dnsSensor.Name, dnsSensor); err != nil {
+		return err
+	}
+	return mgr.EnableSensor(ctx, dnsSensor.Name)
+}
+
+func loadTlsSensor(ctx context.Context, obs *observer.Observer) error {
+	mgr := observer.GetSensorManager()
+	tlsSensor, discovery := tracing.GetTlsSensor()
+
+	if err := mgr.AddSensor(ctx, tlsSensor.Name, tlsSensor); err != nil {
+		return err
+	}
+	if err := mgr.EnableSensor(ctx, tlsSensor.Name); err != nil {
+		return err
+	}
+	discovery.Start(ctx)
+	obs.AddListener(discovery)
+	return nil
+}
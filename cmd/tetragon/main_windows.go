@@ -3,6 +3,13 @@
 
 package main
 
+import (
+	"context"
+	"errors"
+
+	"github.com/cilium/tetragon/pkg/observer"
+)
+
 func logCurrentSecurityContext() {
 }
 
@@ -24,3 +31,15 @@ func checkStructAlignments() error {
 
 func setNetNSDir() {
 }
+
+func loadFlowSensor(_ context.Context) error {
+	return errors.New("network flows are not supported on windows")
+}
+
+func loadDnsSensor(_ context.Context) error {
+	return errors.New("dns events are not supported on windows")
+}
+
+func loadTlsSensor(_ context.Context, _ *observer.Observer) error {
+	return errors.New("tls plaintext events are not supported on windows")
+}
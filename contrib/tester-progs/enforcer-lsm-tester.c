@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: (GPL-2.0-only OR BSD-2-Clause)
+// Copyright Authors of Tetragon
+
+// Fails an openat before it reaches the file_open LSM hook, then opens an
+// existing file. Returns 0 if the second open succeeded, its errno otherwise.
+
+#include <errno.h>
+#include <fcntl.h>
+#include <stdio.h>
+#include <unistd.h>
+
+#define MISSING_FILE "/tetragon-enforcer-lsm-tester/missing"
+
+int main(void)
+{
+	int fd;
+
+	fd = openat(AT_FDCWD, MISSING_FILE, O_RDONLY);
+	if (fd >= 0 || errno != ENOENT) {
+		fprintf(stderr, "unexpected result opening %s: %d\n", MISSING_FILE, fd);
+		return 255;
+	}
+
+	fd = openat(AT_FDCWD, "/dev/null", O_RDONLY);
+	if (fd < 0)
+		return errno;
+	close(fd);
+	return 0;
+}
@@ -21,6 +21,8 @@ import (
 	"github.com/cilium/tetragon/operator/cmd/common"
 	operatorOption "github.com/cilium/tetragon/operator/option"
 	"github.com/cilium/tetragon/operator/podinfo"
+	"github.com/cilium/tetragon/operator/policystatus"
+	"github.com/cilium/tetragon/operator/policyvalidation"
 	ciliumiov1alpha1 "github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/logger/logfields"
@@ -86,6 +88,20 @@ func New() *cobra.Command {
 				}
 			}
 
+			if !operatorOption.Config.SkipTracingPolicyCRD {
+				if err = (&policystatus.Reconciler{
+					Client: mgr.GetClient(),
+				}).SetupWithManager(mgr); err != nil {
+					return fmt.Errorf("unable to create controller: %w %s %s", err, "controller", "tracingpolicy-status")
+				}
+			}
+
+			if !operatorOption.Config.SkipTracingPolicyCRD && operatorOption.Config.EnablePolicyValidationWebhook {
+				if err = policyvalidation.SetupWithManager(mgr); err != nil {
+					return fmt.Errorf("unable to create webhook: %w %s %s", err, "webhook", "tracingpolicy-validation")
+				}
+			}
+
 			if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 				return fmt.Errorf("unable to set up health check %w", err)
 			}
@@ -115,6 +131,8 @@ func New() *cobra.Command {
 		"Duration that current acting master will retry refreshing leadership in before giving up the lock")
 	cmd.Flags().DurationVar(&operatorOption.Config.LeaderElectionRetryPeriod, "leader-election-retry-period", 2*time.Second,
 		"Duration that LeaderElector clients should wait between retries of the actions")
+	cmd.Flags().BoolVar(&operatorOption.Config.EnablePolicyValidationWebhook, operatorOption.EnablePolicyValidationWebhook, false,
+		"Serve a validating admission webhook rejecting invalid TracingPolicy and TracingPolicyNamespaced resources")
 	viper.BindPFlags(cmd.Flags())
 	return &cmd
 }
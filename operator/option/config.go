@@ -57,6 +57,9 @@ const (
 
 	// LeaderElectionRetryPeriod is the duration that LeaderElector clients should wait between retries of the actions.
 	LeaderElectionRetryPeriod = "leader-election-retry-period"
+
+	// EnablePolicyValidationWebhook enables the validating admission webhook for tracing policies.
+	EnablePolicyValidationWebhook = "enable-policy-validation-webhook"
 )
 
 // OperatorConfig is the configuration used by the operator.
@@ -102,6 +105,9 @@ type OperatorConfig struct {
 
 	// LeaderElectionRetryPeriod is the duration that LeaderElector clients should wait between retries of the actions.
 	LeaderElectionRetryPeriod time.Duration
+
+	// EnablePolicyValidationWebhook enables the validating admission webhook for tracing policies.
+	EnablePolicyValidationWebhook bool
 }
 
 // Config represents the operator configuration.
@@ -122,4 +128,5 @@ func ConfigPopulate() {
 	Config.LeaderElectionLeaseDuration = viper.GetDuration(LeaderElectionLeaseDuration)
 	Config.LeaderElectionRenewDeadline = viper.GetDuration(LeaderElectionRenewDeadline)
 	Config.LeaderElectionRetryPeriod = viper.GetDuration(LeaderElectionRetryPeriod)
+	Config.EnablePolicyValidationWebhook = viper.GetBool(EnablePolicyValidationWebhook)
 }
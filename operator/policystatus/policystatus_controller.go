@@ -0,0 +1,241 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package policystatus aggregates the per-node status that agents report on
+// TracingPolicy and TracingPolicyNamespaced resources into conditions.
+package policystatus
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ciliumiov1alpha1 "github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+const (
+	ReasonNoStatus      = "NoStatus"
+	ReasonLoadError     = "LoadError"
+	ReasonProgressing   = "Progressing"
+	ReasonDisabled      = "Disabled"
+	ReasonLoaded        = "Loaded"
+	ReasonNotApplicable = "NotApplicable"
+	ReasonNotLoaded     = "NotLoaded"
+	ReasonMonitorMode   = "MonitorMode"
+	ReasonEnforcing     = "Enforcing"
+
+	// maximum number of node errors listed in the Loaded condition message
+	maxListedErrors = 3
+
+	conflictRequeueDelay = time.Second
+)
+
+type statusObject interface {
+	client.Object
+	TpStatus() *ciliumiov1alpha1.TracingPolicyStatus
+}
+
+// Reconciler reconciles the status of TracingPolicy and
+// TracingPolicyNamespaced objects.
+type Reconciler struct {
+	client.Client
+}
+
+//+kubebuilder:rbac:groups=cilium.io,resources=tracingpolicies;tracingpoliciesnamespaced,verbs=get;list;watch
+//+kubebuilder:rbac:groups=cilium.io,resources=tracingpolicies/status;tracingpoliciesnamespaced/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+
+func (r *Reconciler) reconcile(ctx context.Context, name types.NamespacedName, obj statusObject) (ctrl.Result, error) {
+	if err := r.Get(ctx, name, obj); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes); err != nil {
+		return ctrl.Result{}, err
+	}
+	existing := make(map[string]struct{}, len(nodes.Items))
+	for i := range nodes.Items {
+		existing[nodes.Items[i].Name] = struct{}{}
+	}
+
+	status := obj.TpStatus()
+	old := status.DeepCopy()
+	// Agents do not remove their entry when their node goes away, so
+	// do it for them.
+	status.Nodes = slices.DeleteFunc(status.Nodes, func(n ciliumiov1alpha1.TracingPolicyNodeStatus) bool {
+		_, ok := existing[n.NodeName]
+		return !ok
+	})
+	setConditions(status, obj.GetGeneration())
+	if equality.Semantic.DeepEqual(old, status) {
+		return ctrl.Result{}, nil
+	}
+
+	err := r.Status().Update(ctx, obj)
+	if errors.IsConflict(err) {
+		// An agent updated its status in the meantime, try again.
+		log.FromContext(ctx).V(1).Info("conflict updating policy status, requeuing")
+		return ctrl.Result{RequeueAfter: conflictRequeueDelay}, nil
+	}
+	return ctrl.Result{}, err
+}
+
+// setConditions computes the policy conditions from the per-node status.
+func setConditions(status *ciliumiov1alpha1.TracingPolicyStatus, generation int64) {
+	loaded := metav1.Condition{Type: ciliumiov1alpha1.TPConditionLoaded, ObservedGeneration: generation}
+	enforcing := metav1.Condition{Type: ciliumiov1alpha1.TPConditionEnforcing, ObservedGeneration: generation}
+
+	var failed []string
+	var pending, disabled, enabled, monitor, notApplicable int
+	for _, n := range status.Nodes {
+		switch {
+		case n.State == "load_error" || n.State == "error":
+			failed = append(failed, fmt.Sprintf("%s: %s", n.NodeName, n.Error))
+		case n.ObservedGeneration < generation ||
+			n.State != "enabled" && n.State != "disabled" && n.State != "not_applicable":
+			pending++
+		case n.State == "not_applicable":
+			// the node selector of the policy excludes the node
+			notApplicable++
+		case n.State == "disabled":
+			disabled++
+		default:
+			enabled++
+			if n.Mode == "monitor" || n.Mode == "monitor_only" {
+				monitor++
+			}
+		}
+	}
+	// nodes excluded by the node selector are not counted
+	total := len(status.Nodes) - notApplicable
+
+	switch {
+	case len(status.Nodes) == 0:
+		loaded.Status = metav1.ConditionUnknown
+		loaded.Reason = ReasonNoStatus
+		loaded.Message = "No node reported the status of the policy"
+	case len(failed) > 0:
+		loaded.Status = metav1.ConditionFalse
+		loaded.Reason = ReasonLoadError
+		nfailed := len(failed)
+		slices.Sort(failed)
+		if nfailed > maxListedErrors {
+			failed = append(failed[:maxListedErrors], "...")
+		}
+		loaded.Message = fmt.Sprintf("Failed on %d/%d nodes: %s", nfailed, total, strings.Join(failed, "; "))
+	case pending > 0:
+		loaded.Status = metav1.ConditionUnknown
+		loaded.Reason = ReasonProgressing
+		loaded.Message = fmt.Sprintf("Loaded on %d/%d nodes", enabled, total)
+	case total == 0:
+		loaded.Status = metav1.ConditionFalse
+		loaded.Reason = ReasonNotApplicable
+		loaded.Message = fmt.Sprintf("The node selector excludes all %d nodes", notApplicable)
+	case disabled > 0:
+		loaded.Status = metav1.ConditionFalse
+		loaded.Reason = ReasonDisabled
+		loaded.Message = fmt.Sprintf("Disabled on %d/%d nodes", disabled, total)
+	default:
+		loaded.Status = metav1.ConditionTrue
+		loaded.Reason = ReasonLoaded
+		loaded.Message = fmt.Sprintf("Loaded on %d nodes", total)
+	}
+
+	switch {
+	case enabled == 0:
+		enforcing.Status = metav1.ConditionUnknown
+		enforcing.Reason = ReasonNotLoaded
+		enforcing.Message = "The policy is not loaded on any node"
+	case monitor > 0:
+		enforcing.Status = metav1.ConditionFalse
+		enforcing.Reason = ReasonMonitorMode
+		enforcing.Message = fmt.Sprintf("In monitor mode on %d/%d nodes", monitor, enabled)
+	default:
+		enforcing.Status = metav1.ConditionTrue
+		enforcing.Reason = ReasonEnforcing
+		enforcing.Message = fmt.Sprintf("In enforce mode on %d nodes", enabled)
+	}
+
+	meta.SetStatusCondition(&status.Conditions, loaded)
+	meta.SetStatusCondition(&status.Conditions, enforcing)
+	status.ObservedGeneration = generation
+}
+
+type tpReconciler struct{ *Reconciler }
+
+func (r tpReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcile(ctx, req.NamespacedName, &ciliumiov1alpha1.TracingPolicy{})
+}
+
+type tpNamespacedReconciler struct{ *Reconciler }
+
+func (r tpNamespacedReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.reconcile(ctx, req.NamespacedName, &ciliumiov1alpha1.TracingPolicyNamespaced{})
+}
+
+// nodeDeleted only passes node deletions, which require pruning the status of
+// every policy.
+var nodeDeleted = predicate.Funcs{
+	CreateFunc:  func(event.CreateEvent) bool { return false },
+	UpdateFunc:  func(event.UpdateEvent) bool { return false },
+	DeleteFunc:  func(event.DeleteEvent) bool { return true },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+}
+
+func (r *Reconciler) allPolicies(newList func() client.ObjectList) handler.MapFunc {
+	return func(ctx context.Context, _ client.Object) []reconcile.Request {
+		list := newList()
+		if err := r.List(ctx, list); err != nil {
+			log.FromContext(ctx).Error(err, "unable to list tracing policies")
+			return nil
+		}
+		var reqs []reconcile.Request
+		meta.EachListItem(list, func(obj runtime.Object) error {
+			if o, ok := obj.(client.Object); ok {
+				reqs = append(reqs, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(o)})
+			}
+			return nil
+		})
+		return reqs
+	}
+}
+
+// SetupWithManager sets up the controllers with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	err := ctrl.NewControllerManagedBy(mgr).
+		Named("tracingpolicy-status").
+		For(&ciliumiov1alpha1.TracingPolicy{}).
+		Watches(&corev1.Node{},
+			handler.EnqueueRequestsFromMapFunc(r.allPolicies(func() client.ObjectList { return &ciliumiov1alpha1.TracingPolicyList{} })),
+			builder.WithPredicates(nodeDeleted)).
+		Complete(tpReconciler{r})
+	if err != nil {
+		return err
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("tracingpolicynamespaced-status").
+		For(&ciliumiov1alpha1.TracingPolicyNamespaced{}).
+		Watches(&corev1.Node{},
+			handler.EnqueueRequestsFromMapFunc(r.allPolicies(func() client.ObjectList { return &ciliumiov1alpha1.TracingPolicyNamespacedList{} })),
+			builder.WithPredicates(nodeDeleted)).
+		Complete(tpNamespacedReconciler{r})
+}
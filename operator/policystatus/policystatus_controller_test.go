@@ -0,0 +1,189 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package policystatus
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ciliumv1alpha1 "github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+func nodeStatus(name, state, mode string, generation int64) ciliumv1alpha1.TracingPolicyNodeStatus {
+	return ciliumv1alpha1.TracingPolicyNodeStatus{
+		NodeName:           name,
+		State:              state,
+		Mode:               mode,
+		ObservedGeneration: generation,
+	}
+}
+
+func TestSetConditions(t *testing.T) {
+	tests := []struct {
+		name            string
+		nodes           []ciliumv1alpha1.TracingPolicyNodeStatus
+		loaded          metav1.ConditionStatus
+		loadedReason    string
+		enforcing       metav1.ConditionStatus
+		enforcingReason string
+	}{
+		{
+			name:            "no status",
+			loaded:          metav1.ConditionUnknown,
+			loadedReason:    ReasonNoStatus,
+			enforcing:       metav1.ConditionUnknown,
+			enforcingReason: ReasonNotLoaded,
+		},
+		{
+			name: "loaded",
+			nodes: []ciliumv1alpha1.TracingPolicyNodeStatus{
+				nodeStatus("a", "enabled", "enforce", 2),
+				nodeStatus("b", "enabled", "enforce", 2),
+			},
+			loaded:          metav1.ConditionTrue,
+			loadedReason:    ReasonLoaded,
+			enforcing:       metav1.ConditionTrue,
+			enforcingReason: ReasonEnforcing,
+		},
+		{
+			name: "monitor",
+			nodes: []ciliumv1alpha1.TracingPolicyNodeStatus{
+				nodeStatus("a", "enabled", "enforce", 2),
+				nodeStatus("b", "enabled", "monitor", 2),
+			},
+			loaded:          metav1.ConditionTrue,
+			loadedReason:    ReasonLoaded,
+			enforcing:       metav1.ConditionFalse,
+			enforcingReason: ReasonMonitorMode,
+		},
+		{
+			name: "error",
+			nodes: []ciliumv1alpha1.TracingPolicyNodeStatus{
+				nodeStatus("a", "enabled", "enforce", 2),
+				nodeStatus("b", "load_error", "", 2),
+			},
+			loaded:          metav1.ConditionFalse,
+			loadedReason:    ReasonLoadError,
+			enforcing:       metav1.ConditionTrue,
+			enforcingReason: ReasonEnforcing,
+		},
+		{
+			name: "old generation",
+			nodes: []ciliumv1alpha1.TracingPolicyNodeStatus{
+				nodeStatus("a", "enabled", "enforce", 2),
+				nodeStatus("b", "enabled", "enforce", 1),
+			},
+			loaded:          metav1.ConditionUnknown,
+			loadedReason:    ReasonProgressing,
+			enforcing:       metav1.ConditionTrue,
+			enforcingReason: ReasonEnforcing,
+		},
+		{
+			name: "not applicable",
+			nodes: []ciliumv1alpha1.TracingPolicyNodeStatus{
+				nodeStatus("a", "enabled", "enforce", 2),
+				nodeStatus("b", "not_applicable", "", 2),
+			},
+			loaded:          metav1.ConditionTrue,
+			loadedReason:    ReasonLoaded,
+			enforcing:       metav1.ConditionTrue,
+			enforcingReason: ReasonEnforcing,
+		},
+		{
+			name: "not applicable old generation",
+			nodes: []ciliumv1alpha1.TracingPolicyNodeStatus{
+				nodeStatus("a", "enabled", "enforce", 2),
+				nodeStatus("b", "not_applicable", "", 1),
+			},
+			loaded:          metav1.ConditionUnknown,
+			loadedReason:    ReasonProgressing,
+			enforcing:       metav1.ConditionTrue,
+			enforcingReason: ReasonEnforcing,
+		},
+		{
+			name: "not applicable on all nodes",
+			nodes: []ciliumv1alpha1.TracingPolicyNodeStatus{
+				nodeStatus("a", "not_applicable", "", 2),
+			},
+			loaded:          metav1.ConditionFalse,
+			loadedReason:    ReasonNotApplicable,
+			enforcing:       metav1.ConditionUnknown,
+			enforcingReason: ReasonNotLoaded,
+		},
+		{
+			name: "disabled",
+			nodes: []ciliumv1alpha1.TracingPolicyNodeStatus{
+				nodeStatus("a", "disabled", "enforce", 2),
+			},
+			loaded:          metav1.ConditionFalse,
+			loadedReason:    ReasonDisabled,
+			enforcing:       metav1.ConditionUnknown,
+			enforcingReason: ReasonNotLoaded,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			status := &ciliumv1alpha1.TracingPolicyStatus{Nodes: tc.nodes}
+			setConditions(status, 2)
+			assert.Equal(t, int64(2), status.ObservedGeneration)
+
+			loaded := meta.FindStatusCondition(status.Conditions, ciliumv1alpha1.TPConditionLoaded)
+			require.NotNil(t, loaded)
+			assert.Equal(t, tc.loaded, loaded.Status)
+			assert.Equal(t, tc.loadedReason, loaded.Reason)
+
+			enforcing := meta.FindStatusCondition(status.Conditions, ciliumv1alpha1.TPConditionEnforcing)
+			require.NotNil(t, enforcing)
+			assert.Equal(t, tc.enforcing, enforcing.Status)
+			assert.Equal(t, tc.enforcingReason, enforcing.Reason)
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(ciliumv1alpha1.AddToScheme(scheme))
+
+	tp := &ciliumv1alpha1.TracingPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Generation: 1},
+		Status: ciliumv1alpha1.TracingPolicyStatus{
+			Nodes: []ciliumv1alpha1.TracingPolicyNodeStatus{
+				nodeStatus("node-1", "enabled", "enforce", 1),
+				nodeStatus("gone", "load_error", "", 1),
+			},
+		},
+	}
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}}
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(tp, node).
+		WithStatusSubresource(tp).
+		Build()
+
+	r := tpReconciler{&Reconciler{c}}
+	key := types.NamespacedName{Name: "policy"}
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	require.NoError(t, err)
+
+	got := &ciliumv1alpha1.TracingPolicy{}
+	require.NoError(t, c.Get(context.Background(), key, got))
+	// the status of the deleted node is pruned, so the policy is loaded
+	// on all remaining nodes
+	require.Len(t, got.Status.Nodes, 1)
+	assert.Equal(t, "node-1", got.Status.Nodes[0].NodeName)
+	assert.True(t, meta.IsStatusConditionTrue(got.Status.Conditions, ciliumv1alpha1.TPConditionLoaded))
+	assert.Equal(t, int64(1), got.Status.ObservedGeneration)
+}
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package policyvalidation implements a validating admission webhook that
+// rejects TracingPolicy and TracingPolicyNamespaced resources that the agents
+// would fail to load, whatever the kernel of their node.
+package policyvalidation
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	ciliumiov1alpha1 "github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/policyspec"
+	"github.com/cilium/tetragon/pkg/selectors"
+)
+
+type policyObject interface {
+	runtime.Object
+	GetName() string
+	TpSpec() *ciliumiov1alpha1.TracingPolicySpec
+}
+
+// features are the kernel features the selectors are compiled for. The nodes
+// the policies are loaded on are not known, so all of them are enabled and the
+// agents run the checks that depend on their kernel and options.
+var features = selectors.Features{LargeProgs: true, ParentsMap: true}
+
+// Validator validates the spec of tracing policies of one kind.
+type Validator[T policyObject] struct {
+	kind string
+}
+
+// SetupWithManager registers the validating webhooks of TracingPolicy and
+// TracingPolicyNamespaced with the manager.
+func SetupWithManager(mgr ctrl.Manager) error {
+	err := ctrl.NewWebhookManagedBy(mgr, &ciliumiov1alpha1.TracingPolicy{}).
+		WithValidator(&Validator[*ciliumiov1alpha1.TracingPolicy]{kind: ciliumiov1alpha1.TPKindDefinition}).
+		Complete()
+	if err != nil {
+		return err
+	}
+	return ctrl.NewWebhookManagedBy(mgr, &ciliumiov1alpha1.TracingPolicyNamespaced{}).
+		WithValidator(&Validator[*ciliumiov1alpha1.TracingPolicyNamespaced]{kind: ciliumiov1alpha1.TPNamespacedKindDefinition}).
+		Complete()
+}
+
+func (v *Validator[T]) validate(obj T) error {
+	errs := policyspec.ValidateSpec(obj.TpSpec(), field.NewPath("spec"), &features)
+	if len(errs) == 0 {
+		return nil
+	}
+	gk := ciliumiov1alpha1.SchemeGroupVersion.WithKind(v.kind).GroupKind()
+	return apierrors.NewInvalid(gk, obj.GetName(), errs)
+}
+
+func (v *Validator[T]) ValidateCreate(_ context.Context, obj T) (admission.Warnings, error) {
+	return nil, v.validate(obj)
+}
+
+func (v *Validator[T]) ValidateUpdate(_ context.Context, _, newObj T) (admission.Warnings, error) {
+	return nil, v.validate(newObj)
+}
+
+func (v *Validator[T]) ValidateDelete(_ context.Context, _ T) (admission.Warnings, error) {
+	return nil, nil
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package policyvalidation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ciliumv1alpha1 "github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+func TestValidator(t *testing.T) {
+	v := &Validator[*ciliumv1alpha1.TracingPolicyNamespaced]{kind: ciliumv1alpha1.TPNamespacedKindDefinition}
+	tp := &ciliumv1alpha1.TracingPolicyNamespaced{
+		ObjectMeta: metav1.ObjectMeta{Name: "policy", Namespace: "default"},
+		Spec: ciliumv1alpha1.TracingPolicySpec{
+			KProbes: []ciliumv1alpha1.KProbeSpec{{
+				Call:    "sys_write",
+				Syscall: true,
+			}},
+		},
+	}
+
+	_, err := v.ValidateCreate(t.Context(), tp)
+	require.NoError(t, err)
+
+	bad := tp.DeepCopy()
+	bad.Spec.KProbes[0].Selectors = []ciliumv1alpha1.KProbeSelector{{Macros: []string{"noSuchMacro"}}}
+	_, err = v.ValidateUpdate(t.Context(), tp, bad)
+	require.Error(t, err)
+	require.True(t, apierrors.IsInvalid(err))
+	status := err.(*apierrors.StatusError).ErrStatus
+	require.Equal(t, ciliumv1alpha1.TPNamespacedKindDefinition, status.Details.Kind)
+	require.Len(t, status.Details.Causes, 1)
+	require.Equal(t, "spec.kprobes[0].selectors[0].macros", status.Details.Causes[0].Field)
+
+	_, err = v.ValidateDelete(t.Context(), bad)
+	require.NoError(t, err)
+}
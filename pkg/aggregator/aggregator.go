@@ -4,20 +4,68 @@
 package aggregator
 
 import (
+	"fmt"
 	"sort"
 	"strings"
 	"time"
 
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/fieldfilters"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/logger/logfields"
 )
 
+// DefaultGroupBy is the list of field paths events are grouped on if
+// AggregationOptions.group_by is empty.
+var DefaultGroupBy = []string{
+	"process.pod.namespace",
+	"process.pod.name",
+	"process.binary",
+	"function_name",
+	"policy_name",
+}
+
+const (
+	// DefaultMaxGroups is the maximum number of groups of an aggregation
+	// window if AggregationOptions.max_groups is 0.
+	DefaultMaxGroups = 10000
+	// DefaultMaxDistinctValues is the maximum number of distinct values
+	// counted per field and group if AggregationOptions.max_distinct_values
+	// is 0.
+	DefaultMaxDistinctValues = 1000
+)
+
+// group holds the events of an aggregation window that have the same event
+// type and values for the group_by fields.
+type group struct {
+	// event is the first event of the group, which is sent with the
+	// aggregation info.
+	event     *tetragon.GetEventsResponse
+	count     uint64
+	firstSeen *timestamppb.Timestamp
+	lastSeen  *timestamppb.Timestamp
+	distinct  map[string]map[string]struct{}
+}
+
 type Aggregator struct {
-	server tetragon.FineGuidanceSensors_GetEventsServer
-	window time.Duration
-	events chan *tetragon.GetEventsResponse
-	cache  map[string]*tetragon.GetEventsResponse
+	server   tetragon.FineGuidanceSensors_GetEventsServer
+	window   time.Duration
+	events   chan *tetragon.GetEventsResponse
+	groupBy  []fieldfilters.FieldPath
+	distinct []fieldfilters.FieldPath
+	cache    map[string]*group
+	// groups holds the groups of cache in the order they were created, so
+	// that they are sent in the order of their first event.
+	groups []*group
+
+	maxGroups         int
+	maxDistinctValues int
+	// dropped is the number of events dropped in the current window
+	// because maxGroups was reached.
+	dropped uint64
 }
 
 func NewAggregator(
@@ -31,45 +79,166 @@ func NewAggregator(
 	if options.WindowSize != nil {
 		window = options.WindowSize.AsDuration()
 	}
+	if window <= 0 {
+		return nil, fmt.Errorf("invalid aggregation window size %s", window)
+	}
+	groupByPaths := options.GroupBy
+	if len(groupByPaths) == 0 {
+		groupByPaths = DefaultGroupBy
+	}
+	groupBy, err := fieldfilters.ParseFieldPaths(groupByPaths)
+	if err != nil {
+		return nil, fmt.Errorf("invalid aggregation group_by: %w", err)
+	}
+	distinct, err := fieldfilters.ParseFieldPaths(options.DistinctFields)
+	if err != nil {
+		return nil, fmt.Errorf("invalid aggregation distinct_fields: %w", err)
+	}
+	maxGroups := int(options.MaxGroups)
+	if maxGroups == 0 {
+		maxGroups = DefaultMaxGroups
+	}
+	maxDistinctValues := int(options.MaxDistinctValues)
+	if maxDistinctValues == 0 {
+		maxDistinctValues = DefaultMaxDistinctValues
+	}
 	return &Aggregator{
-		server,
-		window,
-		make(chan *tetragon.GetEventsResponse, options.ChannelBufferSize),
-		make(map[string]*tetragon.GetEventsResponse),
+		server:            server,
+		window:            window,
+		events:            make(chan *tetragon.GetEventsResponse, options.ChannelBufferSize),
+		groupBy:           groupBy,
+		distinct:          distinct,
+		cache:             make(map[string]*group),
+		maxGroups:         maxGroups,
+		maxDistinctValues: maxDistinctValues,
 	}, nil
 }
 
+// Start aggregates the events received on the event channel and sends the
+// aggregated events at the end of each window, until the context of the
+// server is done.
 func (a *Aggregator) Start() {
-	// nolint Since Aggregator.Start is an endless function,
-	// this qualifies as an acceptable use of time.Tick
-	tick := time.Tick(a.window)
+	ticker := time.NewTicker(a.window)
+	defer ticker.Stop()
 	for {
 		select {
 		case event := <-a.events:
 			a.handleEvent(event)
-		case <-tick:
+		case <-ticker.C:
 			a.flush()
+		case <-a.server.Context().Done():
+			return
 		}
 	}
 }
 
 func (a *Aggregator) flush() {
-	for _, event := range a.cache {
-		if err := a.server.Send(event); err != nil {
+	for _, g := range a.groups {
+		if err := a.server.Send(g.response()); err != nil {
 			logger.GetLogger().Warn("Failed to send aggregated response", logfields.Error, err)
 		}
 	}
+	if a.dropped > 0 {
+		logger.GetLogger().Warn("Aggregator dropped events because the maximum number of groups was reached. Consider increasing AggregationOptions.max_groups.",
+			"dropped", a.dropped, "max_groups", a.maxGroups)
+	}
 	// clear the cache.
-	a.cache = make(map[string]*tetragon.GetEventsResponse)
+	a.cache = make(map[string]*group)
+	a.groups = nil
+	a.dropped = 0
 }
 
 func (a *Aggregator) handleEvent(event *tetragon.GetEventsResponse) {
-	switch event.Event.(type) {
-	default:
-		if err := a.server.Send(event); err != nil {
-			logger.GetLogger().Warn("Failed to send unaggregated response", logfields.Error, err)
+	key := a.key(event)
+	seen := event.GetTime()
+	if seen == nil {
+		seen = timestamppb.Now()
+	}
+	g, ok := a.cache[key]
+	if !ok {
+		if len(a.groups) >= a.maxGroups {
+			a.dropped++
+			getDropped(dropGroups).Inc()
+			return
+		}
+		g = &group{
+			event:     event,
+			firstSeen: seen,
+			distinct:  make(map[string]map[string]struct{}, len(a.distinct)),
+		}
+		a.cache[key] = g
+		a.groups = append(a.groups, g)
+	}
+	g.count++
+	g.lastSeen = seen
+	for _, fp := range a.distinct {
+		v, ok := fp.Value(event)
+		if !ok {
+			continue
+		}
+		values := g.distinct[fp.String()]
+		if values == nil {
+			values = make(map[string]struct{})
+			g.distinct[fp.String()] = values
+		}
+		if _, ok := values[v]; ok {
+			continue
+		}
+		if len(values) >= a.maxDistinctValues {
+			getDropped(dropDistinctValues).Inc()
+			continue
+		}
+		values[v] = struct{}{}
+	}
+}
+
+// key returns the key grouping an event: its type and the values of the
+// group_by fields.
+func (a *Aggregator) key(event *tetragon.GetEventsResponse) string {
+	var sb strings.Builder
+	sb.WriteString(eventType(event))
+	for _, fp := range a.groupBy {
+		v, _ := fp.Value(event)
+		sb.WriteByte(0)
+		sb.WriteString(v)
+	}
+	return sb.String()
+}
+
+// eventType returns the name of the event set in a GetEventsResponse, such as
+// process_exec.
+func eventType(event *tetragon.GetEventsResponse) string {
+	msg := event.ProtoReflect()
+	fd := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("event"))
+	if fd == nil {
+		return ""
+	}
+	return string(fd.Name())
+}
+
+// response returns the first event of the group with the aggregation info.
+func (g *group) response() *tetragon.GetEventsResponse {
+	// The event can be sent to other listeners, so set the aggregation info
+	// in a shallow copy of it.
+	res := &tetragon.GetEventsResponse{}
+	dst := res.ProtoReflect()
+	g.event.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		dst.Set(fd, v)
+		return true
+	})
+	res.Time = g.firstSeen
+	res.AggregationInfo = &tetragon.AggregationInfo{
+		Count:     g.count,
+		FirstSeen: g.firstSeen,
+		LastSeen:  g.lastSeen,
+	}
+	if len(g.distinct) > 0 {
+		res.AggregationInfo.DistinctCounts = make(map[string]uint64, len(g.distinct))
+		for path, values := range g.distinct {
+			res.AggregationInfo.DistinctCounts[path] = uint64(len(values))
 		}
 	}
+	return res
 }
 
 func getNameOrIp(ip string, names []string) string {
@@ -4,12 +4,150 @@
 package aggregator
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
 )
 
 func Test_getNameOrIp(t *testing.T) {
 	assert.Equal(t, "1.1.1.1", getNameOrIp("1.1.1.1", []string{}))
 	assert.Equal(t, "a.com,b.com,c.com", getNameOrIp("1.1.1.1", []string{"b.com", "c.com", "a.com"}))
 }
+
+type fakeServer struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events []*tetragon.GetEventsResponse
+}
+
+func (s *fakeServer) Send(event *tetragon.GetEventsResponse) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *fakeServer) Context() context.Context {
+	return s.ctx
+}
+
+func kprobeEvent(binary, function, arg string, ts int64) *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessKprobe{ProcessKprobe: &tetragon.ProcessKprobe{
+			Process:      &tetragon.Process{Binary: binary},
+			FunctionName: function,
+			Args:         []*tetragon.KprobeArgument{{Arg: &tetragon.KprobeArgument_StringArg{StringArg: arg}}},
+		}},
+		NodeName: "node",
+		Time:     timestamppb.New(time.Unix(ts, 0)),
+	}
+}
+
+func TestAggregator(t *testing.T) {
+	server := &fakeServer{ctx: t.Context()}
+	a, err := NewAggregator(server, &tetragon.AggregationOptions{
+		GroupBy:        []string{"process.binary", "function_name"},
+		DistinctFields: []string{"args", "node_name"},
+	})
+	require.NoError(t, err)
+
+	events := []*tetragon.GetEventsResponse{
+		kprobeEvent("/usr/bin/cat", "security_file_permission", "/etc/passwd", 1),
+		kprobeEvent("/usr/bin/cat", "security_file_permission", "/etc/shadow", 2),
+		kprobeEvent("/usr/bin/curl", "tcp_connect", "", 3),
+		kprobeEvent("/usr/bin/cat", "security_file_permission", "/etc/passwd", 4),
+		{
+			Event: &tetragon.GetEventsResponse_ProcessExec{ProcessExec: &tetragon.ProcessExec{
+				Process: &tetragon.Process{Binary: "/usr/bin/cat"},
+			}},
+			Time: timestamppb.New(time.Unix(5, 0)),
+		},
+	}
+	for _, event := range events {
+		a.handleEvent(event)
+	}
+	a.flush()
+
+	require.Len(t, server.events, 3)
+	cat := server.events[0]
+	assert.Equal(t, "security_file_permission", cat.GetProcessKprobe().GetFunctionName())
+	assert.Equal(t, uint64(3), cat.GetAggregationInfo().GetCount())
+	assert.Equal(t, int64(1), cat.GetTime().GetSeconds())
+	assert.Equal(t, int64(1), cat.GetAggregationInfo().GetFirstSeen().GetSeconds())
+	assert.Equal(t, int64(4), cat.GetAggregationInfo().GetLastSeen().GetSeconds())
+	assert.Equal(t, map[string]uint64{"args": 2, "node_name": 1}, cat.GetAggregationInfo().GetDistinctCounts())
+	// The first event is not modified.
+	assert.Nil(t, events[0].GetAggregationInfo())
+
+	assert.Equal(t, "tcp_connect", server.events[1].GetProcessKprobe().GetFunctionName())
+	assert.Equal(t, uint64(1), server.events[1].GetAggregationInfo().GetCount())
+	assert.Equal(t, "/usr/bin/cat", server.events[2].GetProcessExec().GetProcess().GetBinary())
+	// The process_exec event has no node name.
+	assert.Empty(t, server.events[2].GetAggregationInfo().GetDistinctCounts())
+
+	// The cache is cleared on flush.
+	a.flush()
+	assert.Len(t, server.events, 3)
+}
+
+func TestAggregatorDefaultGroupBy(t *testing.T) {
+	server := &fakeServer{ctx: t.Context()}
+	a, err := NewAggregator(server, &tetragon.AggregationOptions{})
+	require.NoError(t, err)
+	a.handleEvent(kprobeEvent("/usr/bin/cat", "security_file_permission", "/etc/passwd", 1))
+	a.handleEvent(kprobeEvent("/usr/bin/cat", "security_file_permission", "/etc/shadow", 2))
+	a.handleEvent(kprobeEvent("/usr/bin/cat", "security_file_open", "/etc/shadow", 3))
+	a.flush()
+	require.Len(t, server.events, 2)
+	assert.Equal(t, uint64(2), server.events[0].GetAggregationInfo().GetCount())
+	assert.Equal(t, uint64(1), server.events[1].GetAggregationInfo().GetCount())
+}
+
+func TestNewAggregatorInvalidPath(t *testing.T) {
+	server := &fakeServer{ctx: t.Context()}
+	for _, path := range []string{"process.unknown", "process..binary", "args.string_arg", ""} {
+		_, err := NewAggregator(server, &tetragon.AggregationOptions{GroupBy: []string{path}})
+		require.Error(t, err, path)
+	}
+	_, err := NewAggregator(server, &tetragon.AggregationOptions{DistinctFields: []string{"process.pod.pod_labels"}})
+	require.NoError(t, err)
+}
+
+func TestAggregatorLimits(t *testing.T) {
+	server := &fakeServer{ctx: t.Context()}
+	a, err := NewAggregator(server, &tetragon.AggregationOptions{
+		GroupBy:           []string{"process.binary"},
+		DistinctFields:    []string{"args"},
+		MaxGroups:         2,
+		MaxDistinctValues: 2,
+	})
+	require.NoError(t, err)
+
+	a.handleEvent(kprobeEvent("/usr/bin/cat", "security_file_permission", "/etc/passwd", 1))
+	a.handleEvent(kprobeEvent("/usr/bin/cat", "security_file_permission", "/etc/shadow", 2))
+	a.handleEvent(kprobeEvent("/usr/bin/cat", "security_file_permission", "/etc/group", 3))
+	a.handleEvent(kprobeEvent("/usr/bin/cat", "security_file_permission", "/etc/passwd", 4))
+	a.handleEvent(kprobeEvent("/usr/bin/curl", "tcp_connect", "", 5))
+	// dropped, the maximum number of groups is reached
+	a.handleEvent(kprobeEvent("/usr/bin/wget", "tcp_connect", "", 6))
+	assert.Equal(t, uint64(1), a.dropped)
+	a.flush()
+
+	require.Len(t, server.events, 2)
+	assert.Equal(t, uint64(4), server.events[0].GetAggregationInfo().GetCount())
+	// the distinct values stop at the maximum
+	assert.Equal(t, map[string]uint64{"args": 2}, server.events[0].GetAggregationInfo().GetDistinctCounts())
+	assert.Equal(t, "/usr/bin/curl", server.events[1].GetProcessKprobe().GetProcess().GetBinary())
+
+	// the limits apply per window
+	assert.Zero(t, a.dropped)
+	a.handleEvent(kprobeEvent("/usr/bin/wget", "tcp_connect", "", 7))
+	a.flush()
+	require.Len(t, server.events, 3)
+	assert.Equal(t, "/usr/bin/wget", server.events[2].GetProcessKprobe().GetProcess().GetBinary())
+}
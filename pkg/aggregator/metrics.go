@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package aggregator
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cilium/tetragon/pkg/metrics"
+	"github.com/cilium/tetragon/pkg/metrics/consts"
+)
+
+type dropReason string
+
+const (
+	// dropGroups counts the events that were dropped because the maximum
+	// number of groups of the window was reached.
+	dropGroups dropReason = "groups"
+	// dropDistinctValues counts the distinct values that were not counted
+	// because the maximum number of distinct values of the group was
+	// reached.
+	dropDistinctValues dropReason = "distinct_values"
+)
+
+var (
+	aggregatorDropped = metrics.MustNewCounter(metrics.NewOpts(
+		consts.MetricsNamespace, "", "aggregator_dropped_total",
+		"Number of events and distinct values dropped by the event aggregator because of its limits.",
+		nil, []metrics.ConstrainedLabel{{Name: "reason", Values: []string{string(dropGroups), string(dropDistinctValues)}}}, nil,
+	), nil)
+)
+
+func RegisterMetrics(group metrics.Group) {
+	group.MustRegister(aggregatorDropped)
+}
+
+func getDropped(reason dropReason) prometheus.Counter {
+	return aggregatorDropped.WithLabelValues(string(reason))
+}
@@ -37,6 +37,9 @@ const (
 	MSG_OP_LOADER       = 26
 	MSG_OP_THROTTLE     = 27
 	MSG_OP_GENERIC_USDT = 28
+	MSG_OP_FLOW         = 29
+	MSG_OP_DNS          = 30
+	MSG_OP_TLS          = 31
 
 	// just for testing
 	MSG_OP_TEST = 254
@@ -81,6 +84,9 @@ var OpCodeStrings = map[OpCode]string{
 	MSG_OP_LOADER:             "Loader",
 	MSG_OP_THROTTLE:           "Throttle",
 	MSG_OP_GENERIC_USDT:       "GenericUSDT",
+	MSG_OP_FLOW:               "Flow",
+	MSG_OP_DNS:                "DNS",
+	MSG_OP_TLS:                "TLS",
 	MSG_OP_TEST:               "Test",
 }
 
@@ -64,6 +64,13 @@ const (
 	SentFailedMax
 )
 
+// NB: values should match POLICY_PRIORITY_* in bpf/lib/policy_conf.h
+const (
+	PriorityNormal = iota
+	PriorityHigh
+	PriorityMax
+)
+
 type MsgExec struct {
 	Size       uint32
 	PID        uint32
@@ -212,6 +219,11 @@ type MsgProcess struct {
 	Args       string
 	Envs       []string
 	User       MsgUserRecord
+	// ArgsLeftover and EnvsLeftover are the number of bytes of the
+	// arguments and of the environment variables that did not fit in their
+	// data events, when those were truncated.
+	ArgsLeftover uint32
+	EnvsLeftover uint32
 }
 
 type MsgExitInfo struct {
@@ -257,7 +269,8 @@ type MsgThrottleEvent struct {
 }
 
 type KernelStats struct {
-	SentFailed [256][SentFailedMax]uint64 `align:"sent_failed"`
+	SentFailed     [256][SentFailedMax]uint64 `align:"sent_failed"`
+	SentFailedPrio [PriorityMax]uint64        `align:"sent_failed_prio"`
 }
 
 type CgroupRateKey struct {
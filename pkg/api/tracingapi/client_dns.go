@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package tracingapi
+
+import (
+	"github.com/cilium/tetragon/pkg/api/processapi"
+)
+
+const (
+	// DnsMaxSize is the size of the data buffer of struct msg_dns.
+	DnsMaxSize = 512
+)
+
+// MsgDns is the header of the message of the DNS sensor, see struct msg_dns
+// in bpf/process/bpf_dns.c. It is followed by Size bytes of the captured DNS
+// message.
+type MsgDns struct {
+	Common     processapi.MsgCommon
+	ProcessKey processapi.MsgExecveKey
+	Sock       MsgGenericKprobeSock
+	Size       uint32
+	OrigSize   uint32
+	Response   uint8
+	Pad        [7]uint8
+}
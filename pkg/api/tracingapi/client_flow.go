@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package tracingapi
+
+import (
+	"github.com/cilium/tetragon/pkg/api/processapi"
+)
+
+const (
+	FlowDirectionUnknown = 0
+	FlowDirectionEgress  = 1
+	FlowDirectionIngress = 2
+)
+
+// MsgFlow is the message of the network flow sensor, see struct msg_flow in
+// bpf/process/bpf_flow.c.
+type MsgFlow struct {
+	Common        processapi.MsgCommon
+	ProcessKey    processapi.MsgExecveKey
+	Sock          MsgGenericKprobeSock
+	Start         uint64
+	BytesSent     uint64
+	BytesReceived uint64
+	SegsOut       uint32
+	SegsIn        uint32
+	Direction     uint8
+	End           uint8
+	Pad           [6]uint8
+}
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package tracingapi
+
+import (
+	"github.com/cilium/tetragon/pkg/api/processapi"
+)
+
+const (
+	// TlsMaxSize is the size of the data buffer of struct msg_tls.
+	TlsMaxSize = 4096
+
+	TlsLibraryOpenSSL = 1
+	TlsLibraryGo      = 2
+
+	TlsDirectionWrite = 1
+	TlsDirectionRead  = 2
+)
+
+// MsgTls is the header of the message of the TLS plaintext sensor, see struct
+// msg_tls in bpf/process/bpf_tls.c. It is followed by Size bytes of the
+// captured plaintext.
+type MsgTls struct {
+	Common     processapi.MsgCommon
+	ProcessKey processapi.MsgExecveKey
+	Sock       MsgGenericKprobeSock
+	Size       uint32
+	OrigSize   uint32
+	Library    uint8
+	Direction  uint8
+	HasSock    uint8
+	Pad        [5]uint8
+}
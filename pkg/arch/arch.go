@@ -45,6 +45,12 @@ func AddSyscallPrefix(symbol string) (string, error) {
 	return addSyscallPrefix(symbol, runtime.GOARCH)
 }
 
+// AddSyscallPrefixArch is like AddSyscallPrefix, for the given arch (amd64,
+// arm64 or i386) instead of the running one.
+func AddSyscallPrefixArch(symbol string, arch string) (string, error) {
+	return addSyscallPrefix(symbol, arch)
+}
+
 // AddSyscallPrefixTestHelper is like AddSyscallPrefix but calls t.Fatal if the
 // arch is unsupported or if the symbol has already a prefix that doesn't
 // correspond to the running arch.
@@ -57,6 +57,7 @@ var (
 	uprobeRegsChange       Feature
 	kfuncs                 FeatureKfuncs
 	mixBpfAndTailCalls     Feature
+	fentry                 Feature
 )
 
 func HasOverrideHelper() bool {
@@ -170,6 +171,43 @@ func HasBuildId() bool {
 	return buildid.detected
 }
 
+// detectFentry checks that fentry and fexit programs can be attached, which
+// requires BTF and BPF trampolines support for the architecture.
+func detectFentry() bool {
+	for _, attachType := range []ebpf.AttachType{ebpf.AttachTraceFEntry, ebpf.AttachTraceFExit} {
+		prog, err := ebpf.NewProgram(&ebpf.ProgramSpec{
+			Name: "probe_fentry",
+			Type: ebpf.Tracing,
+			Instructions: asm.Instructions{
+				asm.Mov.Imm(asm.R0, 0),
+				asm.Return(),
+			},
+			AttachType: attachType,
+			AttachTo:   "vprintk",
+			License:    "MIT",
+		})
+		if err != nil {
+			return false
+		}
+		link, err := link.AttachTracing(link.TracingOptions{
+			Program: prog,
+		})
+		prog.Close()
+		if err != nil {
+			return false
+		}
+		link.Close()
+	}
+	return true
+}
+
+func HasFentry() bool {
+	fentry.init.Do(func() {
+		fentry.detected = detectFentry()
+	})
+	return fentry.detected
+}
+
 func detectModifyReturn() bool {
 	prog, err := ebpf.NewProgram(&ebpf.ProgramSpec{
 		Name: "probe_fmod_ret",
@@ -718,6 +756,7 @@ var FeatureProbes = []FeatureProbe{
 	{"uprobe_multi", HasUprobeMulti},
 	{"fmodret", HasModifyReturn},
 	{"fmodret_syscall", HasModifyReturnSyscall},
+	{"fentry", HasFentry},
 	{"signal", HasSignalHelper},
 	{"large", HasProgramLargeSize},
 	{"link_pin", HasLinkPin},
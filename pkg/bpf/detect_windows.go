@@ -25,6 +25,10 @@ func HasModifyReturnSyscall() bool {
 	return false
 }
 
+func HasFentry() bool {
+	return false
+}
+
 func HasProgramLargeSize() bool {
 	return false
 }
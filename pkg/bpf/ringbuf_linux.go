@@ -4,5 +4,6 @@
 package bpf
 
 var (
-	RingBufEventsMapName = "tg_rb_events"
+	RingBufEventsMapName     = "tg_rb_events"
+	RingBufEventsHighMapName = "tg_rb_events_high"
 )
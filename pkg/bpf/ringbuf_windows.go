@@ -3,5 +3,6 @@
 package bpf
 
 var (
-	RingBufEventsMapName = ""
+	RingBufEventsMapName     = ""
+	RingBufEventsHighMapName = ""
 )
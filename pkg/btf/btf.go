@@ -156,6 +156,28 @@ func firstTypeByName(spec *btf.Spec, name string, typ any) error {
 	return btf.ErrNotFound
 }
 
+// FindBTFFunc returns the BTF function of a kernel or kernel module function.
+func FindBTFFunc(name string) (*btf.Func, error) {
+	var fn *btf.Func
+
+	spec, err := NewBTF()
+	if err != nil {
+		return nil, err
+	}
+	if ks, err := ksyms.KernelSymbols(); err == nil {
+		if kmod, err := ks.GetKmod(name); err == nil {
+			spec, err = btf.LoadKernelModuleSpec(kmod)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := spec.TypeByName(name, &fn); err != nil {
+		return nil, fmt.Errorf("failed to find BTF type for function %q: %w", name, err)
+	}
+	return fn, nil
+}
+
 func FindBTFFuncParamFromHook(hook string, argIndex int) (*btf.FuncParam, error) {
 	// If the hook is part of a kernel module, load its BTF file
 	if ks, err := ksyms.KernelSymbols(); err == nil {
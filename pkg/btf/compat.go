@@ -0,0 +1,534 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+//go:build !windows
+
+package btf
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/cilium/ebpf/btf"
+
+	"github.com/cilium/tetragon/pkg/arch"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/ksyms"
+	"github.com/cilium/tetragon/pkg/syscallinfo"
+)
+
+// CompatResult is the result of checking a hook of a tracing policy against a
+// kernel.
+type CompatResult int
+
+const (
+	// CompatOK means that the hook can be loaded on the kernel.
+	CompatOK CompatResult = iota
+	// CompatMissingSymbol means that the hooked function, tracepoint or LSM
+	// hook does not exist in the kernel.
+	CompatMissingSymbol
+	// CompatArgTypeMismatch means that the arguments of the hook in the policy
+	// do not match the kernel.
+	CompatArgTypeMismatch
+	// CompatUnsupportedFeature means that the kernel does not support a BPF
+	// feature needed by the hook, for example a helper of one of its actions.
+	CompatUnsupportedFeature
+)
+
+func (r CompatResult) String() string {
+	switch r {
+	case CompatOK:
+		return "ok"
+	case CompatMissingSymbol:
+		return "missing symbol"
+	case CompatArgTypeMismatch:
+		return "arg type mismatch"
+	case CompatUnsupportedFeature:
+		return "unsupported feature"
+	}
+	return fmt.Sprintf("unknown(%d)", int(r))
+}
+
+// MarshalText implements encoding.TextMarshaler, so that results are encoded
+// as strings in JSON.
+func (r CompatResult) MarshalText() ([]byte, error) {
+	return []byte(r.String()), nil
+}
+
+// CompatKernel is a kernel to check tracing policies against, described by its
+// vmlinux BTF and, optionally, a snapshot of its kallsyms.
+type CompatKernel struct {
+	Name  string
+	Spec  *btf.Spec
+	Ksyms ksyms.Snapshot
+	// Arch is the architecture of the kernel (amd64 or arm64), detected
+	// from the prefix of its syscall functions. It is empty if no syscall
+	// function is found.
+	Arch string
+}
+
+// compatArchs are the architectures of kernels, with the prefix and the ABI
+// of their syscalls.
+var compatArchs = []struct {
+	arch, prefix, abi string
+}{
+	{arch: "amd64", prefix: "__x64_", abi: "x64"},
+	{arch: "arm64", prefix: "__arm64_", abi: "arm64"},
+}
+
+// compatDetectArch returns the architecture of a kernel, from the prefix of
+// common syscall functions in its BTF.
+func compatDetectArch(bspec *btf.Spec) string {
+	for _, a := range compatArchs {
+		for _, name := range []string{"sys_read", "sys_write", "sys_close", "sys_openat"} {
+			var fn *btf.Func
+			err := bspec.TypeByName(a.prefix+name, &fn)
+			if err == nil || errors.Is(err, btf.ErrMultipleMatches) {
+				return a.arch
+			}
+		}
+	}
+	return ""
+}
+
+// syscallSymbol returns the function of a syscall in the kernel, adding the
+// prefix of its architecture if needed.
+func (k *CompatKernel) syscallSymbol(name string) (string, error) {
+	if k.Arch == "" {
+		return "", errors.New("unknown kernel architecture, no syscall function found in BTF")
+	}
+	return arch.AddSyscallPrefixArch(name, k.Arch)
+}
+
+// syscallABI returns the ABI of the syscalls of the kernel.
+func (k *CompatKernel) syscallABI() (string, error) {
+	for _, a := range compatArchs {
+		if a.arch == k.Arch {
+			return a.abi, nil
+		}
+	}
+	return "", errors.New("unknown kernel architecture, no syscall function found in BTF")
+}
+
+const (
+	compatBTFExt      = ".btf"
+	compatKallsymsExt = ".kallsyms"
+)
+
+// LoadCompatKernels loads the kernels of a directory. Every file of the
+// directory is the vmlinux BTF of a kernel named after the file, without its
+// .btf extension if any, except the files with the .kallsyms extension which
+// are the kallsyms snapshot of the kernel with the same name. Kernels are
+// sorted by name.
+func LoadCompatKernels(dir string) ([]CompatKernel, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var kernels []CompatKernel
+	for _, de := range dirEntries {
+		fname := de.Name()
+		if de.IsDir() || strings.HasSuffix(fname, compatKallsymsExt) {
+			continue
+		}
+		k := CompatKernel{Name: strings.TrimSuffix(fname, compatBTFExt)}
+		k.Spec, err = btf.LoadSpec(filepath.Join(dir, fname))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load BTF of kernel %s: %w", k.Name, err)
+		}
+		k.Arch = compatDetectArch(k.Spec)
+		kallsyms := filepath.Join(dir, k.Name+compatKallsymsExt)
+		if _, err := os.Stat(kallsyms); err == nil {
+			k.Ksyms, err = ksyms.ReadSnapshot(kallsyms)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read kallsyms of kernel %s: %w", k.Name, err)
+			}
+		}
+		kernels = append(kernels, k)
+	}
+	if len(kernels) == 0 {
+		return nil, fmt.Errorf("no BTF file found in %s", dir)
+	}
+	slices.SortFunc(kernels, func(a, b CompatKernel) int { return strings.Compare(a.Name, b.Name) })
+	return kernels, nil
+}
+
+// CompatCheck is the result of checking a hook of a tracing policy against a
+// kernel.
+type CompatCheck struct {
+	// Hook is the hook, prefixed by its type, for example kprobe:fd_install
+	// or tracepoint:syscalls/sys_enter_openat.
+	Hook   string       `json:"hook"`
+	Kernel string       `json:"kernel"`
+	Result CompatResult `json:"result"`
+	// Detail explains the result.
+	Detail string `json:"detail,omitempty"`
+}
+
+// compatHook is a hook of a policy and the function that checks it against a
+// kernel.
+type compatHook struct {
+	name  string
+	check func(k *CompatKernel) (CompatResult, string)
+}
+
+// CheckPolicyCompat checks the hooks of a tracing policy against kernels,
+// without loading anything. Kprobes, tracepoints, LSM hooks and enforcers are
+// checked, uprobes and USDTs do not depend on the kernel.
+//
+// Checks are limited to what the BTF and the kallsyms of a kernel tell: for
+// example, they do not tell whether a function can be overridden or whether
+// BPF LSM is enabled on the kernel command line.
+func CheckPolicyCompat(spec *v1alpha1.TracingPolicySpec, kernels []CompatKernel) ([]CompatCheck, error) {
+	var hooks []compatHook
+	for i := range spec.KProbes {
+		kh, err := compatKprobeHooks(spec, &spec.KProbes[i])
+		if err != nil {
+			return nil, fmt.Errorf("kprobes[%d]: %w", i, err)
+		}
+		hooks = append(hooks, kh...)
+	}
+	for i := range spec.Tracepoints {
+		hooks = append(hooks, compatTracepointHook(&spec.Tracepoints[i]))
+	}
+	for i := range spec.LsmHooks {
+		hooks = append(hooks, compatLsmHook(&spec.LsmHooks[i]))
+	}
+	for i := range spec.Enforcers {
+		eh, err := compatEnforcerHooks(spec, &spec.Enforcers[i])
+		if err != nil {
+			return nil, fmt.Errorf("enforcers[%d]: %w", i, err)
+		}
+		hooks = append(hooks, eh...)
+	}
+
+	var ret []CompatCheck
+	for _, hook := range hooks {
+		for i := range kernels {
+			res, detail := hook.check(&kernels[i])
+			ret = append(ret, CompatCheck{
+				Hook:   hook.name,
+				Kernel: kernels[i].Name,
+				Result: res,
+				Detail: detail,
+			})
+		}
+	}
+	return ret, nil
+}
+
+// compatCalls returns the calls of a kprobe or enforcer, expanding lists, and
+// whether they are syscalls. Generated syscall lists are expanded for each
+// kernel, so they are returned as a nil slice.
+func compatCalls(spec *v1alpha1.TracingPolicySpec, call string, syscall bool) ([]string, bool, error) {
+	name, isList := strings.CutPrefix(call, "list:")
+	if !isList {
+		return []string{call}, syscall, nil
+	}
+	idx := slices.IndexFunc(spec.Lists, func(l v1alpha1.ListSpec) bool { return l.Name == name })
+	if idx < 0 {
+		return nil, false, fmt.Errorf("list %q not found", name)
+	}
+	list := &spec.Lists[idx]
+	switch strings.ToLower(list.Type) {
+	case "":
+		return list.Values, syscall, nil
+	case "syscalls":
+		calls := make([]string, 0, len(list.Values))
+		for _, val := range list.Values {
+			calls = append(calls, compatSyscallSymbol(val))
+		}
+		return calls, true, nil
+	case "generated_syscalls":
+		return nil, true, nil
+	}
+	return nil, false, fmt.Errorf("list %q of type %s cannot be checked offline", name, list.Type)
+}
+
+var compatABIPrefixes = map[string]string{
+	"x64":   "__x64_",
+	"arm64": "__arm64_",
+	"i386":  "__ia32_",
+	"arm32": "__arm64_",
+}
+
+// compatSyscallSymbol returns the symbol of a value of a syscalls list, which
+// can be prefixed by an ABI, for example i386/sys_open.
+func compatSyscallSymbol(val string) string {
+	abi, name, ok := strings.Cut(val, "/")
+	if !ok {
+		return val
+	}
+	prefix := compatABIPrefixes[abi]
+	if !strings.HasPrefix(name, "sys_") {
+		name = "sys_" + name
+	}
+	return prefix + name
+}
+
+// compatSyscalls returns the syscalls of a kernel, as generated_syscalls lists
+// do for the running kernel.
+func compatSyscalls(k *CompatKernel) ([]string, error) {
+	abi, err := k.syscallABI()
+	if err != nil {
+		return nil, err
+	}
+	names, err := syscallinfo.SyscallsNames(abi)
+	if err != nil {
+		return nil, err
+	}
+	var ret []string
+	for _, name := range names {
+		sym, err := k.syscallSymbol("sys_" + name)
+		if err != nil {
+			return nil, err
+		}
+		var fn *btf.Func
+		if err := k.Spec.TypeByName(sym, &fn); err == nil {
+			ret = append(ret, sym)
+		}
+	}
+	slices.Sort(ret)
+	return ret, nil
+}
+
+func compatKprobeHooks(spec *v1alpha1.TracingPolicySpec, kspec *v1alpha1.KProbeSpec) ([]compatHook, error) {
+	calls, syscall, err := compatCalls(spec, kspec.Call, kspec.Syscall)
+	if err != nil {
+		return nil, err
+	}
+	kspec = kspec.DeepCopy()
+	kspec.Syscall = syscall
+
+	check := func(k *CompatKernel, call string) (CompatResult, string) {
+		if res, detail := compatCheckCall(k, call, kspec); res != CompatOK {
+			return res, detail
+		}
+		return compatCheckActions(k, kspec.Selectors)
+	}
+
+	if calls == nil {
+		// generated syscalls list, check all the syscalls of each kernel
+		return []compatHook{{
+			name: "kprobe:" + kspec.Call,
+			check: func(k *CompatKernel) (CompatResult, string) {
+				syscalls, err := compatSyscalls(k)
+				if err != nil {
+					return CompatMissingSymbol, err.Error()
+				}
+				for _, call := range syscalls {
+					if res, detail := check(k, call); res != CompatOK {
+						return res, call + ": " + detail
+					}
+				}
+				return CompatOK, fmt.Sprintf("%d syscalls", len(syscalls))
+			},
+		}}, nil
+	}
+
+	hooks := make([]compatHook, 0, len(calls))
+	for _, call := range calls {
+		hooks = append(hooks, compatHook{
+			name:  "kprobe:" + call,
+			check: func(k *CompatKernel) (CompatResult, string) { return check(k, call) },
+		})
+	}
+	return hooks, nil
+}
+
+// compatCheckCall checks that a kprobe call exists in a kernel and that its
+// arguments match the spec.
+func compatCheckCall(k *CompatKernel, call string, kspec *v1alpha1.KProbeSpec) (CompatResult, string) {
+	syms := []string{call}
+	if kspec.Syscall {
+		sym, err := k.syscallSymbol(call)
+		if err != nil {
+			return CompatMissingSymbol, err.Error()
+		}
+		if sym != call {
+			syms = append(syms, sym)
+			call = sym
+		}
+	}
+
+	if !slices.ContainsFunc(syms, func(sym string) bool { return compatHasFunc(k.Spec, sym) }) {
+		// functions that are not in the vmlinux BTF, such as the functions of
+		// kernel modules, can still be hooked.
+		for _, sym := range syms {
+			if kmod, ok := k.Ksyms.GetKmod(sym); ok {
+				return CompatOK, fmt.Sprintf("in module %s, arguments not checked", kmod)
+			}
+			if k.Ksyms.IsAvailable(sym) {
+				return CompatOK, "not in BTF, arguments not checked"
+			}
+		}
+		return CompatMissingSymbol, fmt.Sprintf("%s not found in BTF", call)
+	}
+
+	err := validateKprobeSpec(k.Spec, call, kspec, k.Arch)
+	// without arguments nor return value, there is nothing to mismatch:
+	// ignore warnings such as missing syscall information.
+	if err != nil && (len(kspec.Args) > 0 || kspec.Return) {
+		return CompatArgTypeMismatch, err.Error()
+	}
+
+	// functions in the BTF can still be missing from kallsyms, for example
+	// when they were inlined.
+	if k.Ksyms != nil && !slices.ContainsFunc(syms, k.Ksyms.IsAvailable) {
+		return CompatMissingSymbol, "not in kallsyms"
+	}
+	return CompatOK, ""
+}
+
+// compatHasFunc returns true if a function is in the BTF of a kernel.
+func compatHasFunc(bspec *btf.Spec, name string) bool {
+	var fn *btf.Func
+	err := bspec.TypeByName(name, &fn)
+	return err == nil || errors.Is(err, btf.ErrMultipleMatches)
+}
+
+func compatTracepointHook(tp *v1alpha1.TracepointSpec) compatHook {
+	return compatHook{
+		name: "tracepoint:" + tp.Subsystem + "/" + tp.Event,
+		check: func(k *CompatKernel) (CompatResult, string) {
+			if res, detail := compatCheckTracepoint(k, tp); res != CompatOK {
+				return res, detail
+			}
+			return compatCheckActions(k, tp.Selectors)
+		},
+	}
+}
+
+// compatCheckTracepoint checks that a tracepoint exists in a kernel. The
+// arguments of tracepoints are described by their format in tracefs, so they
+// are not checked.
+func compatCheckTracepoint(k *CompatKernel, tp *v1alpha1.TracepointSpec) (CompatResult, string) {
+	if tp.Subsystem == "syscalls" {
+		// syscall tracepoints are defined for every syscall.
+		name, ok := strings.CutPrefix(tp.Event, "sys_enter_")
+		if !ok {
+			name, _ = strings.CutPrefix(tp.Event, "sys_exit_")
+		}
+		sym, err := k.syscallSymbol("sys_" + name)
+		if err != nil {
+			return CompatMissingSymbol, err.Error()
+		}
+		var fn *btf.Func
+		if err := k.Spec.TypeByName(sym, &fn); err != nil {
+			return CompatMissingSymbol, fmt.Sprintf("syscall %s: %s", sym, err)
+		}
+		return CompatOK, ""
+	}
+
+	// kernels with BPF support have a btf_trace_<event> type for every
+	// tracepoint.
+	var typedef *btf.Typedef
+	if err := k.Spec.TypeByName("btf_trace_"+tp.Event, &typedef); err == nil {
+		return CompatOK, ""
+	}
+	if k.Ksyms.IsAvailable("__tracepoint_" + tp.Event) {
+		return CompatOK, ""
+	}
+	return CompatMissingSymbol, fmt.Sprintf("tracepoint %s not found", tp.Event)
+}
+
+func compatLsmHook(lsm *v1alpha1.LsmHookSpec) compatHook {
+	return compatHook{
+		name: "lsm:" + lsm.Hook,
+		check: func(k *CompatKernel) (CompatResult, string) {
+			if !compatEnumHas(k.Spec, "bpf_prog_type", "BPF_PROG_TYPE_LSM") {
+				return CompatUnsupportedFeature, "BPF LSM programs are not supported"
+			}
+			kspec := &v1alpha1.KProbeSpec{Call: "bpf_lsm_" + lsm.Hook, Args: lsm.Args}
+			err := validateKprobeSpec(k.Spec, kspec.Call, kspec, k.Arch)
+			if errors.Is(err, btf.ErrNotFound) {
+				return CompatMissingSymbol, err.Error()
+			}
+			if err != nil {
+				return CompatArgTypeMismatch, err.Error()
+			}
+			for _, sel := range lsm.Selectors {
+				for _, act := range sel.MatchActions {
+					if act.ImaHash && !compatEnumHas(k.Spec, "bpf_func_id", "BPF_FUNC_ima_file_hash") {
+						return CompatUnsupportedFeature, "imaHash requires the bpf_ima_file_hash helper"
+					}
+				}
+			}
+			return compatCheckActions(k, lsm.Selectors)
+		},
+	}
+}
+
+func compatEnforcerHooks(spec *v1alpha1.TracingPolicySpec, enforcer *v1alpha1.EnforcerSpec) ([]compatHook, error) {
+	var hooks []compatHook
+	for _, call := range enforcer.Calls {
+		calls, syscall, err := compatCalls(spec, call, false)
+		if err != nil {
+			return nil, err
+		}
+		if calls == nil {
+			return nil, fmt.Errorf("generated syscalls list %s is not supported by enforcers", call)
+		}
+		for _, call := range calls {
+			kspec := &v1alpha1.KProbeSpec{Call: call, Syscall: syscall || strings.HasPrefix(call, "sys_")}
+			hooks = append(hooks, compatHook{
+				name: "enforcer:" + call,
+				check: func(k *CompatKernel) (CompatResult, string) {
+					if res, detail := compatCheckCall(k, call, kspec); res != CompatOK {
+						return res, detail
+					}
+					if !compatEnumHas(k.Spec, "bpf_func_id", "BPF_FUNC_override_return") &&
+						!compatEnumHas(k.Spec, "bpf_attach_type", "BPF_MODIFY_RETURN") &&
+						!compatEnumHas(k.Spec, "bpf_prog_type", "BPF_PROG_TYPE_LSM") {
+						return CompatUnsupportedFeature, "neither override return, fmod_ret nor BPF LSM is supported"
+					}
+					return CompatOK, ""
+				},
+			})
+		}
+	}
+	return hooks, nil
+}
+
+// compatActionHelpers are the BPF helpers needed by actions.
+var compatActionHelpers = map[string]string{
+	"sigkill":  "BPF_FUNC_send_signal",
+	"signal":   "BPF_FUNC_send_signal",
+	"override": "BPF_FUNC_override_return",
+}
+
+// compatCheckActions checks that a kernel supports the actions of selectors.
+func compatCheckActions(k *CompatKernel, selectors []v1alpha1.KProbeSelector) (CompatResult, string) {
+	for _, sel := range selectors {
+		for _, act := range sel.MatchActions {
+			action := strings.ToLower(act.Action)
+			helper, ok := compatActionHelpers[action]
+			if !ok {
+				continue
+			}
+			if !compatEnumHas(k.Spec, "bpf_func_id", helper) {
+				return CompatUnsupportedFeature, fmt.Sprintf("%s action requires the %s helper", act.Action, helper)
+			}
+			// bpf_override_return is only built with
+			// CONFIG_BPF_KPROBE_OVERRIDE.
+			if action == "override" && k.Ksyms != nil && !k.Ksyms.IsAvailable("bpf_override_return_proto") {
+				return CompatUnsupportedFeature, "Override action requires CONFIG_BPF_KPROBE_OVERRIDE"
+			}
+		}
+	}
+	return CompatOK, ""
+}
+
+// compatEnumHas returns whether an enum of a kernel has a value, for example
+// whether the bpf_func_id enum has a helper.
+func compatEnumHas(bspec *btf.Spec, enum, value string) bool {
+	var e *btf.Enum
+	if err := bspec.TypeByName(enum, &e); err != nil {
+		return false
+	}
+	return slices.ContainsFunc(e.Values, func(v btf.EnumValue) bool { return v.Name == value })
+}
@@ -0,0 +1,212 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+//go:build !windows
+
+package btf
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/cilium/ebpf/btf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/pkg/arch"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+// writeCompatBTF writes a vmlinux BTF with the fd_install and openat functions,
+// the sched_process_exec tracepoint and the file_open LSM hook. Newer kernels
+// also have the BPF LSM and the bpf_send_signal helper, and fd_install takes a
+// struct file * instead of an int as second argument.
+func writeCompatBTF(t *testing.T, fname string, newer bool) {
+	uint := &btf.Int{Name: "unsigned int", Size: 4}
+	int := &btf.Int{Name: "int", Size: 4, Encoding: btf.Signed}
+	long := &btf.Int{Name: "long int", Size: 8, Encoding: btf.Signed}
+	file := &btf.Struct{Name: "file"}
+	regs := &btf.Struct{Name: "pt_regs"}
+
+	fdInstallArg := btf.Type(int)
+	if newer {
+		fdInstallArg = &btf.Pointer{Target: file}
+	}
+	funcIDs := []btf.EnumValue{{Name: "BPF_FUNC_override_return", Value: 58}}
+	progTypes := []btf.EnumValue{{Name: "BPF_PROG_TYPE_KPROBE", Value: 2}}
+	if newer {
+		funcIDs = append(funcIDs, btf.EnumValue{Name: "BPF_FUNC_send_signal", Value: 109})
+		progTypes = append(progTypes, btf.EnumValue{Name: "BPF_PROG_TYPE_LSM", Value: 29})
+	}
+
+	types := []btf.Type{
+		&btf.Func{Name: "fd_install", Type: &btf.FuncProto{
+			Return: &btf.Void{},
+			Params: []btf.FuncParam{{Name: "fd", Type: uint}, {Name: "file", Type: fdInstallArg}},
+		}},
+		&btf.Func{Name: arch.AddSyscallPrefixTestHelper(t, "sys_openat"), Type: &btf.FuncProto{
+			Return: long,
+			Params: []btf.FuncParam{{Name: "regs", Type: &btf.Pointer{Target: &btf.Const{Type: regs}}}},
+		}},
+		&btf.Typedef{Name: "btf_trace_sched_process_exec", Type: &btf.Pointer{Target: &btf.FuncProto{Return: &btf.Void{}}}},
+		&btf.Func{Name: "bpf_lsm_file_open", Type: &btf.FuncProto{
+			Return: int,
+			Params: []btf.FuncParam{{Name: "file", Type: &btf.Pointer{Target: file}}},
+		}},
+		&btf.Enum{Name: "bpf_func_id", Size: 4, Values: funcIDs},
+		&btf.Enum{Name: "bpf_prog_type", Size: 4, Values: progTypes},
+	}
+
+	b, err := btf.NewBuilder(types)
+	require.NoError(t, err)
+	raw, err := b.Marshal(nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(fname, raw, 0644))
+}
+
+func TestCheckPolicyCompat(t *testing.T) {
+	dir := t.TempDir()
+	writeCompatBTF(t, filepath.Join(dir, "5.4"), false)
+	writeCompatBTF(t, filepath.Join(dir, "6.1.btf"), true)
+	// fd_install is inlined in the 6.1 kernel
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "6.1.kallsyms"), []byte(
+		"0000000000000000 T "+arch.AddSyscallPrefixTestHelper(t, "sys_openat")+"\n"+
+			"0000000000000000 T bpf_lsm_file_open\n"+
+			"0000000000000000 T ext4_file_open\t[ext4]\n"), 0644))
+
+	kernels, err := LoadCompatKernels(dir)
+	require.NoError(t, err)
+	require.Len(t, kernels, 2)
+	assert.Equal(t, "5.4", kernels[0].Name)
+	assert.Nil(t, kernels[0].Ksyms)
+	assert.Equal(t, "6.1", kernels[1].Name)
+	assert.NotNil(t, kernels[1].Ksyms)
+
+	spec := &v1alpha1.TracingPolicySpec{
+		Lists: []v1alpha1.ListSpec{
+			{Name: "opens", Type: "syscalls", Values: []string{"sys_openat"}},
+			{Name: "all", Type: "generated_syscalls"},
+		},
+		KProbes: []v1alpha1.KProbeSpec{
+			{Call: "fd_install", Args: []v1alpha1.KProbeArg{{Index: 0, Type: "int"}, {Index: 1, Type: "file"}}},
+			{Call: "list:opens", Selectors: []v1alpha1.KProbeSelector{{
+				MatchActions: []v1alpha1.ActionSelector{{Action: "Sigkill"}},
+			}}},
+			{Call: "ext4_file_open"},
+			{Call: "list:all"},
+		},
+		Tracepoints: []v1alpha1.TracepointSpec{
+			{Subsystem: "sched", Event: "sched_process_exec"},
+			{Subsystem: "syscalls", Event: "sys_enter_openat"},
+			{Subsystem: "sched", Event: "sched_process_free"},
+		},
+		LsmHooks: []v1alpha1.LsmHookSpec{
+			{Hook: "file_open", Args: []v1alpha1.KProbeArg{{Index: 0, Type: "file"}}},
+		},
+	}
+
+	checks, err := CheckPolicyCompat(spec, kernels)
+	require.NoError(t, err)
+
+	type result struct {
+		hook, kernel string
+		result       CompatResult
+	}
+	var results []result
+	for _, c := range checks {
+		results = append(results, result{c.Hook, c.Kernel, c.Result})
+		if c.Hook == "kprobe:list:all" {
+			assert.Equal(t, "1 syscalls", c.Detail)
+		}
+	}
+	assert.Equal(t, []result{
+		{"kprobe:fd_install", "5.4", CompatArgTypeMismatch},
+		{"kprobe:fd_install", "6.1", CompatMissingSymbol},
+		{"kprobe:sys_openat", "5.4", CompatUnsupportedFeature},
+		{"kprobe:sys_openat", "6.1", CompatOK},
+		{"kprobe:ext4_file_open", "5.4", CompatMissingSymbol},
+		{"kprobe:ext4_file_open", "6.1", CompatOK},
+		{"kprobe:list:all", "5.4", CompatOK},
+		{"kprobe:list:all", "6.1", CompatOK},
+		{"tracepoint:sched/sched_process_exec", "5.4", CompatOK},
+		{"tracepoint:sched/sched_process_exec", "6.1", CompatOK},
+		{"tracepoint:syscalls/sys_enter_openat", "5.4", CompatOK},
+		{"tracepoint:syscalls/sys_enter_openat", "6.1", CompatOK},
+		{"tracepoint:sched/sched_process_free", "5.4", CompatMissingSymbol},
+		{"tracepoint:sched/sched_process_free", "6.1", CompatMissingSymbol},
+		{"lsm:file_open", "5.4", CompatUnsupportedFeature},
+		{"lsm:file_open", "6.1", CompatOK},
+	}, results)
+}
+
+// TestCheckPolicyCompatArch checks a kernel of another architecture than the
+// running one: syscalls must be resolved with the prefix of the kernel.
+func TestCheckPolicyCompatArch(t *testing.T) {
+	kernelArch, prefix := "arm64", "__arm64_"
+	if runtime.GOARCH == "arm64" {
+		kernelArch, prefix = "amd64", "__x64_"
+	}
+
+	long := &btf.Int{Name: "long int", Size: 8, Encoding: btf.Signed}
+	regs := &btf.Struct{Name: "pt_regs"}
+	b, err := btf.NewBuilder([]btf.Type{
+		&btf.Func{Name: prefix + "sys_openat", Type: &btf.FuncProto{
+			Return: long,
+			Params: []btf.FuncParam{{Name: "regs", Type: &btf.Pointer{Target: &btf.Const{Type: regs}}}},
+		}},
+		&btf.Enum{Name: "bpf_func_id", Size: 4, Values: []btf.EnumValue{{Name: "BPF_FUNC_send_signal", Value: 109}}},
+	})
+	require.NoError(t, err)
+	raw, err := b.Marshal(nil, nil)
+	require.NoError(t, err)
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "other"), raw, 0644))
+
+	kernels, err := LoadCompatKernels(dir)
+	require.NoError(t, err)
+	require.Len(t, kernels, 1)
+	assert.Equal(t, kernelArch, kernels[0].Arch)
+
+	spec := &v1alpha1.TracingPolicySpec{
+		Lists: []v1alpha1.ListSpec{
+			{Name: "opens", Type: "syscalls", Values: []string{"sys_openat"}},
+			{Name: "all", Type: "generated_syscalls"},
+		},
+		KProbes: []v1alpha1.KProbeSpec{
+			{Call: "sys_openat", Syscall: true},
+			{Call: "list:opens", Selectors: []v1alpha1.KProbeSelector{{
+				MatchActions: []v1alpha1.ActionSelector{{Action: "Sigkill"}},
+			}}},
+			{Call: "list:all"},
+			{Call: arch.AddSyscallPrefixTestHelper(t, "sys_openat"), Syscall: true},
+			{Call: "sys_doesnotexist", Syscall: true},
+		},
+		Tracepoints: []v1alpha1.TracepointSpec{
+			{Subsystem: "syscalls", Event: "sys_enter_openat"},
+		},
+	}
+
+	checks, err := CheckPolicyCompat(spec, kernels)
+	require.NoError(t, err)
+	require.Len(t, checks, 6)
+	for _, c := range checks[:3] {
+		assert.Equal(t, CompatOK, c.Result, "%s: %s", c.Hook, c.Detail)
+	}
+	assert.Equal(t, "1 syscalls", checks[2].Detail)
+	// the host prefix does not match the kernel
+	assert.Equal(t, CompatMissingSymbol, checks[3].Result)
+	assert.Equal(t, CompatMissingSymbol, checks[4].Result, checks[4].Detail)
+	assert.Equal(t, CompatOK, checks[5].Result, checks[5].Detail)
+}
+
+func TestCheckPolicyCompatUnsupportedList(t *testing.T) {
+	pattern := "^ext4_"
+	spec := &v1alpha1.TracingPolicySpec{
+		Lists:   []v1alpha1.ListSpec{{Name: "ext4", Type: "generated_ftrace", Pattern: &pattern}},
+		KProbes: []v1alpha1.KProbeSpec{{Call: "list:ext4"}},
+	}
+	_, err := CheckPolicyCompat(spec, nil)
+	require.Error(t, err)
+}
@@ -56,8 +56,6 @@ func (err *ValidationFailedError) Error() string {
 // syscalls). We still keep this code in the btf package for now, and we can
 // move it once we found a better home for it.
 func ValidateKprobeSpec(bspec *btf.Spec, call string, kspec *v1alpha1.KProbeSpec, ks *ksyms.Ksyms) error {
-	var fn *btf.Func
-
 	// check if this function name is part of a kernel module
 	if kmod, err := ks.GetKmod(call); err == nil {
 		// get the spec from the kernel module and continue the validation with that
@@ -68,12 +66,29 @@ func ValidateKprobeSpec(bspec *btf.Spec, call string, kspec *v1alpha1.KProbeSpec
 		bspec = kmodSpec
 	}
 
+	return validateKprobeSpec(bspec, call, kspec, "")
+}
+
+// validateKprobeSpec validates a kprobe spec against the BTF of the kernel, or
+// of the kernel module, the call belongs to. Syscalls are looked up with the
+// prefix of kernelArch, or of the running kernel if it is empty.
+func validateKprobeSpec(bspec *btf.Spec, call string, kspec *v1alpha1.KProbeSpec, kernelArch string) error {
+	var fn *btf.Func
+
 	origCall := call
 	err := bspec.TypeByName(call, &fn)
 	if err != nil && kspec.Syscall {
-		// Try with system call prefix
-		call, err = arch.AddSyscallPrefix(call)
-		if err == nil {
+		// Try with system call prefix, keeping the lookup error if the
+		// prefix cannot be added
+		var prefixed string
+		var perr error
+		if kernelArch == "" {
+			prefixed, perr = arch.AddSyscallPrefix(call)
+		} else {
+			prefixed, perr = arch.AddSyscallPrefixArch(call, kernelArch)
+		}
+		if perr == nil {
+			call = prefixed
 			err = bspec.TypeByName(call, &fn)
 		}
 	}
@@ -249,7 +264,8 @@ func getKernelType(arg btf.Type) string {
 
 func typesCompatible(specTy string, kernelTy string) bool {
 	switch specTy {
-	case "nop":
+	case "nop", "auto":
+		// auto types are resolved from the kernel type
 		return true
 
 	case "uint64":
@@ -35,6 +35,7 @@ import (
 	"github.com/cilium/tetragon/cmd/tetra/common"
 	"github.com/cilium/tetragon/pkg/defaults"
 	"github.com/cilium/tetragon/pkg/dump"
+	"github.com/cilium/tetragon/pkg/fieldfilters"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/policyfilter"
 	"github.com/cilium/tetragon/pkg/sensors/base"
@@ -172,6 +173,9 @@ type bugtoolInfo struct {
 	prefixDir string
 	multiLog  MultiLog
 	tarWriter *tar.Writer
+	// redaction, if set, is applied to the processes of the process cache
+	// and to the text files received from the agent
+	redaction *fieldfilters.RedactionFilterList
 }
 
 func doTarAddBuff(tarWriter *tar.Writer, fname string, buff *bytes.Buffer) error {
@@ -274,6 +278,41 @@ func Bugtool(outFname string, bpftool string, gops string, maxRecvSize int, comm
 }
 
 func doBugtool(info *InitInfo, outFname string, commandActions []CommandAction, grpcActions []GRPCAction) error {
+	return writeArchive(info, outFname, func(si *bugtoolInfo) {
+		si.addInitInfo()
+		si.addLibFiles()
+		si.addBTFFile()
+		si.addTetragonLog()
+		si.addMetrics()
+		si.ExecCmd("dmesg.out", "dmesg")
+		si.addTcInfo()
+		si.addBpftoolInfo()
+		si.addGopsInfo()
+		si.dumpPolicyFilterMap()
+		si.addProcessCache()
+		si.addExecveMap()
+		si.addGrpcInfo()
+		si.addPmapOut()
+		si.addMemCgroupStats()
+		si.addBPFMapsStats()
+		si.addTracefsTraceFile()
+		si.addExtraFiles()
+
+		// Additional command actions
+		for _, action := range commandActions {
+			action(si)
+		}
+
+		// Additional grpc actions
+		for _, action := range grpcActions {
+			action(si)
+		}
+	})
+}
+
+// writeArchive creates the bugtool tar archive outFname, and calls fill to add
+// files to it.
+func writeArchive(info *InitInfo, outFname string, fill func(si *bugtoolInfo)) error {
 	// we log into two logs, one is the standard one and another one is a
 	// buffer that we are going to include as a file into the bugtool archive.
 	logBuff := new(bytes.Buffer)
@@ -310,35 +349,7 @@ func doBugtool(info *InitInfo, outFname string, commandActions []CommandAction,
 		si.tarAddBuff("tetragon-bugtool.log", logBuff)
 	}()
 
-	si.addInitInfo()
-	si.addLibFiles()
-	si.addBTFFile()
-	si.addTetragonLog()
-	si.addMetrics()
-	si.ExecCmd("dmesg.out", "dmesg")
-	si.addTcInfo()
-	si.addBpftoolInfo()
-	si.addGopsInfo()
-	si.dumpPolicyFilterMap()
-	si.addProcessCache()
-	si.addExecveMap()
-	si.addGrpcInfo()
-	si.addPmapOut()
-	si.addMemCgroupStats()
-	si.addBPFMapsStats()
-	si.addTracefsTraceFile()
-	si.addExtraFiles()
-
-	// Additional command actions
-	for _, action := range commandActions {
-		action(&si)
-	}
-
-	// Additional grpc actions
-	for _, action := range grpcActions {
-		action(&si)
-	}
-
+	fill(&si)
 	return nil
 }
 
@@ -664,30 +675,36 @@ func (s *bugtoolInfo) addGopsInfo() {
 	}
 }
 
-func (s *bugtoolInfo) dumpPolicyFilterMap() error {
-	fname := path.Join(s.info.MapDir, policyfilter.MapName)
-	m, err := policyfilter.OpenMap(fname)
+// dumpPolicyFilterMap dumps the policyfilter map pinned in mapDir.
+func dumpPolicyFilterMap(mapDir string) (any, error) {
+	m, err := policyfilter.OpenMap(path.Join(mapDir, policyfilter.MapName))
 	if err != nil {
-		s.multiLog.WithError(err).Warnf("failed to open policyfilter map")
-		return err
+		return nil, fmt.Errorf("failed to open policyfilter map: %w", err)
 	}
-
 	obj, err := m.Dump()
 	if err != nil {
-		s.multiLog.WithError(err).Warnf("failed to dump policyfilter map")
+		return nil, fmt.Errorf("failed to dump policyfilter map: %w", err)
+	}
+	return obj, nil
+}
+
+func (s *bugtoolInfo) dumpPolicyFilterMap() error {
+	obj, err := dumpPolicyFilterMap(s.info.MapDir)
+	if err != nil {
+		s.multiLog.WithError(err).Warn("failed to dump policyfilter map")
 		return err
 	}
 	return s.TarAddJson(policyfilter.MapName+".json", obj)
 }
 
-func (s *bugtoolInfo) addExecveMap() error {
-	fname := path.Join(s.info.MapDir, base.ExecveMap.Name)
+// dumpExecveMap dumps the execve map pinned in mapDir, one entry per line.
+func dumpExecveMap(mapDir string) (*bytes.Buffer, error) {
+	fname := path.Join(mapDir, base.ExecveMap.Name)
 	m, err := ebpf.LoadPinnedMap(fname, &ebpf.LoadPinOptions{
 		ReadOnly: true,
 	})
 	if err != nil {
-		s.multiLog.WithError(err).Warnf("failed to open execve map")
-		return err
+		return nil, fmt.Errorf("failed to open execve map: %w", err)
 	}
 	defer m.Close()
 
@@ -701,7 +718,15 @@ func (s *bugtoolInfo) addExecveMap() error {
 	}
 
 	if err := iter.Err(); err != nil {
-		s.multiLog.WithError(err).Warnf("error iterating execve map")
+		return nil, fmt.Errorf("error iterating execve map: %w", err)
+	}
+	return buff, nil
+}
+
+func (s *bugtoolInfo) addExecveMap() error {
+	buff, err := dumpExecveMap(s.info.MapDir)
+	if err != nil {
+		s.multiLog.WithError(err).Warn("failed to dump execve map")
 		return err
 	}
 
@@ -726,6 +751,9 @@ func (s *bugtoolInfo) addProcessCache() error {
 
 	buff := new(bytes.Buffer)
 	for _, p := range processes {
+		if s.redaction != nil {
+			s.redaction.RedactProcess(p.Process)
+		}
 		b, err := p.MarshalJSON()
 		if err != nil {
 			s.multiLog.WithError(err).Warn("failed to marshal process")
@@ -0,0 +1,234 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package bugtool
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime/pprof"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/fieldfilters"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
+	"github.com/cilium/tetragon/pkg/policyfilter"
+	"github.com/cilium/tetragon/pkg/sensors/base"
+)
+
+const (
+	// CollectorChunkSize is the maximum size of the data of a
+	// GetBugtoolFilesResponse. Larger files are split across multiple
+	// responses.
+	CollectorChunkSize = 1024 * 1024
+	// MaxCPUProfileDuration is the maximum duration of the CPU profile of
+	// the pprof section.
+	MaxCPUProfileDuration = time.Minute
+)
+
+// collectedFile is a file collected by the Collector, or the error that
+// prevented collecting it.
+type collectedFile struct {
+	name   string
+	data   []byte
+	err    error
+	binary bool
+}
+
+// PolicyLister lists the tracing policies of the agent.
+type PolicyLister func(ctx context.Context) (*tetragon.ListTracingPoliciesResponse, error)
+
+// Collector collects the debug information of the agent for bugtool to run
+// remotely. It implements server.BugtoolCollector.
+type Collector struct {
+	mapDir    string
+	gatherer  prometheus.Gatherer
+	policies  PolicyLister
+	redaction *fieldfilters.RedactionFilterList
+}
+
+// NewCollector returns a collector that dumps the BPF maps pinned in mapDir,
+// the metrics of gatherer and the tracing policies listed by policies. The
+// redaction filters of the agent are applied to the files that are not binary.
+func NewCollector(mapDir string, gatherer prometheus.Gatherer, policies PolicyLister) *Collector {
+	return &Collector{
+		mapDir:    mapDir,
+		gatherer:  gatherer,
+		policies:  policies,
+		redaction: fieldfilters.RedactionFilters,
+	}
+}
+
+// Collect collects the sections of a request and passes the files to send, in
+// chunks of at most CollectorChunkSize bytes.
+func (c *Collector) Collect(ctx context.Context, req *tetragon.GetBugtoolFilesRequest, send func(*tetragon.GetBugtoolFilesResponse) error) error {
+	sections := req.GetSections()
+	if len(sections) == 0 {
+		sections = []tetragon.BugtoolSection{
+			tetragon.BugtoolSection_BUGTOOL_SECTION_MAPS,
+			tetragon.BugtoolSection_BUGTOOL_SECTION_METRICS,
+			tetragon.BugtoolSection_BUGTOOL_SECTION_PPROF,
+			tetragon.BugtoolSection_BUGTOOL_SECTION_LOGS,
+			tetragon.BugtoolSection_BUGTOOL_SECTION_POLICIES,
+		}
+	}
+
+	for _, section := range sections {
+		var files []collectedFile
+		switch section {
+		case tetragon.BugtoolSection_BUGTOOL_SECTION_MAPS:
+			files = c.collectMaps()
+		case tetragon.BugtoolSection_BUGTOOL_SECTION_METRICS:
+			files = c.collectMetrics()
+		case tetragon.BugtoolSection_BUGTOOL_SECTION_PPROF:
+			files = c.collectPProf(ctx, req.GetCpuProfileDuration().AsDuration())
+		case tetragon.BugtoolSection_BUGTOOL_SECTION_LOGS:
+			files = []collectedFile{{name: "tetragon-agent.log", data: logger.RecentLogs()}}
+		case tetragon.BugtoolSection_BUGTOOL_SECTION_POLICIES:
+			files = c.collectPolicies(ctx)
+		default:
+			return fmt.Errorf("unknown bugtool section %s", section)
+		}
+
+		for _, f := range files {
+			if c.redaction != nil && !f.binary {
+				f.data = c.redaction.RedactText(f.data)
+			}
+			if err := sendFile(f, send); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func sendFile(f collectedFile, send func(*tetragon.GetBugtoolFilesResponse) error) error {
+	if f.err != nil {
+		logger.GetLogger().Warn("bugtool: failed to collect file", "file", f.name, logfields.Error, f.err)
+		return send(&tetragon.GetBugtoolFilesResponse{Name: f.name, Error: f.err.Error()})
+	}
+	data := f.data
+	for {
+		n := min(len(data), CollectorChunkSize)
+		if err := send(&tetragon.GetBugtoolFilesResponse{Name: f.name, Data: data[:n], Binary: f.binary}); err != nil {
+			return err
+		}
+		data = data[n:]
+		if len(data) == 0 {
+			return nil
+		}
+	}
+}
+
+func (c *Collector) collectMaps() []collectedFile {
+	var files []collectedFile
+
+	f := collectedFile{name: policyfilter.MapName + ".json"}
+	if obj, err := dumpPolicyFilterMap(c.mapDir); err != nil {
+		f.err = err
+	} else {
+		f.data, f.err = json.Marshal(obj)
+	}
+	files = append(files, f)
+
+	f = collectedFile{name: base.ExecveMap.Name + ".out"}
+	if buff, err := dumpExecveMap(c.mapDir); err != nil {
+		f.err = err
+	} else {
+		f.data = buff.Bytes()
+	}
+	files = append(files, f)
+
+	f = collectedFile{name: "debugmaps.json"}
+	if out, err := RunMapsChecks(c.mapDir); err != nil {
+		f.err = fmt.Errorf("failed to run BPF maps checks: %w", err)
+	} else {
+		f.data, f.err = json.Marshal(out)
+	}
+	return append(files, f)
+}
+
+func (c *Collector) collectMetrics() []collectedFile {
+	f := collectedFile{name: "metrics"}
+	families, err := c.gatherer.Gather()
+	if err != nil {
+		f.err = fmt.Errorf("failed to gather metrics: %w", err)
+		return []collectedFile{f}
+	}
+	buff := new(bytes.Buffer)
+	for _, mf := range families {
+		if _, err := expfmt.MetricFamilyToText(buff, mf); err != nil {
+			f.err = fmt.Errorf("failed to encode metrics: %w", err)
+			return []collectedFile{f}
+		}
+	}
+	f.data = buff.Bytes()
+	return []collectedFile{f}
+}
+
+func (c *Collector) collectPolicies(ctx context.Context) []collectedFile {
+	f := collectedFile{name: "tracing-policies.json"}
+	if c.policies == nil {
+		f.err = errors.New("tracing policies are not available")
+		return []collectedFile{f}
+	}
+	res, err := c.policies(ctx)
+	if err != nil {
+		f.err = fmt.Errorf("failed to list tracing policies: %w", err)
+		return []collectedFile{f}
+	}
+	f.data, f.err = json.Marshal(res)
+	return []collectedFile{f}
+}
+
+func (c *Collector) collectPProf(ctx context.Context, cpuDuration time.Duration) []collectedFile {
+	var files []collectedFile
+	profiles := []struct {
+		name  string
+		debug int
+	}{
+		{"heap", 0},
+		// debug=2 prints the goroutines stacks like gops stack does
+		{"goroutine", 2},
+	}
+	for _, p := range profiles {
+		f := collectedFile{name: "pprof-" + p.name, binary: p.debug == 0}
+		buff := new(bytes.Buffer)
+		if err := pprof.Lookup(p.name).WriteTo(buff, p.debug); err != nil {
+			f.err = fmt.Errorf("failed to write %s profile: %w", p.name, err)
+		} else {
+			f.data = buff.Bytes()
+		}
+		files = append(files, f)
+	}
+
+	if cpuDuration <= 0 {
+		return files
+	}
+	if cpuDuration > MaxCPUProfileDuration {
+		logger.GetLogger().Info("bugtool: capping CPU profile duration",
+			"requested", cpuDuration, "max", MaxCPUProfileDuration)
+		cpuDuration = MaxCPUProfileDuration
+	}
+	f := collectedFile{name: "pprof-cpu", binary: true}
+	buff := new(bytes.Buffer)
+	if err := pprof.StartCPUProfile(buff); err != nil {
+		// for example, if the agent runs with --cpuprofile
+		f.err = fmt.Errorf("failed to start CPU profile: %w", err)
+		return append(files, f)
+	}
+	select {
+	case <-ctx.Done():
+	case <-time.After(cpuDuration):
+	}
+	pprof.StopCPUProfile()
+	f.data = buff.Bytes()
+	return append(files, f)
+}
@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package bugtool
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/fieldfilters"
+)
+
+func collect(t *testing.T, c *Collector, req *tetragon.GetBugtoolFilesRequest) (map[string][]byte, map[string]string) {
+	files := make(map[string][]byte)
+	errs := make(map[string]string)
+	err := c.Collect(context.Background(), req, func(res *tetragon.GetBugtoolFilesResponse) error {
+		require.LessOrEqual(t, len(res.Data), CollectorChunkSize)
+		if res.Error != "" {
+			errs[res.Name] = res.Error
+			return nil
+		}
+		files[res.Name] = append(files[res.Name], res.Data...)
+		return nil
+	})
+	require.NoError(t, err)
+	return files, errs
+}
+
+func TestCollector(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	counter := prometheus.NewCounter(prometheus.CounterOpts{Name: "bugtool_test_total"})
+	reg.MustRegister(counter)
+	counter.Add(3)
+	policies := func(context.Context) (*tetragon.ListTracingPoliciesResponse, error) {
+		return &tetragon.ListTracingPoliciesResponse{Policies: []*tetragon.TracingPolicyStatus{
+			{Name: "policy", Info: "token=abc"},
+		}}, nil
+	}
+	c := NewCollector(filepath.Join(t.TempDir(), "nomaps"), reg, policies)
+	var err error
+	c.redaction, err = fieldfilters.ParseRedactionFilterList(`{"redact": ["token=(\\w+)"]}`)
+	require.NoError(t, err)
+
+	files, errs := collect(t, c, &tetragon.GetBugtoolFilesRequest{
+		Sections: []tetragon.BugtoolSection{
+			tetragon.BugtoolSection_BUGTOOL_SECTION_METRICS,
+			tetragon.BugtoolSection_BUGTOOL_SECTION_PPROF,
+			tetragon.BugtoolSection_BUGTOOL_SECTION_LOGS,
+			tetragon.BugtoolSection_BUGTOOL_SECTION_POLICIES,
+		},
+	})
+	assert.Empty(t, errs)
+	assert.Contains(t, string(files["tracing-policies.json"]), `"info":"token=*****"`)
+	assert.Contains(t, string(files["metrics"]), "bugtool_test_total 3")
+	assert.NotEmpty(t, files["pprof-heap"])
+	assert.Contains(t, string(files["pprof-goroutine"]), "goroutine")
+	assert.NotContains(t, files, "pprof-cpu")
+	assert.Contains(t, files, "tetragon-agent.log")
+
+	// maps that cannot be opened are reported as errors
+	files, errs = collect(t, c, &tetragon.GetBugtoolFilesRequest{
+		Sections: []tetragon.BugtoolSection{tetragon.BugtoolSection_BUGTOOL_SECTION_MAPS},
+	})
+	assert.Empty(t, files)
+	assert.Len(t, errs, 3)
+}
+
+func TestCollectorChunks(t *testing.T) {
+	data := bytes.Repeat([]byte("a"), 2*CollectorChunkSize+1)
+	var chunks []*tetragon.GetBugtoolFilesResponse
+	err := sendFile(collectedFile{name: "big", data: data}, func(res *tetragon.GetBugtoolFilesResponse) error {
+		chunks = append(chunks, res)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, chunks, 3)
+	assert.Len(t, chunks[2].Data, 1)
+
+	// empty files are sent as a single empty chunk
+	chunks = nil
+	err = sendFile(collectedFile{name: "empty"}, func(res *tetragon.GetBugtoolFilesResponse) error {
+		chunks = append(chunks, res)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, chunks, 1)
+	assert.Equal(t, "empty", chunks[0].Name)
+}
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package bugtool
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/cmd/tetra/common"
+	"github.com/cilium/tetragon/pkg/fieldfilters"
+)
+
+const (
+	sectionProcessCache = "processcache"
+	sectionPolicies     = "policies"
+	sectionMaps         = "maps"
+	sectionMetrics      = "metrics"
+	sectionPProf        = "pprof"
+	sectionLogs         = "logs"
+)
+
+// RemoteSections are the sections that RemoteBugtool can collect.
+var RemoteSections = []string{
+	sectionProcessCache,
+	sectionPolicies,
+	sectionMaps,
+	sectionMetrics,
+	sectionPProf,
+	sectionLogs,
+}
+
+// fileSections are the sections collected through GetBugtoolFiles.
+var fileSections = map[string]tetragon.BugtoolSection{
+	sectionMaps:     tetragon.BugtoolSection_BUGTOOL_SECTION_MAPS,
+	sectionMetrics:  tetragon.BugtoolSection_BUGTOOL_SECTION_METRICS,
+	sectionPProf:    tetragon.BugtoolSection_BUGTOOL_SECTION_PPROF,
+	sectionLogs:     tetragon.BugtoolSection_BUGTOOL_SECTION_LOGS,
+	sectionPolicies: tetragon.BugtoolSection_BUGTOOL_SECTION_POLICIES,
+}
+
+// RemoteOptions configures RemoteBugtool.
+type RemoteOptions struct {
+	// ServerAddr is the address of the gRPC server of the agent.
+	ServerAddr string
+	// Timeout is the timeout of the GetBugtoolFiles call, on top of the
+	// CPU profile duration.
+	Timeout time.Duration
+	// MaxRecvSize is the maximum size of the process cache dump.
+	MaxRecvSize int
+	// Sections are the sections to collect, all of RemoteSections if empty.
+	Sections []string
+	// CPUProfileDuration is the duration of the CPU profile of the pprof
+	// section. No CPU profile is taken if zero.
+	CPUProfileDuration time.Duration
+	// Redaction, if set, redacts the arguments, environment variables, and
+	// pod labels and annotations of the processes of the process cache,
+	// and the files that are not binary. It applies on top of the redaction
+	// filters of the agent, which the agent applies itself.
+	Redaction *fieldfilters.RedactionFilterList
+}
+
+// RemoteBugtool gathers information from the agent over gRPC and writes it as
+// a tar archive in the given filename. Unlike Bugtool, it does not need to run
+// in the agent container. Additional grpc calls can be enqueued through the
+// last param.
+func RemoteBugtool(outFname string, opts *RemoteOptions, grpcActions []GRPCAction) error {
+	sections := opts.Sections
+	if len(sections) == 0 {
+		sections = RemoteSections
+	}
+	for _, section := range sections {
+		if !slices.Contains(RemoteSections, section) {
+			return fmt.Errorf("unknown bugtool section %q, valid sections are %v", section, RemoteSections)
+		}
+	}
+
+	info := &InitInfo{
+		ServerAddr:  opts.ServerAddr,
+		MaxRecvSize: opts.MaxRecvSize,
+	}
+	return writeArchive(info, outFname, func(si *bugtoolInfo) {
+		si.redaction = opts.Redaction
+
+		req := &tetragon.GetBugtoolFilesRequest{}
+		for _, section := range sections {
+			switch section {
+			case sectionProcessCache:
+				si.addProcessCache()
+			default:
+				req.Sections = append(req.Sections, fileSections[section])
+			}
+		}
+		if len(req.Sections) > 0 {
+			if slices.Contains(sections, sectionPProf) && opts.CPUProfileDuration > 0 {
+				req.CpuProfileDuration = durationpb.New(opts.CPUProfileDuration)
+			}
+			si.addRemoteFiles(req, opts.Timeout+opts.CPUProfileDuration)
+		}
+
+		// Additional grpc actions
+		for _, action := range grpcActions {
+			action(si)
+		}
+	})
+}
+
+// addRemoteFiles adds the files returned by GetBugtoolFiles to the archive.
+func (s *bugtoolInfo) addRemoteFiles(req *tetragon.GetBugtoolFilesRequest, timeout time.Duration) error {
+	c, err := common.NewClient(context.Background(), s.info.ServerAddr, timeout)
+	if err != nil {
+		s.multiLog.WithError(err).Warnf("failed to create gRPC client to %s", s.info.ServerAddr)
+		return err
+	}
+	defer c.Close()
+
+	stream, err := c.Client.GetBugtoolFiles(c.Ctx, req)
+	if err != nil {
+		s.multiLog.WithError(err).Warn("failed to get bugtool files")
+		return err
+	}
+	return s.recvRemoteFiles(stream)
+}
+
+// recvRemoteFiles adds the files of a GetBugtoolFiles stream to the archive.
+// The chunks of a file are consecutive responses with the same name.
+func (s *bugtoolInfo) recvRemoteFiles(stream grpc.ServerStreamingClient[tetragon.GetBugtoolFilesResponse]) error {
+	var name string
+	var buff *bytes.Buffer
+	var binary bool
+	flush := func() {
+		if buff == nil {
+			return
+		}
+		if s.redaction != nil && !binary {
+			buff = bytes.NewBuffer(s.redaction.RedactText(buff.Bytes()))
+		}
+		if err := s.tarAddBuff(name, buff); err != nil {
+			s.multiLog.WithError(err).WithField("file", name).Warn("failed to add remote file")
+		} else {
+			s.multiLog.WithField("file", name).Info("remote file added")
+		}
+		buff = nil
+	}
+
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			flush()
+			return nil
+		}
+		if err != nil {
+			flush()
+			s.multiLog.WithError(err).Warn("failed to receive bugtool files")
+			return err
+		}
+
+		if res.Name != name || buff == nil {
+			flush()
+			name = res.Name
+		}
+		if res.Error != "" {
+			s.multiLog.WithField("file", res.Name).Warnf("agent failed to collect file: %s", res.Error)
+			continue
+		}
+		if buff == nil {
+			buff = new(bytes.Buffer)
+			binary = res.Binary
+		}
+		buff.Write(res.Data)
+	}
+}
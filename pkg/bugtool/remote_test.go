@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package bugtool
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/fieldfilters"
+	"github.com/cilium/tetragon/pkg/logger"
+)
+
+type fakeFilesStream struct {
+	grpc.ServerStreamingClient[tetragon.GetBugtoolFilesResponse]
+	responses []*tetragon.GetBugtoolFilesResponse
+	err       error
+}
+
+func (f *fakeFilesStream) Recv() (*tetragon.GetBugtoolFilesResponse, error) {
+	if len(f.responses) == 0 {
+		return nil, f.err
+	}
+	res := f.responses[0]
+	f.responses = f.responses[1:]
+	return res, nil
+}
+
+func TestRecvRemoteFiles(t *testing.T) {
+	redaction, err := fieldfilters.ParseRedactionFilterList(`{"redact": ["token=(\\S+)"]}`)
+	require.NoError(t, err)
+	var out bytes.Buffer
+	si := bugtoolInfo{
+		prefixDir: "prefix",
+		multiLog:  MultiLog{Logs: []logger.FieldLogger{logger.GetLogger()}},
+		tarWriter: tar.NewWriter(&out),
+		redaction: redaction,
+	}
+
+	err = si.recvRemoteFiles(&fakeFilesStream{
+		responses: []*tetragon.GetBugtoolFilesResponse{
+			{Name: "metrics", Data: []byte("foo ")},
+			{Name: "metrics", Data: []byte("1")},
+			{Name: "execve_map.out", Error: "failed to open execve map"},
+			{Name: "empty"},
+			{Name: "tetragon-agent.log", Data: []byte("log token=abc")},
+			{Name: "pprof-heap", Data: []byte("token=abc"), Binary: true},
+		},
+		err: io.EOF,
+	})
+	require.NoError(t, err)
+	require.NoError(t, si.tarWriter.Close())
+
+	files := make(map[string]string)
+	r := tar.NewReader(&out)
+	for {
+		hdr, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		files[hdr.Name] = string(b)
+	}
+	assert.Equal(t, map[string]string{
+		"prefix/metrics":            "foo 1",
+		"prefix/empty":              "",
+		"prefix/tetragon-agent.log": "log token=*****",
+		"prefix/pprof-heap":         "token=abc",
+	}, files)
+}
+
+func TestRemoteBugtoolUnknownSection(t *testing.T) {
+	err := RemoteBugtool(filepath.Join(t.TempDir(), "out.tar.gz"), &RemoteOptions{
+		Sections: []string{"maps", "foo"},
+	}, nil)
+	require.Error(t, err)
+}
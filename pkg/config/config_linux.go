@@ -77,6 +77,13 @@ func GenericKprobeObjs(multi bool) (string, string) {
 	return "bpf_generic_kprobe.o", "bpf_generic_retkprobe.o"
 }
 
+// GenericFentryObjs returns the generic fentry and fexit objects, used to
+// attach kprobe specs through BPF trampolines. They are only built for 6.1
+// kernels and later.
+func GenericFentryObjs() (string, string) {
+	return "bpf_fentry_kprobe_v61.o", "bpf_fexit_kprobe_v61.o"
+}
+
 // GenericUprobeObjs returns the generic uprobe and generic uretprobe objects
 func GenericUprobeObjs(multi bool) (string, string) {
 	if multi {
@@ -168,6 +175,33 @@ func LoaderObj() string {
 	return "bpf_loader.o"
 }
 
+func FlowObj() string {
+	if EnableV511Progs() {
+		return "bpf_flow_v511.o"
+	}
+	return "bpf_flow.o"
+}
+
+func DnsObj() string {
+	if EnableV61Progs() {
+		return "bpf_dns_v61.o"
+	} else if EnableV511Progs() {
+		return "bpf_dns_v511.o"
+	}
+	return "bpf_dns.o"
+}
+
+func TlsObj() string {
+	if EnableV511Progs() {
+		return "bpf_tls_v511.o"
+	}
+	return "bpf_tls.o"
+}
+
+func PerfEventObj() string {
+	return "bpf_perf_event.o"
+}
+
 func EnableRhel7Progs() bool {
 	kernelVer, _, _ := kernels.GetKernelVersion(option.Config.KernelVersion, option.Config.ProcFS)
 	return (int64(kernelVer) < kernels.KernelStringToNumeric("3.11.0"))
@@ -210,11 +244,29 @@ func GetRBSize() int {
 		size = option.Config.RBSizeTotal
 	}
 
+	return ringBufSize(size)
+}
+
+// EnableHighPriorityRingBuf returns true if the events of high priority
+// policies are sent through their own BPF ring buffer.
+func EnableHighPriorityRingBuf() bool {
+	return EnableV511Progs() && !option.Config.UsePerfRingBuffer && option.Config.RBSizeHigh != 0
+}
+
+// GetRBSizeHigh returns the size of the BPF ring buffer of high priority
+// events. If the ring buffer is disabled, it's kept at its minimal size.
+func GetRBSizeHigh() int {
+	if option.Config.RBSizeHigh == 0 {
+		return os.Getpagesize()
+	}
+	return ringBufSize(option.Config.RBSizeHigh)
+}
+
+func ringBufSize(size int) int {
 	pageSize := os.Getpagesize()
-	nPages := size / pageSize
+	nPages := max(size/pageSize, 1)
 
 	// Round up to nearest power of two number of pages
 	nPages = int(math.Pow(2, math.Ceil(math.Log2(float64(nPages)))))
-	size = nPages * pageSize
-	return size
+	return nPages * pageSize
 }
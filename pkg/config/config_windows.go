@@ -25,6 +25,10 @@ func GenericKprobeObjs(_ bool) (string, string) {
 	return "", ""
 }
 
+func GenericFentryObjs() (string, string) {
+	return "", ""
+}
+
 func GenericUprobeObjs(_ bool) (string, string) {
 	return "", ""
 }
@@ -53,6 +57,22 @@ func LoaderObj() string {
 	return ""
 }
 
+func FlowObj() string {
+	return ""
+}
+
+func DnsObj() string {
+	return ""
+}
+
+func TlsObj() string {
+	return ""
+}
+
+func PerfEventObj() string {
+	return ""
+}
+
 func EnableRhel7Progs() bool {
 	return false
 }
@@ -72,3 +92,11 @@ func EnableLargeProgs() bool {
 func GetRBSize() int {
 	return 0
 }
+
+func EnableHighPriorityRingBuf() bool {
+	return false
+}
+
+func GetRBSizeHigh() int {
+	return 0
+}
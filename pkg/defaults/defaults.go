@@ -68,4 +68,20 @@ const (
 var (
 	// NetnsDir is the network namespace directory for runtime
 	NetnsDir = DefaultNetnsDir
+
+	// DefaultTlsPlaintextPaths are the common locations of libssl
+	DefaultTlsPlaintextPaths = []string{
+		"/usr/lib/*/libssl.so*",
+		"/usr/lib64/libssl.so*",
+		"/usr/lib/libssl.so*",
+	}
+
+	// DefaultTlsPlaintextRedactHeaders are the HTTP headers that usually
+	// contain credentials
+	DefaultTlsPlaintextRedactHeaders = []string{
+		"authorization",
+		"proxy-authorization",
+		"cookie",
+		"set-cookie",
+	}
 )
@@ -56,3 +56,8 @@ const (
 	// defaults for the {k,u}retprobes lru cache
 	DefaultRetprobesCacheSize = 4096
 )
+
+var (
+	DefaultTlsPlaintextPaths         = []string{}
+	DefaultTlsPlaintextRedactHeaders = []string{}
+)
@@ -0,0 +1,275 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package elf
+
+import (
+	"bytes"
+	"debug/elf"
+	"debug/gosym"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"go/version"
+
+	"golang.org/x/arch/x86/x86asm"
+)
+
+type TLSLibrary uint8
+
+const (
+	TLSLibraryUnknown TLSLibrary = 0
+	TLSLibraryOpenSSL TLSLibrary = 1
+	TLSLibraryGo      TLSLibrary = 2
+)
+
+const (
+	GoTLSWriteSymbol = "crypto/tls.(*Conn).Write"
+	GoTLSReadSymbol  = "crypto/tls.(*Conn).Read"
+)
+
+var (
+	ErrNoTLSLibrary = errors.New("no supported TLS library found")
+
+	openSSLSymbols   = []string{"SSL_write", "SSL_read"}
+	openSSLExSymbols = []string{"SSL_write_ex", "SSL_read_ex"}
+
+	// header of the .go.buildinfo section, followed by the pointer size and
+	// the flags
+	buildInfoMagic           = []byte("\xff Go buildinf:")
+	buildInfoFlagsVersionInl = byte(0x2)
+
+	// arm64 RET instruction (ret x30)
+	arm64Ret = uint32(0xd65f03c0)
+)
+
+// TLSTarget describes the TLS functions of an ELF file that can be probed to
+// capture TLS plaintext.
+type TLSTarget struct {
+	Library TLSLibrary
+	// Symbols are the read and write functions of the library.
+	Symbols []string
+	// Offsets are the file offsets of the functions in Symbols, which
+	// uprobes are attached to. Symbols of stripped Go binaries cannot be
+	// resolved from the ELF symbol tables.
+	Offsets map[string]uint64
+	// ReturnOffsets are the offsets, relative to the function start, of the
+	// return instructions of the Go functions in Symbols. Go functions cannot
+	// be probed with uretprobes, so these are probed instead.
+	ReturnOffsets map[string][]uint64
+}
+
+// TLSTarget inspects the ELF file for a TLS library: OpenSSL (dynamically or
+// statically linked) or the crypto/tls package of Go binaries. It returns
+// ErrNoTLSLibrary if no supported library is found.
+func (se *SafeELFFile) TLSTarget() (*TLSTarget, error) {
+	funcs := se.funcSymbols()
+
+	if hasAll(funcs, openSSLSymbols) {
+		target := &TLSTarget{
+			Library: TLSLibraryOpenSSL,
+			Symbols: append([]string{}, openSSLSymbols...),
+		}
+		if hasAll(funcs, openSSLExSymbols) {
+			target.Symbols = append(target.Symbols, openSSLExSymbols...)
+		}
+		return target, se.setOffsets(target, funcs)
+	}
+
+	if se.Section(".go.buildinfo") != nil {
+		return se.goTLSTarget(funcs)
+	}
+
+	return nil, ErrNoTLSLibrary
+}
+
+func (se *SafeELFFile) goTLSTarget(funcs map[string]elf.Symbol) (*TLSTarget, error) {
+	symbols := []string{GoTLSWriteSymbol, GoTLSReadSymbol}
+	if !hasAll(funcs, symbols) {
+		// Go binaries are often stripped, fallback to the Go symbol table
+		// which is always present.
+		funcs = se.goFuncSymbols(symbols)
+		if !hasAll(funcs, symbols) {
+			return nil, ErrNoTLSLibrary
+		}
+	}
+
+	// The function arguments are read from registers, which requires the
+	// register based calling convention (Go 1.17 on amd64 and Go 1.18 on
+	// arm64). Binaries built with Go 1.17 cannot be told apart from older
+	// ones, so Go 1.18 is required on all architectures.
+	goVersion, err := se.goVersion()
+	if err != nil {
+		return nil, err
+	}
+	if version.Compare(version.Lang(goVersion), "go1.18") < 0 {
+		return nil, fmt.Errorf("unsupported Go version %s, go1.18 or later is required", goVersion)
+	}
+
+	target := &TLSTarget{
+		Library:       TLSLibraryGo,
+		Symbols:       symbols,
+		ReturnOffsets: make(map[string][]uint64),
+	}
+	for _, name := range target.Symbols {
+		offsets, err := se.returnOffsets(funcs[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to find return instructions of %s: %w", name, err)
+		}
+		if len(offsets) == 0 {
+			return nil, fmt.Errorf("no return instructions found in %s", name)
+		}
+		target.ReturnOffsets[name] = offsets
+	}
+	return target, se.setOffsets(target, funcs)
+}
+
+func (se *SafeELFFile) setOffsets(target *TLSTarget, funcs map[string]elf.Symbol) error {
+	target.Offsets = make(map[string]uint64, len(target.Symbols))
+	for _, name := range target.Symbols {
+		off, err := se.OffsetFromAddr(funcs[name].Value)
+		if err != nil {
+			return err
+		}
+		if off == 0 {
+			return fmt.Errorf("failed to find the file offset of %s", name)
+		}
+		target.Offsets[name] = off
+	}
+	return nil
+}
+
+// goVersion returns the Go version a binary was built with, from the
+// .go.buildinfo section. Binaries built with Go 1.17 and earlier do not
+// embed the version in the section, for those goVersion returns "go1.17".
+func (se *SafeELFFile) goVersion() (string, error) {
+	section := se.Section(".go.buildinfo")
+	if section == nil {
+		return "", errors.New("no .go.buildinfo section")
+	}
+	data := make([]byte, 64)
+	n, err := section.ReadAt(data, 0)
+	if err != nil && n < 32 {
+		return "", fmt.Errorf("failed to read .go.buildinfo: %w", err)
+	}
+	data = data[:n]
+	if !bytes.HasPrefix(data, buildInfoMagic) {
+		return "", errors.New("invalid .go.buildinfo section")
+	}
+	// Since Go 1.18, the version is stored inline after the header, as a
+	// varint length prefixed string.
+	if data[len(buildInfoMagic)+1]&buildInfoFlagsVersionInl == 0 {
+		return "go1.17", nil
+	}
+	l, sz := binary.Uvarint(data[32:])
+	if sz <= 0 || uint64(len(data)-32-sz) < l {
+		return "", errors.New("invalid Go version in .go.buildinfo")
+	}
+	v := string(data[32+sz : 32+sz+int(l)])
+	if !version.IsValid(version.Lang(v)) {
+		return "", fmt.Errorf("invalid Go version %q", v)
+	}
+	return v, nil
+}
+
+// returnOffsets returns the offsets of the return instructions of a function,
+// relative to the function start.
+func (se *SafeELFFile) returnOffsets(sym elf.Symbol) ([]uint64, error) {
+	section := se.Section(".text")
+	if section == nil {
+		return nil, errors.New("no .text section")
+	}
+	if sym.Value < section.Addr || sym.Value+sym.Size > section.Addr+section.Size {
+		return nil, errors.New("function outside of the .text section")
+	}
+	code := make([]byte, sym.Size)
+	if _, err := section.ReadAt(code, int64(sym.Value-section.Addr)); err != nil {
+		return nil, err
+	}
+
+	var offsets []uint64
+	switch se.Machine {
+	case elf.EM_X86_64:
+		for off := 0; off < len(code); {
+			inst, err := x86asm.Decode(code[off:], 64)
+			if err != nil {
+				// skip undecodable bytes, e.g. padding
+				off++
+				continue
+			}
+			if inst.Op == x86asm.RET {
+				offsets = append(offsets, uint64(off))
+			}
+			off += inst.Len
+		}
+	case elf.EM_AARCH64:
+		for off := 0; off+4 <= len(code); off += 4 {
+			if binary.LittleEndian.Uint32(code[off:]) == arm64Ret {
+				offsets = append(offsets, uint64(off))
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported machine %s", se.Machine)
+	}
+	return offsets, nil
+}
+
+// funcSymbols returns the function symbols of the file, from both the
+// symbol table and the dynamic symbol table.
+func (se *SafeELFFile) funcSymbols() map[string]elf.Symbol {
+	funcs := make(map[string]elf.Symbol)
+	add := func(symbols []elf.Symbol) {
+		for _, sym := range symbols {
+			if elf.ST_TYPE(sym.Info) != elf.STT_FUNC || sym.Value == 0 {
+				continue
+			}
+			funcs[sym.Name] = sym
+		}
+	}
+	// errors are ignored, files might not have both tables
+	if symbols, err := se.Symbols(); err == nil {
+		add(symbols)
+	}
+	if symbols, err := se.DynamicSymbols(); err == nil {
+		add(symbols)
+	}
+	return funcs
+}
+
+// goFuncSymbols looks up functions in the Go symbol table (.gopclntab) and
+// returns them as ELF symbols.
+func (se *SafeELFFile) goFuncSymbols(names []string) map[string]elf.Symbol {
+	funcs := make(map[string]elf.Symbol)
+	pclntab, text := se.Section(".gopclntab"), se.Section(".text")
+	if pclntab == nil || text == nil {
+		return funcs
+	}
+	data, err := pclntab.Data()
+	if err != nil {
+		return funcs
+	}
+	table, err := gosym.NewTable(nil, gosym.NewLineTable(data, text.Addr))
+	if err != nil {
+		return funcs
+	}
+	for _, name := range names {
+		if fn := table.LookupFunc(name); fn != nil {
+			funcs[name] = elf.Symbol{
+				Name:  name,
+				Info:  elf.ST_INFO(elf.STB_GLOBAL, elf.STT_FUNC),
+				Value: fn.Entry,
+				Size:  fn.End - fn.Entry,
+			}
+		}
+	}
+	return funcs
+}
+
+func hasAll(funcs map[string]elf.Symbol, names []string) bool {
+	for _, name := range names {
+		if _, ok := funcs[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package elf
+
+import (
+	"crypto/tls"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tlsConn makes sure that the test binary includes the crypto/tls functions
+var tlsConn io.ReadWriter = &tls.Conn{}
+
+func TestTLSTargetGo(t *testing.T) {
+	require.NotNil(t, tlsConn)
+
+	path, err := os.Executable()
+	require.NoError(t, err)
+	se, err := OpenSafeELFFile(path)
+	require.NoError(t, err)
+	defer se.Close()
+
+	v, err := se.goVersion()
+	require.NoError(t, err)
+	assert.Contains(t, v, "go1.")
+
+	target, err := se.TLSTarget()
+	require.NoError(t, err)
+	assert.Equal(t, TLSLibraryGo, target.Library)
+	assert.Equal(t, []string{GoTLSWriteSymbol, GoTLSReadSymbol}, target.Symbols)
+	for _, sym := range target.Symbols {
+		assert.NotZero(t, target.Offsets[sym], sym)
+		assert.NotEmpty(t, target.ReturnOffsets[sym], sym)
+	}
+}
+
+func TestTLSTargetOpenSSL(t *testing.T) {
+	var path string
+	for _, p := range []string{
+		"/usr/lib/x86_64-linux-gnu/libssl.so.3",
+		"/usr/lib/aarch64-linux-gnu/libssl.so.3",
+		"/usr/lib64/libssl.so.3",
+	} {
+		if _, err := os.Stat(p); err == nil {
+			path = p
+			break
+		}
+	}
+	if path == "" {
+		t.Skip("libssl not found")
+	}
+
+	se, err := OpenSafeELFFile(path)
+	require.NoError(t, err)
+	defer se.Close()
+
+	target, err := se.TLSTarget()
+	require.NoError(t, err)
+	assert.Equal(t, TLSLibraryOpenSSL, target.Library)
+	assert.Equal(t, []string{"SSL_write", "SSL_read", "SSL_write_ex", "SSL_read_ex"}, target.Symbols)
+	assert.Len(t, target.Offsets, 4)
+}
+
+func TestTLSTargetNone(t *testing.T) {
+	se, err := OpenSafeELFFile("/bin/true")
+	if err != nil {
+		t.Skip("/bin/true not found")
+	}
+	defer se.Close()
+
+	_, err = se.TLSTarget()
+	require.ErrorIs(t, err, ErrNoTLSLibrary)
+}
@@ -325,6 +325,70 @@ func (p *CompactEncoder) EventToString(response *tetragon.GetEventsResponse) (st
 		path := p.Colorer.Yellow.Sprint(loader.Path)
 		return CapTrailorPrinter(fmt.Sprintf("%s %s %s%s", event, processInfo,
 			buildid, path), caps), nil
+	case *tetragon.GetEventsResponse_ProcessNetworkFlow:
+		flow := response.GetProcessNetworkFlow()
+		if flow.Process == nil {
+			return "", ErrMissingProcessInfo
+		}
+		event := p.Colorer.Blue.Sprintf("🔌 %-7s", "flow")
+		processInfo, caps := p.Colorer.ProcessInfo(response.NodeName, flow.Process)
+		sock := flow.GetSock()
+		endpoints := p.Colorer.Cyan.Sprintf("tcp %s:%d -> %s:%d",
+			sock.GetSaddr(), sock.GetSport(), sock.GetDaddr(), sock.GetDport())
+		state := "open"
+		if flow.End {
+			state = "closed"
+		}
+		stats := p.Colorer.Magenta.Sprintf("tx %d bytes rx %d bytes %s %s", flow.BytesSent,
+			flow.BytesReceived, flow.GetDuration().AsDuration(), state)
+		return CapTrailorPrinter(fmt.Sprintf("%s %s %s %s", event, processInfo,
+			endpoints, stats), caps), nil
+	case *tetragon.GetEventsResponse_ProcessDns:
+		dns := response.GetProcessDns()
+		if dns.Process == nil {
+			return "", ErrMissingProcessInfo
+		}
+		event := p.Colorer.Blue.Sprintf("🌐 %-7s", "dns")
+		processInfo, caps := p.Colorer.ProcessInfo(response.NodeName, dns.Process)
+		if !dns.Response {
+			query := p.Colorer.Cyan.Sprintf("query %s %s", dns.Qtype, dns.Qname)
+			return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo, query), caps), nil
+		}
+		answer := p.Colorer.Cyan.Sprintf("answer %s %s", dns.Qtype, dns.Qname)
+		var data []string
+		for _, a := range dns.Answers {
+			if a.Data != "" {
+				data = append(data, a.Data)
+			}
+		}
+		result := p.Colorer.Magenta.Sprint(dns.Rcode)
+		if len(data) > 0 {
+			result += " " + p.Colorer.Magenta.Sprint(strings.Join(data, ","))
+		}
+		return CapTrailorPrinter(fmt.Sprintf("%s %s %s %s", event, processInfo,
+			answer, result), caps), nil
+	case *tetragon.GetEventsResponse_ProcessTls:
+		tls := response.GetProcessTls()
+		if tls.Process == nil {
+			return "", ErrMissingProcessInfo
+		}
+		event := p.Colorer.Blue.Sprintf("🔐 %-7s", "tls")
+		processInfo, caps := p.Colorer.ProcessInfo(response.NodeName, tls.Process)
+		http := tls.GetHttp()
+		var line string
+		if http.GetMethod() != "" {
+			line = fmt.Sprintf("%s %s %s", http.GetMethod(), http.GetPath(), http.GetVersion())
+			for _, h := range http.GetHeaders() {
+				if strings.EqualFold(h.Name, "Host") {
+					line += " " + h.Value
+					break
+				}
+			}
+		} else {
+			line = fmt.Sprintf("%s %d %s", http.GetVersion(), http.GetStatusCode(), http.GetReason())
+		}
+		return CapTrailorPrinter(fmt.Sprintf("%s %s %s", event, processInfo,
+			p.Colorer.Cyan.Sprint(line)), caps), nil
 	case *tetragon.GetEventsResponse_ProcessKprobe:
 		kprobe := response.GetProcessKprobe()
 		if kprobe.Process == nil {
@@ -8,9 +8,11 @@ import (
 	"encoding/json"
 	"os"
 	"testing"
+	"time"
 
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/sryoya/protorand"
@@ -289,6 +291,131 @@ func TestCompactEncoder_KprobeCloseEventToString(t *testing.T) {
 	assert.Equal(t, "📪 close   kube-system/tetragon /usr/bin/curl /etc/password", result)
 }
 
+func TestCompactEncoder_NetworkFlowEventToString(t *testing.T) {
+	p := NewCompactEncoder(os.Stdout, Never, false, false, false)
+
+	result, err := p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessNetworkFlow{
+			ProcessNetworkFlow: &tetragon.ProcessNetworkFlow{
+				Process: &tetragon.Process{
+					Binary: "/usr/bin/curl",
+					Pod: &tetragon.Pod{
+						Namespace: "kube-system",
+						Name:      "tetragon",
+					},
+				},
+				Sock: &tetragon.KprobeSock{
+					Protocol: "IPPROTO_TCP",
+					Saddr:    "10.0.0.1",
+					Sport:    43210,
+					Daddr:    "10.0.0.2",
+					Dport:    443,
+				},
+				Direction:     tetragon.FlowDirection_FLOW_DIRECTION_EGRESS,
+				BytesSent:     512,
+				BytesReceived: 4096,
+				Duration:      durationpb.New(1500 * time.Millisecond),
+				End:           true,
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "🔌 flow    kube-system/tetragon /usr/bin/curl tcp 10.0.0.1:43210 -> 10.0.0.2:443 tx 512 bytes rx 4096 bytes 1.5s closed", result)
+}
+
+func TestCompactEncoder_DnsEventToString(t *testing.T) {
+	p := NewCompactEncoder(os.Stdout, Never, false, false, false)
+
+	process := &tetragon.Process{
+		Binary: "/usr/bin/curl",
+		Pod: &tetragon.Pod{
+			Namespace: "kube-system",
+			Name:      "tetragon",
+		},
+	}
+
+	// query
+	result, err := p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessDns{
+			ProcessDns: &tetragon.ProcessDns{
+				Process: process,
+				Qname:   "cilium.io",
+				Qtype:   "A",
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "🌐 dns     kube-system/tetragon /usr/bin/curl query A cilium.io", result)
+
+	// response
+	result, err = p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessDns{
+			ProcessDns: &tetragon.ProcessDns{
+				Process:  process,
+				Response: true,
+				Qname:    "cilium.io",
+				Qtype:    "A",
+				Rcode:    "NOERROR",
+				Answers: []*tetragon.DnsAnswer{
+					{Name: "cilium.io", Type: "A", Ttl: 300, Data: "104.198.14.52"},
+					{Name: "cilium.io", Type: "A", Ttl: 300, Data: "104.198.14.53"},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "🌐 dns     kube-system/tetragon /usr/bin/curl answer A cilium.io NOERROR 104.198.14.52,104.198.14.53", result)
+}
+
+func TestCompactEncoder_TlsEventToString(t *testing.T) {
+	p := NewCompactEncoder(os.Stdout, Never, false, false, false)
+
+	process := &tetragon.Process{
+		Binary: "/usr/bin/curl",
+		Pod: &tetragon.Pod{
+			Namespace: "kube-system",
+			Name:      "tetragon",
+		},
+	}
+
+	// request
+	result, err := p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessTls{
+			ProcessTls: &tetragon.ProcessTls{
+				Process:   process,
+				Direction: tetragon.TlsDirection_TLS_DIRECTION_WRITE,
+				Http: &tetragon.HttpMessage{
+					Method:  "GET",
+					Path:    "/index.html",
+					Version: "HTTP/1.1",
+					Headers: []*tetragon.HttpHeader{
+						{Name: "Host", Value: "cilium.io"},
+					},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "🔐 tls     kube-system/tetragon /usr/bin/curl GET /index.html HTTP/1.1 cilium.io", result)
+
+	// response
+	result, err = p.EventToString(&tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessTls{
+			ProcessTls: &tetragon.ProcessTls{
+				Process:   process,
+				Direction: tetragon.TlsDirection_TLS_DIRECTION_READ,
+				Http: &tetragon.HttpMessage{
+					StatusCode: 200,
+					Reason:     "OK",
+					Version:    "HTTP/1.1",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "🔐 tls     kube-system/tetragon /usr/bin/curl HTTP/1.1 200 OK", result)
+}
+
 func TestCompactEncoder_KprobeBPFEventToString(t *testing.T) {
 	p := NewCompactEncoder(os.Stdout, Never, false, false, false)
 
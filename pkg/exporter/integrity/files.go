@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package integrity
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// backupTimeFormat and compressSuffix match the names lumberjack gives
+	// to rotated files.
+	backupTimeFormat = "2006-01-02T15-04-05.000"
+	compressSuffix   = ".gz"
+)
+
+// Files returns the files of the export written to filename, oldest first:
+// the rotated backups, compressed or not, followed by filename itself if it
+// exists.
+func Files(filename string) ([]string, error) {
+	dir := filepath.Dir(filename)
+	base := filepath.Base(filename)
+	ext := filepath.Ext(base)
+	prefix := base[:len(base)-len(ext)] + "-"
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	type backup struct {
+		name string
+		t    time.Time
+	}
+	var backups []backup
+	seen := map[string]bool{}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		name := strings.TrimSuffix(e.Name(), compressSuffix)
+		if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ext) {
+			continue
+		}
+		t, err := time.Parse(backupTimeFormat, name[len(prefix):len(name)-len(ext)])
+		if err != nil {
+			continue
+		}
+		// lumberjack removes uncompressed backups once they are compressed,
+		// so both only exist while the compressed one is being written.
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		backups = append(backups, backup{name: name, t: t})
+	}
+	slices.SortFunc(backups, func(a, b backup) int {
+		return a.t.Compare(b.t)
+	})
+
+	var files []string
+	for _, b := range backups {
+		path := filepath.Join(dir, b.name)
+		if _, err := os.Stat(path); err != nil {
+			path += compressSuffix
+		}
+		files = append(files, path)
+	}
+	if _, err := os.Stat(filename); err == nil {
+		files = append(files, filename)
+	}
+	return files, nil
+}
+
+// open opens an export file, decompressing it if needed.
+func open(path string) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(path, compressSuffix) {
+		return f, nil
+	}
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &gzipFile{Reader: gz, f: f}, nil
+}
+
+type gzipFile struct {
+	*gzip.Reader
+	f *os.File
+}
+
+func (g *gzipFile) Close() error {
+	return errors.Join(g.Reader.Close(), g.f.Close())
+}
+
+// forEachLine calls fn with each line of an export file, without its trailing
+// newline. Empty lines are skipped.
+func forEachLine(path string, fn func(lineNo int, line []byte) error) error {
+	f, err := open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	for lineNo := 1; ; lineNo++ {
+		line, err := r.ReadBytes('\n')
+		if line = bytes.TrimSuffix(line, []byte{'\n'}); len(line) > 0 {
+			if fnErr := fn(lineNo, line); fnErr != nil {
+				return fnErr
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// lastLine returns the last line of an export file, or nil if it is empty.
+func lastLine(path string) ([]byte, error) {
+	var last []byte
+	err := forEachLine(path, func(_ int, line []byte) error {
+		last = line
+		return nil
+	})
+	return last, err
+}
@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package integrity makes JSON export files tamper-evident.
+//
+// Each exported line carries a sequence number and the hash of the previous
+// line, so that modifying, removing or reordering lines breaks the chain.
+// Hashes are SHA-256, or HMAC-SHA256 if a key is configured, in which case
+// the chain cannot be recomputed by someone who does not have the key. Before
+// the export file is rotated, a checkpoint record signed with the key is
+// appended to it, so that truncating a rotated file is detected as well.
+package integrity
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+// checkpointReserve is the room left in the export file for the checkpoint
+// record when deciding whether to rotate it.
+const checkpointReserve = 1024
+
+var ErrInvalidEvent = errors.New("invalid event")
+
+// Rotator rotates the export file, such as lumberjack.Logger.
+type Rotator interface {
+	Rotate() error
+}
+
+type Options struct {
+	// Key, if set, is used to compute HMAC-SHA256 instead of SHA-256 hashes
+	// and to sign checkpoints.
+	Key []byte
+	// MaxSize is the size in bytes at which the export file is rotated, or
+	// 0 if it is not rotated by size. The encoder rotates the file itself,
+	// so that the checkpoint is written before the rotation.
+	MaxSize int64
+	// NodeName is the node name of the checkpoint records.
+	NodeName string
+}
+
+// Encoder encodes events as JSON lines with integrity metadata.
+type Encoder struct {
+	mu       sync.Mutex
+	w        io.Writer
+	rotator  Rotator
+	opts     Options
+	size     int64
+	seq      uint64
+	prevHash string
+}
+
+// NewEncoder returns an encoder writing the export to w. The chain is resumed
+// from the last record of the files of the export written to filename, if
+// any.
+func NewEncoder(filename string, w io.Writer, rotator Rotator, opts Options) (*Encoder, error) {
+	e := &Encoder{
+		w:       w,
+		rotator: rotator,
+		opts:    opts,
+	}
+	if info, err := os.Stat(filename); err == nil {
+		e.size = info.Size()
+	}
+	files, err := Files(filename)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to list export files: %w", err)
+	}
+	for i := len(files) - 1; i >= 0; i-- {
+		line, err := lastLine(files[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read export file %s: %w", files[i], err)
+		}
+		if line == nil {
+			continue
+		}
+		// Start a new chain if the last record cannot be parsed, for
+		// example because it was only partially written.
+		if rec, err := parseRecord(line); err == nil && rec.GetExportIntegrity() != nil {
+			e.seq = rec.GetExportIntegrity().GetSequence()
+			e.prevHash = hashLine(opts.Key, line)
+		}
+		break
+	}
+	return e, nil
+}
+
+// Encode implements exporter.ExportEncoder.
+func (e *Encoder) Encode(v any) error {
+	event, ok := v.(*tetragon.GetEventsResponse)
+	if !ok {
+		return ErrInvalidEvent
+	}
+	// The event can be sent to other listeners, so add the integrity
+	// metadata to a shallow copy of it.
+	rec := shallowCopy(event)
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	line, err := e.marshal(rec)
+	if err != nil {
+		return err
+	}
+	if e.opts.MaxSize > 0 && e.size > 0 && e.size+int64(len(line))+1+checkpointReserve >= e.opts.MaxSize {
+		if err := e.rotate(); err != nil {
+			return err
+		}
+		if line, err = e.marshal(rec); err != nil {
+			return err
+		}
+	}
+	return e.write(line)
+}
+
+// Rotate appends a checkpoint to the export file and rotates it.
+func (e *Encoder) Rotate() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.rotate()
+}
+
+func (e *Encoder) rotate() error {
+	// There is nothing to protect in an empty file, and rotating it would
+	// leave an empty backup.
+	if e.size == 0 {
+		return nil
+	}
+	rec := &tetragon.GetEventsResponse{
+		NodeName:        e.opts.NodeName,
+		Time:            timestamppb.Now(),
+		ExportIntegrity: &tetragon.ExportIntegrity{},
+	}
+	rec.ExportIntegrity.Checkpoint = &tetragon.ExportCheckpoint{
+		Signature: signCheckpoint(e.opts.Key, e.seq+1, e.prevHash),
+	}
+	line, err := e.marshal(rec)
+	if err != nil {
+		return err
+	}
+	if err := e.write(line); err != nil {
+		return err
+	}
+	if err := e.rotator.Rotate(); err != nil {
+		return err
+	}
+	e.size = 0
+	return nil
+}
+
+func (e *Encoder) marshal(rec *tetragon.GetEventsResponse) ([]byte, error) {
+	if rec.ExportIntegrity == nil {
+		rec.ExportIntegrity = &tetragon.ExportIntegrity{}
+	}
+	rec.ExportIntegrity.Sequence = e.seq + 1
+	rec.ExportIntegrity.PreviousHash = e.prevHash
+	// Use the same options as encoder.ProtojsonEncoder.
+	return protojson.MarshalOptions{UseProtoNames: true}.Marshal(rec)
+}
+
+func (e *Encoder) write(line []byte) error {
+	n, err := e.w.Write(append(line, '\n'))
+	e.size += int64(n)
+	if err != nil {
+		return err
+	}
+	e.seq++
+	e.prevHash = hashLine(e.opts.Key, line)
+	return nil
+}
+
+func shallowCopy(event *tetragon.GetEventsResponse) *tetragon.GetEventsResponse {
+	rec := &tetragon.GetEventsResponse{}
+	dst := rec.ProtoReflect()
+	event.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		dst.Set(fd, v)
+		return true
+	})
+	return rec
+}
+
+func parseRecord(line []byte) (*tetragon.GetEventsResponse, error) {
+	rec := &tetragon.GetEventsResponse{}
+	err := protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(line, rec)
+	return rec, err
+}
+
+func newHash(key []byte) hash.Hash {
+	if len(key) > 0 {
+		return hmac.New(sha256.New, key)
+	}
+	return sha256.New()
+}
+
+// hashLine returns the hash of an exported line, without its newline.
+func hashLine(key []byte, line []byte) string {
+	h := newHash(key)
+	h.Write(line)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// signCheckpoint returns the signature of a checkpoint record, or an empty
+// string if there is no key.
+func signCheckpoint(key []byte, seq uint64, prevHash string) string {
+	if len(key) == 0 {
+		return ""
+	}
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte("checkpoint:" + strconv.FormatUint(seq, 10) + ":" + prevHash))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ReadKey reads a key from a file. Surrounding whitespace is ignored.
+func ReadKey(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	key := bytes.TrimSpace(data)
+	if len(key) == 0 {
+		return nil, fmt.Errorf("key file %s is empty", path)
+	}
+	return key, nil
+}
@@ -0,0 +1,222 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package integrity
+
+import (
+	"compress/gzip"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+// testWriter writes to a file and rotates it the way lumberjack does.
+type testWriter struct {
+	filename string
+	now      time.Time
+}
+
+func (w *testWriter) Write(p []byte) (int, error) {
+	f, err := os.OpenFile(w.filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	return f.Write(p)
+}
+
+func (w *testWriter) Rotate() error {
+	ext := filepath.Ext(w.filename)
+	w.now = w.now.Add(time.Second)
+	backup := fmt.Sprintf("%s-%s%s", strings.TrimSuffix(w.filename, ext), w.now.Format(backupTimeFormat), ext)
+	return os.Rename(w.filename, backup)
+}
+
+func compress(t *testing.T, path string) {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	f, err := os.Create(path + compressSuffix)
+	require.NoError(t, err)
+	gz := gzip.NewWriter(f)
+	_, err = gz.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	require.NoError(t, f.Close())
+	require.NoError(t, os.Remove(path))
+}
+
+func encodeEvents(t *testing.T, enc *Encoder, n int) {
+	for i := range n {
+		err := enc.Encode(&tetragon.GetEventsResponse{
+			Event: &tetragon.GetEventsResponse_ProcessExec{ProcessExec: &tetragon.ProcessExec{
+				Process: &tetragon.Process{Binary: fmt.Sprintf("/bin/true-%d", i)},
+			}},
+			NodeName: "node",
+		})
+		require.NoError(t, err)
+	}
+}
+
+// writeExport writes an export of three files: a compressed backup, a backup
+// and the active file.
+func writeExport(t *testing.T, key []byte) (string, []string) {
+	filename := filepath.Join(t.TempDir(), "tetragon.log")
+	w := &testWriter{filename: filename, now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	enc, err := NewEncoder(filename, w, w, Options{Key: key, NodeName: "node"})
+	require.NoError(t, err)
+
+	encodeEvents(t, enc, 5)
+	require.NoError(t, enc.Rotate())
+	encodeEvents(t, enc, 3)
+	require.NoError(t, enc.Rotate())
+	encodeEvents(t, enc, 2)
+
+	files, err := Files(filename)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	compress(t, files[0])
+	files, err = Files(filename)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	require.True(t, strings.HasSuffix(files[0], compressSuffix))
+	return filename, files
+}
+
+func editLines(t *testing.T, path string, fn func([]string) []string) {
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	lines = fn(lines)
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o600))
+}
+
+func TestVerify(t *testing.T) {
+	key := []byte("secret")
+
+	t.Run("valid", func(t *testing.T) {
+		_, files := writeExport(t, key)
+		res, err := Verify(files, key)
+		require.NoError(t, err)
+		assert.Empty(t, res.Problems)
+		assert.True(t, res.OK())
+		// 10 events and 2 checkpoints
+		assert.Equal(t, uint64(12), res.Records)
+	})
+
+	tests := []struct {
+		name  string
+		file  int
+		edit  func([]string) []string
+		match string
+	}{
+		{
+			name: "modified",
+			file: 1,
+			edit: func(lines []string) []string {
+				lines[1] = strings.Replace(lines[1], "true-1", "false", 1)
+				return lines
+			},
+			match: "previous hash mismatch",
+		},
+		{
+			name: "removed",
+			file: 2,
+			edit: func(lines []string) []string {
+				return lines[1:]
+			},
+			match: "1 records are missing",
+		},
+		{
+			name: "reordered",
+			file: 1,
+			edit: func(lines []string) []string {
+				lines[0], lines[1] = lines[1], lines[0]
+				return lines
+			},
+			match: "records were reordered",
+		},
+		{
+			name: "truncated",
+			file: 1,
+			edit: func(lines []string) []string {
+				return lines[:len(lines)-1]
+			},
+			match: "does not end with a checkpoint",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, files := writeExport(t, key)
+			editLines(t, files[test.file], test.edit)
+			res, err := Verify(files, key)
+			require.NoError(t, err)
+			require.False(t, res.OK())
+			var messages []string
+			for _, p := range res.Problems {
+				messages = append(messages, p.Message)
+			}
+			assert.Contains(t, strings.Join(messages, "\n"), test.match)
+		})
+	}
+
+	t.Run("wrong key", func(t *testing.T) {
+		_, files := writeExport(t, key)
+		res, err := Verify(files, []byte("other"))
+		require.NoError(t, err)
+		assert.False(t, res.OK())
+	})
+
+	t.Run("pruned backups", func(t *testing.T) {
+		_, files := writeExport(t, nil)
+		res, err := Verify(files[1:], nil)
+		require.NoError(t, err)
+		assert.True(t, res.OK())
+		require.Len(t, res.Problems, 1)
+		assert.True(t, res.Problems[0].Warning)
+	})
+}
+
+func TestEncoderResume(t *testing.T) {
+	key := []byte("secret")
+	filename, _ := writeExport(t, key)
+
+	w := &testWriter{filename: filename, now: time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)}
+	enc, err := NewEncoder(filename, w, w, Options{Key: key})
+	require.NoError(t, err)
+	encodeEvents(t, enc, 2)
+
+	files, err := Files(filename)
+	require.NoError(t, err)
+	res, err := Verify(files, key)
+	require.NoError(t, err)
+	assert.Empty(t, res.Problems)
+	assert.Equal(t, uint64(14), res.Records)
+}
+
+func TestEncoderRotateBySize(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "tetragon.log")
+	w := &testWriter{filename: filename, now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	enc, err := NewEncoder(filename, w, w, Options{MaxSize: 2048})
+	require.NoError(t, err)
+	encodeEvents(t, enc, 30)
+
+	files, err := Files(filename)
+	require.NoError(t, err)
+	require.Greater(t, len(files), 1)
+	for _, f := range files {
+		info, err := os.Stat(f)
+		require.NoError(t, err)
+		assert.Less(t, info.Size(), int64(2048))
+	}
+	res, err := Verify(files, nil)
+	require.NoError(t, err)
+	assert.Empty(t, res.Problems)
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package integrity
+
+import (
+	"crypto/hmac"
+	"fmt"
+)
+
+// Problem is a problem found when verifying an export.
+type Problem struct {
+	File string
+	Line int
+	// Warning is set for problems that do not mean that the export was
+	// tampered with, such as the agent starting a new chain.
+	Warning bool
+	Message string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s:%d: %s", p.File, p.Line, p.Message)
+}
+
+// Result is the result of verifying an export.
+type Result struct {
+	Files    []string
+	Records  uint64
+	Problems []Problem
+}
+
+// OK returns whether no problem other than warnings was found.
+func (r *Result) OK() bool {
+	for _, p := range r.Problems {
+		if !p.Warning {
+			return false
+		}
+	}
+	return true
+}
+
+// Verify verifies the chain of records of export files, ordered from the
+// oldest to the newest. If key is set, hashes are checked as HMAC-SHA256 and
+// the signatures of checkpoints are verified. Every file but the last one must
+// end with a checkpoint, because the agent writes one before rotating a file.
+func Verify(files []string, key []byte) (*Result, error) {
+	res := &Result{Files: files}
+	var (
+		started     bool
+		expectedSeq uint64
+		prevHash    string
+		lastIsCkpt  bool
+		lastLineNo  int
+		problem     = func(file string, line int, warning bool, format string, args ...any) {
+			res.Problems = append(res.Problems, Problem{
+				File:    file,
+				Line:    line,
+				Warning: warning,
+				Message: fmt.Sprintf(format, args...),
+			})
+		}
+	)
+
+	for i, file := range files {
+		lastIsCkpt, lastLineNo = false, 0
+		err := forEachLine(file, func(lineNo int, line []byte) error {
+			lastLineNo = lineNo
+			lastIsCkpt = false
+			rec, err := parseRecord(line)
+			if err != nil {
+				problem(file, lineNo, false, "invalid record: %v", err)
+				// The next record cannot be checked against this one.
+				started = false
+				return nil
+			}
+			integ := rec.GetExportIntegrity()
+			if integ == nil {
+				problem(file, lineNo, false, "record has no integrity metadata")
+				started = false
+				return nil
+			}
+			res.Records++
+			seq := integ.GetSequence()
+
+			switch {
+			case !started:
+				if seq != 1 && res.Records == 1 {
+					problem(file, lineNo, true, "chain starts at sequence %d, earlier records are not available", seq)
+				}
+			case seq == 1 && integ.GetPreviousHash() == "":
+				problem(file, lineNo, true, "new chain started, the agent could not resume the previous one")
+			case seq > expectedSeq:
+				problem(file, lineNo, false, "sequence %d follows %d, %d records are missing", seq, expectedSeq-1, seq-expectedSeq)
+			case seq < expectedSeq:
+				problem(file, lineNo, false, "sequence %d follows %d, records were reordered or duplicated", seq, expectedSeq-1)
+			case integ.GetPreviousHash() != prevHash:
+				problem(file, lineNo, false, "previous hash mismatch, the previous record was modified")
+			}
+
+			if ckpt := integ.GetCheckpoint(); ckpt != nil {
+				lastIsCkpt = true
+				if len(key) > 0 {
+					expected := signCheckpoint(key, seq, integ.GetPreviousHash())
+					if !hmac.Equal([]byte(expected), []byte(ckpt.GetSignature())) {
+						problem(file, lineNo, false, "invalid checkpoint signature")
+					}
+				}
+			}
+
+			started = true
+			expectedSeq = seq + 1
+			prevHash = hashLine(key, line)
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		if i < len(files)-1 && lastLineNo > 0 && !lastIsCkpt {
+			problem(file, lastLineNo, false, "rotated file does not end with a checkpoint, it was truncated")
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cilium/tetragon/pkg/logger"
+)
+
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http"
+
+	httpLogsPath = "/v1/logs"
+)
+
+// retryableError marks export errors after which the request may be retried,
+// as defined by the OTLP specification.
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *retryableError) Unwrap() error {
+	return e.err
+}
+
+func isRetryable(err error) bool {
+	var re *retryableError
+	return errors.As(err, &re)
+}
+
+// client sends export requests to an OTLP collector.
+type client interface {
+	export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error
+	close() error
+}
+
+func newClient(opts *Options) (client, error) {
+	switch opts.Protocol {
+	case ProtocolGRPC, "":
+		return newGRPCClient(opts)
+	case ProtocolHTTP:
+		return newHTTPClient(opts)
+	default:
+		return nil, fmt.Errorf("unknown OTLP protocol %q, supported protocols are %q and %q", opts.Protocol, ProtocolGRPC, ProtocolHTTP)
+	}
+}
+
+func logPartialSuccess(ps *collogspb.ExportLogsPartialSuccess) {
+	if ps.GetRejectedLogRecords() == 0 {
+		return
+	}
+	eventsDropped.WithLabelValues(dropReasonRejected).Add(float64(ps.GetRejectedLogRecords()))
+	logger.GetLogger().Warn("OTLP collector rejected log records",
+		"rejected", ps.GetRejectedLogRecords(),
+		"message", ps.GetErrorMessage())
+}
+
+type grpcClient struct {
+	conn    *grpc.ClientConn
+	client  collogspb.LogsServiceClient
+	headers metadata.MD
+}
+
+func newGRPCClient(opts *Options) (*grpcClient, error) {
+	creds := credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	if opts.Insecure {
+		creds = insecure.NewCredentials()
+	}
+	conn, err := grpc.NewClient(opts.Endpoint, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP gRPC client: %w", err)
+	}
+	return &grpcClient{
+		conn:    conn,
+		client:  collogspb.NewLogsServiceClient(conn),
+		headers: metadata.New(opts.Headers),
+	}, nil
+}
+
+func (c *grpcClient) export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+	if len(c.headers) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, c.headers)
+	}
+	resp, err := c.client.Export(ctx, req)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Canceled, codes.DeadlineExceeded, codes.Aborted, codes.OutOfRange,
+			codes.Unavailable, codes.DataLoss, codes.ResourceExhausted:
+			return &retryableError{err}
+		}
+		return err
+	}
+	logPartialSuccess(resp.GetPartialSuccess())
+	return nil
+}
+
+func (c *grpcClient) close() error {
+	return c.conn.Close()
+}
+
+type httpClient struct {
+	url     string
+	client  *http.Client
+	headers map[string]string
+}
+
+// httpURL returns the URL to post logs to. Endpoints without a scheme use
+// https, or http if insecure is set, and endpoints without a path use the
+// default OTLP logs path.
+func httpURL(endpoint string, insecure bool) (string, error) {
+	if !strings.Contains(endpoint, "://") {
+		scheme := "https"
+		if insecure {
+			scheme = "http"
+		}
+		endpoint = scheme + "://" + endpoint
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid OTLP endpoint %q: %w", endpoint, err)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = httpLogsPath
+	}
+	return u.String(), nil
+}
+
+func newHTTPClient(opts *Options) (*httpClient, error) {
+	u, err := httpURL(opts.Endpoint, opts.Insecure)
+	if err != nil {
+		return nil, err
+	}
+	return &httpClient{
+		url:     u,
+		client:  &http.Client{},
+		headers: opts.Headers,
+	}, nil
+}
+
+func (c *httpClient) export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) error {
+	body, err := proto.Marshal(req)
+	if err != nil {
+		return err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/x-protobuf")
+	for k, v := range c.headers {
+		httpReq.Header.Set(k, v)
+	}
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return &retryableError{err}
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return &retryableError{err}
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		exportResp := &collogspb.ExportLogsServiceResponse{}
+		if err := proto.Unmarshal(respBody, exportResp); err == nil {
+			logPartialSuccess(exportResp.GetPartialSuccess())
+		}
+		return nil
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return &retryableError{fmt.Errorf("OTLP collector returned %s", resp.Status)}
+	default:
+		return fmt.Errorf("OTLP collector returned %s", resp.Status)
+	}
+}
+
+func (c *httpClient) close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
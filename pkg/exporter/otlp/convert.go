@@ -0,0 +1,191 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package otlp
+
+import (
+	"strings"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/api/v1/tetragon/codegen/helpers"
+)
+
+const (
+	serviceName = "tetragon"
+	scopeName   = "github.com/cilium/tetragon/pkg/exporter/otlp"
+)
+
+// workloadKindKeys maps Kubernetes workload kinds to the corresponding
+// OpenTelemetry semantic convention resource attribute.
+var workloadKindKeys = map[string]string{
+	"Deployment":  "k8s.deployment.name",
+	"DaemonSet":   "k8s.daemonset.name",
+	"StatefulSet": "k8s.statefulset.name",
+	"ReplicaSet":  "k8s.replicaset.name",
+	"Job":         "k8s.job.name",
+	"CronJob":     "k8s.cronjob.name",
+}
+
+var bodyMarshaler = protojson.MarshalOptions{UseProtoNames: true}
+
+// logRecord is a converted event together with the resource it originates
+// from. Records sharing the same resource key are grouped together when
+// building export requests.
+type logRecord struct {
+	resourceKey string
+	resource    *resourcepb.Resource
+	record      *logspb.LogRecord
+}
+
+func stringAttr(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+func intAttr(key string, value int64) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: value}},
+	}
+}
+
+// appendString appends a string attribute, skipping empty values.
+func appendString(attrs []*commonpb.KeyValue, key, value string) []*commonpb.KeyValue {
+	if value == "" {
+		return attrs
+	}
+	return append(attrs, stringAttr(key, value))
+}
+
+// newResource returns the resource attributes for the node and pod an event
+// originates from, along with a key identifying them.
+func newResource(ev *tetragon.GetEventsResponse, process *tetragon.Process) (string, *resourcepb.Resource) {
+	attrs := []*commonpb.KeyValue{stringAttr("service.name", serviceName)}
+	attrs = appendString(attrs, "k8s.cluster.name", ev.GetClusterName())
+	attrs = appendString(attrs, "k8s.node.name", ev.GetNodeName())
+
+	pod := process.GetPod()
+	attrs = appendString(attrs, "k8s.namespace.name", pod.GetNamespace())
+	attrs = appendString(attrs, "k8s.pod.name", pod.GetName())
+	attrs = appendString(attrs, "k8s.pod.uid", pod.GetUid())
+	attrs = appendString(attrs, "k8s.container.name", pod.GetContainer().GetName())
+	attrs = appendString(attrs, "container.id", pod.GetContainer().GetId())
+	attrs = appendString(attrs, "container.image.name", pod.GetContainer().GetImage().GetName())
+	if key, ok := workloadKindKeys[pod.GetWorkloadKind()]; ok {
+		attrs = appendString(attrs, key, pod.GetWorkload())
+	}
+
+	var sb strings.Builder
+	for _, attr := range attrs {
+		sb.WriteString(attr.Key)
+		sb.WriteByte('=')
+		sb.WriteString(attr.GetValue().GetStringValue())
+		sb.WriteByte(0)
+	}
+	return sb.String(), &resourcepb.Resource{Attributes: attrs}
+}
+
+// policyAttributes returns the attributes describing the policy that
+// generated a policy event, if any.
+func policyAttributes(ev *tetragon.GetEventsResponse) []*commonpb.KeyValue {
+	var policy, function string
+	var action tetragon.KprobeAction
+	switch e := ev.Event.(type) {
+	case *tetragon.GetEventsResponse_ProcessKprobe:
+		policy, function, action = e.ProcessKprobe.GetPolicyName(), e.ProcessKprobe.GetFunctionName(), e.ProcessKprobe.GetAction()
+	case *tetragon.GetEventsResponse_ProcessTracepoint:
+		policy, action = e.ProcessTracepoint.GetPolicyName(), e.ProcessTracepoint.GetAction()
+		function = e.ProcessTracepoint.GetSubsys() + "/" + e.ProcessTracepoint.GetEvent()
+	case *tetragon.GetEventsResponse_ProcessUprobe:
+		policy, function = e.ProcessUprobe.GetPolicyName(), e.ProcessUprobe.GetSymbol()
+	case *tetragon.GetEventsResponse_ProcessLsm:
+		policy, function, action = e.ProcessLsm.GetPolicyName(), e.ProcessLsm.GetFunctionName(), e.ProcessLsm.GetAction()
+	default:
+		return nil
+	}
+
+	var attrs []*commonpb.KeyValue
+	attrs = appendString(attrs, "tetragon.policy.name", policy)
+	attrs = appendString(attrs, "tetragon.policy.function", function)
+	if action != tetragon.KprobeAction_KPROBE_ACTION_UNKNOWN {
+		attrs = append(attrs, stringAttr("tetragon.policy.action", action.String()))
+	}
+	return attrs
+}
+
+// convert converts an event into an OTLP log record. The record body holds
+// the protojson encoding of the event, while the most commonly queried
+// process, pod and policy fields are mapped to attributes.
+func convert(ev *tetragon.GetEventsResponse) (*logRecord, error) {
+	body, err := bodyMarshaler.Marshal(ev)
+	if err != nil {
+		return nil, err
+	}
+
+	process := helpers.ResponseGetProcess(ev)
+	key, resource := newResource(ev, process)
+
+	eventType := ev.EventType().String()
+	attrs := []*commonpb.KeyValue{stringAttr("tetragon.event.type", eventType)}
+	if process != nil {
+		if pid := process.GetPid(); pid != nil {
+			attrs = append(attrs, intAttr("process.pid", int64(pid.GetValue())))
+		}
+		if uid := process.GetUid(); uid != nil {
+			attrs = append(attrs, intAttr("process.user.id", int64(uid.GetValue())))
+		}
+		attrs = appendString(attrs, "process.executable.path", process.GetBinary())
+		attrs = appendString(attrs, "process.command_args", process.GetArguments())
+		attrs = appendString(attrs, "process.working_directory", process.GetCwd())
+		attrs = appendString(attrs, "tetragon.process.exec_id", process.GetExecId())
+		attrs = appendString(attrs, "tetragon.process.parent_exec_id", process.GetParentExecId())
+	}
+	attrs = append(attrs, policyAttributes(ev)...)
+
+	var ts uint64
+	if t := ev.GetTime(); t != nil {
+		ts = uint64(t.AsTime().UnixNano())
+	}
+	return &logRecord{
+		resourceKey: key,
+		resource:    resource,
+		record: &logspb.LogRecord{
+			TimeUnixNano:         ts,
+			ObservedTimeUnixNano: ts,
+			SeverityNumber:       logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+			SeverityText:         "INFO",
+			EventName:            "tetragon." + strings.ToLower(eventType),
+			Body:                 &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: string(body)}},
+			Attributes:           attrs,
+		},
+	}, nil
+}
+
+// newRequest groups records by resource into an export request.
+func newRequest(records []*logRecord) *collogspb.ExportLogsServiceRequest {
+	req := &collogspb.ExportLogsServiceRequest{}
+	scopes := make(map[string]*logspb.ScopeLogs)
+	for _, r := range records {
+		scope, ok := scopes[r.resourceKey]
+		if !ok {
+			scope = &logspb.ScopeLogs{
+				Scope: &commonpb.InstrumentationScope{Name: scopeName},
+			}
+			scopes[r.resourceKey] = scope
+			req.ResourceLogs = append(req.ResourceLogs, &logspb.ResourceLogs{
+				Resource:  r.resource,
+				ScopeLogs: []*logspb.ScopeLogs{scope},
+			})
+		}
+		scope.LogRecords = append(scope.LogRecords, r.record)
+	}
+	return req
+}
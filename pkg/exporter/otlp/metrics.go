@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package otlp
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cilium/tetragon/pkg/metrics"
+	"github.com/cilium/tetragon/pkg/metrics/consts"
+)
+
+const (
+	dropReasonQueueFull    = "queue_full"
+	dropReasonExportFailed = "export_failed"
+	dropReasonRejected     = "rejected"
+	dropReasonEncodeFailed = "encode_failed"
+
+	requestStatusSuccess = "success"
+	requestStatusRetry   = "retry"
+	requestStatusFailure = "failure"
+)
+
+var (
+	eventsExported = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: consts.MetricsNamespace,
+		Name:      "otlp_events_exported_total",
+		Help:      "Number of events successfully exported to the OTLP collector",
+	})
+
+	eventsDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: consts.MetricsNamespace,
+		Name:      "otlp_events_dropped_total",
+		Help:      "Number of events dropped by the OTLP exporter",
+	}, []string{"reason"})
+
+	exportRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: consts.MetricsNamespace,
+		Name:      "otlp_export_requests_total",
+		Help:      "Number of export requests sent to the OTLP collector",
+	}, []string{"status"})
+
+	queueLength = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: consts.MetricsNamespace,
+		Name:      "otlp_queue_length",
+		Help:      "Number of events waiting to be exported to the OTLP collector",
+	})
+)
+
+func RegisterMetrics(group metrics.Group) {
+	group.MustRegister(
+		eventsExported,
+		eventsDropped,
+		exportRequests,
+		queueLength,
+	)
+}
+
+func InitMetrics() {
+	for _, reason := range []string{dropReasonQueueFull, dropReasonExportFailed, dropReasonRejected, dropReasonEncodeFailed} {
+		eventsDropped.WithLabelValues(reason).Add(0)
+	}
+	for _, status := range []string{requestStatusSuccess, requestStatusRetry, requestStatusFailure} {
+		exportRequests.WithLabelValues(status).Add(0)
+	}
+}
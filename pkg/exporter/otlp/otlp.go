@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package otlp implements an exporter encoder that converts events into
+// OpenTelemetry log records and ships them to an OTLP collector over gRPC or
+// HTTP. It is meant to be used with exporter.NewExporter, so that events are
+// subject to the same filters, field filters and rate limits as the JSON
+// file exporter.
+package otlp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
+)
+
+const (
+	minRetryBackoff = 500 * time.Millisecond
+	maxRetryBackoff = 30 * time.Second
+)
+
+// Options configures the OTLP exporter.
+type Options struct {
+	// Endpoint of the collector. For gRPC this is a host:port, for HTTP it
+	// can also be a URL.
+	Endpoint string
+	// Protocol is either ProtocolGRPC or ProtocolHTTP.
+	Protocol string
+	// Insecure disables TLS.
+	Insecure bool
+	// Headers are sent with every export request.
+	Headers map[string]string
+	// Timeout bounds a single export request.
+	Timeout time.Duration
+	// BatchSize is the maximum number of events sent in one request.
+	BatchSize int
+	// BatchTimeout is the maximum time an event waits for a batch to fill.
+	BatchTimeout time.Duration
+	// QueueSize is the number of events that can be queued for export.
+	// Events arriving while the queue is full are dropped.
+	QueueSize int
+	// MaxRetries is the number of times a failed request is retried.
+	MaxRetries int
+}
+
+// ParseHeaders parses a comma-separated list of key=value pairs.
+func ParseHeaders(s string) (map[string]string, error) {
+	headers := make(map[string]string)
+	for kv := range strings.SplitSeq(s, ",") {
+		kv = strings.TrimSpace(kv)
+		if kv == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || strings.TrimSpace(k) == "" {
+			return nil, fmt.Errorf("invalid OTLP header %q, expected key=value", kv)
+		}
+		headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return headers, nil
+}
+
+// Encoder batches events and exports them to an OTLP collector. Encode never
+// blocks on the collector: events are queued and, if the queue is full,
+// dropped.
+type Encoder struct {
+	opts   Options
+	client client
+	queue  chan *logRecord
+
+	// ctx is cancelled on Close to abort retries of pending requests
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	wg     sync.WaitGroup
+}
+
+// NewEncoder creates an OTLP encoder and starts its export loop.
+func NewEncoder(opts Options) (*Encoder, error) {
+	if opts.Endpoint == "" {
+		return nil, errors.New("OTLP endpoint not set")
+	}
+	if opts.BatchSize <= 0 {
+		return nil, fmt.Errorf("invalid OTLP batch size %d", opts.BatchSize)
+	}
+	if opts.QueueSize <= 0 {
+		return nil, fmt.Errorf("invalid OTLP queue size %d", opts.QueueSize)
+	}
+	if opts.BatchTimeout <= 0 {
+		return nil, fmt.Errorf("invalid OTLP batch timeout %s", opts.BatchTimeout)
+	}
+	c, err := newClient(&opts)
+	if err != nil {
+		return nil, err
+	}
+	return newEncoder(opts, c), nil
+}
+
+func newEncoder(opts Options, c client) *Encoder {
+	ctx, cancel := context.WithCancel(context.Background())
+	e := &Encoder{
+		opts:   opts,
+		client: c,
+		queue:  make(chan *logRecord, opts.QueueSize),
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	e.wg.Add(1)
+	go e.run()
+	return e
+}
+
+// Encode converts an event and queues it for export.
+func (e *Encoder) Encode(v any) error {
+	ev, ok := v.(*tetragon.GetEventsResponse)
+	if !ok {
+		eventsDropped.WithLabelValues(dropReasonEncodeFailed).Inc()
+		return fmt.Errorf("OTLPEncoder: unexpected event type %T", v)
+	}
+	r, err := convert(ev)
+	if err != nil {
+		eventsDropped.WithLabelValues(dropReasonEncodeFailed).Inc()
+		return fmt.Errorf("OTLPEncoder: failed to convert event: %w", err)
+	}
+	select {
+	case e.queue <- r:
+		queueLength.Set(float64(len(e.queue)))
+	default:
+		eventsDropped.WithLabelValues(dropReasonQueueFull).Inc()
+	}
+	return nil
+}
+
+// Close exports the queued events and stops the exporter. Requests are not
+// retried once Close is called.
+func (e *Encoder) Close() error {
+	close(e.done)
+	e.wg.Wait()
+	return e.client.close()
+}
+
+func (e *Encoder) run() {
+	defer e.wg.Done()
+	ticker := time.NewTicker(e.opts.BatchTimeout)
+	defer ticker.Stop()
+
+	batch := make([]*logRecord, 0, e.opts.BatchSize)
+	flush := func() {
+		if len(batch) > 0 {
+			e.export(batch)
+			batch = make([]*logRecord, 0, e.opts.BatchSize)
+		}
+		queueLength.Set(float64(len(e.queue)))
+	}
+	for {
+		select {
+		case r := <-e.queue:
+			batch = append(batch, r)
+			if len(batch) >= e.opts.BatchSize {
+				flush()
+				ticker.Reset(e.opts.BatchTimeout)
+			}
+		case <-ticker.C:
+			flush()
+		case <-e.done:
+			e.cancel()
+			for {
+				select {
+				case r := <-e.queue:
+					batch = append(batch, r)
+					if len(batch) >= e.opts.BatchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// export sends a batch to the collector, retrying with exponential backoff
+// on retryable errors.
+func (e *Encoder) export(batch []*logRecord) {
+	req := newRequest(batch)
+	backoff := minRetryBackoff
+	for attempt := 0; ; attempt++ {
+		err := e.exportOnce(req)
+		if err == nil {
+			exportRequests.WithLabelValues(requestStatusSuccess).Inc()
+			eventsExported.Add(float64(len(batch)))
+			return
+		}
+		if !isRetryable(err) || attempt >= e.opts.MaxRetries || e.ctx.Err() != nil {
+			exportRequests.WithLabelValues(requestStatusFailure).Inc()
+			eventsDropped.WithLabelValues(dropReasonExportFailed).Add(float64(len(batch)))
+			logger.GetLogger().Warn("Failed to export events to OTLP collector",
+				"events", len(batch),
+				"attempts", attempt+1,
+				logfields.Error, err)
+			return
+		}
+		exportRequests.WithLabelValues(requestStatusRetry).Inc()
+		select {
+		case <-time.After(backoff):
+		case <-e.ctx.Done():
+		}
+		backoff = min(2*backoff, maxRetryBackoff)
+	}
+}
+
+func (e *Encoder) exportOnce(req *collogspb.ExportLogsServiceRequest) error {
+	ctx := e.ctx
+	if ctx.Err() != nil {
+		// We are closing, but still want to attempt sending what is left.
+		ctx = context.Background()
+	}
+	if e.opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.opts.Timeout)
+		defer cancel()
+	}
+	return e.client.export(ctx, req)
+}
@@ -0,0 +1,312 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package otlp
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+var testTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func testEvent(pod string) *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessKprobe{
+			ProcessKprobe: &tetragon.ProcessKprobe{
+				Process: &tetragon.Process{
+					ExecId: "exec-id",
+					Pid:    wrapperspb.UInt32(42),
+					Binary: "/usr/bin/curl",
+					Pod: &tetragon.Pod{
+						Namespace:    "default",
+						Name:         pod,
+						Workload:     "client",
+						WorkloadKind: "Deployment",
+						Container:    &tetragon.Container{Name: "curl"},
+					},
+				},
+				FunctionName: "tcp_connect",
+				PolicyName:   "connect",
+				Action:       tetragon.KprobeAction_KPROBE_ACTION_POST,
+			},
+		},
+		NodeName: "node",
+		Time:     timestamppb.New(testTime),
+	}
+}
+
+func attrMap(attrs []*commonpb.KeyValue) map[string]any {
+	ret := make(map[string]any)
+	for _, kv := range attrs {
+		switch v := kv.GetValue().GetValue().(type) {
+		case *commonpb.AnyValue_StringValue:
+			ret[kv.GetKey()] = v.StringValue
+		case *commonpb.AnyValue_IntValue:
+			ret[kv.GetKey()] = v.IntValue
+		}
+	}
+	return ret
+}
+
+func TestConvert(t *testing.T) {
+	r, err := convert(testEvent("pod-1"))
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]any{
+		"service.name":        "tetragon",
+		"k8s.node.name":       "node",
+		"k8s.namespace.name":  "default",
+		"k8s.pod.name":        "pod-1",
+		"k8s.container.name":  "curl",
+		"k8s.deployment.name": "client",
+	}, attrMap(r.resource.GetAttributes()))
+	require.Equal(t, map[string]any{
+		"tetragon.event.type":      "PROCESS_KPROBE",
+		"process.pid":              int64(42),
+		"process.executable.path":  "/usr/bin/curl",
+		"tetragon.process.exec_id": "exec-id",
+		"tetragon.policy.name":     "connect",
+		"tetragon.policy.function": "tcp_connect",
+		"tetragon.policy.action":   "KPROBE_ACTION_POST",
+	}, attrMap(r.record.GetAttributes()))
+	require.Equal(t, uint64(testTime.UnixNano()), r.record.GetTimeUnixNano())
+	require.Equal(t, "tetragon.process_kprobe", r.record.GetEventName())
+	require.JSONEq(t, `{
+		"process_kprobe": {
+			"process": {
+				"exec_id": "exec-id",
+				"pid": 42,
+				"binary": "/usr/bin/curl",
+				"pod": {
+					"namespace": "default",
+					"name": "pod-1",
+					"workload": "client",
+					"workload_kind": "Deployment",
+					"container": {"name": "curl"}
+				}
+			},
+			"function_name": "tcp_connect",
+			"policy_name": "connect",
+			"action": "KPROBE_ACTION_POST"
+		},
+		"node_name": "node",
+		"time": "2024-01-01T00:00:00Z"
+	}`, r.record.GetBody().GetStringValue())
+}
+
+func TestNewRequestGroupsResources(t *testing.T) {
+	var records []*logRecord
+	for _, pod := range []string{"pod-1", "pod-2", "pod-1"} {
+		r, err := convert(testEvent(pod))
+		require.NoError(t, err)
+		records = append(records, r)
+	}
+	req := newRequest(records)
+	require.Len(t, req.GetResourceLogs(), 2)
+	require.Len(t, req.GetResourceLogs()[0].GetScopeLogs()[0].GetLogRecords(), 2)
+	require.Len(t, req.GetResourceLogs()[1].GetScopeLogs()[0].GetLogRecords(), 1)
+}
+
+// collector is a stub OTLP logs collector.
+type collector struct {
+	collogspb.UnimplementedLogsServiceServer
+
+	mu       sync.Mutex
+	records  int
+	headers  []string
+	failures int // number of requests to fail before accepting
+}
+
+func newCollector(failures int) *collector {
+	return &collector{failures: failures}
+}
+
+// accept records req and reports whether it was accepted.
+func (c *collector) accept(req *collogspb.ExportLogsServiceRequest, header string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.failures > 0 {
+		c.failures--
+		return false
+	}
+	for _, rl := range req.GetResourceLogs() {
+		for _, sl := range rl.GetScopeLogs() {
+			c.records += len(sl.GetLogRecords())
+		}
+	}
+	c.headers = append(c.headers, header)
+	return true
+}
+
+func (c *collector) Export(ctx context.Context, req *collogspb.ExportLogsServiceRequest) (*collogspb.ExportLogsServiceResponse, error) {
+	var header string
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get("x-token")) > 0 {
+		header = md.Get("x-token")[0]
+	}
+	if !c.accept(req, header) {
+		return nil, status.Error(codes.Unavailable, "try again")
+	}
+	return &collogspb.ExportLogsServiceResponse{}, nil
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != httpLogsPath || r.Header.Get("Content-Type") != "application/x-protobuf" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	req := &collogspb.ExportLogsServiceRequest{}
+	if err := proto.Unmarshal(body, req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if !c.accept(req, r.Header.Get("x-token")) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+	resp, _ := proto.Marshal(&collogspb.ExportLogsServiceResponse{})
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.Write(resp)
+}
+
+func (c *collector) wait(t *testing.T, records int) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return c.records >= records
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func testOptions(endpoint, protocol string) Options {
+	return Options{
+		Endpoint:     endpoint,
+		Protocol:     protocol,
+		Insecure:     true,
+		Headers:      map[string]string{"x-token": "secret"},
+		Timeout:      5 * time.Second,
+		BatchSize:    2,
+		BatchTimeout: 50 * time.Millisecond,
+		QueueSize:    100,
+		MaxRetries:   3,
+	}
+}
+
+func testExport(t *testing.T, c *collector, opts Options) {
+	enc, err := NewEncoder(opts)
+	require.NoError(t, err)
+	for range 3 {
+		require.NoError(t, enc.Encode(testEvent("pod")))
+	}
+	// two events fill a batch, the last one is sent on batch timeout
+	c.wait(t, 3)
+	require.NoError(t, enc.Close())
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	require.Equal(t, 3, c.records)
+	require.Len(t, c.headers, 2)
+	for _, h := range c.headers {
+		require.Equal(t, "secret", h)
+	}
+}
+
+func TestExportGRPC(t *testing.T) {
+	c := newCollector(1)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := grpc.NewServer()
+	collogspb.RegisterLogsServiceServer(srv, c)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	testExport(t, c, testOptions(lis.Addr().String(), ProtocolGRPC))
+}
+
+func TestExportHTTP(t *testing.T) {
+	c := newCollector(1)
+	srv := httptest.NewServer(c)
+	defer srv.Close()
+
+	testExport(t, c, testOptions(srv.URL, ProtocolHTTP))
+}
+
+func TestExportQueueFull(t *testing.T) {
+	blocked := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		<-blocked
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+	defer close(blocked)
+
+	opts := testOptions(srv.URL, ProtocolHTTP)
+	opts.BatchSize = 1
+	opts.QueueSize = 1
+	enc, err := NewEncoder(opts)
+	require.NoError(t, err)
+
+	dropped := eventsDropped.WithLabelValues(dropReasonQueueFull)
+	before := testutil.ToFloat64(dropped)
+	// the first event is picked up by the (blocked) export loop, the
+	// second one fills the queue and the rest are dropped
+	for range 10 {
+		require.NoError(t, enc.Encode(testEvent("pod")))
+	}
+	require.Greater(t, testutil.ToFloat64(dropped), before)
+}
+
+func TestParseHeaders(t *testing.T) {
+	headers, err := ParseHeaders("authorization=Bearer abc, x-scope = tenant ")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"authorization": "Bearer abc", "x-scope": "tenant"}, headers)
+
+	headers, err = ParseHeaders("")
+	require.NoError(t, err)
+	require.Empty(t, headers)
+
+	_, err = ParseHeaders("foo")
+	require.Error(t, err)
+}
+
+func TestHTTPURL(t *testing.T) {
+	for _, tc := range []struct {
+		endpoint string
+		insecure bool
+		want     string
+	}{
+		{"collector:4318", false, "https://collector:4318/v1/logs"},
+		{"collector:4318", true, "http://collector:4318/v1/logs"},
+		{"http://collector:4318/", false, "http://collector:4318/v1/logs"},
+		{"https://collector/custom/logs", false, "https://collector/custom/logs"},
+	} {
+		got, err := httpURL(tc.endpoint, tc.insecure)
+		require.NoError(t, err)
+		require.Equal(t, tc.want, got, tc.endpoint)
+	}
+}
@@ -0,0 +1,266 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package fieldfilters
+
+import (
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+// FieldPath is the path of a field of the event of a GetEventsResponse, with
+// field names separated by periods as in field filters, for example
+// process.binary. Paths that are not fields of the event, such as node_name,
+// are looked up in the GetEventsResponse itself. Unlike field filters, an
+// element of a repeated field can be selected by its index, for example
+// args.0.sock_arg.dport.
+type FieldPath struct {
+	path  string
+	names []string
+}
+
+// ParseFieldPath parses a field path, checking that at least one event type
+// has this field.
+func ParseFieldPath(path string) (FieldPath, error) {
+	fp := FieldPath{path: path}
+	for name := range strings.SplitSeq(path, ".") {
+		if name == "" {
+			return FieldPath{}, fmt.Errorf("invalid field path %q", path)
+		}
+		fp.names = append(fp.names, name)
+	}
+	if !fp.valid() {
+		return FieldPath{}, fmt.Errorf("invalid field path %q: no event has this field", path)
+	}
+	return fp, nil
+}
+
+// ParseFieldPaths parses a list of field paths.
+func ParseFieldPaths(paths []string) ([]FieldPath, error) {
+	ret := make([]FieldPath, 0, len(paths))
+	for _, path := range paths {
+		fp, err := ParseFieldPath(path)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, fp)
+	}
+	return ret, nil
+}
+
+func (fp FieldPath) String() string {
+	return fp.path
+}
+
+// valid returns whether the path exists in at least one event type or in the
+// GetEventsResponse.
+func (fp FieldPath) valid() bool {
+	desc := (&tetragon.GetEventsResponse{}).ProtoReflect().Descriptor()
+	if fp.validIn(desc) {
+		return true
+	}
+	fields := desc.Oneofs().ByName("event").Fields()
+	for i := range fields.Len() {
+		if fp.validIn(fields.Get(i).Message()) {
+			return true
+		}
+	}
+	return false
+}
+
+func (fp FieldPath) validIn(desc protoreflect.MessageDescriptor) bool {
+	for i := 0; i < len(fp.names); i++ {
+		fd := desc.Fields().ByName(protoreflect.Name(fp.names[i]))
+		if fd == nil {
+			return false
+		}
+		if fd.IsList() && i+1 < len(fp.names) {
+			if _, err := strconv.ParseUint(fp.names[i+1], 10, 32); err != nil {
+				return false
+			}
+			i++
+		} else if fd.IsList() || fd.IsMap() {
+			return i == len(fp.names)-1
+		}
+		if i == len(fp.names)-1 {
+			return true
+		}
+		if fd.Message() == nil {
+			return false
+		}
+		desc = fd.Message()
+	}
+	return false
+}
+
+// field is the value of a field found by a FieldPath. If the path selects an
+// element of a repeated field, list is false and v holds the element.
+type field struct {
+	fd   protoreflect.FieldDescriptor
+	v    protoreflect.Value
+	list bool
+}
+
+// lookup returns the field found by the path. If defaults is set, scalar fields
+// that are not set are returned with their default value, so that zero values
+// can be told apart from missing fields.
+func (fp FieldPath) lookup(event *tetragon.GetEventsResponse, defaults bool) (field, bool) {
+	msg := event.ProtoReflect()
+	if inner := eventMessage(msg); inner != nil && inner.Descriptor().Fields().ByName(protoreflect.Name(fp.names[0])) != nil {
+		msg = inner
+	}
+	for i := 0; i < len(fp.names); i++ {
+		fd := msg.Descriptor().Fields().ByName(protoreflect.Name(fp.names[i]))
+		if fd == nil {
+			return field{}, false
+		}
+		if !msg.Has(fd) {
+			if defaults && i == len(fp.names)-1 && fd.Message() == nil && !fd.IsList() && !fd.IsMap() {
+				return field{fd: fd, v: fd.Default()}, true
+			}
+			return field{}, false
+		}
+		v := msg.Get(fd)
+		isList := fd.IsList() || fd.IsMap()
+		if fd.IsList() && i+1 < len(fp.names) {
+			idx, err := strconv.Atoi(fp.names[i+1])
+			if err != nil || idx >= v.List().Len() {
+				return field{}, false
+			}
+			v = v.List().Get(idx)
+			isList = false
+			i++
+		}
+		if i == len(fp.names)-1 {
+			return field{fd: fd, v: v, list: isList}, true
+		}
+		if fd.Message() == nil || isList {
+			return field{}, false
+		}
+		msg = v.Message()
+	}
+	return field{}, false
+}
+
+// Value returns the value of the field as a string, and whether it is set.
+// Elements of repeated fields are separated by commas, and maps are formatted
+// as sorted key=value pairs.
+func (fp FieldPath) Value(event *tetragon.GetEventsResponse) (string, bool) {
+	f, ok := fp.lookup(event, false)
+	if !ok {
+		return "", false
+	}
+	switch {
+	case f.list && f.fd.IsMap():
+		var values []string
+		f.v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			values = append(values, k.String()+"="+formatScalar(f.fd.MapValue(), v))
+			return true
+		})
+		slices.Sort(values)
+		return strings.Join(values, ","), true
+	case f.list:
+		list := f.v.List()
+		values := make([]string, 0, list.Len())
+		for i := range list.Len() {
+			values = append(values, formatScalar(f.fd, list.Get(i)))
+		}
+		return strings.Join(values, ","), true
+	default:
+		return formatScalar(f.fd, f.v), true
+	}
+}
+
+// Float returns the value of a numeric field, and whether the event has this
+// field and it is numeric. Numeric fields that are not set are returned as 0.
+// Durations are returned in seconds, and wrapper types such as
+// google.protobuf.UInt32Value are unwrapped.
+func (fp FieldPath) Float(event *tetragon.GetEventsResponse) (float64, bool) {
+	f, ok := fp.lookup(event, true)
+	if !ok || f.list {
+		return 0, false
+	}
+	return toFloat(f.fd, f.v)
+}
+
+func toFloat(fd protoreflect.FieldDescriptor, v protoreflect.Value) (float64, bool) {
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return float64(v.Int()), true
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return float64(v.Uint()), true
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return v.Float(), true
+	case protoreflect.MessageKind:
+		msg := v.Message()
+		desc := msg.Descriptor()
+		if desc.FullName() == "google.protobuf.Duration" {
+			secs := msg.Get(desc.Fields().ByName("seconds")).Int()
+			nanos := msg.Get(desc.Fields().ByName("nanos")).Int()
+			return (time.Duration(secs)*time.Second + time.Duration(nanos)).Seconds(), true
+		}
+		if wfd := wrapperValue(desc); wfd != nil {
+			return toFloat(wfd, msg.Get(wfd))
+		}
+	}
+	return 0, false
+}
+
+// eventMessage returns the event set in a GetEventsResponse, or nil.
+func eventMessage(msg protoreflect.Message) protoreflect.Message {
+	fd := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("event"))
+	if fd == nil {
+		return nil
+	}
+	return msg.Get(fd).Message()
+}
+
+func formatScalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) string {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return strconv.Itoa(int(v.Enum()))
+	case protoreflect.BytesKind:
+		return hex.EncodeToString(v.Bytes())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return formatMessage(v.Message())
+	default:
+		return v.String()
+	}
+}
+
+func formatMessage(msg protoreflect.Message) string {
+	desc := msg.Descriptor()
+	if desc.FullName() == "google.protobuf.Timestamp" {
+		secs := msg.Get(desc.Fields().ByName("seconds")).Int()
+		nanos := msg.Get(desc.Fields().ByName("nanos")).Int()
+		return time.Unix(secs, nanos).UTC().Format(time.RFC3339Nano)
+	}
+	if fd := wrapperValue(desc); fd != nil {
+		return formatScalar(fd, msg.Get(fd))
+	}
+	b, _ := protojson.Marshal(msg.Interface())
+	return string(b)
+}
+
+// wrapperValue returns the value field of wrapper types, such as
+// google.protobuf.UInt32Value, or nil.
+func wrapperValue(desc protoreflect.MessageDescriptor) protoreflect.FieldDescriptor {
+	if desc.ParentFile().Package() != "google.protobuf" || desc.Fields().Len() != 1 {
+		return nil
+	}
+	return desc.Fields().ByName("value")
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package fieldfilters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+func TestFieldPath(t *testing.T) {
+	event := &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessKprobe{ProcessKprobe: &tetragon.ProcessKprobe{
+			Process: &tetragon.Process{
+				Binary: "/usr/bin/curl",
+				Pid:    wrapperspb.UInt32(1234),
+				Pod: &tetragon.Pod{
+					Name:      "xwing",
+					PodLabels: map[string]string{"org": "alliance", "class": "xwing"},
+				},
+			},
+			FunctionName: "tcp_connect",
+			Args: []*tetragon.KprobeArgument{
+				{Arg: &tetragon.KprobeArgument_SockArg{SockArg: &tetragon.KprobeSock{Daddr: "10.0.0.1", Dport: 443}}},
+			},
+			Action: tetragon.KprobeAction_KPROBE_ACTION_POST,
+		}},
+		NodeName: "node",
+	}
+
+	tests := []struct {
+		path  string
+		value string
+		ok    bool
+	}{
+		{"process.binary", "/usr/bin/curl", true},
+		{"process.pid", "1234", true},
+		{"process.pod.pod_labels", "class=xwing,org=alliance", true},
+		{"function_name", "tcp_connect", true},
+		{"action", "KPROBE_ACTION_POST", true},
+		{"args.0.sock_arg.dport", "443", true},
+		{"args.1.sock_arg.dport", "", false},
+		{"node_name", "node", true},
+		{"process.pod.namespace", "", false},
+		{"parent.binary", "", false},
+	}
+	for _, test := range tests {
+		fp, err := ParseFieldPath(test.path)
+		require.NoError(t, err, test.path)
+		value, ok := fp.Value(event)
+		assert.Equal(t, test.ok, ok, test.path)
+		assert.Equal(t, test.value, value, test.path)
+	}
+
+	for _, path := range []string{"", "process.", "process.unknown", "args.sock_arg", "process.pod.pod_labels.org"} {
+		_, err := ParseFieldPath(path)
+		require.Error(t, err, path)
+	}
+}
+
+func TestFieldPathFloat(t *testing.T) {
+	event := &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessNetworkFlow{ProcessNetworkFlow: &tetragon.ProcessNetworkFlow{
+			Process:   &tetragon.Process{Binary: "/usr/bin/curl", Pid: wrapperspb.UInt32(1234)},
+			Sock:      &tetragon.KprobeSock{Dport: 443},
+			BytesSent: 1500,
+			Duration:  durationpb.New(1500 * time.Millisecond),
+		}},
+	}
+	tests := []struct {
+		path  string
+		value float64
+		ok    bool
+	}{
+		{"bytes_sent", 1500, true},
+		{"sock.dport", 443, true},
+		{"duration", 1.5, true},
+		{"process.pid", 1234, true},
+		{"process.binary", 0, false},
+		{"bytes_received", 0, true},
+		{"process.tid", 0, false},
+	}
+	for _, test := range tests {
+		fp, err := ParseFieldPath(test.path)
+		require.NoError(t, err, test.path)
+		value, ok := fp.Float(event)
+		assert.Equal(t, test.ok, ok, test.path)
+		assert.InDelta(t, test.value, value, 1e-9, test.path)
+	}
+}
@@ -96,6 +96,68 @@ func (f RedactionFilterList) Redact(binary, args string, envs []string) (string,
 	return args, envs
 }
 
+// RedactProcess redacts the arguments, environment variables, and pod labels
+// and annotations of a process in place. Environment variables, labels and
+// annotations are matched as "key=value" strings.
+func (f RedactionFilterList) RedactProcess(p *tetragon.Process) {
+	if p == nil {
+		return
+	}
+
+	envs := make([]string, 0, len(p.EnvironmentVariables))
+	for _, env := range p.EnvironmentVariables {
+		envs = append(envs, env.Key+"="+env.Value)
+	}
+	p.Arguments, envs = f.Redact(p.Binary, p.Arguments, envs)
+	for i, env := range envs {
+		p.EnvironmentVariables[i].Key, p.EnvironmentVariables[i].Value, _ = strings.Cut(env, "=")
+	}
+
+	f.RedactPod(p.Binary, p.Pod)
+}
+
+// RedactPod redacts the labels and annotations of the pod of a process in
+// place, matched as "key=value" strings.
+func (f RedactionFilterList) RedactPod(binary string, pod *tetragon.Pod) {
+	if pod == nil {
+		return
+	}
+	pod.PodLabels = f.redactMap(binary, pod.PodLabels)
+	pod.PodAnnotations = f.redactMap(binary, pod.PodAnnotations)
+}
+
+// RedactText redacts free-form text, such as logs, line by line. Text is not
+// attributed to a binary, so the binary regexes are ignored and every filter
+// applies.
+func (f RedactionFilterList) RedactText(data []byte) []byte {
+	lines := strings.Split(string(data), "\n")
+	for i := range lines {
+		for _, filter := range f.list {
+			for _, re := range filter.redact {
+				lines[i], _ = redactString(re, lines[i])
+			}
+		}
+	}
+	return []byte(strings.Join(lines, "\n"))
+}
+
+func (f RedactionFilterList) redactMap(binary string, m map[string]string) map[string]string {
+	if len(m) == 0 {
+		return m
+	}
+	kvs := make([]string, 0, len(m))
+	for k, v := range m {
+		kvs = append(kvs, k+"="+v)
+	}
+	_, kvs = f.Redact(binary, "", kvs)
+	ret := make(map[string]string, len(kvs))
+	for _, kv := range kvs {
+		k, v, _ := strings.Cut(kv, "=")
+		ret[k] = v
+	}
+	return ret
+}
+
 // Redact resursively checks any string fields in the event for matches to
 // redaction regexes and replaces any capture groups with `*****`.
 //
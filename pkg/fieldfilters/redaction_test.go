@@ -10,6 +10,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
 )
 
 func TestRedactString_Simple(t *testing.T) {
@@ -140,3 +142,48 @@ func TestRedact_ArgsWithEnvs(t *testing.T) {
 	str := strings.Join(envs, " ")
 	assert.Equal(t, "VAR1=XXX SSH_PASSWORD="+REDACTION_STR+" VAR2=YYY", str)
 }
+
+func TestRedactProcess(t *testing.T) {
+	filterList := `{"redact": ["--password[\\s=]+(\\S+)", "(?:SSH_PASSWORD)=(\\S+)", "token=(\\S+)"]}`
+	filters, err := ParseRedactionFilterList(filterList)
+	require.NoError(t, err)
+
+	p := &tetragon.Process{
+		Binary:    "/usr/bin/ssh",
+		Arguments: "--password ybx511!ackt544 host",
+		EnvironmentVariables: []*tetragon.EnvVar{
+			{Key: "SSH_PASSWORD", Value: "verysecretpassword"},
+			{Key: "HOME", Value: "/root"},
+		},
+		Pod: &tetragon.Pod{
+			PodLabels:      map[string]string{"app": "ssh", "token": "abc"},
+			PodAnnotations: map[string]string{"token": "def"},
+		},
+	}
+	filters.RedactProcess(p)
+	assert.Equal(t, "--password "+REDACTION_STR+" host", p.Arguments)
+	assert.Equal(t, []*tetragon.EnvVar{
+		{Key: "SSH_PASSWORD", Value: REDACTION_STR},
+		{Key: "HOME", Value: "/root"},
+	}, p.EnvironmentVariables)
+	assert.Equal(t, map[string]string{"app": "ssh", "token": REDACTION_STR}, p.Pod.PodLabels)
+	assert.Equal(t, map[string]string{"token": REDACTION_STR}, p.Pod.PodAnnotations)
+
+	// processes without pod nor environment variables
+	p = &tetragon.Process{Arguments: "--password foo"}
+	filters.RedactProcess(p)
+	assert.Equal(t, "--password "+REDACTION_STR, p.Arguments)
+	filters.RedactProcess(nil)
+}
+
+func TestRedactText(t *testing.T) {
+	filterList := `{"binary_regex": ["ssh"], "redact": ["--password[\\s=]+(\\S+)"]}
+{"redact": ["token=(\\S+)"]}`
+	filters, err := ParseRedactionFilterList(filterList)
+	require.NoError(t, err)
+
+	text := "exec /usr/bin/curl --password foo\nheader token=abc\n--password\nbar\n"
+	assert.Equal(t,
+		"exec /usr/bin/curl --password "+REDACTION_STR+"\nheader token="+REDACTION_STR+"\n--password\nbar\n",
+		string(filters.RedactText([]byte(text))))
+}
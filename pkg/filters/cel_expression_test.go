@@ -107,6 +107,26 @@ func TestProcessKprobeFilter(t *testing.T) {
 	assert.False(t, fl.MatchOne(&ev))
 }
 
+func TestProcessDnsFilter(t *testing.T) {
+	f := []*tetragon.Filter{{CelExpression: []string{"process_dns.response && process_dns.qname.endsWith('.cilium.io')"}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{NewCELExpressionFilter(logger.GetLogger())})
+	require.NoError(t, err)
+	ev := event.Event{
+		Event: &tetragon.GetEventsResponse{
+			Event: &tetragon.GetEventsResponse_ProcessDns{
+				ProcessDns: &tetragon.ProcessDns{Response: true, Qname: "docs.cilium.io"}},
+		},
+	}
+	assert.True(t, fl.MatchOne(&ev))
+	ev = event.Event{
+		Event: &tetragon.GetEventsResponse{
+			Event: &tetragon.GetEventsResponse_ProcessDns{
+				ProcessDns: &tetragon.ProcessDns{Response: false, Qname: "docs.cilium.io"}},
+		},
+	}
+	assert.False(t, fl.MatchOne(&ev))
+}
+
 func TestCIDR(t *testing.T) {
 	f := []*tetragon.Filter{{CelExpression: []string{"cidr('10.0.0.0/16').containsIP(process_kprobe.args[0].sock_arg.saddr)"}}}
 	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{NewCELExpressionFilter(logger.GetLogger())})
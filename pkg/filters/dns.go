@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/event"
+)
+
+func filterByDnsQnameRegex(qnamePatterns []string) (FilterFunc, error) {
+	var qnames []*regexp.Regexp
+	for _, pattern := range qnamePatterns {
+		query, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile regexp: %w", err)
+		}
+		qnames = append(qnames, query)
+	}
+	return func(ev *event.Event) bool {
+		if ev == nil {
+			return false
+		}
+		response, ok := ev.Event.(*tetragon.GetEventsResponse)
+		if !ok {
+			return false
+		}
+		dns := response.GetProcessDns()
+		if dns == nil {
+			return false
+		}
+		for _, qname := range qnames {
+			if qname.MatchString(dns.Qname) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+type DnsQnameRegexFilter struct{}
+
+func (f *DnsQnameRegexFilter) OnBuildFilter(_ context.Context, ff *tetragon.Filter) ([]FilterFunc, error) {
+	var fs []FilterFunc
+	if ff.DnsQnameRegex != nil {
+		filters, err := filterByDnsQnameRegex(ff.DnsQnameRegex)
+		if err != nil {
+			return nil, err
+		}
+		fs = append(fs, filters)
+	}
+	return fs, nil
+}
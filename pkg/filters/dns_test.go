@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package filters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/event"
+)
+
+func dnsEvent(qname string) *event.Event {
+	return &event.Event{
+		Event: &tetragon.GetEventsResponse{
+			Event: &tetragon.GetEventsResponse_ProcessDns{
+				ProcessDns: &tetragon.ProcessDns{
+					Process: &tetragon.Process{Binary: "/usr/bin/curl"},
+					Qname:   qname,
+				},
+			},
+		},
+	}
+}
+
+func TestDnsQnameRegexFilter(t *testing.T) {
+	f := []*tetragon.Filter{{DnsQnameRegex: []string{`^api\.github\.com$`, `\.cluster\.local$`}}}
+	fl, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&DnsQnameRegexFilter{}})
+	require.NoError(t, err)
+	assert.True(t, fl.MatchOne(dnsEvent("api.github.com")))
+	assert.True(t, fl.MatchOne(dnsEvent("kube-dns.kube-system.svc.cluster.local")))
+	assert.False(t, fl.MatchOne(dnsEvent("github.com")))
+	assert.False(t, fl.MatchOne(&event.Event{
+		Event: &tetragon.GetEventsResponse{
+			Event: &tetragon.GetEventsResponse_ProcessExec{
+				ProcessExec: &tetragon.ProcessExec{
+					Process: &tetragon.Process{Binary: "/usr/bin/curl"},
+				},
+			},
+		},
+	}))
+}
+
+func TestDnsQnameRegexFilterInvalidRegex(t *testing.T) {
+	f := []*tetragon.Filter{{DnsQnameRegex: []string{"*"}}}
+	_, err := BuildFilterList(context.Background(), f, []OnBuildFilter{&DnsQnameRegexFilter{}})
+	require.Error(t, err)
+}
@@ -153,6 +153,7 @@ var Filters = []OnBuildFilter{
 	&InInitTreeFilter{},
 	NewCELExpressionFilter(logger.GetLogger()),
 	&ContainerNameFilter{},
+	&DnsQnameRegexFilter{},
 }
 
 func GetProcess(event *event.Event) *tetragon.Process {
@@ -224,6 +225,9 @@ func CheckAncestorsEnabled(types []tetragon.EventType) error {
 			tetragon.EventType_PROCESS_UPROBE,
 			tetragon.EventType_PROCESS_LSM,
 			tetragon.EventType_PROCESS_USDT,
+			tetragon.EventType_PROCESS_NETWORK_FLOW,
+			tetragon.EventType_PROCESS_DNS,
+			tetragon.EventType_PROCESS_TLS,
 		}
 	}
 
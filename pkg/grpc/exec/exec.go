@@ -76,9 +76,10 @@ func GetProcessExec(event *MsgExecveEventUnix, useCache bool) *tetragon.ProcessE
 	}
 
 	tetragonEvent := &tetragon.ProcessExec{
-		Process:   tetragonProcess,
-		Parent:    tetragonParent,
-		Ancestors: tetragonAncestors,
+		Process:       tetragonProcess,
+		Parent:        tetragonParent,
+		Ancestors:     tetragonAncestors,
+		FullArguments: proc.TakeFullArguments(),
 	}
 
 	if tetragonProcess.Pid == nil {
@@ -411,6 +412,7 @@ func GetProcessExit(event *MsgExitEventUnix) *tetragon.ProcessExit {
 
 	proc, parent := process.GetParentProcessInternal(event.ProcessKey.Pid, event.ProcessKey.Ktime)
 	if proc != nil {
+		proc.SetExitTime(event.Common.Ktime)
 		tetragonProcess = proc.UnsafeGetProcess()
 	} else {
 		tetragonProcess = &tetragon.Process{
@@ -541,6 +543,7 @@ func (msg *MsgExitEventUnix) RetryInternal(ev notify.Event, timestamp uint64) (*
 	}
 
 	if proc != nil {
+		proc.SetExitTime(msg.Common.Ktime)
 		// Use cached version of the process
 		ev.SetProcess(proc.UnsafeGetProcess())
 	} else {
@@ -14,6 +14,7 @@ import (
 	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/reader/node"
 	"github.com/cilium/tetragon/pkg/reader/notify"
+	"github.com/cilium/tetragon/pkg/replay"
 	"github.com/cilium/tetragon/pkg/rthooks"
 	"github.com/cilium/tetragon/pkg/sensors"
 	"github.com/cilium/tetragon/pkg/server"
@@ -25,6 +26,8 @@ type ProcessManager struct {
 	// synchronize access to the listeners map.
 	mux       sync.Mutex
 	listeners map[server.Listener]struct{}
+	// replay, if set, records every event before it is passed to listeners.
+	replay *replay.Buffer
 }
 
 // NewProcessManager returns a pointer to an initialized ProcessManager struct.
@@ -50,6 +53,15 @@ func NewProcessManager(
 	return pm, nil
 }
 
+// EnableReplay records all events into the replay buffer buf, and allows
+// GetEvents clients to resume from it.
+func (pm *ProcessManager) EnableReplay(buf *replay.Buffer) {
+	pm.mux.Lock()
+	defer pm.mux.Unlock()
+	pm.replay = buf
+	pm.Server.SetReplayer(buf)
+}
+
 // Notify implements Listener.Notify.
 func (pm *ProcessManager) Notify(event notify.Message) error {
 	processedEvent := event.HandleMessage()
@@ -82,6 +94,9 @@ func (pm *ProcessManager) NotifyListener(original any, processed *tetragon.GetEv
 	pm.mux.Lock()
 	defer pm.mux.Unlock()
 	node.SetCommonFields(processed)
+	if pm.replay != nil {
+		pm.replay.Record(processed)
+	}
 	for l := range pm.listeners {
 		l.Notify(processed)
 	}
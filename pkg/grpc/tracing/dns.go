@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package tracing
+
+import (
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/api/tracingapi"
+	"github.com/cilium/tetragon/pkg/eventcache"
+	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/process"
+	"github.com/cilium/tetragon/pkg/reader/dns"
+	"github.com/cilium/tetragon/pkg/reader/network"
+	"github.com/cilium/tetragon/pkg/reader/notify"
+)
+
+type MsgProcessDnsUnix struct {
+	Msg *tracingapi.MsgDns
+	Dns *dns.Message
+}
+
+func GetProcessDns(msg *MsgProcessDnsUnix) *tetragon.ProcessDns {
+	var ancestors []*process.ProcessInternal
+	var tetragonAncestors []*tetragon.Process
+
+	proc, parent, tetragonProcess, tetragonParent := getProcessParent(&msg.Msg.ProcessKey, msg.Msg.Common.Flags)
+
+	// Set the ancestors only if --enable-ancestors flag includes 'dns'.
+	if option.Config.EnableProcessDnsAncestors && proc.NeededAncestors() {
+		ancestors, _ = process.GetAncestorProcessesInternal(tetragonProcess.ParentExecId)
+		for _, ancestor := range ancestors {
+			tetragonAncestors = append(tetragonAncestors, ancestor.UnsafeGetProcess())
+		}
+	}
+
+	var answers []*tetragon.DnsAnswer
+	for _, answer := range msg.Dns.Answers {
+		answers = append(answers, &tetragon.DnsAnswer{
+			Name: answer.Name,
+			Type: answer.Type,
+			Ttl:  answer.TTL,
+			Data: answer.Data,
+		})
+	}
+
+	sock := &msg.Msg.Sock
+	tetragonEvent := &tetragon.ProcessDns{
+		Process:   tetragonProcess,
+		Parent:    tetragonParent,
+		Ancestors: tetragonAncestors,
+		Sock: &tetragon.KprobeSock{
+			Cookie:   sock.Sockaddr,
+			Family:   network.InetFamily(sock.Tuple.Family),
+			State:    network.TcpState(sock.State),
+			Type:     network.InetType(sock.Type),
+			Protocol: network.InetProtocol(sock.Tuple.Protocol),
+			Mark:     sock.Mark,
+			Priority: sock.Priority,
+			Saddr:    network.GetIP(sock.Tuple.Saddr, sock.Tuple.Family).String(),
+			Daddr:    network.GetIP(sock.Tuple.Daddr, sock.Tuple.Family).String(),
+			Sport:    uint32(sock.Tuple.Sport),
+			Dport:    uint32(sock.Tuple.Dport),
+		},
+		Response:  msg.Dns.Response,
+		Id:        uint32(msg.Dns.ID),
+		Qname:     msg.Dns.QName,
+		Qtype:     msg.Dns.QType,
+		Rcode:     msg.Dns.RCode,
+		Answers:   answers,
+		Truncated: msg.Dns.Truncated,
+	}
+
+	if tetragonProcess.Pid == nil {
+		eventcache.CacheErrors(eventcache.NilProcessPid, notify.EventType(tetragonEvent)).Inc()
+		return nil
+	}
+
+	if ec := eventcache.Get(); ec != nil &&
+		(ec.Needed(tetragonProcess) ||
+			((tetragonProcess.Pid.Value > 1) && ec.Needed(tetragonParent)) ||
+			(option.Config.EnableProcessDnsAncestors && ec.NeededAncestors(parent, ancestors))) {
+		ec.Add(nil, tetragonEvent, msg.Msg.Common.Ktime, msg.Msg.ProcessKey.Ktime, msg)
+		return nil
+	}
+
+	return tetragonEvent
+}
+
+func (msg *MsgProcessDnsUnix) Notify() bool {
+	return true
+}
+
+func (msg *MsgProcessDnsUnix) RetryInternal(ev notify.Event, timestamp uint64) (*process.ProcessInternal, error) {
+	return eventcache.HandleGenericInternal(ev, msg.Msg.ProcessKey.Pid, nil, timestamp)
+}
+
+func (msg *MsgProcessDnsUnix) Retry(internal *process.ProcessInternal, ev notify.Event) error {
+	return eventcache.HandleGenericEvent(internal, ev, nil)
+}
+
+func (msg *MsgProcessDnsUnix) HandleMessage() *tetragon.GetEventsResponse {
+	k := GetProcessDns(msg)
+	if k == nil {
+		return nil
+	}
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessDns{ProcessDns: k},
+	}
+}
+
+func (msg *MsgProcessDnsUnix) Cast(o any) notify.Message {
+	t := o.(MsgProcessDnsUnix)
+	return &t
+}
@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package tracing
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/api/tracingapi"
+	"github.com/cilium/tetragon/pkg/eventcache"
+	"github.com/cilium/tetragon/pkg/ktime"
+	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/process"
+	"github.com/cilium/tetragon/pkg/reader/network"
+	"github.com/cilium/tetragon/pkg/reader/notify"
+)
+
+type MsgProcessNetworkFlowUnix struct {
+	Msg *tracingapi.MsgFlow
+}
+
+func flowDirection(direction uint8) tetragon.FlowDirection {
+	switch direction {
+	case tracingapi.FlowDirectionEgress:
+		return tetragon.FlowDirection_FLOW_DIRECTION_EGRESS
+	case tracingapi.FlowDirectionIngress:
+		return tetragon.FlowDirection_FLOW_DIRECTION_INGRESS
+	}
+	return tetragon.FlowDirection_FLOW_DIRECTION_UNKNOWN
+}
+
+func GetProcessNetworkFlow(msg *MsgProcessNetworkFlowUnix) *tetragon.ProcessNetworkFlow {
+	var ancestors []*process.ProcessInternal
+	var tetragonAncestors []*tetragon.Process
+
+	proc, parent, tetragonProcess, tetragonParent := getProcessParent(&msg.Msg.ProcessKey, msg.Msg.Common.Flags)
+
+	// Set the ancestors only if --enable-ancestors flag includes 'flow'.
+	if option.Config.EnableProcessNetworkFlowAncestors && proc.NeededAncestors() {
+		ancestors, _ = process.GetAncestorProcessesInternal(tetragonProcess.ParentExecId)
+		for _, ancestor := range ancestors {
+			tetragonAncestors = append(tetragonAncestors, ancestor.UnsafeGetProcess())
+		}
+	}
+
+	sock := &msg.Msg.Sock
+	tetragonEvent := &tetragon.ProcessNetworkFlow{
+		Process:   tetragonProcess,
+		Parent:    tetragonParent,
+		Ancestors: tetragonAncestors,
+		Sock: &tetragon.KprobeSock{
+			Cookie:   sock.Sockaddr,
+			Family:   network.InetFamily(sock.Tuple.Family),
+			State:    network.TcpState(sock.State),
+			Type:     network.InetType(sock.Type),
+			Protocol: network.InetProtocol(sock.Tuple.Protocol),
+			Mark:     sock.Mark,
+			Priority: sock.Priority,
+			Saddr:    network.GetIP(sock.Tuple.Saddr, sock.Tuple.Family).String(),
+			Daddr:    network.GetIP(sock.Tuple.Daddr, sock.Tuple.Family).String(),
+			Sport:    uint32(sock.Tuple.Sport),
+			Dport:    uint32(sock.Tuple.Dport),
+		},
+		Direction:       flowDirection(msg.Msg.Direction),
+		BytesSent:       msg.Msg.BytesSent,
+		BytesReceived:   msg.Msg.BytesReceived,
+		PacketsSent:     uint64(msg.Msg.SegsOut),
+		PacketsReceived: uint64(msg.Msg.SegsIn),
+		StartTime:       ktime.ToProto(msg.Msg.Start),
+		Duration:        durationpb.New(ktime.DiffKtime(msg.Msg.Start, msg.Msg.Common.Ktime)),
+		End:             msg.Msg.End != 0,
+	}
+
+	if tetragonProcess.Pid == nil {
+		eventcache.CacheErrors(eventcache.NilProcessPid, notify.EventType(tetragonEvent)).Inc()
+		return nil
+	}
+
+	if ec := eventcache.Get(); ec != nil &&
+		(ec.Needed(tetragonProcess) ||
+			((tetragonProcess.Pid.Value > 1) && ec.Needed(tetragonParent)) ||
+			(option.Config.EnableProcessNetworkFlowAncestors && ec.NeededAncestors(parent, ancestors))) {
+		ec.Add(nil, tetragonEvent, msg.Msg.Common.Ktime, msg.Msg.ProcessKey.Ktime, msg)
+		return nil
+	}
+
+	return tetragonEvent
+}
+
+func (msg *MsgProcessNetworkFlowUnix) Notify() bool {
+	return true
+}
+
+func (msg *MsgProcessNetworkFlowUnix) RetryInternal(ev notify.Event, timestamp uint64) (*process.ProcessInternal, error) {
+	return eventcache.HandleGenericInternal(ev, msg.Msg.ProcessKey.Pid, nil, timestamp)
+}
+
+func (msg *MsgProcessNetworkFlowUnix) Retry(internal *process.ProcessInternal, ev notify.Event) error {
+	return eventcache.HandleGenericEvent(internal, ev, nil)
+}
+
+func (msg *MsgProcessNetworkFlowUnix) HandleMessage() *tetragon.GetEventsResponse {
+	k := GetProcessNetworkFlow(msg)
+	if k == nil {
+		return nil
+	}
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessNetworkFlow{ProcessNetworkFlow: k},
+	}
+}
+
+func (msg *MsgProcessNetworkFlowUnix) Cast(o any) notify.Message {
+	t := o.(MsgProcessNetworkFlowUnix)
+	return &t
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package tracing
+
+import (
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/api/tracingapi"
+	"github.com/cilium/tetragon/pkg/eventcache"
+	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/process"
+	"github.com/cilium/tetragon/pkg/reader/http"
+	"github.com/cilium/tetragon/pkg/reader/network"
+	"github.com/cilium/tetragon/pkg/reader/notify"
+)
+
+type MsgProcessTlsUnix struct {
+	Msg  *tracingapi.MsgTls
+	Http *http.Message
+}
+
+func tlsLibrary(library uint8) tetragon.TlsLibrary {
+	switch library {
+	case tracingapi.TlsLibraryOpenSSL:
+		return tetragon.TlsLibrary_TLS_LIBRARY_OPENSSL
+	case tracingapi.TlsLibraryGo:
+		return tetragon.TlsLibrary_TLS_LIBRARY_GO
+	}
+	return tetragon.TlsLibrary_TLS_LIBRARY_UNKNOWN
+}
+
+func tlsDirection(direction uint8) tetragon.TlsDirection {
+	switch direction {
+	case tracingapi.TlsDirectionWrite:
+		return tetragon.TlsDirection_TLS_DIRECTION_WRITE
+	case tracingapi.TlsDirectionRead:
+		return tetragon.TlsDirection_TLS_DIRECTION_READ
+	}
+	return tetragon.TlsDirection_TLS_DIRECTION_UNKNOWN
+}
+
+func GetProcessTls(msg *MsgProcessTlsUnix) *tetragon.ProcessTls {
+	var ancestors []*process.ProcessInternal
+	var tetragonAncestors []*tetragon.Process
+
+	proc, parent, tetragonProcess, tetragonParent := getProcessParent(&msg.Msg.ProcessKey, msg.Msg.Common.Flags)
+
+	// Set the ancestors only if --enable-ancestors flag includes 'tls'.
+	if option.Config.EnableProcessTlsAncestors && proc.NeededAncestors() {
+		ancestors, _ = process.GetAncestorProcessesInternal(tetragonProcess.ParentExecId)
+		for _, ancestor := range ancestors {
+			tetragonAncestors = append(tetragonAncestors, ancestor.UnsafeGetProcess())
+		}
+	}
+
+	var headers []*tetragon.HttpHeader
+	for _, h := range msg.Http.Headers {
+		headers = append(headers, &tetragon.HttpHeader{
+			Name:  h.Name,
+			Value: h.Value,
+		})
+	}
+
+	tetragonEvent := &tetragon.ProcessTls{
+		Process:   tetragonProcess,
+		Parent:    tetragonParent,
+		Ancestors: tetragonAncestors,
+		Library:   tlsLibrary(msg.Msg.Library),
+		Direction: tlsDirection(msg.Msg.Direction),
+		Size:      msg.Msg.OrigSize,
+		Http: &tetragon.HttpMessage{
+			Method:     msg.Http.Method,
+			Path:       msg.Http.Path,
+			StatusCode: msg.Http.StatusCode,
+			Reason:     msg.Http.Reason,
+			Version:    msg.Http.Version,
+			Headers:    headers,
+		},
+		Truncated: msg.Http.Truncated,
+	}
+
+	if msg.Msg.HasSock != 0 {
+		sock := &msg.Msg.Sock
+		tetragonEvent.Sock = &tetragon.KprobeSock{
+			Cookie:   sock.Sockaddr,
+			Family:   network.InetFamily(sock.Tuple.Family),
+			State:    network.TcpState(sock.State),
+			Type:     network.InetType(sock.Type),
+			Protocol: network.InetProtocol(sock.Tuple.Protocol),
+			Mark:     sock.Mark,
+			Priority: sock.Priority,
+			Saddr:    network.GetIP(sock.Tuple.Saddr, sock.Tuple.Family).String(),
+			Daddr:    network.GetIP(sock.Tuple.Daddr, sock.Tuple.Family).String(),
+			Sport:    uint32(sock.Tuple.Sport),
+			Dport:    uint32(sock.Tuple.Dport),
+		}
+	}
+
+	if tetragonProcess.Pid == nil {
+		eventcache.CacheErrors(eventcache.NilProcessPid, notify.EventType(tetragonEvent)).Inc()
+		return nil
+	}
+
+	if ec := eventcache.Get(); ec != nil &&
+		(ec.Needed(tetragonProcess) ||
+			((tetragonProcess.Pid.Value > 1) && ec.Needed(tetragonParent)) ||
+			(option.Config.EnableProcessTlsAncestors && ec.NeededAncestors(parent, ancestors))) {
+		ec.Add(nil, tetragonEvent, msg.Msg.Common.Ktime, msg.Msg.ProcessKey.Ktime, msg)
+		return nil
+	}
+
+	return tetragonEvent
+}
+
+func (msg *MsgProcessTlsUnix) Notify() bool {
+	return true
+}
+
+func (msg *MsgProcessTlsUnix) RetryInternal(ev notify.Event, timestamp uint64) (*process.ProcessInternal, error) {
+	return eventcache.HandleGenericInternal(ev, msg.Msg.ProcessKey.Pid, nil, timestamp)
+}
+
+func (msg *MsgProcessTlsUnix) Retry(internal *process.ProcessInternal, ev notify.Event) error {
+	return eventcache.HandleGenericEvent(internal, ev, nil)
+}
+
+func (msg *MsgProcessTlsUnix) HandleMessage() *tetragon.GetEventsResponse {
+	k := GetProcessTls(msg)
+	if k == nil {
+		return nil
+	}
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessTls{ProcessTls: k},
+	}
+}
+
+func (msg *MsgProcessTlsUnix) Cast(o any) notify.Message {
+	t := o.(MsgProcessTlsUnix)
+	return &t
+}
@@ -35,26 +35,42 @@ const (
 )
 
 // +genclient
-// +genclient:noStatus
 // +genclient:nonNamespaced
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:resource:categories={tetragon},singular="tracingpolicy",path="tracingpolicies",scope="Cluster",shortName={tgtp}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Loaded",type=string,JSONPath=`.status.conditions[?(@.type=="Loaded")].status`
+// +kubebuilder:printcolumn:name="Enforcing",type=string,JSONPath=`.status.conditions[?(@.type=="Enforcing")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Loaded")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 type TracingPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
 	// Tracing policy specification.
 	Spec TracingPolicySpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Tracing policy status, as reported by the agents and aggregated by
+	// the operator.
+	Status TracingPolicyStatus `json:"status,omitempty"`
 }
 
 // +genclient
-// +genclient:noStatus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:resource:categories={tetragon},singular="tracingpolicynamespaced",path="tracingpoliciesnamespaced",scope="Namespaced",shortName={tgtpn}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Loaded",type=string,JSONPath=`.status.conditions[?(@.type=="Loaded")].status`
+// +kubebuilder:printcolumn:name="Enforcing",type=string,JSONPath=`.status.conditions[?(@.type=="Enforcing")].status`
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=`.status.conditions[?(@.type=="Loaded")].reason`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 type TracingPolicyNamespaced struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata"`
 	// Tracing policy specification.
 	Spec TracingPolicySpec `json:"spec"`
+	// +kubebuilder:validation:Optional
+	// Tracing policy status, as reported by the agents and aggregated by
+	// the operator.
+	Status TracingPolicyStatus `json:"status,omitempty"`
 }
 
 func (tp *TracingPolicyNamespaced) TpSpec() *TracingPolicySpec {
@@ -73,6 +89,10 @@ func (tp *TracingPolicyNamespaced) TpNamespace() string {
 	return tp.ObjectMeta.Namespace
 }
 
+func (tp *TracingPolicyNamespaced) TpStatus() *TracingPolicyStatus {
+	return &tp.Status
+}
+
 type TracingPolicySpec struct {
 	// +kubebuilder:validation:Optional
 	// A list of kprobe specs.
@@ -92,6 +112,9 @@ type TracingPolicySpec struct {
 	// +kubebuilder:validation:Optional
 	// A list of usdt specs.
 	Usdts []UsdtSpec `json:"usdts,omitempty"`
+	// +kubebuilder:validation:Optional
+	// A list of perf event specs.
+	PerfEvents []PerfEventSpec `json:"perfEvents,omitempty"`
 
 	// +kubebuilder:validation:Optional
 	// PodSelector selects pods that this policy applies to
@@ -104,6 +127,18 @@ type TracingPolicySpec struct {
 	// Currently, only the "name" field is supported.
 	ContainerSelector *slimv1.LabelSelector `json:"containerSelector,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// WorkloadSelector selects pods that this policy applies to by the
+	// workload that controls them. It is combined with PodSelector: pods
+	// must match both.
+	WorkloadSelector *WorkloadSelector `json:"workloadSelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// NodeSelector selects the nodes that this policy is loaded on, based on
+	// the labels of the node. Agents on nodes that do not match skip the
+	// policy and report it as not applicable.
+	NodeSelector *slimv1.LabelSelector `json:"nodeSelector,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	// A list of list specs.
 	Lists []ListSpec `json:"lists,omitempty"`
@@ -122,6 +157,88 @@ type TracingPolicySpec struct {
 	SelectorsMacros map[string]KProbeSelector `json:"selectorsMacros,omitempty"`
 }
 
+const (
+	// TPConditionLoaded is the condition type reporting whether the policy
+	// is loaded on all the nodes that reported a status.
+	TPConditionLoaded = "Loaded"
+	// TPConditionEnforcing is the condition type reporting whether the
+	// policy runs in enforce mode on all the nodes that loaded it.
+	TPConditionEnforcing = "Enforcing"
+)
+
+type TracingPolicyStatus struct {
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=nodeName
+	// Per-node status of the policy. Each agent reports its own entry.
+	Nodes []TracingPolicyNodeStatus `json:"nodes,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=type
+	// Conditions aggregated by the operator from the per-node status.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// The policy generation the conditions were computed for.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+type TracingPolicyNodeStatus struct {
+	// Name of the node reporting the status.
+	NodeName string `json:"nodeName"`
+	// +kubebuilder:validation:Optional
+	// The policy generation loaded on the node.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=unknown;enabled;disabled;load_error;error;loading;unloading;not_applicable
+	// State of the policy on the node.
+	State string `json:"state,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Error that prevented the policy from loading or running on the node.
+	Error string `json:"error,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=unknown;enforce;monitor;monitor_only
+	// Mode of the policy on the node.
+	Mode string `json:"mode,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Kernel memory in bytes used by the policy's BPF maps on the node.
+	KernelMemoryBytes uint64 `json:"kernelMemoryBytes,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Counters of the actions taken by the policy on the node.
+	ActionCounters *TracingPolicyActionCounters `json:"actionCounters,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Last time the node updated its status.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+type TracingPolicyActionCounters struct {
+	// +kubebuilder:validation:Optional
+	// Number of post events generated by the policy.
+	Post uint64 `json:"post,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Number of signals sent by the policy.
+	Signal uint64 `json:"signal,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Number of signals not sent because the policy was in monitor mode.
+	MonitorSignal uint64 `json:"monitorSignal,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Number of return overrides.
+	Override uint64 `json:"override,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Number of return overrides not done because the policy was in monitor mode.
+	MonitorOverride uint64 `json:"monitorOverride,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Number of enforcer notifications triggered by the policy.
+	NotifyEnforcer uint64 `json:"notifyEnforcer,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Number of enforcer notifications not triggered because the policy was in monitor mode.
+	MonitorNotifyEnforcer uint64 `json:"monitorNotifyEnforcer,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Number of events not posted because they were sampled out.
+	SampledOut uint64 `json:"sampledOut,omitempty"`
+}
+
 func (tp *TracingPolicy) TpName() string {
 	return tp.ObjectMeta.Name
 }
@@ -130,6 +247,10 @@ func (tp *TracingPolicy) TpSpec() *TracingPolicySpec {
 	return &tp.Spec
 }
 
+func (tp *TracingPolicy) TpStatus() *TracingPolicyStatus {
+	return &tp.Status
+}
+
 func (tp *TracingPolicy) TpInfo() string {
 	return fmt.Sprintf("%s (object:%d/%s) (type:%s/%s)", tp.ObjectMeta.Name, tp.ObjectMeta.Generation, tp.ObjectMeta.UID, tp.TypeMeta.Kind, tp.TypeMeta.APIVersion)
 }
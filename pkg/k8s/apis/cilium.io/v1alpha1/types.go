@@ -289,6 +289,20 @@ type ActionSelector struct {
 	// Only valid with the post action and with a rateLimit specified.
 	RateLimitScope string `json:"rateLimitScope"`
 	// +kubebuilder:validation:Optional
+	// Post only a sample of the events. Can be specified as "1/N" (or "N")
+	// to post one event out of N, or as a probability in (0, 1] (e.g.
+	// "0.01") to post each event with the given probability. Sampling is
+	// applied after rate limiting, and events that are not posted are
+	// accounted in the sampledOut policy counter. Only valid with the post
+	// action.
+	Sample string `json:"sample"`
+	// +kubebuilder:validation:Optional
+	// The scope of the "1/N" sampling. Can be "global" (default), "process"
+	// or "cgroup". If "process" or "cgroup" is selected, one event out of N
+	// is posted for each process or cgroup respectively. Only valid with the
+	// post action and with a "1/N" sample specified.
+	SampleScope string `json:"sampleScope"`
+	// +kubebuilder:validation:Optional
 	// Enable kernel stack trace export. Only valid with the post action.
 	KernelStackTrace bool `json:"kernelStackTrace"`
 	// +kubebuilder:validation:Optional
@@ -408,6 +422,37 @@ type UsdtSpec struct {
 	Selectors []KProbeSelector `json:"selectors,omitempty"`
 }
 
+type PerfEventSpec struct {
+	// Name of the stack trace tree that the samples are aggregated in. The
+	// tree can be retrieved with the GetStackTraceTree API.
+	Name string `json:"name"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10000
+	// +kubebuilder:default=99
+	// Sampling frequency in Hz, per CPU.
+	Frequency uint64 `json:"frequency,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=all;user;kernel
+	// +kubebuilder:default=all
+	// Stacks to sample: user stacks, kernel stacks, or both.
+	Stacks string `json:"stacks,omitempty"`
+}
+
+// WorkloadSelector selects pods by the workload that controls them, as
+// reported in the workload and workload_kind fields of process events.
+type WorkloadSelector struct {
+	// +kubebuilder:validation:Optional
+	// Kinds of the workloads, for example Deployment, DaemonSet, StatefulSet,
+	// Job or CronJob. Pods without a controller have the Pod kind. Kinds are
+	// case insensitive. An empty list matches all kinds.
+	Kinds []string `json:"kinds,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Names of the workloads. Names can contain the * and ? glob patterns.
+	// An empty list matches all names.
+	Names []string `json:"names,omitempty"`
+}
+
 type LsmHookSpec struct {
 	// Name of the function to apply the kprobe spec to.
 	Hook string `json:"hook"`
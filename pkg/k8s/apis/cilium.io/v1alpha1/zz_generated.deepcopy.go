@@ -9,6 +9,7 @@ package v1alpha1
 
 import (
 	"github.com/cilium/tetragon/pkg/k8s/slim/k8s/apis/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -435,6 +436,21 @@ func (in *PIDSelector) DeepCopy() *PIDSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PerfEventSpec) DeepCopyInto(out *PerfEventSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PerfEventSpec.
+func (in *PerfEventSpec) DeepCopy() *PerfEventSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PerfEventSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodIP) DeepCopyInto(out *PodIP) {
 	*out = *in
@@ -584,6 +600,7 @@ func (in *TracingPolicy) DeepCopyInto(out *TracingPolicy) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingPolicy.
@@ -604,6 +621,21 @@ func (in *TracingPolicy) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingPolicyActionCounters) DeepCopyInto(out *TracingPolicyActionCounters) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingPolicyActionCounters.
+func (in *TracingPolicyActionCounters) DeepCopy() *TracingPolicyActionCounters {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingPolicyActionCounters)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracingPolicyList) DeepCopyInto(out *TracingPolicyList) {
 	*out = *in
@@ -642,6 +674,7 @@ func (in *TracingPolicyNamespaced) DeepCopyInto(out *TracingPolicyNamespaced) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingPolicyNamespaced.
@@ -694,6 +727,27 @@ func (in *TracingPolicyNamespacedList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingPolicyNodeStatus) DeepCopyInto(out *TracingPolicyNodeStatus) {
+	*out = *in
+	if in.ActionCounters != nil {
+		in, out := &in.ActionCounters, &out.ActionCounters
+		*out = new(TracingPolicyActionCounters)
+		**out = **in
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingPolicyNodeStatus.
+func (in *TracingPolicyNodeStatus) DeepCopy() *TracingPolicyNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingPolicyNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracingPolicySpec) DeepCopyInto(out *TracingPolicySpec) {
 	*out = *in
@@ -732,6 +786,11 @@ func (in *TracingPolicySpec) DeepCopyInto(out *TracingPolicySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PerfEvents != nil {
+		in, out := &in.PerfEvents, &out.PerfEvents
+		*out = make([]PerfEventSpec, len(*in))
+		copy(*out, *in)
+	}
 	if in.PodSelector != nil {
 		in, out := &in.PodSelector, &out.PodSelector
 		*out = new(v1.LabelSelector)
@@ -742,6 +801,16 @@ func (in *TracingPolicySpec) DeepCopyInto(out *TracingPolicySpec) {
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.WorkloadSelector != nil {
+		in, out := &in.WorkloadSelector, &out.WorkloadSelector
+		*out = new(WorkloadSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Lists != nil {
 		in, out := &in.Lists, &out.Lists
 		*out = make([]ListSpec, len(*in))
@@ -780,6 +849,35 @@ func (in *TracingPolicySpec) DeepCopy() *TracingPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingPolicyStatus) DeepCopyInto(out *TracingPolicyStatus) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]TracingPolicyNodeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingPolicyStatus.
+func (in *TracingPolicyStatus) DeepCopy() *TracingPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UProbeSpec) DeepCopyInto(out *UProbeSpec) {
 	*out = *in
@@ -888,3 +986,28 @@ func (in *WorkloadObjectMeta) DeepCopy() *WorkloadObjectMeta {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadSelector) DeepCopyInto(out *WorkloadSelector) {
+	*out = *in
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadSelector.
+func (in *WorkloadSelector) DeepCopy() *WorkloadSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadSelector)
+	in.DeepCopyInto(out)
+	return out
+}
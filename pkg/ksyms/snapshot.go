@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package ksyms
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Snapshot holds the symbols of a kallsyms snapshot, for example of another
+// kernel than the running one, with the kernel module of each symbol, if any.
+// Unlike Ksyms, it ignores symbol addresses, so the snapshot can be taken
+// without the privileges to read them.
+type Snapshot map[string]string
+
+// ReadSnapshot reads a kallsyms snapshot.
+func ReadSnapshot(fname string) (Snapshot, error) {
+	file, err := os.Open(fname)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %q file: %w", fname, err)
+	}
+	defer file.Close()
+
+	snap := make(Snapshot)
+	s := bufio.NewScanner(file)
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) < 3 {
+			continue
+		}
+		var kmod string
+		if len(fields) >= 4 {
+			kmod = strings.Trim(fields[3], "[]")
+		}
+		snap[fields[2]] = kmod
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("error while parsing %q: %w", fname, err)
+	}
+	if len(snap) == 0 {
+		return nil, fmt.Errorf("no kernel symbols found in %q", fname)
+	}
+	return snap, nil
+}
+
+// IsAvailable returns whether the snapshot has a symbol.
+func (s Snapshot) IsAvailable(name string) bool {
+	_, ok := s[name]
+	return ok
+}
+
+// GetKmod returns the kernel module of a symbol, and whether the symbol is
+// part of a module.
+func (s Snapshot) GetKmod(name string) (string, bool) {
+	kmod := s[name]
+	return kmod, kmod != ""
+}
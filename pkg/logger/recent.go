@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package logger
+
+import (
+	"bytes"
+	"sync"
+)
+
+// RecentLogsLines is the number of log lines kept in memory, to be returned
+// by RecentLogs.
+const RecentLogsLines = 5000
+
+// recentLogs keeps the last RecentLogsLines lines written to it. The slog
+// handlers write each record in a single Write call, so every write is a line.
+type recentLogs struct {
+	mu    sync.Mutex
+	lines [][]byte
+	next  int
+}
+
+var recentLogsBuffer = &recentLogs{}
+
+func (r *recentLogs) Write(p []byte) (int, error) {
+	line := bytes.Clone(p)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.lines) < RecentLogsLines {
+		r.lines = append(r.lines, line)
+	} else {
+		r.lines[r.next] = line
+	}
+	r.next = (r.next + 1) % RecentLogsLines
+	return len(p), nil
+}
+
+// RecentLogs returns the last RecentLogsLines lines logged through the
+// default logger, oldest first.
+func RecentLogs() []byte {
+	r := recentLogsBuffer
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// until the buffer is full, next is the number of lines, afterwards it is
+	// the index of the oldest line: in both cases, the oldest line is at next
+	// modulo the number of lines.
+	var buf bytes.Buffer
+	for i := range r.lines {
+		buf.Write(r.lines[(r.next+i)%len(r.lines)])
+	}
+	return buf.Bytes()
+}
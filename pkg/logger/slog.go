@@ -5,6 +5,7 @@ package logger
 
 import (
 	"context"
+	"io"
 	"log/slog"
 	"os"
 	"strings"
@@ -66,10 +67,12 @@ func initializeSlog(logOpts LogOptions, useStdout bool) {
 		opts.ReplaceAttr = replaceAttrFn
 	}
 
-	writer := os.Stderr
+	var writer io.Writer = os.Stderr
 	if useStdout {
 		writer = os.Stdout
 	}
+	// keep the recent logs in memory, for bugtool to retrieve them
+	writer = io.MultiWriter(writer, recentLogsBuffer)
 
 	switch logFormat {
 	case logFormatJSON, logFormatJSONTimestamp:
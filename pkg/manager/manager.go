@@ -6,6 +6,7 @@ package manager
 import (
 	"context"
 	"fmt"
+	"maps"
 	"math"
 	"sync"
 	"time"
@@ -130,6 +131,36 @@ func (cm *ControllerManager) GetNode() (*corev1.Node, error) {
 	return &k8sNode, nil
 }
 
+// AddNodeLabelsHandler calls handler with the labels of the node the agent
+// runs on when the node is added to the cache, and every time they change.
+func (cm *ControllerManager) AddNodeLabelsHandler(handler func(labels map[string]string)) error {
+	nodeInformer, err := cm.Manager.GetCache().GetInformer(context.Background(), &corev1.Node{})
+	if err != nil {
+		return err
+	}
+	_, err = nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj any) {
+			if k8sNode, ok := obj.(*corev1.Node); ok {
+				handler(k8sNode.Labels)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj any) {
+			oldNode, ok := oldObj.(*corev1.Node)
+			if !ok {
+				return
+			}
+			newNode, ok := newObj.(*corev1.Node)
+			if !ok {
+				return
+			}
+			if !maps.Equal(oldNode.Labels, newNode.Labels) {
+				handler(newNode.Labels)
+			}
+		},
+	})
+	return err
+}
+
 func (cm *ControllerManager) ListNamespaces() ([]corev1.Namespace, error) {
 	namespaceList := corev1.NamespaceList{}
 	if err := cm.Manager.GetCache().List(context.Background(), &namespaceList, &client.ListOptions{}); err != nil {
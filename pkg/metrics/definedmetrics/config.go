@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package definedmetrics
+
+import (
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+const (
+	TypeCounter   = "counter"
+	TypeHistogram = "histogram"
+
+	// DefaultMaxSeries is the maximum number of combinations of label
+	// values of a metric if its definition does not set one.
+	DefaultMaxSeries = 1000
+	// OverflowLabelValue is the value of the labels of a metric for the
+	// events past its maximum number of series.
+	OverflowLabelValue = "__overflow__"
+)
+
+// Config is the configuration file of metrics defined from events.
+type Config struct {
+	Metrics []Definition `json:"metrics"`
+}
+
+// Definition defines a metric from the events of a type.
+type Definition struct {
+	// Name of the metric, prefixed with tetragon_.
+	Name string `json:"name"`
+	// Help of the metric.
+	Help string `json:"help,omitempty"`
+	// Type of the metric, counter or histogram. Defaults to counter.
+	Type string `json:"type,omitempty"`
+	// EventType is the type of the events counted, for example
+	// PROCESS_NETWORK_FLOW.
+	EventType string `json:"eventType"`
+	// Filter selects the events counted, as export filters do.
+	Filter *tetragon.Filter `json:"filter,omitempty"`
+	// Labels of the metric, in addition to the process labels configured
+	// with the metrics label filter.
+	Labels []Label `json:"labels,omitempty"`
+	// Value is the path of a numeric field of the event. A counter is
+	// increased by its value instead of 1, and a histogram observes it.
+	// Required for histograms.
+	Value string `json:"value,omitempty"`
+	// Buckets of a histogram. Defaults to the Prometheus default buckets.
+	Buckets []float64 `json:"buckets,omitempty"`
+	// MaxSeries is the maximum number of combinations of values of the
+	// labels of the definition. Events with new combinations once it is
+	// reached are counted with OverflowLabelValue for these labels.
+	// Defaults to DefaultMaxSeries.
+	MaxSeries int `json:"maxSeries,omitempty"`
+}
+
+// Label defines a label of a metric from a field of the events.
+type Label struct {
+	Name string `json:"name"`
+	// Field is the path of the field of the event, as in field filters,
+	// for example sock.dport.
+	Field string `json:"field"`
+}
+
+// ReadConfig reads a configuration file of metrics defined from events.
+func ReadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseConfig(data)
+}
+
+// ParseConfig parses a configuration of metrics defined from events, in YAML
+// or JSON.
+func ParseConfig(data []byte) (*Config, error) {
+	var config Config
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse metrics definitions: %w", err)
+	}
+	return &config, nil
+}
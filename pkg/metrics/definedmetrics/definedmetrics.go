@@ -0,0 +1,264 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package definedmetrics implements Prometheus metrics defined from the fields
+// of events in a configuration file, in addition to the metrics hard-coded in
+// the other metrics packages.
+package definedmetrics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/event"
+	"github.com/cilium/tetragon/pkg/fieldfilters"
+	"github.com/cilium/tetragon/pkg/filters"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/metrics"
+	"github.com/cilium/tetragon/pkg/metrics/consts"
+	"github.com/cilium/tetragon/pkg/option"
+)
+
+// highCardinalityFields are the names of the fields that have a value per
+// process or per event. They are rejected as labels, since each value would
+// create a new series.
+var highCardinalityFields = map[string]struct{}{
+	"exec_id":        {},
+	"parent_exec_id": {},
+	"pid":            {},
+	"tid":            {},
+	"start_time":     {},
+	"time":           {},
+	"ktime":          {},
+}
+
+// unboundedFields are the names of the fields whose values are usually not
+// bounded. They are accepted as labels with a warning.
+var unboundedFields = map[string]struct{}{
+	"arguments":             {},
+	"cwd":                   {},
+	"args":                  {},
+	"environment_variables": {},
+	"pod_labels":            {},
+}
+
+// Metric is a metric defined from events.
+type Metric struct {
+	name      string
+	filter    filters.FilterFuncs
+	labels    []fieldfilters.FieldPath
+	value     *fieldfilters.FieldPath
+	counter   *metrics.GranularCounter[metrics.ProcessLabels]
+	histogram *metrics.GranularHistogram[metrics.ProcessLabels]
+
+	maxSeries int
+	// seriesMu protects series, the combinations of values of labels that
+	// have a series.
+	seriesMu sync.Mutex
+	series   map[string]struct{}
+}
+
+// NewMetric returns the metric of a definition.
+func NewMetric(ctx context.Context, def *Definition) (*Metric, error) {
+	if def.Name == "" {
+		return nil, errors.New("metric name is required")
+	}
+	m := &Metric{name: def.Name}
+
+	eventType, ok := tetragon.EventType_value[def.EventType]
+	if !ok || tetragon.EventType(eventType) == tetragon.EventType_UNDEF {
+		return nil, fmt.Errorf("metric %s: invalid event type %q", def.Name, def.EventType)
+	}
+	filter := &tetragon.Filter{}
+	if def.Filter != nil {
+		if len(def.Filter.EventSet) > 0 {
+			return nil, fmt.Errorf("metric %s: filter must not have an event set, use eventType instead", def.Name)
+		}
+		filter = proto.Clone(def.Filter).(*tetragon.Filter)
+	}
+	filter.EventSet = []tetragon.EventType{tetragon.EventType(eventType)}
+	var err error
+	m.filter, err = filters.BuildFilterList(ctx, []*tetragon.Filter{filter}, filters.Filters)
+	if err != nil {
+		return nil, fmt.Errorf("metric %s: invalid filter: %w", def.Name, err)
+	}
+
+	unconstrained := make([]metrics.UnconstrainedLabel, 0, len(def.Labels))
+	for _, label := range def.Labels {
+		fp, err := fieldfilters.ParseFieldPath(label.Field)
+		if err != nil {
+			return nil, fmt.Errorf("metric %s: label %s: %w", def.Name, label.Name, err)
+		}
+		field := label.Field[strings.LastIndexByte(label.Field, '.')+1:]
+		if _, ok := highCardinalityFields[field]; ok {
+			return nil, fmt.Errorf("metric %s: label %s: field %s has a value per process or event and cannot be a label", def.Name, label.Name, label.Field)
+		}
+		if _, ok := unboundedFields[field]; ok {
+			logger.GetLogger().Warn("Label of defined metric has unbounded values, the metric may reach its maximum number of series",
+				"metric", def.Name, "label", label.Name, "field", label.Field)
+		}
+		m.labels = append(m.labels, fp)
+		unconstrained = append(unconstrained, metrics.UnconstrainedLabel{Name: label.Name, ExampleValue: "example"})
+	}
+	if def.Value != "" {
+		fp, err := fieldfilters.ParseFieldPath(def.Value)
+		if err != nil {
+			return nil, fmt.Errorf("metric %s: value: %w", def.Name, err)
+		}
+		m.value = &fp
+	}
+
+	if def.MaxSeries < 0 {
+		return nil, fmt.Errorf("metric %s: maxSeries must not be negative", def.Name)
+	}
+	m.maxSeries = def.MaxSeries
+	if m.maxSeries == 0 {
+		m.maxSeries = DefaultMaxSeries
+	}
+	m.series = make(map[string]struct{})
+
+	opts := metrics.NewOpts(consts.MetricsNamespace, "", def.Name, def.Help, nil, nil, unconstrained)
+	if opts.Help == "" {
+		opts.Help = fmt.Sprintf("Metric defined from %s events.", def.EventType)
+	}
+	switch def.Type {
+	case "", TypeCounter:
+		if len(def.Buckets) > 0 {
+			return nil, fmt.Errorf("metric %s: buckets are only supported by histograms", def.Name)
+		}
+		m.counter, err = metrics.NewGranularCounter[metrics.ProcessLabels](opts, nil)
+	case TypeHistogram:
+		if m.value == nil {
+			return nil, fmt.Errorf("metric %s: value is required for histograms", def.Name)
+		}
+		buckets := def.Buckets
+		if len(buckets) == 0 {
+			buckets = prometheus.DefBuckets
+		}
+		if !slices.IsSorted(buckets) {
+			return nil, fmt.Errorf("metric %s: buckets must be sorted", def.Name)
+		}
+		m.histogram, err = metrics.NewGranularHistogram[metrics.ProcessLabels](metrics.HistogramOpts{Opts: opts, Buckets: buckets}, nil)
+	default:
+		return nil, fmt.Errorf("metric %s: invalid type %q, expected %s or %s", def.Name, def.Type, TypeCounter, TypeHistogram)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("metric %s: %w", def.Name, err)
+	}
+	return m, nil
+}
+
+// Collector returns the collector of the metric, to be registered.
+func (m *Metric) Collector() prometheus.Collector {
+	if m.histogram != nil {
+		return m.histogram
+	}
+	return m.counter
+}
+
+// Handle updates the metric with an event, if it matches the metric's event
+// type and filter.
+func (m *Metric) Handle(ev *tetragon.GetEventsResponse) {
+	if !m.filter.MatchOne(&event.Event{Event: ev}) {
+		return
+	}
+	value := 1.0
+	if m.value != nil {
+		var ok bool
+		if value, ok = m.value.Float(ev); !ok {
+			return
+		}
+	}
+	lvs := make([]string, len(m.labels))
+	for i, fp := range m.labels {
+		lvs[i], _ = fp.Value(ev)
+	}
+	m.limitSeries(lvs)
+	var namespace, workload, pod, binary string
+	if process := filters.GetProcess(&event.Event{Event: ev}); process != nil {
+		binary = process.Binary
+		if process.Pod != nil {
+			namespace, workload, pod = process.Pod.Namespace, process.Pod.Workload, process.Pod.Name
+		}
+	}
+	processLabels := option.CreateProcessLabels(namespace, workload, pod, binary, ev.NodeName)
+
+	if m.histogram != nil {
+		m.histogram.WithLabelValues(processLabels, lvs...).Observe(value)
+		return
+	}
+	// Counters cannot decrease.
+	if value < 0 {
+		return
+	}
+	m.counter.WithLabelValues(processLabels, lvs...).Add(value)
+}
+
+// limitSeries replaces the label values with OverflowLabelValue if they are a
+// new combination and the metric reached its maximum number of series.
+func (m *Metric) limitSeries(lvs []string) {
+	if len(lvs) == 0 {
+		return
+	}
+	key := strings.Join(lvs, "\x00")
+	m.seriesMu.Lock()
+	defer m.seriesMu.Unlock()
+	if _, ok := m.series[key]; ok {
+		return
+	}
+	if len(m.series) >= m.maxSeries {
+		for i := range lvs {
+			lvs[i] = OverflowLabelValue
+		}
+		return
+	}
+	m.series[key] = struct{}{}
+}
+
+// definedMetrics holds the metrics registered by InitMetrics.
+var definedMetrics atomic.Pointer[[]*Metric]
+
+// InitMetrics registers the metrics defined in a configuration file.
+func InitMetrics(ctx context.Context, registry prometheus.Registerer, path string) error {
+	config, err := ReadConfig(path)
+	if err != nil {
+		return err
+	}
+	ms := make([]*Metric, 0, len(config.Metrics))
+	for i := range config.Metrics {
+		m, err := NewMetric(ctx, &config.Metrics[i])
+		if err != nil {
+			return err
+		}
+		if err := registry.Register(m.Collector()); err != nil {
+			return fmt.Errorf("failed to register metric %s: %w", m.name, err)
+		}
+		ms = append(ms, m)
+	}
+	definedMetrics.Store(&ms)
+	return nil
+}
+
+// Handle updates the metrics registered by InitMetrics with an event.
+func Handle(processedEvent any) {
+	ms := definedMetrics.Load()
+	if ms == nil {
+		return
+	}
+	ev, ok := processedEvent.(*tetragon.GetEventsResponse)
+	if !ok {
+		return
+	}
+	for _, m := range *ms {
+		m.Handle(ev)
+	}
+}
@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package definedmetrics
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+const testConfig = `
+metrics:
+- name: network_flow_connections_total
+  help: Connections per destination port.
+  eventType: PROCESS_NETWORK_FLOW
+  filter:
+    cel_expression:
+    - "process_network_flow.end"
+  labels:
+  - name: dport
+    field: sock.dport
+- name: network_flow_bytes_sent
+  help: Bytes sent per connection.
+  type: histogram
+  eventType: PROCESS_NETWORK_FLOW
+  value: bytes_sent
+  buckets: [100, 1000]
+`
+
+func flowEvent(binary string, dport uint32, bytesSent uint64, end bool) *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessNetworkFlow{ProcessNetworkFlow: &tetragon.ProcessNetworkFlow{
+			Process: &tetragon.Process{
+				Binary: binary,
+				Pod:    &tetragon.Pod{Namespace: "default", Name: "xwing", Workload: "xwing"},
+			},
+			Sock:      &tetragon.KprobeSock{Dport: dport},
+			BytesSent: bytesSent,
+			End:       end,
+		}},
+		NodeName: "node",
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	config, err := ParseConfig([]byte(testConfig))
+	require.NoError(t, err)
+	require.Len(t, config.Metrics, 2)
+
+	var ms []*Metric
+	for i := range config.Metrics {
+		m, err := NewMetric(t.Context(), &config.Metrics[i])
+		require.NoError(t, err)
+		ms = append(ms, m)
+	}
+
+	events := []*tetragon.GetEventsResponse{
+		flowEvent("/usr/bin/curl", 443, 50, true),
+		flowEvent("/usr/bin/curl", 443, 500, true),
+		flowEvent("/usr/bin/curl", 80, 5000, true),
+		// Not counted by the counter since the connection is open.
+		flowEvent("/usr/bin/curl", 80, 5000, false),
+		{
+			Event: &tetragon.GetEventsResponse_ProcessExec{ProcessExec: &tetragon.ProcessExec{
+				Process: &tetragon.Process{Binary: "/usr/bin/curl"},
+			}},
+		},
+	}
+	for _, ev := range events {
+		for _, m := range ms {
+			m.Handle(ev)
+		}
+	}
+
+	expected := strings.NewReader(`# HELP tetragon_network_flow_connections_total Connections per destination port.
+# TYPE tetragon_network_flow_connections_total counter
+tetragon_network_flow_connections_total{binary="/usr/bin/curl",dport="443",namespace="default",node_name="node",pod="xwing",workload="xwing"} 2
+tetragon_network_flow_connections_total{binary="/usr/bin/curl",dport="80",namespace="default",node_name="node",pod="xwing",workload="xwing"} 1
+`)
+	require.NoError(t, testutil.CollectAndCompare(ms[0].Collector(), expected))
+
+	expected = strings.NewReader(`# HELP tetragon_network_flow_bytes_sent Bytes sent per connection.
+# TYPE tetragon_network_flow_bytes_sent histogram
+tetragon_network_flow_bytes_sent_bucket{binary="/usr/bin/curl",namespace="default",node_name="node",pod="xwing",workload="xwing",le="100"} 1
+tetragon_network_flow_bytes_sent_bucket{binary="/usr/bin/curl",namespace="default",node_name="node",pod="xwing",workload="xwing",le="1000"} 2
+tetragon_network_flow_bytes_sent_bucket{binary="/usr/bin/curl",namespace="default",node_name="node",pod="xwing",workload="xwing",le="+Inf"} 4
+tetragon_network_flow_bytes_sent_sum{binary="/usr/bin/curl",namespace="default",node_name="node",pod="xwing",workload="xwing"} 10550
+tetragon_network_flow_bytes_sent_count{binary="/usr/bin/curl",namespace="default",node_name="node",pod="xwing",workload="xwing"} 4
+`)
+	require.NoError(t, testutil.CollectAndCompare(ms[1].Collector(), expected))
+}
+
+func TestInvalidDefinitions(t *testing.T) {
+	tests := map[string]Definition{
+		"no name":          {EventType: "PROCESS_EXEC"},
+		"bad event type":   {Name: "m", EventType: "PROCESS_UNKNOWN"},
+		"bad label field":  {Name: "m", EventType: "PROCESS_EXEC", Labels: []Label{{Name: "l", Field: "process.unknown"}}},
+		"process label":    {Name: "m", EventType: "PROCESS_EXEC", Labels: []Label{{Name: "pod", Field: "process.pod.name"}}},
+		"histogram value":  {Name: "m", EventType: "PROCESS_EXEC", Type: TypeHistogram},
+		"unsorted buckets": {Name: "m", EventType: "PROCESS_EXEC", Type: TypeHistogram, Value: "process.uid", Buckets: []float64{2, 1}},
+		"bad type":         {Name: "m", EventType: "PROCESS_EXEC", Type: "gauge"},
+		"exec id label":    {Name: "m", EventType: "PROCESS_EXEC", Labels: []Label{{Name: "l", Field: "process.exec_id"}}},
+		"pid label":        {Name: "m", EventType: "PROCESS_EXEC", Labels: []Label{{Name: "l", Field: "process.pid"}}},
+		"max series":       {Name: "m", EventType: "PROCESS_EXEC", MaxSeries: -1},
+		"event set": {Name: "m", EventType: "PROCESS_EXEC", Filter: &tetragon.Filter{
+			EventSet: []tetragon.EventType{tetragon.EventType_PROCESS_EXIT},
+		}},
+	}
+	for name, def := range tests {
+		_, err := NewMetric(t.Context(), &def)
+		require.Error(t, err, name)
+	}
+
+	_, err := ParseConfig([]byte("metrics:\n- name: m\n  eventTypes: [PROCESS_EXEC]\n"))
+	require.Error(t, err)
+}
+
+func TestInitMetricsDuplicate(t *testing.T) {
+	path := t.TempDir() + "/metrics.yaml"
+	config := "metrics:\n- name: m_total\n  eventType: PROCESS_EXEC\n- name: m_total\n  eventType: PROCESS_EXIT\n"
+	require.NoError(t, os.WriteFile(path, []byte(config), 0o600))
+	err := InitMetrics(t.Context(), prometheus.NewRegistry(), path)
+	require.Error(t, err)
+}
+
+func TestMaxSeries(t *testing.T) {
+	m, err := NewMetric(t.Context(), &Definition{
+		Name:      "connections_total",
+		EventType: "PROCESS_NETWORK_FLOW",
+		Labels:    []Label{{Name: "dport", Field: "sock.dport"}},
+		MaxSeries: 2,
+	})
+	require.NoError(t, err)
+
+	for _, dport := range []uint32{80, 443, 8080, 80, 9090} {
+		m.Handle(flowEvent("/usr/bin/curl", dport, 0, true))
+	}
+
+	expected := strings.NewReader(`# HELP tetragon_connections_total Metric defined from PROCESS_NETWORK_FLOW events.
+# TYPE tetragon_connections_total counter
+tetragon_connections_total{binary="/usr/bin/curl",dport="443",namespace="default",node_name="node",pod="xwing",workload="xwing"} 1
+tetragon_connections_total{binary="/usr/bin/curl",dport="80",namespace="default",node_name="node",pod="xwing",workload="xwing"} 2
+tetragon_connections_total{binary="/usr/bin/curl",dport="__overflow__",namespace="default",node_name="node",pod="xwing",workload="xwing"} 2
+`)
+	require.NoError(t, testutil.CollectAndCompare(m.Collector(), expected))
+}
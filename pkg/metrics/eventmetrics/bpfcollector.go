@@ -19,6 +19,7 @@ func NewBPFCollector() metrics.CollectorWithInit {
 	return metrics.NewCustomCollector(
 		metrics.CustomMetrics{
 			MissedEvents,
+			PriorityMissedEvents,
 		},
 		collect,
 		collectForDocs,
@@ -45,6 +46,9 @@ func collect(ch chan<- prometheus.Metric) {
 				sum.SentFailed[opcode][er] += count
 			}
 		}
+		for prio, count := range val.SentFailedPrio {
+			sum.SentFailedPrio[prio] += count
+		}
 	}
 
 	for opcode, errors := range sum.SentFailed {
@@ -54,6 +58,12 @@ func collect(ch chan<- prometheus.Metric) {
 			}
 		}
 	}
+
+	for prio, count := range sum.SentFailedPrio {
+		if count > 0 {
+			ch <- PriorityMissedEvents.MustMetric(float64(count), priorities[prio])
+		}
+	}
 }
 
 func collectForDocs(ch chan<- prometheus.Metric) {
@@ -62,4 +72,7 @@ func collectForDocs(ch chan<- prometheus.Metric) {
 			ch <- MissedEvents.MustMetric(0, opcode, er)
 		}
 	}
+	for _, prio := range priorityLabel.Values {
+		ch <- PriorityMissedEvents.MustMetric(0, prio)
+	}
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/cilium/tetragon/pkg/logger/logfields"
 	"github.com/cilium/tetragon/pkg/metrics"
 	"github.com/cilium/tetragon/pkg/metrics/consts"
+	"github.com/cilium/tetragon/pkg/metrics/definedmetrics"
 	"github.com/cilium/tetragon/pkg/metrics/syscallmetrics"
 	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/reader/exec"
@@ -38,6 +39,14 @@ var (
 		Name:   "error",
 		Values: slices.Collect(maps.Values(perfEventErrors)),
 	}
+	priorities = map[int]string{
+		processapi.PriorityNormal: "normal",
+		processapi.PriorityHigh:   "high",
+	}
+	priorityLabel = metrics.ConstrainedLabel{
+		Name:   "priority",
+		Values: slices.Collect(maps.Values(priorities)),
+	}
 )
 
 var (
@@ -60,6 +69,11 @@ var (
 		"Number of Tetragon perf events that are failed to be sent from the kernel.",
 		nil, []metrics.ConstrainedLabel{metrics.OpCodeLabel, perfEventErrorLabel}, nil,
 	))
+	PriorityMissedEvents = metrics.MustNewCustomCounter(metrics.NewOpts(
+		consts.MetricsNamespace, "bpf", "priority_missed_events_total",
+		"Number of Tetragon events that failed to be sent from the kernel, per policy priority class.",
+		nil, []metrics.ConstrainedLabel{priorityLabel}, nil,
+	))
 	FlagCount = metrics.MustNewCounter(
 		metrics.NewOpts(
 			consts.MetricsNamespace, "", "flags_total",
@@ -182,4 +196,5 @@ func ProcessEvent(originalEvent any, processedEvent any) {
 
 	handleProcessedEvent(&policyInfo, processedEvent)
 	syscallmetrics.Handle(processedEvent)
+	definedmetrics.Handle(processedEvent)
 }
@@ -25,6 +25,7 @@ var stateLabel = metrics.ConstrainedLabel{
 		strings.TrimPrefix(strings.ToLower(tetragon.TracingPolicyState_TP_STATE_ERROR.String()), "tp_state_"),
 		strings.TrimPrefix(strings.ToLower(tetragon.TracingPolicyState_TP_STATE_DISABLED.String()), "tp_state_"),
 		strings.TrimPrefix(strings.ToLower(tetragon.TracingPolicyState_TP_STATE_ENABLED.String()), "tp_state_"),
+		strings.TrimPrefix(strings.ToLower(tetragon.TracingPolicyState_TP_STATE_NOT_APPLICABLE.String()), "tp_state_"),
 	},
 }
 
@@ -94,6 +95,10 @@ func collect(ch chan<- prometheus.Metric) {
 		float64(counters[tetragon.TracingPolicyState_TP_STATE_ENABLED]),
 		strings.TrimPrefix(strings.ToLower(tetragon.TracingPolicyState_TP_STATE_ENABLED.String()), "tp_state_"),
 	)
+	ch <- policyState.MustMetric(
+		float64(counters[tetragon.TracingPolicyState_TP_STATE_NOT_APPLICABLE]),
+		strings.TrimPrefix(strings.ToLower(tetragon.TracingPolicyState_TP_STATE_NOT_APPLICABLE.String()), "tp_state_"),
+	)
 }
 
 func collectForDocs(ch chan<- prometheus.Metric) {
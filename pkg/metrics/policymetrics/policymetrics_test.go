@@ -21,7 +21,7 @@ import (
 )
 
 func Test_policyStatusCollector_Collect(t *testing.T) {
-	expectedMetrics := func(disabled, enabled, err, load_error int) io.Reader {
+	expectedMetrics := func(disabled, enabled, err, load_error, not_applicable int) io.Reader {
 		return strings.NewReader(fmt.Sprintf(`# HELP tetragon_tracingpolicy_kernel_memory_bytes The amount of kernel memory in bytes used by policy's sensors non-shared BPF maps (memlock).
 # TYPE tetragon_tracingpolicy_kernel_memory_bytes gauge
 tetragon_tracingpolicy_kernel_memory_bytes{policy="pizza", policy_namespace=""} 0
@@ -34,7 +34,8 @@ tetragon_tracingpolicy_loaded{state="disabled"} %d
 tetragon_tracingpolicy_loaded{state="enabled"} %d
 tetragon_tracingpolicy_loaded{state="error"} %d
 tetragon_tracingpolicy_loaded{state="load_error"} %d
-`, disabled, enabled, err, load_error))
+tetragon_tracingpolicy_loaded{state="not_applicable"} %d
+`, disabled, enabled, err, load_error, not_applicable))
 	}
 
 	reg := prometheus.NewRegistry()
@@ -77,11 +78,11 @@ tetragon_tracingpolicy_loaded{state="load_error"} %d
 		},
 	})
 	require.NoError(t, err)
-	err = testutil.CollectAndCompare(collector, expectedMetrics(0, 4, 0, 0))
+	err = testutil.CollectAndCompare(collector, expectedMetrics(0, 4, 0, 0, 0))
 	require.NoError(t, err)
 
 	err = manager.DisableTracingPolicy(context.TODO(), "pizza", "")
 	require.NoError(t, err)
-	err = testutil.CollectAndCompare(collector, expectedMetrics(1, 3, 0, 0))
+	err = testutil.CollectAndCompare(collector, expectedMetrics(1, 3, 0, 0, 0))
 	require.NoError(t, err)
 }
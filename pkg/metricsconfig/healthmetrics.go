@@ -9,9 +9,11 @@ import (
 	grpcmetrics "github.com/grpc-ecosystem/go-grpc-middleware/providers/prometheus"
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/cilium/tetragon/pkg/aggregator"
 	"github.com/cilium/tetragon/pkg/errmetrics"
 	"github.com/cilium/tetragon/pkg/eventcache"
 	"github.com/cilium/tetragon/pkg/exporter"
+	"github.com/cilium/tetragon/pkg/exporter/otlp"
 	"github.com/cilium/tetragon/pkg/grpc/tracing"
 	"github.com/cilium/tetragon/pkg/metrics"
 	"github.com/cilium/tetragon/pkg/metrics/cgroupratemetrics"
@@ -26,6 +28,7 @@ import (
 	"github.com/cilium/tetragon/pkg/metrics/watchermetrics"
 	"github.com/cilium/tetragon/pkg/observer"
 	"github.com/cilium/tetragon/pkg/process"
+	"github.com/cilium/tetragon/pkg/replay"
 	"github.com/cilium/tetragon/pkg/version"
 )
 
@@ -84,8 +87,14 @@ func registerHealthMetrics(group metrics.Group) {
 	// tracing metrics
 	tracing.RegisterMetrics(group)
 	group.ExtendInit(tracing.InitMetrics)
+	// aggregator metrics
+	aggregator.RegisterMetrics(group)
 	// exporter metrics
 	exporter.RegisterMetrics(group)
+	otlp.RegisterMetrics(group)
+	group.ExtendInit(otlp.InitMetrics)
+	// event replay buffer metrics
+	replay.RegisterMetrics(group)
 	// cgrup rate metrics
 	cgroupratemetrics.RegisterMetrics(group)
 
@@ -69,7 +69,9 @@ func DataGet(desc dataapi.DataEventDesc) ([]byte, error) {
 	dataCache.remove(desc)
 
 	// make sure we did not loose anything on the way through ring buffer
-	if len(data) != int(desc.Size-desc.Leftover) {
+	// desc.Size is the size of the data that was sent, desc.Leftover is what
+	// did not fit in the data events.
+	if len(data) != int(desc.Size) {
 		DataEventMetricInc(DataEventBad)
 		DataEventMetricSizeBad(desc.Size)
 		return nil, fmt.Errorf("failed to get correct data for id: %v", desc.Id)
@@ -19,6 +19,10 @@ var (
 		Name:   "operation",
 		Values: []string{"get", "remove"},
 	}
+	priorityLabel = metrics.ConstrainedLabel{
+		Name:   "priority",
+		Values: []string{"normal", "high"},
+	}
 )
 
 var (
@@ -62,6 +66,17 @@ var (
 		ConstLabels: nil,
 	})
 
+	ringbufPriorityReceived = metrics.MustNewCounter(metrics.NewOpts(
+		consts.MetricsNamespace, subsystem, "ringbuf_priority_events_received_total",
+		"Number of events received from the BPF ring buffer of each policy priority class.",
+		nil, []metrics.ConstrainedLabel{priorityLabel}, nil,
+	), nil)
+	queuePriorityLost = metrics.MustNewCounter(metrics.NewOpts(
+		consts.MetricsNamespace, subsystem, "ringbuf_priority_queue_events_lost_total",
+		"Number of events of each policy priority class lost because the events queue was full.",
+		nil, []metrics.ConstrainedLabel{priorityLabel}, nil,
+	), nil)
+
 	dataCacheTotal = prometheus.NewGauge(prometheus.GaugeOpts{
 		Namespace:   consts.MetricsNamespace,
 		Name:        "data_cache_size",
@@ -108,6 +123,8 @@ func RegisterHealthMetrics(group metrics.Group) {
 	group.MustRegister(RingbufErrors)
 	group.MustRegister(queueReceived)
 	group.MustRegister(queueLost)
+	group.MustRegister(ringbufPriorityReceived)
+	group.MustRegister(queuePriorityLost)
 	group.MustRegister(
 		dataCacheTotal,
 		dataCacheEvictions,
@@ -157,9 +157,10 @@ func (k *Observer) getRBQueueSize() int {
 // notified of their corresponding events.
 type Observer struct {
 	/* Configuration */
-	listeners      map[Listener]struct{}
-	PerfConfig     *bpf.PerfEventConfig
-	RingBufMapPath string
+	listeners          map[Listener]struct{}
+	PerfConfig         *bpf.PerfEventConfig
+	RingBufMapPath     string
+	RingBufHighMapPath string
 	/* Statistics */
 	lostCntr   prometheus.Counter
 	errorCntr  prometheus.Counter
@@ -201,6 +202,7 @@ func (k *Observer) Start(ctx context.Context) error {
 func (k *Observer) StartReady(ctx context.Context, ready func()) error {
 	k.PerfConfig = bpf.DefaultPerfEventConfig()
 	k.RingBufMapPath = filepath.Join(bpf.MapPrefixPath(), bpf.RingBufEventsMapName)
+	k.RingBufHighMapPath = filepath.Join(bpf.MapPrefixPath(), bpf.RingBufEventsHighMapName)
 
 	var err error
 	if err = k.RunEvents(ctx, ready); err != nil {
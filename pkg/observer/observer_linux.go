@@ -5,6 +5,7 @@ package observer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 	"os"
@@ -92,6 +93,24 @@ func (k *Observer) RunEvents(stopCtx context.Context, ready func()) error {
 		}
 	}
 
+	// Events of high priority policies have their own BPF ring buffer and
+	// events queue, so that they are not lost when the default ones are full.
+	var ringBufHighReader *ringbuf.Reader
+	var highEventsQueue chan *[]byte
+	if config.EnableHighPriorityRingBuf() {
+		ringBufHighMap, err := ebpf.LoadPinnedMap(k.RingBufHighMapPath, &pinOpts)
+		if err != nil {
+			return fmt.Errorf("opening pinned map '%s' failed: %w", k.RingBufHighMapPath, err)
+		}
+		defer ringBufHighMap.Close()
+
+		ringBufHighReader, err = ringbuf.NewReader(ringBufHighMap)
+		if err != nil {
+			return fmt.Errorf("creating high priority ring buffer reader failed: %w", err)
+		}
+		highEventsQueue = make(chan *[]byte, k.getRBQueueSize())
+	}
+
 	// Inform caller that we're about to start processing events.
 	k.observerListeners(&readyapi.MsgTetragonReady{})
 	ready()
@@ -140,34 +159,32 @@ func (k *Observer) RunEvents(stopCtx context.Context, ready func()) error {
 	if config.EnableV511Progs() && !option.Config.UsePerfRingBuffer {
 		// Service the BPF ring buffer as well.
 		wg.Go(func() {
-			for stopCtx.Err() == nil {
-				record, err := ringBufReader.Read()
-				if err != nil {
-					// NOTE(JM and Djalal): count and log errors while excluding the stopping context
-					if stopCtx.Err() == nil {
-						RingbufErrors.Inc()
-						errorCnt := getCounterValue(RingbufErrors)
-						k.log.Warn("Reading bpf events from BPF ring buffer failed", "errors", errorCnt, logfields.Error, err)
-					}
-				} else {
-					if len(record.RawSample) > 0 {
-						select {
-						case eventsQueue <- &record.RawSample:
-						default:
-							// eventsQueue channel is full, drop the event
-							queueLost.Inc()
-						}
-						RingbufReceived.Inc()
-					}
-				}
-			}
+			k.readRingBuf(stopCtx, ringBufReader, eventsQueue, "normal")
+		})
+	}
+
+	if ringBufHighReader != nil {
+		wg.Go(func() {
+			k.readRingBuf(stopCtx, ringBufHighReader, highEventsQueue, "high")
 		})
 	}
 
 	// Start processing records from perf.
 	wg.Go(func() {
 		for {
+			// Process pending high priority events first.
 			select {
+			case eventRawSample := <-highEventsQueue:
+				k.receiveEvent(*eventRawSample)
+				queueReceived.Inc()
+				continue
+			default:
+			}
+
+			select {
+			case eventRawSample := <-highEventsQueue:
+				k.receiveEvent(*eventRawSample)
+				queueReceived.Inc()
 			case eventRawSample := <-eventsQueue:
 				k.receiveEvent(*eventRawSample)
 				queueReceived.Inc()
@@ -192,8 +209,41 @@ func (k *Observer) RunEvents(stopCtx context.Context, ready func()) error {
 	if config.EnableV511Progs() && !option.Config.UsePerfRingBuffer {
 		errRingBufRdr = ringBufReader.Close()
 	}
+	if ringBufHighReader != nil {
+		errRingBufRdr = errors.Join(errRingBufRdr, ringBufHighReader.Close())
+	}
 	if err != nil {
 		return err
 	}
 	return errRingBufRdr
 }
+
+// readRingBuf reads the records of a BPF ring buffer into queue until the
+// reader is closed. priority is the policy priority class of the ring buffer.
+func (k *Observer) readRingBuf(stopCtx context.Context, reader *ringbuf.Reader, queue chan *[]byte, priority string) {
+	received := ringbufPriorityReceived.WithLabelValues(priority)
+	lost := queuePriorityLost.WithLabelValues(priority)
+	for stopCtx.Err() == nil {
+		record, err := reader.Read()
+		if err != nil {
+			// NOTE(JM and Djalal): count and log errors while excluding the stopping context
+			if stopCtx.Err() == nil {
+				RingbufErrors.Inc()
+				errorCnt := getCounterValue(RingbufErrors)
+				k.log.Warn("Reading bpf events from BPF ring buffer failed", "priority", priority, "errors", errorCnt, logfields.Error, err)
+			}
+		} else {
+			if len(record.RawSample) > 0 {
+				select {
+				case queue <- &record.RawSample:
+				default:
+					// queue channel is full, drop the event
+					queueLost.Inc()
+					lost.Inc()
+				}
+				RingbufReceived.Inc()
+				received.Inc()
+			}
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"time"
 
 	"github.com/spf13/viper"
@@ -40,10 +41,20 @@ type config struct {
 	EnableProcessUprobeAncestors      bool
 	EnableProcessLsmAncestors         bool
 	EnableProcessUsdtAncestors        bool
+	EnableProcessNetworkFlowAncestors bool
+	EnableProcessDnsAncestors         bool
+	EnableProcessTlsAncestors         bool
 	EnableProcessEnvironmentVariables bool
 
 	FilterEnvironmentVariables map[string]struct{}
 
+	ProcessArgsMaxSize     int
+	ProcessEnvsMaxSize     int
+	ProcessCwdMaxSize      int
+	ProcessArgsFullCapture bool
+
+	ProcessArgsFullCaptureBinaries []*regexp.Regexp
+
 	EnableProcessNs      bool
 	EnableProcessCred    bool
 	EnableK8s            bool
@@ -63,6 +74,7 @@ type config struct {
 	UsePerfRingBuffer bool
 	RBSize            int
 	RBSizeTotal       int
+	RBSizeHigh        int
 	RBQueueSize       int
 
 	ProcessCacheSize       int
@@ -71,10 +83,16 @@ type config struct {
 
 	MetricsServer      string
 	MetricsLabelFilter metrics.LabelFilter
+	EventMetricsFile   string
 	ServerAddress      string
 	TracingPolicy      string
 	TracingPolicyDir   string
 
+	TracingPolicyVerifyKeys       []string
+	TracingPolicyVerifyTrustRoot  string
+	TracingPolicyVerifyTlogKeys   []string
+	TracingPolicyVerifyIdentities []string
+
 	ExportFilename             string
 	ExportFileMaxSizeMB        int
 	ExportFileRotationInterval time.Duration
@@ -82,11 +100,31 @@ type config struct {
 	ExportFileCompress         bool
 	ExportRateLimit            int
 	ExportFilePerm             string
+	ExportFileIntegrity        bool
+	ExportFileIntegrityKeyFile string
 
 	// Export aggregation options
 	EnableExportAggregation     bool
 	ExportAggregationWindowSize time.Duration
 	ExportAggregationBufferSize uint64
+	ExportAggregationGroupBy    []string
+	ExportAggregationDistinct   []string
+	ExportAggregationMaxGroups  uint32
+	ExportAggregationMaxValues  uint32
+
+	EventReplayDir       string
+	EventReplayMaxSizeMB int
+	EventReplayMaxAge    time.Duration
+
+	ExportOTLPEndpoint     string
+	ExportOTLPProtocol     string
+	ExportOTLPInsecure     bool
+	ExportOTLPHeaders      string
+	ExportOTLPTimeout      time.Duration
+	ExportOTLPBatchSize    int
+	ExportOTLPBatchTimeout time.Duration
+	ExportOTLPQueueSize    int
+	ExportOTLPMaxRetries   int
 
 	CpuProfile string
 	MemProfile string
@@ -104,8 +142,9 @@ type config struct {
 
 	EnableMsgHandlingLatency bool
 
-	EnablePodInfo          bool
-	EnableTracingPolicyCRD bool
+	EnablePodInfo                   bool
+	EnableTracingPolicyCRD          bool
+	TracingPolicyStatusUpdatePeriod time.Duration
 
 	ExposeStackAddresses bool
 
@@ -139,6 +178,15 @@ type config struct {
 
 	RetprobesCacheSize int
 
+	EnableNetworkFlows        bool
+	NetworkFlowReportInterval time.Duration
+
+	EnableDnsEvents bool
+
+	EnableTlsPlaintext        bool
+	TlsPlaintextPaths         []string
+	TlsPlaintextRedactHeaders []string
+
 	KeepCollection bool
 }
 
@@ -191,6 +239,12 @@ func AncestorsEnabled(eventType tetragon.EventType) bool {
 		return Config.EnableProcessLsmAncestors
 	case tetragon.EventType_PROCESS_USDT:
 		return Config.EnableProcessUsdtAncestors
+	case tetragon.EventType_PROCESS_NETWORK_FLOW:
+		return Config.EnableProcessNetworkFlowAncestors
+	case tetragon.EventType_PROCESS_DNS:
+		return Config.EnableProcessDnsAncestors
+	case tetragon.EventType_PROCESS_TLS:
+		return Config.EnableProcessTlsAncestors
 	default:
 		return false
 	}
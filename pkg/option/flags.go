@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -48,6 +49,7 @@ const (
 
 	KeyMetricsServer      = "metrics-server"
 	KeyMetricsLabelFilter = "metrics-label-filter"
+	KeyEventMetricsFile   = "event-metrics-file"
 	KeyServerAddress      = "server-address"
 	KeyGopsAddr           = "gops-address"
 
@@ -55,12 +57,24 @@ const (
 
 	KeyFilterEnvironmentVariables = "filter-environment-variables"
 
+	KeyProcessArgsMaxSize     = "process-args-max-size"
+	KeyProcessEnvsMaxSize     = "process-envs-max-size"
+	KeyProcessCwdMaxSize      = "process-cwd-max-size"
+	KeyProcessArgsFullCapture = "process-args-full-capture"
+
+	KeyProcessArgsFullCaptureBinaries = "process-args-full-capture-binaries"
+
 	KeyEnableAncestors   = "enable-ancestors"
 	KeyEnableProcessCred = "enable-process-cred"
 	KeyEnableProcessNs   = "enable-process-ns"
 	KeyTracingPolicy     = "tracing-policy"
 	KeyTracingPolicyDir  = "tracing-policy-dir"
 
+	KeyTracingPolicyVerifyKeys       = "tracing-policy-verify-keys"
+	KeyTracingPolicyVerifyTrustRoot  = "tracing-policy-verify-trust-root"
+	KeyTracingPolicyVerifyTlogKeys   = "tracing-policy-verify-tlog-keys"
+	KeyTracingPolicyVerifyIdentities = "tracing-policy-verify-identities"
+
 	KeyCpuProfile = "cpuprofile"
 	KeyMemProfile = "memprofile"
 	KeyPprofAddr  = "pprof-address"
@@ -72,10 +86,30 @@ const (
 	KeyExportFileCompress         = "export-file-compress"
 	KeyExportRateLimit            = "export-rate-limit"
 	KeyExportFilePerm             = "export-file-perm"
+	KeyExportFileIntegrity        = "export-file-integrity"
+	KeyExportFileIntegrityKeyFile = "export-file-integrity-key-file"
 
 	KeyEnableExportAggregation     = "enable-export-aggregation"
 	KeyExportAggregationWindowSize = "export-aggregation-window-size"
 	KeyExportAggregationBufferSize = "export-aggregation-buffer-size"
+	KeyExportAggregationGroupBy    = "export-aggregation-group-by"
+	KeyExportAggregationDistinct   = "export-aggregation-distinct-fields"
+	KeyExportAggregationMaxGroups  = "export-aggregation-max-groups"
+	KeyExportAggregationMaxValues  = "export-aggregation-max-distinct-values"
+
+	KeyEventReplayDir       = "event-replay-dir"
+	KeyEventReplayMaxSizeMB = "event-replay-max-size-mb"
+	KeyEventReplayMaxAge    = "event-replay-max-age"
+
+	KeyExportOTLPEndpoint     = "export-otlp-endpoint"
+	KeyExportOTLPProtocol     = "export-otlp-protocol"
+	KeyExportOTLPInsecure     = "export-otlp-insecure"
+	KeyExportOTLPHeaders      = "export-otlp-headers"
+	KeyExportOTLPTimeout      = "export-otlp-timeout"
+	KeyExportOTLPBatchSize    = "export-otlp-batch-size"
+	KeyExportOTLPBatchTimeout = "export-otlp-batch-timeout"
+	KeyExportOTLPQueueSize    = "export-otlp-queue-size"
+	KeyExportOTLPMaxRetries   = "export-otlp-max-retries"
 
 	KeyExportAllowlist = "export-allowlist"
 	KeyExportDenylist  = "export-denylist"
@@ -91,6 +125,7 @@ const (
 	KeyUsePerfRingBuffer = "use-perf-ring-buffer"
 	KeyRBSize            = "rb-size"
 	KeyRBSizeTotal       = "rb-size-total"
+	KeyRBSizeHigh        = "rb-size-high"
 	KeyRBQueueSize       = "rb-queue-size"
 
 	KeyEventQueueSize = "event-queue-size"
@@ -105,8 +140,9 @@ const (
 
 	KeyEnableMsgHandlingLatency = "enable-msg-handling-latency"
 
-	KeyEnablePodInfo          = "enable-pod-info"
-	KeyEnableTracingPolicyCRD = "enable-tracing-policy-crd"
+	KeyEnablePodInfo                   = "enable-pod-info"
+	KeyEnableTracingPolicyCRD          = "enable-tracing-policy-crd"
+	KeyTracingPolicyStatusUpdatePeriod = "tracing-policy-status-update-period"
 
 	KeyExposeStackAddresses = "expose-stack-addresses"
 
@@ -143,6 +179,15 @@ const (
 	KeyParentsMapSize    = "parents-map-size"
 
 	KeyRetprobesCacheSize = "retprobes-cache-size"
+
+	KeyEnableNetworkFlows        = "enable-network-flows"
+	KeyNetworkFlowReportInterval = "network-flow-report-interval"
+
+	KeyEnableDnsEvents = "enable-dns-events"
+
+	KeyEnableTlsPlaintext        = "enable-tls-plaintext"
+	KeyTlsPlaintextPaths         = "tls-plaintext-paths"
+	KeyTlsPlaintextRedactHeaders = "tls-plaintext-redact-headers"
 )
 
 type UsernameMetadaCode int
@@ -189,6 +234,9 @@ func ReadAndSetFlags() error {
 	if Config.RBSizeTotal, err = strutils.ParseSize(viper.GetString(KeyRBSizeTotal)); err != nil {
 		return fmt.Errorf("failed to parse rb-size-total value: %w", err)
 	}
+	if Config.RBSizeHigh, err = strutils.ParseSize(viper.GetString(KeyRBSizeHigh)); err != nil {
+		return fmt.Errorf("failed to parse rb-size-high value: %w", err)
+	}
 	if Config.RBQueueSize, err = strutils.ParseSize(viper.GetString(KeyRBQueueSize)); err != nil {
 		return fmt.Errorf("failed to parse rb-queue-size value: %w", err)
 	}
@@ -204,6 +252,9 @@ func ReadAndSetFlags() error {
 		Config.EnableProcessUprobeAncestors = slices.Contains(enableAncestors, "uprobe")
 		Config.EnableProcessLsmAncestors = slices.Contains(enableAncestors, "lsm")
 		Config.EnableProcessUsdtAncestors = slices.Contains(enableAncestors, "usdt")
+		Config.EnableProcessNetworkFlowAncestors = slices.Contains(enableAncestors, "flow")
+		Config.EnableProcessDnsAncestors = slices.Contains(enableAncestors, "dns")
+		Config.EnableProcessTlsAncestors = slices.Contains(enableAncestors, "tls")
 	}
 
 	Config.EnableProcessEnvironmentVariables = viper.GetBool(KeyEnableProcessEnvironmentVariables)
@@ -217,6 +268,19 @@ func ReadAndSetFlags() error {
 		Config.FilterEnvironmentVariables = filter
 	}
 
+	Config.ProcessArgsMaxSize = viper.GetInt(KeyProcessArgsMaxSize)
+	Config.ProcessEnvsMaxSize = viper.GetInt(KeyProcessEnvsMaxSize)
+	Config.ProcessCwdMaxSize = viper.GetInt(KeyProcessCwdMaxSize)
+	Config.ProcessArgsFullCapture = viper.GetBool(KeyProcessArgsFullCapture)
+	Config.ProcessArgsFullCaptureBinaries = nil
+	for _, v := range viper.GetStringSlice(KeyProcessArgsFullCaptureBinaries) {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s value %q: %w", KeyProcessArgsFullCaptureBinaries, v, err)
+		}
+		Config.ProcessArgsFullCaptureBinaries = append(Config.ProcessArgsFullCaptureBinaries, re)
+	}
+
 	Config.GopsAddr = viper.GetString(KeyGopsAddr)
 
 	logLevel := viper.GetString(KeyLogLevel)
@@ -233,6 +297,7 @@ func ReadAndSetFlags() error {
 
 	Config.MetricsServer = viper.GetString(KeyMetricsServer)
 	Config.MetricsLabelFilter = DefaultLabelFilter().WithEnabledLabels(ParseMetricsLabelFilter(viper.GetString(KeyMetricsLabelFilter)))
+	Config.EventMetricsFile = viper.GetString(KeyEventMetricsFile)
 	Config.ServerAddress = viper.GetString(KeyServerAddress)
 
 	Config.ExportFilename = viper.GetString(KeyExportFilename)
@@ -242,10 +307,30 @@ func ReadAndSetFlags() error {
 	Config.ExportFileCompress = viper.GetBool(KeyExportFileCompress)
 	Config.ExportRateLimit = viper.GetInt(KeyExportRateLimit)
 	Config.ExportFilePerm = viper.GetString(KeyExportFilePerm)
+	Config.ExportFileIntegrity = viper.GetBool(KeyExportFileIntegrity)
+	Config.ExportFileIntegrityKeyFile = viper.GetString(KeyExportFileIntegrityKeyFile)
 
 	Config.EnableExportAggregation = viper.GetBool(KeyEnableExportAggregation)
 	Config.ExportAggregationWindowSize = viper.GetDuration(KeyExportAggregationWindowSize)
 	Config.ExportAggregationBufferSize = viper.GetUint64(KeyExportAggregationBufferSize)
+	Config.ExportAggregationGroupBy = viper.GetStringSlice(KeyExportAggregationGroupBy)
+	Config.ExportAggregationDistinct = viper.GetStringSlice(KeyExportAggregationDistinct)
+	Config.ExportAggregationMaxGroups = viper.GetUint32(KeyExportAggregationMaxGroups)
+	Config.ExportAggregationMaxValues = viper.GetUint32(KeyExportAggregationMaxValues)
+
+	Config.EventReplayDir = viper.GetString(KeyEventReplayDir)
+	Config.EventReplayMaxSizeMB = viper.GetInt(KeyEventReplayMaxSizeMB)
+	Config.EventReplayMaxAge = viper.GetDuration(KeyEventReplayMaxAge)
+
+	Config.ExportOTLPEndpoint = viper.GetString(KeyExportOTLPEndpoint)
+	Config.ExportOTLPProtocol = viper.GetString(KeyExportOTLPProtocol)
+	Config.ExportOTLPInsecure = viper.GetBool(KeyExportOTLPInsecure)
+	Config.ExportOTLPHeaders = viper.GetString(KeyExportOTLPHeaders)
+	Config.ExportOTLPTimeout = viper.GetDuration(KeyExportOTLPTimeout)
+	Config.ExportOTLPBatchSize = viper.GetInt(KeyExportOTLPBatchSize)
+	Config.ExportOTLPBatchTimeout = viper.GetDuration(KeyExportOTLPBatchTimeout)
+	Config.ExportOTLPQueueSize = viper.GetInt(KeyExportOTLPQueueSize)
+	Config.ExportOTLPMaxRetries = viper.GetInt(KeyExportOTLPMaxRetries)
 
 	Config.CpuProfile = viper.GetString(KeyCpuProfile)
 	Config.MemProfile = viper.GetString(KeyMemProfile)
@@ -262,10 +347,15 @@ func ReadAndSetFlags() error {
 	Config.EnablePidSetFilter = viper.GetBool(KeyEnablePidSetFilter)
 
 	Config.TracingPolicyDir = viper.GetString(KeyTracingPolicyDir)
+	Config.TracingPolicyVerifyKeys = viper.GetStringSlice(KeyTracingPolicyVerifyKeys)
+	Config.TracingPolicyVerifyTrustRoot = viper.GetString(KeyTracingPolicyVerifyTrustRoot)
+	Config.TracingPolicyVerifyTlogKeys = viper.GetStringSlice(KeyTracingPolicyVerifyTlogKeys)
+	Config.TracingPolicyVerifyIdentities = viper.GetStringSlice(KeyTracingPolicyVerifyIdentities)
 
 	Config.EnablePodInfo = viper.GetBool(KeyEnablePodInfo)
 	Config.EnablePodAnnotations = viper.GetBool(KeyEnablePodAnnotations)
 	Config.EnableTracingPolicyCRD = viper.GetBool(KeyEnableTracingPolicyCRD)
+	Config.TracingPolicyStatusUpdatePeriod = viper.GetDuration(KeyTracingPolicyStatusUpdatePeriod)
 
 	Config.TracingPolicy = viper.GetString(KeyTracingPolicy)
 
@@ -313,6 +403,15 @@ func ReadAndSetFlags() error {
 	Config.ParentsMapSize = viper.GetString(KeyParentsMapSize)
 
 	Config.RetprobesCacheSize = viper.GetInt(KeyRetprobesCacheSize)
+
+	Config.EnableNetworkFlows = viper.GetBool(KeyEnableNetworkFlows)
+	Config.NetworkFlowReportInterval = viper.GetDuration(KeyNetworkFlowReportInterval)
+
+	Config.EnableDnsEvents = viper.GetBool(KeyEnableDnsEvents)
+
+	Config.EnableTlsPlaintext = viper.GetBool(KeyEnableTlsPlaintext)
+	Config.TlsPlaintextPaths = viper.GetStringSlice(KeyTlsPlaintextPaths)
+	Config.TlsPlaintextRedactHeaders = viper.GetStringSlice(KeyTlsPlaintextRedactHeaders)
 	return nil
 }
 
@@ -398,6 +497,8 @@ func AddFlags(flags *pflag.FlagSet) {
 	flags.Int(KeyExportFileMaxBackups, 5, "Number of rotated JSON export files to retain")
 	flags.Bool(KeyExportFileCompress, false, "Compress rotated JSON export files")
 	flags.String(KeyExportFilePerm, defaults.DefaultLogsPermission, "Access permissions on JSON export files")
+	flags.Bool(KeyExportFileIntegrity, false, "Chain the records of JSON export files with sequence numbers and hashes, and write a checkpoint before rotating them")
+	flags.String(KeyExportFileIntegrityKeyFile, "", "File holding the key used to compute HMACs of JSON export records and to sign checkpoints. Plain SHA-256 hashes are used if empty")
 	flags.Int(KeyExportRateLimit, -1, "Rate limit (per minute) for event export. Set to -1 to disable")
 	flags.String(KeyLogLevel, "info", "Set log level")
 	flags.String(KeyLogFormat, "text", "Set log format")
@@ -406,24 +507,38 @@ func AddFlags(flags *pflag.FlagSet) {
 	flags.Int(KeyK8sControlPlaneRetry, 1, "Number of attempts for Kubernetes control plane connection (negative for infinite, zero is invalid, positive for max attempts)")
 	flags.String(KeyMetricsServer, "", "Metrics server address (e.g. ':2112'). Disabled by default")
 	flags.String(KeyMetricsLabelFilter, "namespace,workload,pod,binary", "Comma-separated list of enabled metrics labels. Unknown labels will be ignored.")
+	flags.String(KeyEventMetricsFile, "", "Path to a YAML file defining additional metrics from the fields of events. Requires the metrics server to be enabled")
 	flags.String(KeyServerAddress, "localhost:54321", "gRPC server address (e.g. 'localhost:54321' or 'unix:///var/run/tetragon/tetragon.sock'). An empty address disables the gRPC server")
 	flags.String(KeyGopsAddr, "", "gops server address (e.g. 'localhost:8118'). Disabled by default")
 	flags.Bool(KeyEnableProcessCred, false, "Enable process_cred events")
 	flags.Bool(KeyEnableProcessNs, false, "Enable namespace information in process_exec and process_kprobe events")
 	flags.Uint(KeyEventQueueSize, 10000, "Set the size of the internal event queue.")
 	flags.Bool(KeyEnablePodAnnotations, false, "Add pod annotations field to events.")
-	flags.StringSlice(KeyEnableAncestors, []string{}, "Comma-separated list of process event types to enable ancestors for. Supported event types are: base, kprobe, tracepoint, loader, uprobe, lsm, usdt. Unknown event types will be ignored. Type 'base' enables ancestors for process_exec and process_exit events and is required by all other supported event types for correct reference counting. An empty string disables ancestors completely")
+	flags.StringSlice(KeyEnableAncestors, []string{}, "Comma-separated list of process event types to enable ancestors for. Supported event types are: base, kprobe, tracepoint, loader, uprobe, lsm, usdt, flow, dns, tls. Unknown event types will be ignored. Type 'base' enables ancestors for process_exec and process_exit events and is required by all other supported event types for correct reference counting. An empty string disables ancestors completely")
 
 	flags.Bool(KeyEnableProcessEnvironmentVariables, false, "Include environment variables in process_exec events. Disabled by default. Note that this option can significantly increase the size of the events and may impact performance, as well as capture sensitive information such as passwords in the events (you can use --redaction-filters to redact the data).")
 
 	// filter option for allowed envs
 	flags.StringSliceP(KeyFilterEnvironmentVariables, "", nil, "Filter for specific environment variables")
 
+	// process arguments, environment variables and cwd size limits
+	flags.Int(KeyProcessArgsMaxSize, 0, "Maximum size in bytes of the arguments of processes in events. Longer arguments are truncated and reported in the process truncated field. 0 means no limit")
+	flags.Int(KeyProcessEnvsMaxSize, 0, "Maximum size in bytes of the environment variables of processes in events. Variables past this size are dropped and reported in the process truncated field. 0 means no limit")
+	flags.Int(KeyProcessCwdMaxSize, 0, "Maximum size in bytes of the current working directory of processes in events. Longer directories are truncated and reported in the process truncated field. 0 means no limit")
+	flags.Bool(KeyProcessArgsFullCapture, false, "Read the complete arguments of processes from the kernel, and include them in the full_arguments field of process_exec events when they are truncated")
+	flags.StringSlice(KeyProcessArgsFullCaptureBinaries, nil, "Only include the full_arguments field for binaries matching one of these regular expressions. Applies with --process-args-full-capture, all binaries if empty")
+
 	// Tracing policy file
 	flags.String(KeyTracingPolicy, "", "Tracing policy file to load at startup")
 
 	flags.String(KeyTracingPolicyDir, defaults.DefaultTpDir, "Directory from where to load Tracing Policies")
 
+	// Tracing policy signature verification
+	flags.StringSlice(KeyTracingPolicyVerifyKeys, nil, "Comma-separated list of PEM files with the public keys trusted to sign tracing policies. If set (or if --tracing-policy-verify-trust-root is set), unsigned policies are refused")
+	flags.String(KeyTracingPolicyVerifyTrustRoot, "", "PEM file with the certificate authorities trusted to issue keyless signing certificates for tracing policies")
+	flags.StringSlice(KeyTracingPolicyVerifyTlogKeys, nil, "Comma-separated list of PEM files with the public keys of the transparency logs recording keyless tracing policy signatures")
+	flags.StringSlice(KeyTracingPolicyVerifyIdentities, nil, "Comma-separated list of identities (emails or URIs) trusted to sign tracing policies with keyless signatures. If empty, any identity issued by the trust root is trusted")
+
 	// Options for debugging/development, not visible to users
 	flags.String(KeyCpuProfile, "", "Store CPU profile into provided file")
 	flags.MarkHidden(KeyCpuProfile)
@@ -437,6 +552,26 @@ func AddFlags(flags *pflag.FlagSet) {
 	flags.Bool(KeyEnableExportAggregation, false, "Enable JSON export aggregation")
 	flags.Duration(KeyExportAggregationWindowSize, 15*time.Second, "JSON export aggregation time window")
 	flags.Uint64(KeyExportAggregationBufferSize, 10000, "Aggregator channel buffer size")
+	flags.StringSlice(KeyExportAggregationGroupBy, nil, "Comma-separated list of field paths to group exported events on, relative to the event as in field filters (e.g. process.binary). Defaults to process.pod.namespace, process.pod.name, process.binary, function_name and policy_name")
+	flags.StringSlice(KeyExportAggregationDistinct, nil, "Comma-separated list of field paths for which to count distinct values in aggregated exported events (e.g. process.pod.name)")
+	flags.Uint32(KeyExportAggregationMaxGroups, 10000, "Maximum number of groups of aggregated exported events per time window. Events of new groups are dropped once it is reached")
+	flags.Uint32(KeyExportAggregationMaxValues, 1000, "Maximum number of distinct values counted per field and group of aggregated exported events")
+
+	// Event replay buffer options
+	flags.String(KeyEventReplayDir, "", "Directory for the on-disk event replay buffer that allows GetEvents clients to resume from a cursor. Disabled by default")
+	flags.Int(KeyEventReplayMaxSizeMB, 100, "Maximum size in MB of the event replay buffer")
+	flags.Duration(KeyEventReplayMaxAge, 0, "Maximum age of events kept in the event replay buffer. Set to 0 to only limit the buffer by size")
+
+	// Options for the OTLP logs exporter
+	flags.String(KeyExportOTLPEndpoint, "", "OTLP collector endpoint to export events to as logs. Disabled by default")
+	flags.String(KeyExportOTLPProtocol, "grpc", "Protocol used to export events to the OTLP collector. grpc or http")
+	flags.Bool(KeyExportOTLPInsecure, false, "Disable TLS when exporting events to the OTLP collector")
+	flags.String(KeyExportOTLPHeaders, "", "Comma-separated list of key=value headers sent with OTLP export requests")
+	flags.Duration(KeyExportOTLPTimeout, 10*time.Second, "Timeout of a single OTLP export request")
+	flags.Int(KeyExportOTLPBatchSize, 512, "Maximum number of events sent in a single OTLP export request")
+	flags.Duration(KeyExportOTLPBatchTimeout, 5*time.Second, "Maximum time an event waits for an OTLP export batch to fill")
+	flags.Int(KeyExportOTLPQueueSize, 10000, "Number of events queued for OTLP export. Events are dropped when the queue is full")
+	flags.Int(KeyExportOTLPMaxRetries, 5, "Maximum number of retries of a failed OTLP export request")
 
 	// JSON export filter options
 	flags.String(KeyExportAllowlist, "", "JSON export allowlist")
@@ -459,6 +594,7 @@ func AddFlags(flags *pflag.FlagSet) {
 	// Allow to specify ring buffer size
 	flags.String(KeyRBSizeTotal, "0", "Set ring buffer size in total for all cpus (default 65k per cpu, allows K/M/G suffix)")
 	flags.String(KeyRBSize, "0", "Set ring buffer size for single cpu (default 65k, allows K/M/G suffix)")
+	flags.String(KeyRBSizeHigh, "1M", "Set size of the BPF ring buffer for events of high priority policies (allows K/M/G suffix, 0 disables it)")
 
 	// Provide option to remove existing pinned BPF programs and maps in Tetragon's
 	// observer dir on startup. Useful for doing upgrades/downgrades. Set to false to
@@ -480,6 +616,7 @@ func AddFlags(flags *pflag.FlagSet) {
 
 	flags.Bool(KeyEnablePodInfo, false, "Enable PodInfo custom resource")
 	flags.Bool(KeyEnableTracingPolicyCRD, true, "Enable TracingPolicy and TracingPolicyNamespaced custom resources")
+	flags.Duration(KeyTracingPolicyStatusUpdatePeriod, time.Minute, "Period at which changes to the state of the policies on this node are reported in the status of TracingPolicy and TracingPolicyNamespaced custom resources. Changes to the action counters only are reported at most every 10 periods. Set to 0 to disable")
 
 	flags.Bool(KeyExposeStackAddresses, false, "Expose real linear addresses in events stack traces")
 
@@ -516,4 +653,13 @@ func AddFlags(flags *pflag.FlagSet) {
 	flags.String(KeyParentsMapSize, "", "Set size for parents_map table (allows K/M/G suffix)")
 
 	flags.Int(KeyRetprobesCacheSize, defaults.DefaultRetprobesCacheSize, "Set {k,u}retprobes events cache maximum size")
+
+	flags.Bool(KeyEnableNetworkFlows, false, "Enable the network flow sensor, which emits a process_network_flow event summarizing each TCP connection")
+	flags.Duration(KeyNetworkFlowReportInterval, 0, "Interval at which the network flow sensor reports open connections with activity, in addition to reporting them on close (0 reports only on close)")
+
+	flags.Bool(KeyEnableDnsEvents, false, "Enable the DNS sensor, which emits a process_dns event for each DNS query sent and response received by processes")
+
+	flags.Bool(KeyEnableTlsPlaintext, false, "Enable the TLS plaintext sensor, which emits a process_tls event for each HTTP/1.x request and response that processes send or receive over TLS")
+	flags.StringSlice(KeyTlsPlaintextPaths, defaults.DefaultTlsPlaintextPaths, "Comma-separated list of paths or glob patterns of the TLS libraries (OpenSSL) and Go binaries to probe with the TLS plaintext sensor, on the host at startup and in the root directory of executed processes")
+	flags.StringSlice(KeyTlsPlaintextRedactHeaders, defaults.DefaultTlsPlaintextRedactHeaders, "Comma-separated list of HTTP headers whose values are redacted in process_tls events")
 }
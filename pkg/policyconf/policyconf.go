@@ -26,10 +26,17 @@ const (
 	PolicyConfMapName = "policy_conf"
 )
 
-// NB: if we add more fields here, we would need to modify SetModeInBPFMap to do a read-modify
-// operation to set the mode.
+type Priority uint8
+
+const (
+	// NB: values below should match the ones in bpf/lib/policy_conf.h
+	NormalPriority Priority = 0
+	HighPriority   Priority = 1
+)
+
 type PolicyConf struct {
-	Mode Mode
+	Mode     Mode
+	Priority Priority
 }
 
 func ParseMode(s string) (Mode, error) {
@@ -43,6 +50,27 @@ func ParseMode(s string) (Mode, error) {
 	return InvalidMode, fmt.Errorf("invalid mode: %q", s)
 }
 
+func ParsePriority(s string) (Priority, error) {
+	switch s {
+	case "normal":
+		return NormalPriority, nil
+	case "high":
+		return HighPriority, nil
+	}
+
+	return NormalPriority, fmt.Errorf("invalid priority: %q", s)
+}
+
+func (p Priority) String() string {
+	switch p {
+	case NormalPriority:
+		return "normal"
+	case HighPriority:
+		return "high"
+	}
+	return fmt.Sprintf("unknown(%d)", uint8(p))
+}
+
 func ModeFromBPFMap(fname string) (Mode, error) {
 	m, err := ebpf.LoadPinnedMap(fname, &ebpf.LoadPinOptions{ReadOnly: true})
 	if err != nil {
@@ -70,10 +98,13 @@ func SetModeInBPFMap(fname string, mode Mode) error {
 	}
 	defer m.Close()
 
-	conf := PolicyConf{
-		Mode: mode,
-	}
+	// read the configuration first, so that the other fields are preserved
+	var conf PolicyConf
 	zero := uint32(0)
+	if err = m.Lookup(&zero, &conf); err != nil {
+		return fmt.Errorf("failed to lookup map %q: %w", fname, err)
+	}
+	conf.Mode = mode
 	if err = m.Update(&zero, &conf, ebpf.UpdateExist); err != nil {
 		return fmt.Errorf("failed to update map %q with val %v: %w", fname, conf, err)
 	}
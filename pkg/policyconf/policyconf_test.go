@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package policyconf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePriority(t *testing.T) {
+	p, err := ParsePriority("normal")
+	require.NoError(t, err)
+	assert.Equal(t, NormalPriority, p)
+
+	p, err = ParsePriority("high")
+	require.NoError(t, err)
+	assert.Equal(t, HighPriority, p)
+	assert.Equal(t, "high", p.String())
+
+	_, err = ParsePriority("urgent")
+	require.Error(t, err)
+}
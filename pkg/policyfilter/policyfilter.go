@@ -9,6 +9,7 @@ import (
 
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	slimv1 "github.com/cilium/tetragon/pkg/k8s/slim/k8s/apis/meta/v1"
 	"github.com/cilium/tetragon/pkg/labels"
 	"github.com/cilium/tetragon/pkg/logger"
@@ -79,8 +80,9 @@ type State interface {
 	//  - namespace for namespaced pilicies (if namespace == "", then policy is not namespaced)
 	//  - label selector
 	//  - container field selector
+	//  - workload selector
 	AddPolicy(polID PolicyID, namespace string, podSelector *slimv1.LabelSelector,
-		containerSelector *slimv1.LabelSelector) error
+		containerSelector *slimv1.LabelSelector, workloadSelector *v1alpha1.WorkloadSelector) error
 
 	// DelPolicy removes a policy from the state
 	DelPolicy(polID PolicyID) error
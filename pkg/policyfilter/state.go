@@ -11,6 +11,7 @@ import (
 	"github.com/cilium/ebpf"
 
 	"github.com/cilium/tetragon/pkg/cgroups/fsscan"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	slimv1 "github.com/cilium/tetragon/pkg/k8s/slim/k8s/apis/meta/v1"
 	"github.com/cilium/tetragon/pkg/labels"
 	"github.com/cilium/tetragon/pkg/logger"
@@ -203,14 +204,19 @@ type policy struct {
 
 	podSelector labels.Selector
 
+	workloadSelector *workloadSelector
+
 	// polMap is the (inner) policy map for this policy
 	polMap polMap
 }
 
-func (pol *policy) podMatches(podNs string, podLabels labels.Labels) bool {
+func (pol *policy) podMatches(podNs, workload, kind string, podLabels labels.Labels) bool {
 	if pol.namespace != "" && podNs != pol.namespace {
 		return false
 	}
+	if !pol.workloadSelector.match(workload, kind) {
+		return false
+	}
 	var podLabels1 labels.Labels
 	if podLabels != nil {
 		podLabels1 = podLabels
@@ -226,7 +232,7 @@ func (pol *policy) podMatches(podNs string, podLabels labels.Labels) bool {
 }
 
 func (pol *policy) podInfoMatches(pod *podInfo) bool {
-	return pol.podMatches(pod.namespace, pod.labels)
+	return pol.podMatches(pod.namespace, pod.workload, pod.kind, pod.labels)
 }
 
 func (pol *policy) containerMatches(container *containerInfo) bool {
@@ -434,7 +440,7 @@ func (m *state) delPod(id PodID) *podInfo {
 
 // AddPolicy adds a policy
 func (m *state) AddPolicy(polID PolicyID, namespace string, podLabelSelector *slimv1.LabelSelector,
-	containerLabelSelector *slimv1.LabelSelector) error {
+	containerLabelSelector *slimv1.LabelSelector, workloadSpec *v1alpha1.WorkloadSelector) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
@@ -452,11 +458,17 @@ func (m *state) AddPolicy(polID PolicyID, namespace string, podLabelSelector *sl
 		return err
 	}
 
+	workloadSelector, err := workloadSelectorFromSpec(workloadSpec)
+	if err != nil {
+		return err
+	}
+
 	policy := policy{
 		id:                polID,
 		namespace:         namespace,
 		podSelector:       podSelector,
 		containerSelector: containerSelector,
+		workloadSelector:  workloadSelector,
 	}
 
 	cgroupIDs := make([]CgroupID, 0)
@@ -787,7 +799,7 @@ type policiesDiffRes struct {
 	newMatchedPolicies []PolicyID
 }
 
-func (m *state) policiesDiff(pod *podInfo, newLabels labels.Labels) *policiesDiffRes {
+func (m *state) policiesDiff(pod *podInfo, newWorkload, newKind string, newLabels labels.Labels) *policiesDiffRes {
 	addedPolicies := []*policy{}
 	deletedPolicies := []*policy{}
 
@@ -795,7 +807,7 @@ func (m *state) policiesDiff(pod *podInfo, newLabels labels.Labels) *policiesDif
 	for i := range m.policies {
 		pol := &m.policies[i]
 		podHasPolicy := pod.hasPolicy(pol.id)
-		if pol.podMatches(pod.namespace, newLabels) {
+		if pol.podMatches(pod.namespace, newWorkload, newKind, newLabels) {
 			newMatchedPolicies = append(newMatchedPolicies, pol.id)
 			if !podHasPolicy {
 				// policy matches, but pod does not have it in its matched policies.
@@ -921,19 +933,23 @@ func (m *state) UpdatePod(podID PodID, namespace, workload, kind string, podLabe
 		return &podNamespaceConflictError{podID: podID, oldNs: pod.namespace, newNs: namespace}
 	}
 
-	// labels changed: check if there are policies ads that:
+	// labels or workload changed: check if there are policies ads that:
 	// - did not match before, but they match now (addPols)
 	// - did match before, but they do not match now (delPols))
 	// and update state accordingly
-	if pod.labels.Cmp(podLabels) {
-		polDiff := m.policiesDiff(pod, podLabels)
-		m.DebugLogWithCallers(1).Info("UpdatePod: pod labels changed",
+	if pod.labels.Cmp(podLabels) || pod.workload != workload || pod.kind != kind {
+		polDiff := m.policiesDiff(pod, workload, kind, podLabels)
+		m.DebugLogWithCallers(1).Info("UpdatePod: pod labels or workload changed",
 			"pod-id", pod.id,
 			"pod-old-labels", pod.labels,
 			"pod-new-labels", podLabels,
+			"pod-old-workload", pod.kind+"/"+pod.workload,
+			"pod-new-workload", kind+"/"+workload,
 			"policy-diff", fmt.Sprintf("%+v", polDiff))
 		m.applyPodPolicyDiff(pod, polDiff)
 		pod.labels = podLabels
+		pod.workload = workload
+		pod.kind = kind
 	}
 
 	// containers changed: check if there are new or deleted containers, and update the policy
@@ -11,6 +11,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	"github.com/cilium/tetragon/pkg/podhelpers"
 )
 
@@ -21,11 +22,11 @@ func TestState(t *testing.T) {
 	}
 	defer s.Close()
 
-	err = s.AddPolicy(PolicyID(1), "ns1", nil, nil)
+	err = s.AddPolicy(PolicyID(1), "ns1", nil, nil, nil)
 	require.NoError(t, err)
-	err = s.AddPolicy(PolicyID(2), "ns2", nil, nil)
+	err = s.AddPolicy(PolicyID(2), "ns2", nil, nil, nil)
 	require.NoError(t, err)
-	err = s.AddPolicy(PolicyID(3), "ns3", nil, nil)
+	err = s.AddPolicy(PolicyID(3), "ns3", nil, nil, nil)
 	require.NoError(t, err)
 
 	pod1 := PodID(uuid.New())
@@ -104,3 +105,49 @@ func TestState(t *testing.T) {
 	require.Empty(t, s.policies)
 	require.Empty(t, s.pods)
 }
+
+func TestStateWorkloadSelector(t *testing.T) {
+	s, err := New(true)
+	if err != nil {
+		t.Skipf("failed to inialize policy filter state: %s", err)
+	}
+	defer s.Close()
+
+	err = s.AddPolicy(PolicyID(1), "", nil, nil, &v1alpha1.WorkloadSelector{
+		Kinds: []string{"deployment"},
+		Names: []string{"payments-*"},
+	})
+	require.NoError(t, err)
+	err = s.AddPolicy(PolicyID(2), "", nil, nil, &v1alpha1.WorkloadSelector{
+		Kinds: []string{"DaemonSet"},
+	})
+	require.NoError(t, err)
+	err = s.AddPolicy(PolicyID(3), "", nil, nil, &v1alpha1.WorkloadSelector{
+		Names: []string{"[invalid"},
+	})
+	require.Error(t, err)
+
+	pod1 := PodID(uuid.New())
+	err = s.AddPodContainer(pod1, "ns1", "payments-api", "Deployment", nil, "cont1", CgroupID(1001), podhelpers.ContainerInfo{Name: "main1"})
+	require.NoError(t, err)
+	pod2 := PodID(uuid.New())
+	err = s.AddPodContainer(pod2, "ns1", "payments-api", "StatefulSet", nil, "cont2", CgroupID(1002), podhelpers.ContainerInfo{Name: "main2"})
+	require.NoError(t, err)
+	pod3 := PodID(uuid.New())
+	err = s.AddPodContainer(pod3, "ns2", "node-agent", "DaemonSet", nil, "cont3", CgroupID(1003), podhelpers.ContainerInfo{Name: "main3"})
+	require.NoError(t, err)
+
+	requirePfmEqualTo(t, s.pfMap, map[uint64][]uint64{
+		1: {1001},
+		2: {1003},
+	})
+
+	// the workload of a pod first seen by the runtime hooks can change
+	// when the pod is seen by the k8s watcher.
+	err = s.UpdatePod(pod2, "ns1", "payments-worker", "Deployment", nil, []string{"cont2"}, []podhelpers.ContainerInfo{{Name: "main2"}})
+	require.NoError(t, err)
+	requirePfmEqualTo(t, s.pfMap, map[uint64][]uint64{
+		1: {1001, 1002},
+		2: {1003},
+	})
+}
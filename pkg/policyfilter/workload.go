@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package policyfilter
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+// workloadSelector matches pods on the workload that controls them. A nil
+// workloadSelector matches all pods.
+type workloadSelector struct {
+	kinds []string
+	names []string
+}
+
+// ValidateWorkloadSelector checks that the name patterns of a workload
+// selector are valid.
+func ValidateWorkloadSelector(ws *v1alpha1.WorkloadSelector) error {
+	if ws == nil {
+		return nil
+	}
+	for _, name := range ws.Names {
+		if _, err := path.Match(name, ""); err != nil {
+			return fmt.Errorf("invalid workload name pattern %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func workloadSelectorFromSpec(ws *v1alpha1.WorkloadSelector) (*workloadSelector, error) {
+	if ws == nil || len(ws.Kinds)+len(ws.Names) == 0 {
+		return nil, nil
+	}
+	if err := ValidateWorkloadSelector(ws); err != nil {
+		return nil, err
+	}
+	return &workloadSelector{
+		kinds: ws.Kinds,
+		names: ws.Names,
+	}, nil
+}
+
+func (ws *workloadSelector) match(workload, kind string) bool {
+	if ws == nil {
+		return true
+	}
+	if len(ws.kinds) > 0 && !slices.ContainsFunc(ws.kinds, func(k string) bool {
+		return strings.EqualFold(k, kind)
+	}) {
+		return false
+	}
+	if len(ws.names) > 0 && !slices.ContainsFunc(ws.names, func(pattern string) bool {
+		ok, _ := path.Match(pattern, workload)
+		return ok
+	}) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package policyfilter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+func TestWorkloadSelector(t *testing.T) {
+	ws, err := workloadSelectorFromSpec(nil)
+	require.NoError(t, err)
+	require.Nil(t, ws)
+	require.True(t, ws.match("payments-api", "Deployment"))
+
+	ws, err = workloadSelectorFromSpec(&v1alpha1.WorkloadSelector{})
+	require.NoError(t, err)
+	require.Nil(t, ws)
+
+	ws, err = workloadSelectorFromSpec(&v1alpha1.WorkloadSelector{
+		Kinds: []string{"deployment", "StatefulSet"},
+		Names: []string{"payments-*", "db"},
+	})
+	require.NoError(t, err)
+	require.True(t, ws.match("payments-api", "Deployment"))
+	require.True(t, ws.match("db", "StatefulSet"))
+	require.False(t, ws.match("payments-api", "DaemonSet"))
+	require.False(t, ws.match("db-0", "StatefulSet"))
+
+	ws, err = workloadSelectorFromSpec(&v1alpha1.WorkloadSelector{
+		Kinds: []string{"Job"},
+	})
+	require.NoError(t, err)
+	require.True(t, ws.match("backup", "Job"))
+	require.False(t, ws.match("backup", "CronJob"))
+
+	_, err = workloadSelectorFromSpec(&v1alpha1.WorkloadSelector{
+		Names: []string{"[payments"},
+	})
+	require.Error(t, err)
+}
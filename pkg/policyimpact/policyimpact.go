@@ -0,0 +1,469 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package policyimpact records what the tracing policies in monitor mode would
+// have enforced: the processes, binaries, pods and arguments of the events
+// whose sigkill, signal, override or notify enforcer actions were not applied.
+package policyimpact
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
+)
+
+const (
+	// MaxEntries is the maximum number of distinct values recorded in each
+	// list of a report.
+	MaxEntries = 1000
+	// PoliciesRefreshInterval is the interval at which the modes, stats and
+	// specs of the tracing policies are refreshed.
+	PoliciesRefreshInterval = 5 * time.Second
+)
+
+// Policy holds the state of the loaded tracing policies of a name.
+type Policy struct {
+	Mode tetragon.TracingPolicyMode
+	// MonitorActions is the number of sigkill, signal, override and notify
+	// enforcer actions that were not applied because the policy was in
+	// monitor mode, from the policy stats.
+	MonitorActions uint64
+	// Specs are the specs of the policies, if known. They tell which
+	// events come from selectors with enforcement actions.
+	Specs []*v1alpha1.TracingPolicySpec
+}
+
+// PoliciesFunc returns the loaded tracing policies, by name.
+type PoliciesFunc func(ctx context.Context) (map[string]*Policy, error)
+
+// PoliciesFromList returns the policies of a ListTracingPoliciesResponse, by
+// name. specs are the specs of the policies by name, or nil if unknown.
+func PoliciesFromList(res *tetragon.ListTracingPoliciesResponse, specs map[string][]*v1alpha1.TracingPolicySpec) map[string]*Policy {
+	policies := make(map[string]*Policy, len(res.GetPolicies()))
+	for _, pol := range res.GetPolicies() {
+		// policies in different namespaces can have the same name, and
+		// events only carry the policy name: record events if any of
+		// them is in monitor mode.
+		p, ok := policies[pol.Name]
+		if !ok {
+			p = &Policy{Mode: pol.Mode, Specs: specs[pol.Name]}
+			policies[pol.Name] = p
+		} else if !monitored(p.Mode) {
+			p.Mode = pol.Mode
+		}
+		if c := pol.GetStats().GetActionCounters(); c != nil {
+			p.MonitorActions += c.MonitorSignal + c.MonitorOverride + c.MonitorNotifyEnforcer
+		}
+	}
+	return policies
+}
+
+func monitored(mode tetragon.TracingPolicyMode) bool {
+	return mode == tetragon.TracingPolicyMode_TP_MODE_MONITOR ||
+		mode == tetragon.TracingPolicyMode_TP_MODE_MONITOR_ONLY
+}
+
+// enforcementEvent is implemented by the events of tracing policies that can
+// have enforcement actions.
+type enforcementEvent interface {
+	GetProcess() *tetragon.Process
+	GetPolicyName() string
+	GetAction() tetragon.KprobeAction
+	GetArgs() []*tetragon.KprobeArgument
+}
+
+func getEnforcementEvent(ev *tetragon.GetEventsResponse) enforcementEvent {
+	switch e := ev.Event.(type) {
+	case *tetragon.GetEventsResponse_ProcessKprobe:
+		return e.ProcessKprobe
+	case *tetragon.GetEventsResponse_ProcessTracepoint:
+		return e.ProcessTracepoint
+	case *tetragon.GetEventsResponse_ProcessUprobe:
+		return e.ProcessUprobe
+	case *tetragon.GetEventsResponse_ProcessLsm:
+		return e.ProcessLsm
+	case *tetragon.GetEventsResponse_ProcessUsdt:
+		return e.ProcessUsdt
+	}
+	return nil
+}
+
+func enforcementAction(action tetragon.KprobeAction) bool {
+	switch action {
+	case tetragon.KprobeAction_KPROBE_ACTION_SIGKILL,
+		tetragon.KprobeAction_KPROBE_ACTION_SIGNAL,
+		tetragon.KprobeAction_KPROBE_ACTION_OVERRIDE,
+		tetragon.KprobeAction_KPROBE_ACTION_NOTIFYENFORCER:
+		return true
+	}
+	return false
+}
+
+// actionFromSpec returns the event action of an action of a policy spec.
+func actionFromSpec(action string) tetragon.KprobeAction {
+	return tetragon.KprobeAction(tetragon.KprobeAction_value["KPROBE_ACTION_"+strings.ToUpper(action)])
+}
+
+// enforcement tells which events of a policy come from selectors with
+// enforcement actions. The action of an event is the last action of its
+// selector, so the events of a selector such as [Sigkill, Post] have the post
+// action.
+type enforcement struct {
+	// enforcing holds the enforcement actions of the selectors with
+	// enforcement actions, by their last action.
+	enforcing map[tetragon.KprobeAction][]tetragon.KprobeAction
+	// other holds the last actions of the selectors without enforcement
+	// actions. The events with these actions are ambiguous if a selector
+	// with enforcement actions has the same last action, and are not
+	// recorded.
+	other map[tetragon.KprobeAction]struct{}
+}
+
+func newEnforcement(specs []*v1alpha1.TracingPolicySpec) *enforcement {
+	e := &enforcement{
+		enforcing: make(map[tetragon.KprobeAction][]tetragon.KprobeAction),
+		other:     make(map[tetragon.KprobeAction]struct{}),
+	}
+	addSelectors := func(selectors []v1alpha1.KProbeSelector, macros map[string]v1alpha1.KProbeSelector) {
+		if len(selectors) == 0 {
+			// hooks without selectors post all their events
+			e.other[tetragon.KprobeAction_KPROBE_ACTION_POST] = struct{}{}
+			e.other[tetragon.KprobeAction_KPROBE_ACTION_UNKNOWN] = struct{}{}
+		}
+		for i := range selectors {
+			actions := selectors[i].MatchActions
+			// the actions can only be defined once, in the selector
+			// or in one of its macros
+			for _, name := range selectors[i].Macros {
+				if len(actions) == 0 {
+					actions = macros[name].MatchActions
+				}
+			}
+			if len(actions) == 0 {
+				e.other[tetragon.KprobeAction_KPROBE_ACTION_POST] = struct{}{}
+				e.other[tetragon.KprobeAction_KPROBE_ACTION_UNKNOWN] = struct{}{}
+				continue
+			}
+			last := actionFromSpec(actions[len(actions)-1].Action)
+			var enforced []tetragon.KprobeAction
+			for _, a := range actions {
+				if action := actionFromSpec(a.Action); enforcementAction(action) {
+					enforced = append(enforced, action)
+				}
+			}
+			if len(enforced) == 0 {
+				e.other[last] = struct{}{}
+				continue
+			}
+			for _, action := range enforced {
+				if !slices.Contains(e.enforcing[last], action) {
+					e.enforcing[last] = append(e.enforcing[last], action)
+				}
+			}
+		}
+	}
+	for _, spec := range specs {
+		if spec == nil {
+			continue
+		}
+		for i := range spec.KProbes {
+			addSelectors(spec.KProbes[i].Selectors, spec.SelectorsMacros)
+		}
+		for i := range spec.Tracepoints {
+			addSelectors(spec.Tracepoints[i].Selectors, spec.SelectorsMacros)
+		}
+		for i := range spec.UProbes {
+			addSelectors(spec.UProbes[i].Selectors, spec.SelectorsMacros)
+		}
+		for i := range spec.LsmHooks {
+			addSelectors(spec.LsmHooks[i].Selectors, spec.SelectorsMacros)
+		}
+		for i := range spec.Usdts {
+			addSelectors(spec.Usdts[i].Selectors, spec.SelectorsMacros)
+		}
+	}
+	return e
+}
+
+// actions returns the enforcement actions that an event with the given action
+// would have applied, or nil if it would not have enforced anything.
+func (e *enforcement) actions(action tetragon.KprobeAction) []tetragon.KprobeAction {
+	if enforcementAction(action) {
+		return []tetragon.KprobeAction{action}
+	}
+	if e == nil {
+		return nil
+	}
+	if _, ok := e.other[action]; ok {
+		return nil
+	}
+	return e.enforcing[action]
+}
+
+type entry struct {
+	count       uint64
+	first, last *timestamppb.Timestamp
+	pid         uint32
+	binary      string
+}
+
+func (e *entry) update(t *timestamppb.Timestamp) {
+	e.count++
+	if e.first == nil {
+		e.first = t
+	}
+	e.last = t
+}
+
+const (
+	listActions = iota
+	listProcesses
+	listBinaries
+	listPods
+	listArguments
+	numLists
+)
+
+type report struct {
+	entry
+	lists     [numLists]map[string]*entry
+	truncated bool
+}
+
+func newReport() *report {
+	r := &report{}
+	for i := range r.lists {
+		r.lists[i] = make(map[string]*entry)
+	}
+	return r
+}
+
+// add updates the entry of a value and returns it, or returns nil if the value
+// is new and the list is full.
+func (r *report) add(list int, value string, t *timestamppb.Timestamp) *entry {
+	e, ok := r.lists[list][value]
+	if !ok {
+		if len(r.lists[list]) >= MaxEntries {
+			r.truncated = true
+			return nil
+		}
+		e = &entry{}
+		r.lists[list][value] = e
+	}
+	e.update(t)
+	return e
+}
+
+func (r *report) record(ev enforcementEvent, actions []tetragon.KprobeAction, t *timestamppb.Timestamp) {
+	r.update(t)
+	for _, action := range actions {
+		r.add(listActions, action.String(), t)
+	}
+	if proc := ev.GetProcess(); proc != nil {
+		if e := r.add(listProcesses, proc.ExecId, t); e != nil {
+			e.pid = proc.GetPid().GetValue()
+			e.binary = proc.Binary
+		}
+		r.add(listBinaries, proc.Binary, t)
+		if pod := proc.Pod; pod != nil {
+			r.add(listPods, pod.Namespace+"/"+pod.Name, t)
+		}
+	}
+	for i, arg := range ev.GetArgs() {
+		r.add(listArguments, "args."+strconv.Itoa(i)+"="+argValue(arg), t)
+	}
+}
+
+// argValue formats the value of an argument.
+func argValue(arg *tetragon.KprobeArgument) string {
+	msg := arg.ProtoReflect()
+	fd := msg.WhichOneof(msg.Descriptor().Oneofs().ByName("arg"))
+	if fd == nil {
+		return ""
+	}
+	v := msg.Get(fd)
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		b, _ := protojson.Marshal(v.Message().Interface())
+		return string(b)
+	case protoreflect.BytesKind:
+		return fmt.Sprintf("%q", v.Bytes())
+	}
+	return v.String()
+}
+
+func entries(m map[string]*entry) []*tetragon.PolicyImpactEntry {
+	ret := make([]*tetragon.PolicyImpactEntry, 0, len(m))
+	for value, e := range m {
+		ret = append(ret, &tetragon.PolicyImpactEntry{
+			Value:     value,
+			Count:     e.count,
+			FirstSeen: e.first,
+			LastSeen:  e.last,
+			Pid:       e.pid,
+			Binary:    e.binary,
+		})
+	}
+	slices.SortFunc(ret, func(a, b *tetragon.PolicyImpactEntry) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Value, b.Value))
+	})
+	return ret
+}
+
+// policyState is the state of the policies of a name in the recorder.
+type policyState struct {
+	mode           tetragon.TracingPolicyMode
+	monitorActions uint64
+	// enforcement is nil if the specs of the policies are not known, in
+	// which case only the events with an enforcement action are recorded.
+	enforcement *enforcement
+}
+
+// Recorder records the events of tracing policies in monitor mode whose
+// enforcement actions were not applied. It implements server.Listener.
+//
+// The events are classified by their action and by the selectors of the
+// policy, since the action of an event is the last action of its selector.
+// The events that are sampled out, rate limited, filtered or lost before
+// reaching the agent are not recorded, so the reports also carry the number
+// of enforcement actions not applied from the policy stats, which the kernel
+// counts for every action.
+type Recorder struct {
+	policiesFn PoliciesFunc
+
+	mu       sync.Mutex
+	policies map[string]*policyState
+	reports  map[string]*report
+}
+
+// NewRecorder returns a recorder that gets the loaded policies from
+// policiesFn.
+func NewRecorder(policiesFn PoliciesFunc) *Recorder {
+	return &Recorder{
+		policiesFn: policiesFn,
+		reports:    make(map[string]*report),
+	}
+}
+
+// Run refreshes the policies every PoliciesRefreshInterval until ctx is done.
+// Events are not recorded before the first refresh.
+func (r *Recorder) Run(ctx context.Context) {
+	ticker := time.NewTicker(PoliciesRefreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := r.refreshPolicies(ctx); err != nil {
+			logger.GetLogger().Warn("policy impact: failed to get the tracing policies", logfields.Error, err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// refreshPolicies gets the loaded policies, and drops the reports of the
+// policies that are not loaded anymore.
+func (r *Recorder) refreshPolicies(ctx context.Context) error {
+	policies, err := r.policiesFn(ctx)
+	if err != nil {
+		return err
+	}
+	states := make(map[string]*policyState, len(policies))
+	for name, p := range policies {
+		state := &policyState{mode: p.Mode, monitorActions: p.MonitorActions}
+		if len(p.Specs) > 0 {
+			state.enforcement = newEnforcement(p.Specs)
+		}
+		states[name] = state
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.policies = states
+	for name := range r.reports {
+		if _, ok := states[name]; !ok {
+			delete(r.reports, name)
+		}
+	}
+	return nil
+}
+
+// Notify records an event if its policy is in monitor mode and its selector
+// has enforcement actions.
+func (r *Recorder) Notify(res *tetragon.GetEventsResponse) {
+	ev := getEnforcementEvent(res)
+	if ev == nil {
+		return
+	}
+	name := ev.GetPolicyName()
+	t := res.Time
+	if t == nil {
+		t = timestamppb.Now()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	state, ok := r.policies[name]
+	if !ok || !monitored(state.mode) {
+		return
+	}
+	actions := state.enforcement.actions(ev.GetAction())
+	if len(actions) == 0 {
+		return
+	}
+	rep, ok := r.reports[name]
+	if !ok {
+		rep = newReport()
+		r.reports[name] = rep
+		logger.GetLogger().Debug("policy impact: recording events of tracing policy", "policy", name)
+	}
+	rep.record(ev, actions, t)
+}
+
+// Report returns the impact report of a policy.
+func (r *Recorder) Report(ctx context.Context, name string) (*tetragon.GetPolicyImpactReportResponse, error) {
+	if err := r.refreshPolicies(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get the tracing policies: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	state, ok := r.policies[name]
+	if !ok {
+		return nil, fmt.Errorf("tracing policy %q not found", name)
+	}
+	res := &tetragon.GetPolicyImpactReportResponse{
+		Name:           name,
+		Mode:           state.mode,
+		MonitorActions: state.monitorActions,
+	}
+	rep, ok := r.reports[name]
+	if !ok {
+		return res, nil
+	}
+	res.Count = rep.count
+	res.FirstSeen = rep.first
+	res.LastSeen = rep.last
+	res.Actions = entries(rep.lists[listActions])
+	res.Processes = entries(rep.lists[listProcesses])
+	res.Binaries = entries(rep.lists[listBinaries])
+	res.Pods = entries(rep.lists[listPods])
+	res.Arguments = entries(rep.lists[listArguments])
+	res.Truncated = rep.truncated
+	return res, nil
+}
@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package policyimpact
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+func kprobeEvent(policy string, action tetragon.KprobeAction, execID string, pid uint32, binary, path string, secs int64) *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Time: &timestamppb.Timestamp{Seconds: secs},
+		Event: &tetragon.GetEventsResponse_ProcessKprobe{
+			ProcessKprobe: &tetragon.ProcessKprobe{
+				Process: &tetragon.Process{
+					ExecId: execID,
+					Pid:    wrapperspb.UInt32(pid),
+					Binary: binary,
+					Pod:    &tetragon.Pod{Namespace: "default", Name: "pod"},
+				},
+				PolicyName: policy,
+				Action:     action,
+				Args: []*tetragon.KprobeArgument{
+					{Arg: &tetragon.KprobeArgument_StringArg{StringArg: path}},
+					{Arg: &tetragon.KprobeArgument_IntArg{IntArg: 1}},
+				},
+			},
+		},
+	}
+}
+
+func newTestRecorder(t *testing.T, modes map[string]tetragon.TracingPolicyMode) *Recorder {
+	policies := make(map[string]*Policy, len(modes))
+	for name, mode := range modes {
+		policies[name] = &Policy{Mode: mode}
+	}
+	return newTestRecorderPolicies(t, policies)
+}
+
+func newTestRecorderPolicies(t *testing.T, policies map[string]*Policy) *Recorder {
+	r := NewRecorder(func(context.Context) (map[string]*Policy, error) {
+		return policies, nil
+	})
+	require.NoError(t, r.refreshPolicies(context.Background()))
+	return r
+}
+
+func TestRecorder(t *testing.T) {
+	r := newTestRecorder(t, map[string]tetragon.TracingPolicyMode{
+		"monitored": tetragon.TracingPolicyMode_TP_MODE_MONITOR,
+		"enforced":  tetragon.TracingPolicyMode_TP_MODE_ENFORCE,
+	})
+
+	r.Notify(kprobeEvent("monitored", tetragon.KprobeAction_KPROBE_ACTION_SIGKILL, "a", 1, "/bin/cat", "/etc/shadow", 10))
+	r.Notify(kprobeEvent("monitored", tetragon.KprobeAction_KPROBE_ACTION_SIGKILL, "b", 2, "/bin/cat", "/etc/passwd", 20))
+	r.Notify(kprobeEvent("monitored", tetragon.KprobeAction_KPROBE_ACTION_OVERRIDE, "b", 2, "/bin/cat", "/etc/shadow", 30))
+	// not an enforcement action
+	r.Notify(kprobeEvent("monitored", tetragon.KprobeAction_KPROBE_ACTION_POST, "c", 3, "/bin/ls", "/etc/shadow", 40))
+	// policy not in monitor mode
+	r.Notify(kprobeEvent("enforced", tetragon.KprobeAction_KPROBE_ACTION_SIGKILL, "c", 3, "/bin/ls", "/etc/shadow", 40))
+
+	res, err := r.Report(context.Background(), "monitored")
+	require.NoError(t, err)
+	assert.Equal(t, tetragon.TracingPolicyMode_TP_MODE_MONITOR, res.Mode)
+	assert.Equal(t, uint64(3), res.Count)
+	assert.Equal(t, int64(10), res.FirstSeen.Seconds)
+	assert.Equal(t, int64(30), res.LastSeen.Seconds)
+
+	values := func(entries []*tetragon.PolicyImpactEntry) map[string]uint64 {
+		ret := make(map[string]uint64)
+		for _, e := range entries {
+			ret[e.Value] = e.Count
+		}
+		return ret
+	}
+	assert.Equal(t, map[string]uint64{"KPROBE_ACTION_SIGKILL": 2, "KPROBE_ACTION_OVERRIDE": 1}, values(res.Actions))
+	assert.Equal(t, map[string]uint64{"a": 1, "b": 2}, values(res.Processes))
+	assert.Equal(t, map[string]uint64{"/bin/cat": 3}, values(res.Binaries))
+	assert.Equal(t, map[string]uint64{"default/pod": 3}, values(res.Pods))
+	assert.Equal(t, map[string]uint64{
+		"args.0=/etc/shadow": 2,
+		"args.0=/etc/passwd": 1,
+		"args.1=1":           3,
+	}, values(res.Arguments))
+
+	// entries are ordered by decreasing count
+	require.Len(t, res.Processes, 2)
+	assert.Equal(t, "b", res.Processes[0].Value)
+	assert.Equal(t, uint32(2), res.Processes[0].Pid)
+	assert.Equal(t, "/bin/cat", res.Processes[0].Binary)
+	assert.Equal(t, int64(20), res.Processes[0].FirstSeen.Seconds)
+	assert.Equal(t, int64(30), res.Processes[0].LastSeen.Seconds)
+	assert.False(t, res.Truncated)
+
+	res, err = r.Report(context.Background(), "enforced")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), res.Count)
+
+	_, err = r.Report(context.Background(), "unknown")
+	require.Error(t, err)
+}
+
+func TestRecorderTruncated(t *testing.T) {
+	r := newTestRecorder(t, map[string]tetragon.TracingPolicyMode{
+		"monitored": tetragon.TracingPolicyMode_TP_MODE_MONITOR_ONLY,
+	})
+	for i := range MaxEntries + 1 {
+		r.Notify(kprobeEvent("monitored", tetragon.KprobeAction_KPROBE_ACTION_SIGKILL, string(rune('a'+i)), uint32(i), "/bin/cat", "/etc/shadow", int64(i)))
+	}
+	res, err := r.Report(context.Background(), "monitored")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(MaxEntries+1), res.Count)
+	assert.Len(t, res.Processes, MaxEntries)
+	assert.True(t, res.Truncated)
+}
+
+func TestRecorderDeletedPolicy(t *testing.T) {
+	policies := map[string]*Policy{
+		"monitored": {Mode: tetragon.TracingPolicyMode_TP_MODE_MONITOR},
+	}
+	r := newTestRecorderPolicies(t, policies)
+	r.Notify(kprobeEvent("monitored", tetragon.KprobeAction_KPROBE_ACTION_SIGKILL, "a", 1, "/bin/cat", "/etc/shadow", 10))
+	delete(policies, "monitored")
+	require.NoError(t, r.refreshPolicies(context.Background()))
+	assert.Empty(t, r.reports)
+}
+
+func TestRecorderSelectorActions(t *testing.T) {
+	spec := &v1alpha1.TracingPolicySpec{
+		KProbes: []v1alpha1.KProbeSpec{{
+			Call: "security_file_permission",
+			Selectors: []v1alpha1.KProbeSelector{
+				// the events of this selector have the post action
+				{MatchActions: []v1alpha1.ActionSelector{{Action: "Sigkill"}, {Action: "Post"}}},
+				{MatchActions: []v1alpha1.ActionSelector{{Action: "Override"}, {Action: "FollowFD"}}},
+				{Macros: []string{"signal"}},
+			},
+		}, {
+			Call: "security_file_open",
+			Selectors: []v1alpha1.KProbeSelector{
+				{MatchActions: []v1alpha1.ActionSelector{{Action: "NotifyEnforcer"}, {Action: "GetUrl"}}},
+				{MatchActions: []v1alpha1.ActionSelector{{Action: "GetUrl"}}},
+			},
+		}},
+		SelectorsMacros: map[string]v1alpha1.KProbeSelector{
+			"signal": {MatchActions: []v1alpha1.ActionSelector{{Action: "Signal"}, {Action: "CopyFD"}}},
+		},
+	}
+	res := &tetragon.ListTracingPoliciesResponse{Policies: []*tetragon.TracingPolicyStatus{{
+		Name: "monitored",
+		Mode: tetragon.TracingPolicyMode_TP_MODE_MONITOR,
+		Stats: &tetragon.TracingPolicyStats{ActionCounters: &tetragon.TracingPolicyActionCounters{
+			Post:          10,
+			MonitorSignal: 4,
+		}},
+	}, {
+		Name:      "monitored",
+		Namespace: "default",
+		Mode:      tetragon.TracingPolicyMode_TP_MODE_ENFORCE,
+		Stats: &tetragon.TracingPolicyStats{ActionCounters: &tetragon.TracingPolicyActionCounters{
+			MonitorOverride: 1,
+		}},
+	}}}
+	policies := PoliciesFromList(res, map[string][]*v1alpha1.TracingPolicySpec{"monitored": {spec}})
+	require.Contains(t, policies, "monitored")
+	assert.Equal(t, tetragon.TracingPolicyMode_TP_MODE_MONITOR, policies["monitored"].Mode)
+	assert.Equal(t, uint64(5), policies["monitored"].MonitorActions)
+
+	r := newTestRecorderPolicies(t, policies)
+	r.Notify(kprobeEvent("monitored", tetragon.KprobeAction_KPROBE_ACTION_POST, "a", 1, "/bin/cat", "/etc/shadow", 10))
+	r.Notify(kprobeEvent("monitored", tetragon.KprobeAction_KPROBE_ACTION_FOLLOWFD, "a", 1, "/bin/cat", "/etc/shadow", 20))
+	r.Notify(kprobeEvent("monitored", tetragon.KprobeAction_KPROBE_ACTION_COPYFD, "a", 1, "/bin/cat", "/etc/shadow", 30))
+	// ambiguous, a selector without enforcement actions has the same last
+	// action
+	r.Notify(kprobeEvent("monitored", tetragon.KprobeAction_KPROBE_ACTION_GETURL, "a", 1, "/bin/cat", "/etc/shadow", 40))
+
+	rep, err := r.Report(context.Background(), "monitored")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), rep.Count)
+	assert.Equal(t, uint64(5), rep.MonitorActions)
+	actions := make(map[string]uint64)
+	for _, e := range rep.Actions {
+		actions[e.Value] = e.Count
+	}
+	assert.Equal(t, map[string]uint64{
+		"KPROBE_ACTION_SIGKILL":  1,
+		"KPROBE_ACTION_OVERRIDE": 1,
+		"KPROBE_ACTION_SIGNAL":   1,
+	}, actions)
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package policysig
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/tracingpolicy"
+)
+
+// verifier is the verifier used by the agent, nil if verification is disabled
+var verifier *Verifier
+
+// InitFromOptions sets up policy signature verification for the agent. If no
+// public key or trust root is configured, verification is disabled.
+func InitFromOptions() error {
+	if len(option.Config.TracingPolicyVerifyKeys) == 0 && option.Config.TracingPolicyVerifyTrustRoot == "" {
+		verifier = nil
+		return nil
+	}
+
+	v, err := NewVerifier(&Config{
+		KeyFiles:                option.Config.TracingPolicyVerifyKeys,
+		TrustRootFile:           option.Config.TracingPolicyVerifyTrustRoot,
+		TransparencyLogKeyFiles: option.Config.TracingPolicyVerifyTlogKeys,
+		Identities:              option.Config.TracingPolicyVerifyIdentities,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set up tracing policy signature verification: %w", err)
+	}
+	verifier = v
+	return nil
+}
+
+// Enabled returns true if the agent requires policies to be signed.
+func Enabled() bool {
+	return verifier != nil
+}
+
+// VerifyPolicy checks the signature of a policy if the agent requires policies
+// to be signed.
+func VerifyPolicy(tp tracingpolicy.TracingPolicy) error {
+	if verifier == nil {
+		return nil
+	}
+	if err := verifier.Verify(tp); err != nil {
+		return fmt.Errorf("tracing policy signature verification failed: %w", err)
+	}
+	return nil
+}
+
+// IsSidecarFile returns true if the file holds the signature of a policy file.
+func IsSidecarFile(fname string) bool {
+	return hasSuffix(fname, SignatureFileSuffix) || hasSuffix(fname, BundleFileSuffix)
+}
+
+// LoadSidecarFiles reads the signature files of the policy file fname, if
+// any, into the annotations of the policy.
+func LoadSidecarFiles(tp tracingpolicy.TracingPolicy, fname string) error {
+	meta, ok := tp.(interface{ GetObjectMetaStruct() *metav1.ObjectMeta })
+	if !ok {
+		return fmt.Errorf("cannot set the signature of policy %s", tp.TpName())
+	}
+
+	for suffix, annotation := range map[string]string{
+		SignatureFileSuffix: SignatureAnnotation,
+		BundleFileSuffix:    BundleAnnotation,
+	} {
+		data, err := os.ReadFile(fname + suffix)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to read signature of %s: %w", fname, err)
+		}
+		m := meta.GetObjectMetaStruct()
+		if m.Annotations == nil {
+			m.Annotations = make(map[string]string)
+		}
+		m.Annotations[annotation] = string(data)
+	}
+	return nil
+}
+
+func hasSuffix(s, suffix string) bool {
+	return len(s) > len(suffix) && s[len(s)-len(suffix):] == suffix
+}
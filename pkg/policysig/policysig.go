@@ -0,0 +1,487 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package policysig verifies the signatures of tracing policies.
+//
+// Signatures are compatible with cosign's sign-blob command. The signed
+// payload, as returned by Payload, is a canonical JSON document holding the
+// apiVersion, kind, name, namespace and spec of the policy, so that the same
+// signature is valid for a policy loaded from a file and for the same policy
+// loaded from the Kubernetes API server, but not for a policy with another
+// identity. Two kinds of signatures are supported, and both can be verified
+// offline:
+//
+//   - signatures made with a key pair, verified against a set of public keys;
+//   - keyless signatures, provided as a cosign bundle, whose certificate must
+//     chain to a local trust root and whose transparency log entry must be
+//     signed by a local transparency log key.
+package policysig
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/tracingpolicy"
+)
+
+const (
+	// SignatureAnnotation holds the base64 encoded signature of the policy
+	SignatureAnnotation = "tetragon.io/signature"
+	// BundleAnnotation holds a cosign bundle of the policy for keyless signatures
+	BundleAnnotation = "tetragon.io/signature-bundle"
+
+	// SignatureFileSuffix is the suffix of the file holding the signature of
+	// a policy file
+	SignatureFileSuffix = ".sig"
+	// BundleFileSuffix is the suffix of the file holding the cosign bundle of
+	// a policy file
+	BundleFileSuffix = ".bundle"
+)
+
+var (
+	ErrUnsigned = errors.New("policy is not signed")
+)
+
+// Payload returns the payload of a policy that is signed.
+//
+// The spec is taken from the object the policy was decoded from when it is
+// available, and from its typed representation otherwise. In both cases,
+// fields with a zero value are dropped and keys are sorted, so that the
+// payload does not depend on the layout of the Go types.
+func Payload(tp tracingpolicy.TracingPolicy) ([]byte, error) {
+	var src any = tp.TpSpec()
+	if raw, ok := tp.(tracingpolicy.TracingPolicyRaw); ok && raw.RawSpec() != nil {
+		src = raw.RawSpec()
+	}
+	// round-trip through JSON to get a generic representation of the spec
+	data, err := json.Marshal(src)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal policy spec: %w", err)
+	}
+	var spec any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&spec); err != nil {
+		return nil, fmt.Errorf("failed to decode policy spec: %w", err)
+	}
+
+	apiVersion := v1alpha1.SchemeGroupVersion.String()
+	if obj, ok := tp.(interface{ GetObjectKind() schema.ObjectKind }); ok {
+		if gvk := obj.GetObjectKind().GroupVersionKind(); gvk.Version != "" {
+			apiVersion = gvk.GroupVersion().String()
+		}
+	}
+	kind := v1alpha1.TPKindDefinition
+	metadata := map[string]any{"name": tp.TpName()}
+	if tpns, ok := tp.(tracingpolicy.TracingPolicyNamespaced); ok {
+		kind = v1alpha1.TPNamespacedKindDefinition
+		metadata["namespace"] = tpns.TpNamespace()
+	}
+
+	// encoding/json sorts map keys, which makes the document canonical
+	return json.Marshal(map[string]any{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   metadata,
+		"spec":       pruneZero(spec),
+	})
+}
+
+// pruneZero removes the null, false, zero, empty string, empty list and empty
+// object values from a decoded JSON value. It returns nil if the value itself
+// is a zero value.
+func pruneZero(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		ret := make(map[string]any, len(v))
+		for k, e := range v {
+			if e = pruneZero(e); e != nil {
+				ret[k] = e
+			}
+		}
+		if len(ret) == 0 {
+			return nil
+		}
+		return ret
+	case []any:
+		if len(v) == 0 {
+			return nil
+		}
+		// keep the list elements, even zero ones, so that their position
+		// is preserved
+		ret := make([]any, len(v))
+		for i, e := range v {
+			if e = pruneZero(e); e == nil {
+				e = zeroOf(v[i])
+			}
+			ret[i] = e
+		}
+		return ret
+	case json.Number:
+		if f, err := v.Float64(); err == nil && f == 0 {
+			return nil
+		}
+		return v
+	case string:
+		if v == "" {
+			return nil
+		}
+		return v
+	case bool:
+		if !v {
+			return nil
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// zeroOf returns the canonical zero value of the type of a decoded JSON value.
+func zeroOf(v any) any {
+	switch v.(type) {
+	case map[string]any:
+		return map[string]any{}
+	case []any:
+		return []any{}
+	case json.Number:
+		return json.Number("0")
+	case string:
+		return ""
+	case bool:
+		return false
+	default:
+		return nil
+	}
+}
+
+// Config is the configuration of a Verifier.
+type Config struct {
+	// KeyFiles are PEM files holding the public keys that are trusted to
+	// sign policies.
+	KeyFiles []string
+	// TrustRootFile is a PEM file holding the certificates of the
+	// certificate authorities that are trusted to issue keyless signing
+	// certificates.
+	TrustRootFile string
+	// TransparencyLogKeyFiles are PEM files holding the public keys of the
+	// transparency logs where keyless signatures are recorded.
+	TransparencyLogKeyFiles []string
+	// Identities are the identities (email or URI subject alternative
+	// names) that are trusted to sign policies with keyless signatures. If
+	// empty, any identity is trusted.
+	Identities []string
+}
+
+// Verifier verifies the signatures of tracing policies.
+type Verifier struct {
+	keys          []crypto.PublicKey
+	roots         *x509.CertPool
+	intermediates *x509.CertPool
+	tlogKeys      []crypto.PublicKey
+	identities    []string
+}
+
+// NewVerifier creates a Verifier from its configuration.
+func NewVerifier(conf *Config) (*Verifier, error) {
+	v := &Verifier{identities: conf.Identities}
+	for _, f := range conf.KeyFiles {
+		keys, err := loadPublicKeys(f)
+		if err != nil {
+			return nil, err
+		}
+		v.keys = append(v.keys, keys...)
+	}
+
+	if conf.TrustRootFile != "" {
+		if len(conf.TransparencyLogKeyFiles) == 0 {
+			return nil, errors.New("keyless verification requires a transparency log key")
+		}
+		data, err := os.ReadFile(conf.TrustRootFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read trust root: %w", err)
+		}
+		certs, err := parseCertificates(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse trust root %s: %w", conf.TrustRootFile, err)
+		}
+		v.roots = x509.NewCertPool()
+		v.intermediates = x509.NewCertPool()
+		for _, cert := range certs {
+			if bytes.Equal(cert.RawIssuer, cert.RawSubject) {
+				v.roots.AddCert(cert)
+			} else {
+				v.intermediates.AddCert(cert)
+			}
+		}
+		for _, f := range conf.TransparencyLogKeyFiles {
+			keys, err := loadPublicKeys(f)
+			if err != nil {
+				return nil, err
+			}
+			v.tlogKeys = append(v.tlogKeys, keys...)
+		}
+	}
+
+	if len(v.keys) == 0 && v.roots == nil {
+		return nil, errors.New("no public keys or trust root configured")
+	}
+	return v, nil
+}
+
+// Verify checks that the policy carries a valid signature, either in its
+// annotations or in a sidecar file loaded by LoadSidecarFiles.
+func (v *Verifier) Verify(tp tracingpolicy.TracingPolicy) error {
+	payload, err := Payload(tp)
+	if err != nil {
+		return fmt.Errorf("failed to compute policy payload: %w", err)
+	}
+
+	annotations := tracingpolicy.Annotations(tp)
+	if bundle, ok := annotations[BundleAnnotation]; ok {
+		return v.verifyBundle(payload, []byte(bundle))
+	}
+	if sig, ok := annotations[SignatureAnnotation]; ok {
+		return v.verifySignature(payload, sig)
+	}
+	return ErrUnsigned
+}
+
+func (v *Verifier) verifySignature(payload []byte, b64Sig string) error {
+	if len(v.keys) == 0 {
+		return errors.New("no public keys configured to verify the policy signature")
+	}
+	sig, err := base64.StdEncoding.DecodeString(strings.TrimSpace(b64Sig))
+	if err != nil {
+		return fmt.Errorf("failed to decode signature: %w", err)
+	}
+	for _, key := range v.keys {
+		if verify(key, payload, sig) == nil {
+			return nil
+		}
+	}
+	return errors.New("invalid signature: policy was not signed by a trusted key or was modified")
+}
+
+// bundle is the bundle written by cosign sign-blob --bundle.
+type bundle struct {
+	Base64Signature string `json:"base64Signature"`
+	Cert            string `json:"cert"`
+	RekorBundle     *struct {
+		SignedEntryTimestamp []byte       `json:"SignedEntryTimestamp"`
+		Payload              rekorPayload `json:"Payload"`
+	} `json:"rekorBundle"`
+}
+
+type rekorPayload struct {
+	Body           string `json:"body"`
+	IntegratedTime int64  `json:"integratedTime"`
+	LogIndex       int64  `json:"logIndex"`
+	LogID          string `json:"logID"`
+}
+
+// hashedRekord is the body of a transparency log entry for a signed blob.
+type hashedRekord struct {
+	Kind string `json:"kind"`
+	Spec struct {
+		Data struct {
+			Hash struct {
+				Algorithm string `json:"algorithm"`
+				Value     string `json:"value"`
+			} `json:"hash"`
+		} `json:"data"`
+		Signature struct {
+			Content   string `json:"content"`
+			PublicKey struct {
+				Content string `json:"content"`
+			} `json:"publicKey"`
+		} `json:"signature"`
+	} `json:"spec"`
+}
+
+func (v *Verifier) verifyBundle(payload []byte, data []byte) error {
+	if v.roots == nil {
+		return errors.New("no trust root configured to verify the policy signature bundle")
+	}
+
+	var b bundle
+	if err := json.Unmarshal(data, &b); err != nil {
+		return fmt.Errorf("failed to parse signature bundle: %w", err)
+	}
+	if b.RekorBundle == nil {
+		return errors.New("signature bundle has no transparency log entry")
+	}
+
+	// the transparency log entry must be signed by a trusted log, and it
+	// must record this signature of this payload
+	if err := v.verifyTlogEntry(b.RekorBundle.SignedEntryTimestamp, &b.RekorBundle.Payload); err != nil {
+		return err
+	}
+	body, err := base64.StdEncoding.DecodeString(b.RekorBundle.Payload.Body)
+	if err != nil {
+		return fmt.Errorf("failed to decode transparency log entry: %w", err)
+	}
+	var rekord hashedRekord
+	if err := json.Unmarshal(body, &rekord); err != nil {
+		return fmt.Errorf("failed to parse transparency log entry: %w", err)
+	}
+	digest := sha256.Sum256(payload)
+	if rekord.Kind != "hashedrekord" ||
+		rekord.Spec.Data.Hash.Algorithm != "sha256" ||
+		rekord.Spec.Data.Hash.Value != hex.EncodeToString(digest[:]) ||
+		rekord.Spec.Signature.Content != b.Base64Signature ||
+		rekord.Spec.Signature.PublicKey.Content != b.Cert {
+		return errors.New("transparency log entry does not match the policy signature")
+	}
+
+	// the certificate must be valid when the signature was recorded
+	certPEM, err := base64.StdEncoding.DecodeString(b.Cert)
+	if err != nil {
+		return fmt.Errorf("failed to decode signing certificate: %w", err)
+	}
+	certs, err := parseCertificates(certPEM)
+	if err != nil || len(certs) == 0 {
+		return fmt.Errorf("failed to parse signing certificate: %w", err)
+	}
+	cert := certs[0]
+	_, err = cert.Verify(x509.VerifyOptions{
+		Roots:         v.roots,
+		Intermediates: v.intermediates,
+		CurrentTime:   time.Unix(b.RekorBundle.Payload.IntegratedTime, 0),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	})
+	if err != nil {
+		return fmt.Errorf("signing certificate is not trusted: %w", err)
+	}
+	if !v.trustedIdentity(cert) {
+		return errors.New("signing certificate identity is not trusted")
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(b.Base64Signature)
+	if err != nil {
+		return fmt.Errorf("failed to decode signature: %w", err)
+	}
+	if err := verify(cert.PublicKey, payload, sig); err != nil {
+		return errors.New("invalid signature: policy was modified")
+	}
+	return nil
+}
+
+func (v *Verifier) verifyTlogEntry(set []byte, p *rekorPayload) error {
+	// The signed entry timestamp is a signature of the canonical JSON
+	// encoding of the payload. encoding/json sorts the keys of maps and does
+	// not add whitespace, which is the canonical encoding for these fields.
+	canonical, err := json.Marshal(map[string]any{
+		"body":           p.Body,
+		"integratedTime": p.IntegratedTime,
+		"logIndex":       p.LogIndex,
+		"logID":          p.LogID,
+	})
+	if err != nil {
+		return err
+	}
+	for _, key := range v.tlogKeys {
+		if verify(key, canonical, set) == nil {
+			return nil
+		}
+	}
+	return errors.New("transparency log entry is not signed by a trusted log")
+}
+
+func (v *Verifier) trustedIdentity(cert *x509.Certificate) bool {
+	if len(v.identities) == 0 {
+		return true
+	}
+	if slices.ContainsFunc(cert.EmailAddresses, func(e string) bool { return slices.Contains(v.identities, e) }) {
+		return true
+	}
+	for _, uri := range cert.URIs {
+		if slices.Contains(v.identities, uri.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+// verify checks a signature the same way cosign does for the given key type.
+func verify(key crypto.PublicKey, payload, sig []byte) error {
+	digest := sha256.Sum256(payload)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, digest[:], sig) {
+			return errors.New("invalid ECDSA signature")
+		}
+		return nil
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, payload, sig) {
+			return errors.New("invalid ed25519 signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig)
+	}
+	return fmt.Errorf("unsupported key type %T", key)
+}
+
+func loadPublicKeys(fname string) ([]crypto.PublicKey, error) {
+	data, err := os.ReadFile(fname)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read public key: %w", err)
+	}
+
+	var keys []crypto.PublicKey
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse public key %s: %w", fname, err)
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no public key found in %s", fname)
+	}
+	return keys, nil
+}
+
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no certificate found")
+	}
+	return certs, nil
+}
@@ -0,0 +1,359 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package policysig
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cilium/tetragon/pkg/crdutils"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/tracingpolicy"
+)
+
+const testPolicy = `
+apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "sys-write"
+spec:
+  kprobes:
+  - call: "sys_write"
+    syscall: true
+`
+
+func writePEM(t *testing.T, typ string, blocks ...[]byte) string {
+	fname := filepath.Join(t.TempDir(), "file.pem")
+	var data []byte
+	for _, b := range blocks {
+		data = append(data, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: b})...)
+	}
+	require.NoError(t, os.WriteFile(fname, data, 0o600))
+	return fname
+}
+
+func writePublicKey(t *testing.T, key crypto.PublicKey) string {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	require.NoError(t, err)
+	return writePEM(t, "PUBLIC KEY", der)
+}
+
+func loadPolicy(t *testing.T, yaml string, annotations map[string]string) tracingpolicy.TracingPolicy {
+	tp, err := tracingpolicy.FromYAML(yaml)
+	require.NoError(t, err)
+	if annotations != nil {
+		tp.(crdutils.CRDObject).GetObjectMetaStruct().Annotations = annotations
+	}
+	return tp
+}
+
+func signECDSA(t *testing.T, key *ecdsa.PrivateKey, payload []byte) []byte {
+	digest := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	require.NoError(t, err)
+	return sig
+}
+
+func TestVerifyKey(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	edPub, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	v, err := NewVerifier(&Config{
+		KeyFiles: []string{writePublicKey(t, &ecKey.PublicKey), writePublicKey(t, edPub)},
+	})
+	require.NoError(t, err)
+
+	payload, err := Payload(loadPolicy(t, testPolicy, nil))
+	require.NoError(t, err)
+
+	// signed with a trusted ECDSA key
+	sig := base64.StdEncoding.EncodeToString(signECDSA(t, ecKey, payload))
+	require.NoError(t, v.Verify(loadPolicy(t, testPolicy, map[string]string{SignatureAnnotation: sig})))
+
+	// signed with a trusted ed25519 key
+	edSig := base64.StdEncoding.EncodeToString(ed25519.Sign(edKey, payload))
+	require.NoError(t, v.Verify(loadPolicy(t, testPolicy, map[string]string{SignatureAnnotation: edSig})))
+
+	// signed with an untrusted key
+	otherSig := base64.StdEncoding.EncodeToString(signECDSA(t, otherKey, payload))
+	require.Error(t, v.Verify(loadPolicy(t, testPolicy, map[string]string{SignatureAnnotation: otherSig})))
+
+	// tampered policy
+	tampered := testPolicy + `  - call: "sys_read"
+    syscall: true
+`
+	require.Error(t, v.Verify(loadPolicy(t, tampered, map[string]string{SignatureAnnotation: sig})))
+
+	// unsigned policy
+	require.ErrorIs(t, v.Verify(loadPolicy(t, testPolicy, nil)), ErrUnsigned)
+}
+
+func TestPayload(t *testing.T) {
+	tp := loadPolicy(t, testPolicy, nil)
+	payload, err := Payload(tp)
+	require.NoError(t, err)
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(payload, &doc))
+	require.Equal(t, "cilium.io/v1alpha1", doc["apiVersion"])
+	require.Equal(t, "TracingPolicy", doc["kind"])
+	require.Equal(t, map[string]any{"name": "sys-write"}, doc["metadata"])
+
+	// the same policy received from the API server has the same payload,
+	// even though its spec is only available as a typed object
+	k8sTp := &v1alpha1.TracingPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "sys-write"},
+		Spec:       *tp.TpSpec(),
+	}
+	k8sPayload, err := Payload(k8sTp)
+	require.NoError(t, err)
+	require.JSONEq(t, string(payload), string(k8sPayload))
+
+	// fields with a zero value do not change the payload
+	k8sTp.Spec.KProbes[0].Message = ""
+	k8sTp.Spec.KProbes[0].Selectors = []v1alpha1.KProbeSelector{}
+	k8sPayload, err = Payload(k8sTp)
+	require.NoError(t, err)
+	require.JSONEq(t, string(payload), string(k8sPayload))
+}
+
+func TestPayloadIdentity(t *testing.T) {
+	const nsPolicy = `
+apiVersion: cilium.io/v1alpha1
+kind: TracingPolicyNamespaced
+metadata:
+  name: "sys-write"
+  namespace: "ns1"
+spec:
+  kprobes:
+  - call: "sys_write"
+    syscall: true
+`
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	v, err := NewVerifier(&Config{KeyFiles: []string{writePublicKey(t, &ecKey.PublicKey)}})
+	require.NoError(t, err)
+
+	payload, err := Payload(loadPolicy(t, nsPolicy, nil))
+	require.NoError(t, err)
+	sig := map[string]string{
+		SignatureAnnotation: base64.StdEncoding.EncodeToString(signECDSA(t, ecKey, payload)),
+	}
+	require.NoError(t, v.Verify(loadPolicy(t, nsPolicy, sig)))
+
+	// the signature cannot be replayed on a policy with another identity
+	for _, replayed := range []string{
+		strings.Replace(nsPolicy, `namespace: "ns1"`, `namespace: "ns2"`, 1),
+		strings.Replace(nsPolicy, `name: "sys-write"`, `name: "sys-write2"`, 1),
+		strings.Replace(strings.Replace(nsPolicy, `kind: TracingPolicyNamespaced`, `kind: TracingPolicy`, 1),
+			`  namespace: "ns1"
+`, "", 1),
+	} {
+		require.Error(t, v.Verify(loadPolicy(t, replayed, sig)))
+	}
+}
+
+func TestLoadSidecarFiles(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	v, err := NewVerifier(&Config{KeyFiles: []string{writePublicKey(t, &ecKey.PublicKey)}})
+	require.NoError(t, err)
+
+	fname := filepath.Join(t.TempDir(), "policy.yaml")
+	require.NoError(t, os.WriteFile(fname, []byte(testPolicy), 0o600))
+	tp, err := tracingpolicy.FromFile(fname)
+	require.NoError(t, err)
+	require.NoError(t, LoadSidecarFiles(tp, fname))
+	require.ErrorIs(t, v.Verify(tp), ErrUnsigned)
+
+	payload, err := Payload(tp)
+	require.NoError(t, err)
+	sig := base64.StdEncoding.EncodeToString(signECDSA(t, ecKey, payload))
+	require.NoError(t, os.WriteFile(fname+SignatureFileSuffix, []byte(sig+"\n"), 0o600))
+	require.NoError(t, LoadSidecarFiles(tp, fname))
+	require.NoError(t, v.Verify(tp))
+
+	require.True(t, IsSidecarFile(fname+SignatureFileSuffix))
+	require.True(t, IsSidecarFile(fname+BundleFileSuffix))
+	require.False(t, IsSidecarFile(fname))
+}
+
+type keylessSetup struct {
+	rootFile  string
+	tlogFile  string
+	leafKey   *ecdsa.PrivateKey
+	leafCert  []byte
+	tlogKey   *ecdsa.PrivateKey
+	notBefore time.Time
+}
+
+func newKeylessSetup(t *testing.T, email string) *keylessSetup {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	notBefore := time.Now().Add(-time.Hour)
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	// keyless signing certificates are short lived
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	leafTmpl := &x509.Certificate{
+		SerialNumber:   big.NewInt(2),
+		NotBefore:      notBefore,
+		NotAfter:       notBefore.Add(10 * time.Minute),
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:    []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		EmailAddresses: []string{email},
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leafTmpl, caCert, &leafKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	tlogKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	return &keylessSetup{
+		rootFile:  writePEM(t, "CERTIFICATE", caDER),
+		tlogFile:  writePublicKey(t, &tlogKey.PublicKey),
+		leafKey:   leafKey,
+		leafCert:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER}),
+		tlogKey:   tlogKey,
+		notBefore: notBefore,
+	}
+}
+
+// bundle creates a cosign bundle for the payload, as cosign sign-blob does
+func (s *keylessSetup) bundle(t *testing.T, payload []byte, integratedTime time.Time) string {
+	sig := base64.StdEncoding.EncodeToString(signECDSA(t, s.leafKey, payload))
+	cert := base64.StdEncoding.EncodeToString(s.leafCert)
+	digest := sha256.Sum256(payload)
+
+	var rekord hashedRekord
+	rekord.Kind = "hashedrekord"
+	rekord.Spec.Data.Hash.Algorithm = "sha256"
+	rekord.Spec.Data.Hash.Value = hex.EncodeToString(digest[:])
+	rekord.Spec.Signature.Content = sig
+	rekord.Spec.Signature.PublicKey.Content = cert
+	body, err := json.Marshal(rekord)
+	require.NoError(t, err)
+
+	p := rekorPayload{
+		Body:           base64.StdEncoding.EncodeToString(body),
+		IntegratedTime: integratedTime.Unix(),
+		LogIndex:       42,
+		LogID:          "c0d23d6ad406973f9559f3ba2d1ca01f84147d8ffc5b8445c224f98b9591801d",
+	}
+	canonical, err := json.Marshal(map[string]any{
+		"body":           p.Body,
+		"integratedTime": p.IntegratedTime,
+		"logIndex":       p.LogIndex,
+		"logID":          p.LogID,
+	})
+	require.NoError(t, err)
+
+	b := map[string]any{
+		"base64Signature": sig,
+		"cert":            cert,
+		"rekorBundle": map[string]any{
+			"SignedEntryTimestamp": signECDSA(t, s.tlogKey, canonical),
+			"Payload":              p,
+		},
+	}
+	data, err := json.Marshal(b)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestVerifyBundle(t *testing.T) {
+	s := newKeylessSetup(t, "alice@example.com")
+	payload, err := Payload(loadPolicy(t, testPolicy, nil))
+	require.NoError(t, err)
+	signedAt := s.notBefore.Add(time.Minute)
+	bundle := s.bundle(t, payload, signedAt)
+
+	v, err := NewVerifier(&Config{
+		TrustRootFile:           s.rootFile,
+		TransparencyLogKeyFiles: []string{s.tlogFile},
+		Identities:              []string{"alice@example.com"},
+	})
+	require.NoError(t, err)
+
+	// the signing certificate has expired, but was valid when the
+	// signature was recorded
+	require.NoError(t, v.Verify(loadPolicy(t, testPolicy, map[string]string{BundleAnnotation: bundle})))
+
+	// tampered policy
+	tampered := testPolicy + `  - call: "sys_read"
+    syscall: true
+`
+	require.Error(t, v.Verify(loadPolicy(t, tampered, map[string]string{BundleAnnotation: bundle})))
+
+	// signature recorded after the certificate expired
+	late := s.bundle(t, payload, s.notBefore.Add(time.Hour))
+	require.Error(t, v.Verify(loadPolicy(t, testPolicy, map[string]string{BundleAnnotation: late})))
+
+	// untrusted identity
+	v2, err := NewVerifier(&Config{
+		TrustRootFile:           s.rootFile,
+		TransparencyLogKeyFiles: []string{s.tlogFile},
+		Identities:              []string{"bob@example.com"},
+	})
+	require.NoError(t, err)
+	require.Error(t, v2.Verify(loadPolicy(t, testPolicy, map[string]string{BundleAnnotation: bundle})))
+
+	// untrusted transparency log
+	other := newKeylessSetup(t, "alice@example.com")
+	v3, err := NewVerifier(&Config{
+		TrustRootFile:           s.rootFile,
+		TransparencyLogKeyFiles: []string{other.tlogFile},
+	})
+	require.NoError(t, err)
+	require.Error(t, v3.Verify(loadPolicy(t, testPolicy, map[string]string{BundleAnnotation: bundle})))
+
+	// certificate issued by an untrusted authority
+	otherBundle := other.bundle(t, payload, other.notBefore.Add(time.Minute))
+	require.Error(t, v.Verify(loadPolicy(t, testPolicy, map[string]string{BundleAnnotation: otherBundle})))
+}
+
+func TestNewVerifier(t *testing.T) {
+	_, err := NewVerifier(&Config{})
+	require.Error(t, err)
+
+	s := newKeylessSetup(t, "alice@example.com")
+	_, err = NewVerifier(&Config{TrustRootFile: s.rootFile})
+	require.Error(t, err)
+}
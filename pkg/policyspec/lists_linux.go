@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package policyspec
+
+import (
+	"fmt"
+	"strings"
+
+	gt "github.com/cilium/tetragon/pkg/generictypes"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+// IsList checks if a value specifies a list, and if so it returns it (or nil if list does not exist)
+func IsList(val string, lists []v1alpha1.ListSpec) (bool, *v1alpha1.ListSpec) {
+	name, found := strings.CutPrefix(val, "list:")
+	if !found {
+		return false, nil
+	}
+	for idx := range lists {
+		list := &lists[idx]
+		if list.Name == name {
+			return true, list
+		}
+	}
+	return true, nil
+}
+
+const (
+	ListTypeInvalid           = -1
+	ListTypeNone              = 0
+	ListTypeSyscalls          = 1
+	ListTypeGeneratedSyscalls = 2
+	ListTypeGeneratedFtrace   = 3
+
+	Is32Bit = 0x80000000
+)
+
+var listTypeTable = map[string]uint32{
+	"":                   ListTypeNone,
+	"syscalls":           ListTypeSyscalls,
+	"generated_syscalls": ListTypeGeneratedSyscalls,
+	"generated_ftrace":   ListTypeGeneratedFtrace,
+}
+
+func ListTypeFromString(s string) int32 {
+	typ, ok := listTypeTable[strings.ToLower(s)]
+	if !ok {
+		return ListTypeInvalid
+	}
+	return int32(typ)
+}
+
+func IsSyscallListType(typ string) bool {
+	return ListTypeFromString(typ) == ListTypeSyscalls ||
+		ListTypeFromString(typ) == ListTypeGeneratedSyscalls
+}
+
+// ValidateListSpec checks a list without generating its values, so that it
+// can be used where the kernel the list applies to is not available.
+func ValidateListSpec(list *v1alpha1.ListSpec) error {
+	switch ListTypeFromString(list.Type) {
+	case ListTypeInvalid:
+		return fmt.Errorf("invalid list type: %s", list.Type)
+	case ListTypeGeneratedSyscalls:
+		if len(list.Values) != 0 {
+			return fmt.Errorf("error generated list '%s' has values", list.Name)
+		}
+	case ListTypeGeneratedFtrace:
+		if len(list.Values) != 0 {
+			return fmt.Errorf("error generated list '%s' has values", list.Name)
+		}
+		if list.Pattern == nil || *(list.Pattern) == "" {
+			return fmt.Errorf("error generated ftrace list '%s' must specify pattern", list.Name)
+		}
+	}
+	return nil
+}
+
+// ListReader reads the syscall IDs of the syscall lists of a policy, for the
+// selectors that reference them.
+type ListReader struct {
+	Lists []v1alpha1.ListSpec
+}
+
+func (lr *ListReader) Read(name string, ty uint32) ([]uint32, error) {
+	list := func() *v1alpha1.ListSpec {
+		for idx := range lr.Lists {
+			if lr.Lists[idx].Name == name {
+				return &lr.Lists[idx]
+			}
+		}
+		return nil
+	}()
+
+	if list == nil {
+		return []uint32{}, fmt.Errorf("error list '%s' not found", name)
+	}
+	if !IsSyscallListType(list.Type) {
+		return []uint32{}, fmt.Errorf("error list '%s' is not syscall type", name)
+	}
+	if ty != gt.GenericSyscall64 {
+		return []uint32{}, fmt.Errorf("error list '%s' argument type is not syscall64", name)
+	}
+
+	var res []uint32
+	for _, val := range list.Values {
+		id, err := SyscallVal(val).ID()
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, uint32(id))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package policyspec
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+// AppendMacrosSelectors expands the macros referenced by the selectors.
+func AppendMacrosSelectors(selectors []v1alpha1.KProbeSelector, macros map[string]v1alpha1.KProbeSelector) error {
+	for i := range selectors {
+		selector := &selectors[i]
+		for _, macroName := range selector.Macros {
+			if len(macros) == 0 {
+				return fmt.Errorf("macro '%s' is used in selector, but no macros were defined in policy spec", macroName)
+			}
+			macro, ok := macros[macroName]
+			if !ok {
+				return fmt.Errorf("undefined macro '%s'", macroName)
+			}
+			if len(macro.Macros) > 0 {
+				return errors.New("macro definition cannot use other macros")
+			}
+
+			var err error
+			selector.MatchPIDs, err = useMacro(selector.MatchPIDs, macro.MatchPIDs)
+			if err != nil {
+				return err
+			}
+
+			selector.MatchArgs, err = useMacro(selector.MatchArgs, macro.MatchArgs)
+			if err != nil {
+				return err
+			}
+
+			selector.MatchData, err = useMacro(selector.MatchData, macro.MatchData)
+			if err != nil {
+				return err
+			}
+
+			selector.MatchActions, err = useMacro(selector.MatchActions, macro.MatchActions)
+			if err != nil {
+				return err
+			}
+
+			selector.MatchReturnArgs, err = useMacro(selector.MatchReturnArgs, macro.MatchReturnArgs)
+			if err != nil {
+				return err
+			}
+
+			selector.MatchReturnActions, err = useMacro(selector.MatchReturnActions, macro.MatchReturnActions)
+			if err != nil {
+				return err
+			}
+
+			selector.MatchBinaries, err = useMacro(selector.MatchBinaries, macro.MatchBinaries)
+			if err != nil {
+				return err
+			}
+
+			selector.MatchParentBinaries, err = useMacro(selector.MatchParentBinaries, macro.MatchParentBinaries)
+			if err != nil {
+				return err
+			}
+
+			selector.MatchNamespaces, err = useMacro(selector.MatchNamespaces, macro.MatchNamespaces)
+			if err != nil {
+				return err
+			}
+
+			selector.MatchNamespaceChanges, err = useMacro(selector.MatchNamespaceChanges, macro.MatchNamespaceChanges)
+			if err != nil {
+				return err
+			}
+
+			selector.MatchCapabilities, err = useMacro(selector.MatchCapabilities, macro.MatchCapabilities)
+			if err != nil {
+				return err
+			}
+
+			selector.MatchCapabilityChanges, err = useMacro(selector.MatchCapabilityChanges, macro.MatchCapabilityChanges)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func useMacro[T any](filters []T, macrosFilters []T) ([]T, error) {
+	if len(filters) > 0 && len(macrosFilters) > 0 {
+		return nil, fmt.Errorf("%T: field is defined in multiple macros and/or policy selectors", filters[0])
+	}
+	return append(filters, macrosFilters...), nil
+}
@@ -1,9 +1,7 @@
 // SPDX-License-Identifier: Apache-2.0
 // Copyright Authors of Tetragon
 
-//go:build !windows
-
-package tracing
+package policyspec
 
 import (
 	"fmt"
@@ -17,65 +15,84 @@ import (
 
 type OverrideMethod int
 
+type KprobeAttachType int
+
 const (
-	keyOverrideMethod = "override-method"
-	valFmodRet        = "fmod-ret"
-	valOverrideReturn = "override-return"
+	KeyOverrideMethod = "override-method"
+	ValFmodRet        = "fmod-ret"
+	ValOverrideReturn = "override-return"
+	ValLsm            = "lsm"
 	keyPolicyMode     = "policy-mode"
+	keyPolicyPriority = "policy-priority"
+	keyKprobeAttach   = "kprobe-attach-type"
+	valKprobe         = "kprobe"
+	valFentry         = "fentry"
 )
 
 const (
 	OverrideMethodDefault OverrideMethod = iota
 	OverrideMethodReturn
 	OverrideMethodFmodRet
+	OverrideMethodLsm
 	OverrideMethodInvalid
 )
 
+const (
+	KprobeAttachTypeKprobe KprobeAttachType = iota
+	KprobeAttachTypeFentry
+)
+
 func overrideMethodParse(s string) OverrideMethod {
 	switch s {
-	case valFmodRet:
+	case ValFmodRet:
 		return OverrideMethodFmodRet
-	case valOverrideReturn:
+	case ValOverrideReturn:
 		return OverrideMethodReturn
+	case ValLsm:
+		return OverrideMethodLsm
 	default:
 		return OverrideMethodInvalid
 	}
 }
 
-type specOptions struct {
+// SpecOptions are the options of a tracing policy, set in its spec.
+type SpecOptions struct {
 	DisableKprobeMulti bool
 	DisableUprobeMulti bool
 	OverrideMethod     OverrideMethod
-	policyMode         policyconf.Mode
+	KprobeAttachType   KprobeAttachType
+	PolicyMode         policyconf.Mode
+	PolicyPriority     policyconf.Priority
 }
 
 type opt struct {
-	set func(val string, options *specOptions) error
+	set func(val string, options *SpecOptions) error
 }
 
-func newDefaultSpecOptions() *specOptions {
-	return &specOptions{
+func newDefaultSpecOptions() *SpecOptions {
+	return &SpecOptions{
 		DisableKprobeMulti: false,
 		OverrideMethod:     OverrideMethodDefault,
+		KprobeAttachType:   KprobeAttachTypeKprobe,
 	}
 }
 
 // Allowed kprobe options
 var opts = map[string]opt{
 	option.KeyDisableKprobeMulti: {
-		set: func(str string, options *specOptions) (err error) {
+		set: func(str string, options *SpecOptions) (err error) {
 			options.DisableKprobeMulti, err = strconv.ParseBool(str)
 			return err
 		},
 	},
 	option.KeyDisableUprobeMulti: {
-		set: func(str string, options *specOptions) (err error) {
+		set: func(str string, options *SpecOptions) (err error) {
 			options.DisableUprobeMulti, err = strconv.ParseBool(str)
 			return err
 		},
 	},
-	keyOverrideMethod: {
-		set: func(str string, options *specOptions) (err error) {
+	KeyOverrideMethod: {
+		set: func(str string, options *SpecOptions) (err error) {
 			m := overrideMethodParse(str)
 			if m == OverrideMethodInvalid {
 				return fmt.Errorf("invalid override method: '%s'", str)
@@ -84,19 +101,39 @@ var opts = map[string]opt{
 			return nil
 		},
 	},
+	keyKprobeAttach: {
+		set: func(str string, options *SpecOptions) (err error) {
+			switch str {
+			case valKprobe:
+				options.KprobeAttachType = KprobeAttachTypeKprobe
+			case valFentry:
+				options.KprobeAttachType = KprobeAttachTypeFentry
+			default:
+				return fmt.Errorf("invalid kprobe attach type: '%s'", str)
+			}
+			return nil
+		},
+	},
 	keyPolicyMode: {
-		set: func(str string, options *specOptions) (err error) {
+		set: func(str string, options *SpecOptions) (err error) {
 			mode, err := policyconf.ParseMode(str)
 			if err != nil {
 				return err
 			}
-			options.policyMode = mode
+			options.PolicyMode = mode
 			return nil
 		},
 	},
+	keyPolicyPriority: {
+		set: func(str string, options *SpecOptions) (err error) {
+			options.PolicyPriority, err = policyconf.ParsePriority(str)
+			return err
+		},
+	},
 }
 
-func getSpecOptions(specs []v1alpha1.OptionSpec) (*specOptions, error) {
+// GetSpecOptions parses the options of a tracing policy spec.
+func GetSpecOptions(specs []v1alpha1.OptionSpec) (*SpecOptions, error) {
 	options := newDefaultSpecOptions()
 	for _, spec := range specs {
 		opt, ok := opts[spec.Name]
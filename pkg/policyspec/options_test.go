@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package policyspec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+func TestSpecOptionsKprobeAttachType(t *testing.T) {
+	opts, err := GetSpecOptions(nil)
+	require.NoError(t, err)
+	require.Equal(t, KprobeAttachTypeKprobe, opts.KprobeAttachType)
+
+	opts, err = GetSpecOptions([]v1alpha1.OptionSpec{{Name: "kprobe-attach-type", Value: "fentry"}})
+	require.NoError(t, err)
+	require.Equal(t, KprobeAttachTypeFentry, opts.KprobeAttachType)
+
+	opts, err = GetSpecOptions([]v1alpha1.OptionSpec{{Name: "kprobe-attach-type", Value: "kprobe"}})
+	require.NoError(t, err)
+	require.Equal(t, KprobeAttachTypeKprobe, opts.KprobeAttachType)
+
+	_, err = GetSpecOptions([]v1alpha1.OptionSpec{{Name: "kprobe-attach-type", Value: "uprobe"}})
+	require.Error(t, err)
+}
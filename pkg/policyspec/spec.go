@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package policyspec implements the parts of tracing policy handling that only
+// depend on the policy spec, so that they can be used outside of the agent.
+package policyspec
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+var ErrMultipleSections = errors.New("tracing policies with multiple sections of kprobes, tracepoints, lsm hooks, uprobes, usdts or perf events are currently not supported")
+
+// SpecSections returns the number of hook sections of a spec.
+func SpecSections(spec *v1alpha1.TracingPolicySpec) int {
+	sections := 0
+	for _, n := range []int{
+		len(spec.KProbes),
+		len(spec.Tracepoints),
+		len(spec.LsmHooks),
+		len(spec.UProbes),
+		len(spec.Usdts),
+		len(spec.PerfEvents),
+	} {
+		if n > 0 {
+			sections++
+		}
+	}
+	return sections
+}
+
+// ValidateKprobeType checks that an argument type can be used with kprobes.
+func ValidateKprobeType(ty string, autoTypes bool) error {
+	invalidArgTypes := []string{"syscall64"}
+	// auto types are resolved from BTF, only for policies attached with
+	// fentry programs which have typed arguments.
+	if !autoTypes {
+		invalidArgTypes = append(invalidArgTypes, "auto")
+	}
+	if slices.Contains(invalidArgTypes, ty) {
+		return fmt.Errorf("type '%s' is invalid for kprobes", ty)
+	}
+	return nil
+}
+
+// PerfEventStacks returns which stacks a perf event collects.
+func PerfEventStacks(pe *v1alpha1.PerfEventSpec) (kernel, user bool, err error) {
+	switch pe.Stacks {
+	case "", "all":
+		return true, true, nil
+	case "user":
+		return false, true, nil
+	case "kernel":
+		return true, false, nil
+	}
+	return false, false, fmt.Errorf("perf event %q: invalid stacks %q, expected all, user or kernel", pe.Name, pe.Stacks)
+}
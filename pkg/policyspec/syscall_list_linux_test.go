@@ -1,9 +1,7 @@
 // SPDX-License-Identifier: Apache-2.0
 // Copyright Authors of Tetragon
 
-//go:build !windows
-
-package tracing
+package policyspec
 
 import (
 	"runtime"
@@ -0,0 +1,365 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package policyspec
+
+import (
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/cilium/tetragon/pkg/arch"
+	"github.com/cilium/tetragon/pkg/idtable"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/labels"
+	"github.com/cilium/tetragon/pkg/policyfilter"
+	"github.com/cilium/tetragon/pkg/selectors"
+	"github.com/cilium/tetragon/pkg/syscallinfo"
+)
+
+// ValidateSpec runs the checks of a tracing policy spec that do not depend on
+// the kernel or the configuration of the agent loading the policy, and
+// returns the errors with the path of the field they apply to. It is meant to
+// reject invalid policies before they reach the agents, for example from an
+// admission webhook. A spec that passes ValidateSpec can still fail to load,
+// for example when the hooked functions do not exist on a node.
+//
+// The selectors are compiled for the given kernel features. Callers that do
+// not know the nodes the policy will be loaded on should enable all of them.
+func ValidateSpec(spec *v1alpha1.TracingPolicySpec, fldPath *field.Path, features *selectors.Features) field.ErrorList {
+	var allErrs field.ErrorList
+
+	autoTypes := false
+	opts, err := GetSpecOptions(spec.Options)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("options"), field.OmitValueType{}, err.Error()))
+	} else {
+		autoTypes = opts.KprobeAttachType == KprobeAttachTypeFentry
+	}
+
+	if SpecSections(spec) > 1 {
+		allErrs = append(allErrs, field.Forbidden(fldPath, ErrMultipleSections.Error()))
+	}
+
+	if _, err := labels.SelectorFromLabelSelector(spec.PodSelector); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("podSelector"), field.OmitValueType{}, err.Error()))
+	}
+	if _, err := labels.SelectorFromLabelSelector(spec.ContainerSelector); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("containerSelector"), field.OmitValueType{}, err.Error()))
+	}
+	if err := policyfilter.ValidateWorkloadSelector(spec.WorkloadSelector); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("workloadSelector"), field.OmitValueType{}, err.Error()))
+	}
+	if _, err := labels.SelectorFromLabelSelector(spec.NodeSelector); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeSelector"), field.OmitValueType{}, err.Error()))
+	}
+
+	allErrs = append(allErrs, validateListsSpec(spec.Lists, fldPath.Child("lists"))...)
+	allErrs = append(allErrs, validateEnforcersSpec(spec, fldPath.Child("enforcers"))...)
+
+	for i := range spec.KProbes {
+		allErrs = append(allErrs, validateKprobeSpec(spec, &spec.KProbes[i], autoTypes, features, fldPath.Child("kprobes").Index(i))...)
+	}
+	for i := range spec.Tracepoints {
+		// tracepoint arguments are typed by the tracepoint format of the
+		// kernel, so selectors are only checked for macros and actions.
+		tp := &spec.Tracepoints[i]
+		tpPath := fldPath.Child("tracepoints").Index(i)
+		for j := range tp.Selectors {
+			selPath := tpPath.Child("selectors").Index(j)
+			sel := []v1alpha1.KProbeSelector{*tp.Selectors[j].DeepCopy()}
+			if err := AppendMacrosSelectors(sel, spec.SelectorsMacros); err != nil {
+				allErrs = append(allErrs, field.Invalid(selPath.Child("macros"), tp.Selectors[j].Macros, err.Error()))
+			}
+			allErrs = append(allErrs, validateActionsSpec(spec, "", false, sel[0].MatchActions, selPath.Child("matchActions"))...)
+		}
+	}
+	for i := range spec.LsmHooks {
+		lsm := &spec.LsmHooks[i]
+		allErrs = append(allErrs, validateSelectorsSpec(spec, features, lsm.Selectors, &selectors.KernelSelectorArgs{
+			Args: lsm.Args,
+			Data: []v1alpha1.KProbeArg{},
+		}, nil, fldPath.Child("lsmhooks").Index(i).Child("selectors"))...)
+	}
+	for i := range spec.UProbes {
+		uprobe := &spec.UProbes[i]
+		allErrs = append(allErrs, validateSelectorsSpec(spec, features, uprobe.Selectors, &selectors.KernelSelectorArgs{
+			Args:     uprobe.Args,
+			Data:     uprobe.Data,
+			IsUprobe: true,
+		}, nil, fldPath.Child("uprobes").Index(i).Child("selectors"))...)
+	}
+	for i := range spec.Usdts {
+		usdt := &spec.Usdts[i]
+		allErrs = append(allErrs, validateSelectorsSpec(spec, features, usdt.Selectors, &selectors.KernelSelectorArgs{
+			Args: usdt.Args,
+			Data: []v1alpha1.KProbeArg{},
+		}, nil, fldPath.Child("usdts").Index(i).Child("selectors"))...)
+	}
+
+	allErrs = append(allErrs, validatePerfEventsSpec(spec.PerfEvents, fldPath.Child("perfEvents"))...)
+	return allErrs
+}
+
+func validateListsSpec(lists []v1alpha1.ListSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range lists {
+		list := &lists[i]
+		if err := ValidateListSpec(list); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), list.Name, err.Error()))
+			continue
+		}
+		if ListTypeFromString(list.Type) != ListTypeSyscalls {
+			continue
+		}
+		for k, val := range list.Values {
+			if _, err := SyscallVal(val).ID(); err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("values").Index(k), val, err.Error()))
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateEnforcersSpec(spec *v1alpha1.TracingPolicySpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(spec.Enforcers) > 1 {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(spec.Enforcers), 1))
+	}
+	for i, enforcer := range spec.Enforcers {
+		for k, call := range enforcer.Calls {
+			callPath := fldPath.Index(i).Child("calls").Index(k)
+			syms := []string{call}
+			if isL, list := IsList(call, spec.Lists); isL {
+				if list == nil {
+					allErrs = append(allErrs, field.NotFound(callPath, call))
+					continue
+				}
+				if IsSyscallListType(list.Type) {
+					continue
+				}
+				syms = list.Values
+			}
+			for _, sym := range syms {
+				if !arch.HasSyscallPrefix(sym) && !strings.HasPrefix(sym, "sys_") && !strings.HasPrefix(sym, "security_") {
+					allErrs = append(allErrs, field.Invalid(callPath, sym, "enforcer calls must be either syscalls or security_ functions"))
+				}
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateKprobeSpec(
+	spec *v1alpha1.TracingPolicySpec,
+	kprobe *v1alpha1.KProbeSpec,
+	autoTypes bool,
+	features *selectors.Features,
+	fldPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	// overrideCall is the call that override actions are checked against,
+	// empty if the kprobe can be overridden.
+	overrideCall := ""
+	if isL, list := IsList(kprobe.Call, spec.Lists); isL {
+		if list == nil {
+			allErrs = append(allErrs, field.NotFound(fldPath.Child("call"), kprobe.Call))
+		} else if !IsSyscallListType(list.Type) {
+			for _, val := range list.Values {
+				if !strings.HasPrefix(val, "security_") {
+					overrideCall = val
+					break
+				}
+			}
+		}
+	} else {
+		if kprobe.Syscall && !isKnownSyscall(kprobe.Call) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("call"), kprobe.Call, "unknown syscall"))
+		}
+		if !kprobe.Syscall && !strings.HasPrefix(kprobe.Call, "security_") {
+			overrideCall = kprobe.Call
+		}
+	}
+
+	for k, arg := range kprobe.Args {
+		if err := ValidateKprobeType(arg.Type, autoTypes); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("args").Index(k).Child("type"), arg.Type, err.Error()))
+		}
+	}
+
+	var returnArg *v1alpha1.KProbeArg
+	if kprobe.Return {
+		returnArg = kprobe.ReturnArg
+	}
+	allErrs = append(allErrs, validateSelectorsSpec(spec, features, kprobe.Selectors, &selectors.KernelSelectorArgs{
+		Args: kprobe.Args,
+		Data: kprobe.Data,
+	}, returnArg, fldPath.Child("selectors"))...)
+
+	for j := range kprobe.Selectors {
+		sel := []v1alpha1.KProbeSelector{*kprobe.Selectors[j].DeepCopy()}
+		if err := AppendMacrosSelectors(sel, spec.SelectorsMacros); err != nil {
+			// reported by validateSelectorsSpec
+			continue
+		}
+		actionsPath := fldPath.Child("selectors").Index(j).Child("matchActions")
+		allErrs = append(allErrs, validateActionsSpec(spec, overrideCall, true, sel[0].MatchActions, actionsPath)...)
+	}
+	return allErrs
+}
+
+// validateActionsSpec checks the actions of a selector. Override actions are
+// rejected if overrideCall is not empty, and stack trace options are only
+// checked if stackTraces is set.
+func validateActionsSpec(
+	spec *v1alpha1.TracingPolicySpec,
+	overrideCall string,
+	stackTraces bool,
+	actions []v1alpha1.ActionSelector,
+	fldPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+	for k, act := range actions {
+		actPath := fldPath.Index(k)
+		switch strings.ToLower(act.Action) {
+		case "notifyenforcer":
+			if len(spec.Enforcers) == 0 {
+				allErrs = append(allErrs, field.Forbidden(actPath, "NotifyEnforcer action specified, but spec contains no enforcers"))
+			}
+		case "override":
+			if overrideCall != "" {
+				allErrs = append(allErrs, field.Forbidden(actPath,
+					"override action can be used only with syscalls and security_ hooks, got '"+overrideCall+"'"))
+			}
+		}
+		if stackTraces && (act.KernelStackTrace || act.UserStackTrace) && act.Action != "Post" {
+			allErrs = append(allErrs, field.Invalid(actPath, act.Action, "kernelStackTrace or userStackTrace can only be used along Post action"))
+		}
+	}
+	return allErrs
+}
+
+// validateSelectorsSpec compiles the selectors of a hook, one by one so that
+// errors point to the selector they come from. The selectors are copied, and
+// args provides the arguments they are compiled against.
+func validateSelectorsSpec(
+	spec *v1alpha1.TracingPolicySpec,
+	features *selectors.Features,
+	sels []v1alpha1.KProbeSelector,
+	args *selectors.KernelSelectorArgs,
+	returnArg *v1alpha1.KProbeArg,
+	fldPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(sels) > selectors.MaxSelectors {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(sels), selectors.MaxSelectors))
+	}
+
+	for j := range sels {
+		selPath := fldPath.Index(j)
+		sel := []v1alpha1.KProbeSelector{*sels[j].DeepCopy()}
+		if err := AppendMacrosSelectors(sel, spec.SelectorsMacros); err != nil {
+			allErrs = append(allErrs, field.Invalid(selPath.Child("macros"), sels[j].Macros, err.Error()))
+			continue
+		}
+
+		if errs := detachSelector(&sel[0], selPath); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+
+		selArgs := *args
+		selArgs.Selectors = sel
+		selArgs.ActionArgTable = &idtable.Table{}
+		selArgs.ListReader = &ListReader{Lists: spec.Lists}
+		selArgs.Features = features
+		if _, err := selectors.InitKernelSelectorState(&selArgs); err != nil {
+			allErrs = append(allErrs, field.Invalid(selPath, field.OmitValueType{}, err.Error()))
+			continue
+		}
+
+		if returnArg != nil {
+			_, err := selectors.InitKernelReturnSelectorState(sel, returnArg, &idtable.Table{}, &ListReader{Lists: spec.Lists}, nil, features)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(selPath, field.OmitValueType{}, err.Error()))
+			}
+		}
+	}
+	return allErrs
+}
+
+// detachSelector removes from a selector the parts whose compilation depends
+// on the host or on the state of the agent, after checking them.
+func detachSelector(sel *v1alpha1.KProbeSelector, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	// followChildren allocates an ID shared with the BPF maps of the agent
+	for k := range sel.MatchBinaries {
+		b := &sel.MatchBinaries[k]
+		if !b.FollowChildren {
+			continue
+		}
+		op, err := selectors.SelectorOp(b.Operator)
+		if err == nil && op != selectors.SelectorOpIn && op != selectors.SelectorOpNotIn {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("matchBinaries").Index(k).Child("followChildren"), true,
+				"followChildren not yet implemented for operation '"+b.Operator+"'"))
+		}
+		b.FollowChildren = false
+	}
+
+	// host namespaces are read from /proc/1
+	for k := range sel.MatchNamespaces {
+		for v, val := range sel.MatchNamespaces[k].Values {
+			if val == "host_ns" {
+				sel.MatchNamespaces[k].Values[v] = "0"
+			}
+		}
+	}
+	for k := range sel.MatchCapabilities {
+		sel.MatchCapabilities[k].IsNamespaceCapability = false
+	}
+	for k := range sel.MatchCapabilityChanges {
+		sel.MatchCapabilityChanges[k].IsNamespaceCapability = false
+	}
+
+	return allErrs
+}
+
+func validatePerfEventsSpec(perfEvents []v1alpha1.PerfEventSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	names := make(map[string]struct{})
+	for i := range perfEvents {
+		pe := &perfEvents[i]
+		pePath := fldPath.Index(i)
+		if pe.Name == "" {
+			allErrs = append(allErrs, field.Required(pePath.Child("name"), "perf event name is required"))
+		} else if _, ok := names[pe.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(pePath.Child("name"), pe.Name))
+		}
+		names[pe.Name] = struct{}{}
+		if _, _, err := PerfEventStacks(pe); err != nil {
+			allErrs = append(allErrs, field.Invalid(pePath.Child("stacks"), pe.Stacks, err.Error()))
+		}
+	}
+	return allErrs
+}
+
+// isKnownSyscall checks a syscall name against the syscall tables of all the
+// supported ABIs, since the architecture of the nodes is not known. Names
+// that do not start with sys_ are left to the BTF validation of the agents.
+func isKnownSyscall(call string) bool {
+	_, name := arch.CutSyscallPrefix(call)
+	name, ok := strings.CutPrefix(name, "sys_")
+	if !ok {
+		return true
+	}
+	if _, ok := syscallinfo.GetSyscallArgs(name); ok {
+		return true
+	}
+	return slices.ContainsFunc([]string{"x64", "arm64", "i386", "arm32"}, func(abi string) bool {
+		_, err := syscallinfo.SyscallID(name, abi)
+		return err == nil
+	})
+}
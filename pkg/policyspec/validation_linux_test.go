@@ -0,0 +1,184 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package policyspec
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/cilium/tetragon/pkg/selectors"
+	"github.com/cilium/tetragon/pkg/tracingpolicy"
+)
+
+func TestValidateSpec(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy string
+		fields []string
+	}{
+		{
+			name: "valid",
+			policy: `
+kprobes:
+- call: "sys_write"
+  syscall: true
+  args:
+  - index: 0
+    type: "int"
+  selectors:
+  - matchArgs:
+    - index: 0
+      operator: "Equal"
+      values:
+      - "1"
+    matchActions:
+    - action: Override
+      argError: -1
+`,
+		},
+		{
+			name: "undefined macro",
+			policy: `
+kprobes:
+- call: "fd_install"
+  selectors:
+  - macros:
+    - "noSuchMacro"
+`,
+			fields: []string{"spec.kprobes[0].selectors[0].macros"},
+		},
+		{
+			name: "missing list",
+			policy: `
+kprobes:
+- call: "list:syscalls"
+`,
+			fields: []string{"spec.kprobes[0].call"},
+		},
+		{
+			name: "unknown syscall",
+			policy: `
+kprobes:
+- call: "sys_nosuchsyscall"
+  syscall: true
+`,
+			fields: []string{"spec.kprobes[0].call"},
+		},
+		{
+			name: "invalid arg type",
+			policy: `
+kprobes:
+- call: "fd_install"
+  args:
+  - index: 0
+    type: "auto"
+`,
+			fields: []string{"spec.kprobes[0].args[0].type"},
+		},
+		{
+			name: "invalid operator for arg type",
+			policy: `
+kprobes:
+- call: "fd_install"
+  args:
+  - index: 0
+    type: "string"
+  selectors:
+  - matchArgs:
+    - index: 0
+      operator: "InRange"
+      values:
+      - "1:5"
+`,
+			fields: []string{"spec.kprobes[0].selectors[0]"},
+		},
+		{
+			name: "override on non syscall",
+			policy: `
+kprobes:
+- call: "fd_install"
+  syscall: false
+  selectors:
+  - matchActions:
+    - action: Override
+      argError: -1
+`,
+			fields: []string{"spec.kprobes[0].selectors[0].matchActions[0]"},
+		},
+		{
+			name: "notify enforcer without enforcers",
+			policy: `
+kprobes:
+- call: "sys_write"
+  syscall: true
+  selectors:
+  - matchActions:
+    - action: NotifyEnforcer
+`,
+			fields: []string{"spec.kprobes[0].selectors[0].matchActions[0]"},
+		},
+		{
+			name: "invalid syscall list value",
+			policy: `
+lists:
+- name: "syscalls"
+  type: "syscalls"
+  values:
+  - "sys_dup"
+  - "sys_nosuchsyscall"
+`,
+			fields: []string{"spec.lists[0].values[1]"},
+		},
+		{
+			name: "invalid workload name pattern",
+			policy: `
+workloadSelector:
+  names:
+  - "[payments"
+kprobes:
+- call: "fd_install"
+`,
+			fields: []string{"spec.workloadSelector"},
+		},
+		{
+			name: "multiple sections",
+			policy: `
+kprobes:
+- call: "fd_install"
+tracepoints:
+- subsystem: "raw_syscalls"
+  event: "sys_enter"
+`,
+			fields: []string{"spec"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tp, err := tracingpolicy.FromYAML(`apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "validate"
+spec:` + strings.ReplaceAll(test.policy, "\n", "\n  "))
+			require.NoError(t, err)
+
+			errs := ValidateSpec(tp.TpSpec(), field.NewPath("spec"), &selectors.Features{LargeProgs: true, ParentsMap: true})
+			fields := make([]string, 0, len(errs))
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			require.ElementsMatch(t, test.fields, fields, "errors: %v", errs)
+		})
+	}
+}
+
+func TestValidateKprobeTypeAuto(t *testing.T) {
+	require.Error(t, ValidateKprobeType("auto", false))
+	require.NoError(t, ValidateKprobeType("auto", true))
+	require.Error(t, ValidateKprobeType("syscall64", true))
+	require.NoError(t, ValidateKprobeType("int", false))
+}
@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package policyspec
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/selectors"
+)
+
+func ValidateSpec(_ *v1alpha1.TracingPolicySpec, _ *field.Path, _ *selectors.Features) field.ErrorList {
+	return nil
+}
@@ -29,7 +29,8 @@ const (
 	PolicyMonitorOverride       PolicyAction = 5
 	PolicyNotifyEnforcer        PolicyAction = 6
 	PolicyMonitorNotifyEnforcer PolicyAction = 7
-	PolicyActionsNr                          = 8
+	PolicySampledOut            PolicyAction = 8
+	PolicyActionsNr                          = 9
 )
 
 type PolicyStats struct {
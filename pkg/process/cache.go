@@ -16,6 +16,7 @@ import (
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/pkg/defaults"
+	"github.com/cilium/tetragon/pkg/fieldfilters"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/logger/logfields"
 	"github.com/cilium/tetragon/pkg/sensors/exec/execvemap"
@@ -246,8 +247,15 @@ func (pc *Cache) dump(opts *tetragon.DumpProcessCacheReqArgs) []*tetragon.Proces
 				continue
 			}
 		}
+		p := proto.Clone(v.process).(*tetragon.Process)
+		// arguments and environment variables are redacted when the process
+		// is created, pod labels and annotations are redacted here since
+		// the pod can be resolved later
+		if fieldfilters.RedactionFilters != nil {
+			fieldfilters.RedactionFilters.RedactPod(p.Binary, p.Pod)
+		}
 		processes = append(processes, &tetragon.ProcessInternal{
-			Process:   proto.Clone(v.process).(*tetragon.Process),
+			Process:   p,
 			Refcnt:    &wrapperspb.UInt32Value{Value: ref},
 			RefcntOps: maps.Clone(v.refcntOps),
 			Color:     colorStr[v.color],
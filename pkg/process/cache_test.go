@@ -12,6 +12,7 @@ import (
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/pkg/defaults"
+	"github.com/cilium/tetragon/pkg/fieldfilters"
 )
 
 func TestProcessCache(t *testing.T) {
@@ -46,3 +47,32 @@ func TestProcessCache(t *testing.T) {
 	_, err = cache.get(proc.process.ExecId)
 	require.Error(t, err)
 }
+
+func TestProcessCacheDumpRedaction(t *testing.T) {
+	filters, err := fieldfilters.ParseRedactionFilterList(`{"redact": ["token=(\\S+)"]}`)
+	require.NoError(t, err)
+	fieldfilters.RedactionFilters = filters
+	t.Cleanup(func() { fieldfilters.RedactionFilters = nil })
+
+	cache, err := NewCache(10, defaults.DefaultProcessCacheGCInterval)
+	require.NoError(t, err)
+	proc := ProcessInternal{
+		process: &tetragon.Process{
+			ExecId: "process1",
+			Pid:    &wrapperspb.UInt32Value{Value: 1234},
+			Pod: &tetragon.Pod{
+				PodLabels:      map[string]string{"app": "web", "token": "abc"},
+				PodAnnotations: map[string]string{"token": "def"},
+			},
+		},
+	}
+	cache.add(&proc)
+
+	processes := cache.dump(&tetragon.DumpProcessCacheReqArgs{})
+	require.Len(t, processes, 1)
+	pod := processes[0].Process.Pod
+	assert.Equal(t, map[string]string{"app": "web", "token": fieldfilters.REDACTION_STR}, pod.PodLabels)
+	assert.Equal(t, map[string]string{"token": fieldfilters.REDACTION_STR}, pod.PodAnnotations)
+	// the cached process is not modified
+	assert.Equal(t, "abc", proc.process.Pod.PodLabels["token"])
+}
@@ -49,6 +49,11 @@ type ProcessInternal struct {
 	// will be constructed on the fly when returning these extra fields
 	// about the binary during the corresponding ProcessExec only.
 	apiBinaryProp *tetragon.BinaryProperties
+	// The full arguments of the process when they were truncated and
+	// --process-args-full-capture is set for its binary. Like apiBinaryProp,
+	// it is only returned during the corresponding ProcessExec, and it is
+	// released once returned.
+	fullArgs string
 	// garbage collector metadata
 	color  int // Writes should happen only inside gc select channel
 	refcnt atomic.Uint32
@@ -61,6 +66,8 @@ type ProcessInternal struct {
 	refcntOps map[string]int32
 	// protects the refcntOps map
 	refcntOpsLock sync.Mutex
+	// ktime of the exit of the process, zero if it has not exited
+	exitKtime atomic.Uint64
 }
 
 var (
@@ -127,6 +134,14 @@ func (pi *ProcessInternal) AddPodInfo(pod *tetragon.Pod) {
 	pi.mu.Unlock()
 }
 
+// getPod returns the pod of the process, which can be set concurrently by
+// AddPodInfo.
+func (pi *ProcessInternal) getPod() *tetragon.Pod {
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+	return pi.process.GetPod()
+}
+
 func (pi *ProcessInternal) getProcess() *tetragon.Process {
 	pi.mu.Lock()
 	return pi.process
@@ -205,6 +220,18 @@ func (pi *ProcessInternal) UpdateExecOutsideCache(cred bool) (*tetragon.Process,
 	return process, update
 }
 
+// TakeFullArguments returns the full arguments of the process if they were
+// truncated and --process-args-full-capture is set for its binary, an empty
+// string otherwise. The full arguments are only meant for the corresponding
+// ProcessExec, so they are released on the first call.
+func (pi *ProcessInternal) TakeFullArguments() string {
+	pi.mu.Lock()
+	defer pi.mu.Unlock()
+	args := pi.fullArgs
+	pi.fullArgs = ""
+	return args
+}
+
 func (pi *ProcessInternal) AnnotateProcess(cred, ns bool) error {
 	process := pi.getProcess()
 	defer pi.putProcess()
@@ -233,6 +260,11 @@ func (pi *ProcessInternal) RefGet() uint32 {
 	return pi.refcnt.Load()
 }
 
+// SetExitTime records the ktime at which the process exited.
+func (pi *ProcessInternal) SetExitTime(ktime uint64) {
+	pi.exitKtime.Store(ktime)
+}
+
 func (pi *ProcessInternal) NeededAncestors() bool {
 	if pi != nil && pi.process.Pid.Value > 2 {
 		return true
@@ -389,6 +421,30 @@ func initProcessInternalExec(
 		args, envs = fieldfilters.RedactionFilters.Redact(binary, args, envs)
 	}
 
+	// Apply the size limits last, so that the original lengths are the ones
+	// of the fields that would have been exported. Fields that did not fit in
+	// their data events are already truncated, the leftover is what was lost.
+	var argsLength, envsLength, cwdLength uint32
+	if process.ArgsLeftover != 0 {
+		argsLength = uint32(len(args)) + process.ArgsLeftover
+	}
+	if process.EnvsLeftover != 0 {
+		envsLength = uint32(envsLen(envs)) + process.EnvsLeftover
+	}
+	var fullArgs string
+	if truncArgs, n := truncateString(args, option.Config.ProcessArgsMaxSize); n != 0 {
+		if fullCaptureBinary(binary) {
+			fullArgs = args
+		}
+		args = truncArgs
+		argsLength = max(argsLength, n)
+	}
+	if truncEnvs, n := truncateEnvs(envs, option.Config.ProcessEnvsMaxSize); n != 0 {
+		envs = truncEnvs
+		envsLength = max(envsLength, n)
+	}
+	cwd, cwdLength = truncateString(cwd, option.Config.ProcessCwdMaxSize)
+
 	var user *tetragon.UserRecord
 
 	if len(process.User.Name) != 0 {
@@ -415,10 +471,12 @@ func initProcessInternalExec(
 			Refcnt:               0,
 			User:                 user,
 			EnvironmentVariables: getEnvironmentVariables(envs),
+			Truncated:            getProcessTruncated(argsLength, envsLength, cwdLength),
 		},
 		capabilities:  apiCaps,
 		apiCreds:      apiCreds,
 		apiBinaryProp: apiBinaryProp,
+		fullArgs:      fullArgs,
 		namespaces:    apiNs,
 		refcntOps:     map[string]int32{"process++": 1},
 	}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package process
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/constants"
+	"github.com/cilium/tetragon/pkg/ktime"
+)
+
+// GetProcessTree returns the ancestors and the descendant subtrees of the
+// processes selected by the request.
+func GetProcessTree(req *tetragon.GetProcessTreeRequest) (*tetragon.GetProcessTreeResponse, error) {
+	return procCache.tree(req)
+}
+
+func (pc *Cache) tree(req *tetragon.GetProcessTreeRequest) (*tetragon.GetProcessTreeResponse, error) {
+	// Take a snapshot of the cache and index it by parent, so that the
+	// tree is built from a consistent view.
+	processes := pc.cache.Values()
+	byExecID := make(map[string]*ProcessInternal, len(processes))
+	children := make(map[string][]*ProcessInternal)
+	for _, p := range processes {
+		if p.process == nil {
+			continue
+		}
+		byExecID[p.process.ExecId] = p
+		children[p.process.ParentExecId] = append(children[p.process.ParentExecId], p)
+	}
+	for _, c := range children {
+		slices.SortFunc(c, compareStartTime)
+	}
+
+	var roots []*ProcessInternal
+	switch sel := req.GetSelector().(type) {
+	case *tetragon.GetProcessTreeRequest_ExecId:
+		p, ok := byExecID[sel.ExecId]
+		if !ok {
+			return nil, fmt.Errorf("process with exec_id %q not found in the process cache", sel.ExecId)
+		}
+		roots = append(roots, p)
+	case *tetragon.GetProcessTreeRequest_Pid:
+		var latest *ProcessInternal
+		for _, p := range byExecID {
+			if p.process.Pid.GetValue() == sel.Pid && (latest == nil || compareStartTime(p, latest) > 0) {
+				latest = p
+			}
+		}
+		if latest == nil {
+			return nil, fmt.Errorf("process with pid %d not found in the process cache", sel.Pid)
+		}
+		roots = append(roots, latest)
+	case *tetragon.GetProcessTreeRequest_Pod:
+		inPod := func(p *ProcessInternal) bool {
+			pod := p.getPod()
+			return pod.GetNamespace() == sel.Pod.GetNamespace() && pod.GetName() == sel.Pod.GetName()
+		}
+		for _, p := range byExecID {
+			if !inPod(p) {
+				continue
+			}
+			if parent, ok := byExecID[p.process.ParentExecId]; ok && inPod(parent) {
+				continue
+			}
+			roots = append(roots, p)
+		}
+		if len(roots) == 0 {
+			return nil, fmt.Errorf("no process of pod %s/%s found in the process cache",
+				sel.Pod.GetNamespace(), sel.Pod.GetName())
+		}
+		slices.SortFunc(roots, compareStartTime)
+	default:
+		return nil, fmt.Errorf("invalid process tree selector %T", sel)
+	}
+
+	res := &tetragon.GetProcessTreeResponse{}
+	if len(roots) == 1 && req.GetPod() == nil {
+		// No need to include <kernel> process (PID 0), as in
+		// GetAncestorProcessesInternal.
+		p := roots[0]
+		for p.process.Pid.GetValue() > constants.OLDEST_ANCESTOR_PID {
+			parent, ok := byExecID[p.process.ParentExecId]
+			if !ok {
+				break
+			}
+			res.Ancestors = append(res.Ancestors, parent.treeNode())
+			p = parent
+		}
+	}
+	visited := make(map[*ProcessInternal]bool)
+	for _, p := range roots {
+		res.Roots = append(res.Roots, p.subtree(children, visited, 0, req.GetMaxDepth()))
+	}
+	return res, nil
+}
+
+func (pi *ProcessInternal) subtree(
+	children map[string][]*ProcessInternal,
+	visited map[*ProcessInternal]bool,
+	depth, maxDepth uint32,
+) *tetragon.ProcessTreeNode {
+	node := pi.treeNode()
+	visited[pi] = true
+	if maxDepth != 0 && depth >= maxDepth {
+		return node
+	}
+	for _, child := range children[pi.process.ExecId] {
+		if visited[child] {
+			continue
+		}
+		node.Children = append(node.Children, child.subtree(children, visited, depth+1, maxDepth))
+	}
+	return node
+}
+
+func (pi *ProcessInternal) treeNode() *tetragon.ProcessTreeNode {
+	pi.refcntOpsLock.Lock()
+	refcntOps := maps.Clone(pi.refcntOps)
+	pi.refcntOpsLock.Unlock()
+
+	node := &tetragon.ProcessTreeNode{
+		Process: &tetragon.ProcessInternal{
+			Process:   pi.GetProcessCopy(),
+			Refcnt:    &wrapperspb.UInt32Value{Value: pi.refcnt.Load()},
+			RefcntOps: refcntOps,
+			Color:     colorStr[pi.color],
+		},
+	}
+	if exit := pi.exitKtime.Load(); exit != 0 {
+		node.ExitTime = ktime.ToProto(exit)
+	}
+	return node
+}
+
+func compareStartTime(a, b *ProcessInternal) int {
+	if c := a.process.GetStartTime().AsTime().Compare(b.process.GetStartTime().AsTime()); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.process.ExecId, b.process.ExecId)
+}
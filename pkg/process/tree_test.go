@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package process
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/defaults"
+)
+
+func treeExecIDs(nodes []*tetragon.ProcessTreeNode) []string {
+	var ret []string
+	for _, n := range nodes {
+		ret = append(ret, n.Process.Process.ExecId)
+	}
+	return ret
+}
+
+func TestProcessTree(t *testing.T) {
+	cache, err := NewCache(20, defaults.DefaultProcessCacheGCInterval)
+	require.NoError(t, err)
+
+	pod := &tetragon.Pod{Namespace: "default", Name: "shell"}
+	add := func(execID, parentExecID string, pid uint32, start int64, pod *tetragon.Pod) *ProcessInternal {
+		proc := &ProcessInternal{
+			process: &tetragon.Process{
+				ExecId:       execID,
+				ParentExecId: parentExecID,
+				Pid:          &wrapperspb.UInt32Value{Value: pid},
+				StartTime:    &timestamppb.Timestamp{Seconds: start},
+				Pod:          pod,
+			},
+		}
+		proc.refcnt.Store(1)
+		cache.add(proc)
+		return proc
+	}
+	add("init", "kernel", 1, 1, nil)
+	add("containerd", "init", 100, 2, nil)
+	add("sh", "containerd", 200, 3, pod)
+	add("curl", "sh", 201, 5, pod)
+	add("ls", "sh", 202, 4, pod).SetExitTime(6)
+	add("cat", "ls", 203, 6, pod)
+	add("sh-2", "containerd", 200, 7, nil)
+
+	t.Run("exec_id", func(t *testing.T) {
+		res, err := cache.tree(&tetragon.GetProcessTreeRequest{
+			Selector: &tetragon.GetProcessTreeRequest_ExecId{ExecId: "sh"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"containerd", "init"}, treeExecIDs(res.Ancestors))
+		require.Len(t, res.Roots, 1)
+		root := res.Roots[0]
+		assert.Equal(t, "sh", root.Process.Process.ExecId)
+		assert.Equal(t, uint32(1), root.Process.Refcnt.GetValue())
+		assert.Nil(t, root.ExitTime)
+		assert.Equal(t, []string{"ls", "curl"}, treeExecIDs(root.Children))
+		assert.NotNil(t, root.Children[0].ExitTime)
+		assert.Equal(t, []string{"cat"}, treeExecIDs(root.Children[0].Children))
+	})
+
+	t.Run("pid", func(t *testing.T) {
+		res, err := cache.tree(&tetragon.GetProcessTreeRequest{
+			Selector: &tetragon.GetProcessTreeRequest_Pid{Pid: 200},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"sh-2"}, treeExecIDs(res.Roots))
+	})
+
+	t.Run("pod", func(t *testing.T) {
+		res, err := cache.tree(&tetragon.GetProcessTreeRequest{
+			Selector: &tetragon.GetProcessTreeRequest_Pod{
+				Pod: &tetragon.ProcessTreePod{Namespace: "default", Name: "shell"},
+			},
+			MaxDepth: 1,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, res.Ancestors)
+		require.Equal(t, []string{"sh"}, treeExecIDs(res.Roots))
+		assert.Equal(t, []string{"ls", "curl"}, treeExecIDs(res.Roots[0].Children))
+		assert.Empty(t, res.Roots[0].Children[0].Children)
+	})
+
+	t.Run("pod info update", func(t *testing.T) {
+		// the pod of a process can be resolved while the tree is built
+		proc := add("late", "containerd", 300, 8, nil)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			proc.AddPodInfo(pod)
+		}()
+		_, err := cache.tree(&tetragon.GetProcessTreeRequest{
+			Selector: &tetragon.GetProcessTreeRequest_Pod{
+				Pod: &tetragon.ProcessTreePod{Namespace: "default", Name: "shell"},
+			},
+		})
+		require.NoError(t, err)
+		<-done
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		_, err := cache.tree(&tetragon.GetProcessTreeRequest{
+			Selector: &tetragon.GetProcessTreeRequest_ExecId{ExecId: "nope"},
+		})
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package process
+
+import (
+	"unicode/utf8"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/option"
+)
+
+// truncateString truncates s to at most maxSize bytes, without splitting a
+// UTF-8 character. It returns the original length of s if it was truncated,
+// 0 otherwise. A maxSize of 0 or less means no limit.
+func truncateString(s string, maxSize int) (string, uint32) {
+	if maxSize <= 0 || len(s) <= maxSize {
+		return s, 0
+	}
+	n := maxSize
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n], uint32(len(s))
+}
+
+// envsLen returns the size in bytes of the environment variables, counted
+// as the sum of the lengths of the KEY=VALUE strings.
+func envsLen(envs []string) int {
+	size := 0
+	for _, v := range envs {
+		size += len(v)
+	}
+	return size
+}
+
+// truncateEnvs keeps the environment variables that fit in maxSize bytes,
+// dropping the following ones. It returns the original length of the
+// environment variables if some were dropped, 0 otherwise. A maxSize of 0 or
+// less means no limit.
+func truncateEnvs(envs []string, maxSize int) ([]string, uint32) {
+	size := envsLen(envs)
+	if maxSize <= 0 || size <= maxSize {
+		return envs, 0
+	}
+	kept := 0
+	for i, v := range envs {
+		if kept+len(v) > maxSize {
+			return envs[:i], uint32(size)
+		}
+		kept += len(v)
+	}
+	return envs, 0
+}
+
+// fullCaptureBinary returns true if the full arguments of the binary should be
+// exported, according to --process-args-full-capture and
+// --process-args-full-capture-binaries.
+func fullCaptureBinary(binary string) bool {
+	if !option.Config.ProcessArgsFullCapture {
+		return false
+	}
+	if len(option.Config.ProcessArgsFullCaptureBinaries) == 0 {
+		return true
+	}
+	for _, re := range option.Config.ProcessArgsFullCaptureBinaries {
+		if re.MatchString(binary) {
+			return true
+		}
+	}
+	return false
+}
+
+// getProcessTruncated returns the truncation information of a process, or nil
+// if none of its fields was truncated.
+func getProcessTruncated(args, envs, cwd uint32) *tetragon.ProcessTruncated {
+	if args == 0 && envs == 0 && cwd == 0 {
+		return nil
+	}
+	return &tetragon.ProcessTruncated{
+		ArgumentsLength:            args,
+		EnvironmentVariablesLength: envs,
+		CwdLength:                  cwd,
+	}
+}
@@ -0,0 +1,118 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package process
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	tetragonAPI "github.com/cilium/tetragon/pkg/api/processapi"
+	"github.com/cilium/tetragon/pkg/option"
+)
+
+func TestTruncateString(t *testing.T) {
+	s, n := truncateString("arg1 arg2", 0)
+	assert.Equal(t, "arg1 arg2", s)
+	assert.Zero(t, n)
+
+	s, n = truncateString("arg1 arg2", 9)
+	assert.Equal(t, "arg1 arg2", s)
+	assert.Zero(t, n)
+
+	s, n = truncateString("arg1 arg2", 4)
+	assert.Equal(t, "arg1", s)
+	assert.Equal(t, uint32(9), n)
+
+	// multi-byte characters are not split
+	s, n = truncateString("aé", 2)
+	assert.Equal(t, "a", s)
+	assert.Equal(t, uint32(3), n)
+}
+
+func TestTruncateEnvs(t *testing.T) {
+	envs := []string{"A=1", "BB=22", "C=3"}
+
+	res, n := truncateEnvs(envs, 0)
+	assert.Equal(t, envs, res)
+	assert.Zero(t, n)
+
+	res, n = truncateEnvs(envs, 11)
+	assert.Equal(t, envs, res)
+	assert.Zero(t, n)
+
+	res, n = truncateEnvs(envs, 10)
+	assert.Equal(t, []string{"A=1", "BB=22"}, res)
+	assert.Equal(t, uint32(11), n)
+
+	res, n = truncateEnvs(envs, 2)
+	assert.Empty(t, res)
+	assert.Equal(t, uint32(11), n)
+}
+
+func TestInitProcessInternalExecTruncated(t *testing.T) {
+	oldConfig := option.Config
+	t.Cleanup(func() { option.Config = oldConfig })
+
+	event := func(leftover uint32) *tetragonAPI.MsgExecveEventUnix {
+		return &tetragonAPI.MsgExecveEventUnix{
+			Msg: &tetragonAPI.MsgExecveEvent{},
+			Process: tetragonAPI.MsgProcess{
+				PID:          1234,
+				TID:          1234,
+				Ktime:        1,
+				Filename:     "/usr/bin/curl",
+				Args:         "-s\x00https://example.com\x00/home/user",
+				Envs:         []string{"HOME=/home/user", "TERM=xterm"},
+				ArgsLeftover: leftover,
+			},
+		}
+	}
+
+	// no limits
+	pi := initProcessInternalExec(event(0), tetragonAPI.MsgExecveKey{})
+	assert.Equal(t, "-s https://example.com", pi.process.Arguments)
+	assert.Nil(t, pi.process.Truncated)
+	assert.Empty(t, pi.TakeFullArguments())
+
+	option.Config.ProcessArgsMaxSize = 10
+	option.Config.ProcessEnvsMaxSize = 20
+	option.Config.ProcessCwdMaxSize = 5
+	pi = initProcessInternalExec(event(0), tetragonAPI.MsgExecveKey{})
+	assert.Equal(t, "-s https:/", pi.process.Arguments)
+	assert.Equal(t, "/home", pi.process.Cwd)
+	assert.Equal(t, "/usr/bin/curl", pi.process.Binary)
+	require.Len(t, pi.process.EnvironmentVariables, 1)
+	assert.Equal(t, "HOME", pi.process.EnvironmentVariables[0].Key)
+	assert.Equal(t, &tetragon.ProcessTruncated{
+		ArgumentsLength:            22,
+		EnvironmentVariablesLength: 25,
+		CwdLength:                  10,
+	}, pi.process.Truncated)
+	assert.Empty(t, pi.TakeFullArguments())
+
+	option.Config.ProcessArgsFullCapture = true
+	pi = initProcessInternalExec(event(0), tetragonAPI.MsgExecveKey{})
+	assert.Equal(t, "-s https:/", pi.process.Arguments)
+	assert.Equal(t, "-s https://example.com", pi.TakeFullArguments())
+	// the full arguments are released once taken
+	assert.Empty(t, pi.TakeFullArguments())
+
+	option.Config.ProcessArgsFullCaptureBinaries = []*regexp.Regexp{regexp.MustCompile("wget$")}
+	pi = initProcessInternalExec(event(0), tetragonAPI.MsgExecveKey{})
+	assert.Empty(t, pi.TakeFullArguments())
+	option.Config.ProcessArgsFullCaptureBinaries = []*regexp.Regexp{regexp.MustCompile("curl$")}
+	pi = initProcessInternalExec(event(0), tetragonAPI.MsgExecveKey{})
+	assert.Equal(t, "-s https://example.com", pi.TakeFullArguments())
+
+	// arguments that did not fit in their data event
+	option.Config.ProcessArgsMaxSize = 0
+	pi = initProcessInternalExec(event(100), tetragonAPI.MsgExecveKey{})
+	assert.Equal(t, "-s https://example.com", pi.process.Arguments)
+	assert.Equal(t, uint32(122), pi.process.Truncated.GetArgumentsLength())
+	assert.Empty(t, pi.TakeFullArguments())
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package dns
+
+import (
+	"encoding/binary"
+	"errors"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+// Answer is a resource record of a DNS response.
+type Answer struct {
+	Name string
+	Type string
+	TTL  uint32
+	Data string
+}
+
+// Message is a parsed DNS message.
+type Message struct {
+	ID       uint16
+	Response bool
+	QName    string
+	QType    string
+	RCode    string
+	Answers  []Answer
+	// Truncated is set if the message could not be fully parsed because
+	// only a part of it was captured.
+	Truncated bool
+}
+
+// Parse parses a captured DNS message. If tcp is true, the message is
+// expected to start with the 2 bytes length prefix of DNS over TCP. If
+// truncated is true, only a prefix of the message was captured and Parse
+// returns the sections it was able to parse.
+func Parse(data []byte, tcp bool, truncated bool) (*Message, error) {
+	if tcp {
+		if len(data) < 2 {
+			return nil, errors.New("DNS over TCP message too short")
+		}
+		if int(binary.BigEndian.Uint16(data)) > len(data)-2 {
+			truncated = true
+		}
+		data = data[2:]
+	}
+
+	var p dnsmessage.Parser
+	hdr, err := p.Start(data)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &Message{
+		ID:        hdr.ID,
+		Response:  hdr.Response,
+		Truncated: truncated,
+	}
+	if hdr.Response {
+		msg.RCode = rcodeString(hdr.RCode)
+	}
+
+	q, err := p.Question()
+	if errors.Is(err, dnsmessage.ErrSectionDone) {
+		return msg, nil
+	} else if err != nil {
+		return partial(msg, truncated, err)
+	}
+	msg.QName = trimDot(q.Name.String())
+	msg.QType = typeString(q.Type)
+
+	if !hdr.Response {
+		return msg, nil
+	}
+
+	if err := p.SkipAllQuestions(); err != nil {
+		return partial(msg, truncated, err)
+	}
+	for {
+		rh, err := p.AnswerHeader()
+		if errors.Is(err, dnsmessage.ErrSectionDone) {
+			break
+		} else if err != nil {
+			return partial(msg, truncated, err)
+		}
+		answer := Answer{
+			Name: trimDot(rh.Name.String()),
+			Type: typeString(rh.Type),
+			TTL:  rh.TTL,
+		}
+		answer.Data, err = answerData(&p, rh.Type)
+		if err != nil {
+			return partial(msg, truncated, err)
+		}
+		msg.Answers = append(msg.Answers, answer)
+	}
+	return msg, nil
+}
+
+// partial returns what was parsed so far if the message was truncated, and
+// the error otherwise.
+func partial(msg *Message, truncated bool, err error) (*Message, error) {
+	if truncated {
+		return msg, nil
+	}
+	return nil, err
+}
+
+func answerData(p *dnsmessage.Parser, typ dnsmessage.Type) (string, error) {
+	switch typ {
+	case dnsmessage.TypeA:
+		r, err := p.AResource()
+		if err != nil {
+			return "", err
+		}
+		return netip.AddrFrom4(r.A).String(), nil
+	case dnsmessage.TypeAAAA:
+		r, err := p.AAAAResource()
+		if err != nil {
+			return "", err
+		}
+		return netip.AddrFrom16(r.AAAA).String(), nil
+	case dnsmessage.TypeCNAME:
+		r, err := p.CNAMEResource()
+		if err != nil {
+			return "", err
+		}
+		return trimDot(r.CNAME.String()), nil
+	case dnsmessage.TypeNS:
+		r, err := p.NSResource()
+		if err != nil {
+			return "", err
+		}
+		return trimDot(r.NS.String()), nil
+	case dnsmessage.TypePTR:
+		r, err := p.PTRResource()
+		if err != nil {
+			return "", err
+		}
+		return trimDot(r.PTR.String()), nil
+	case dnsmessage.TypeTXT:
+		r, err := p.TXTResource()
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(r.TXT, ""), nil
+	}
+	return "", p.SkipAnswer()
+}
+
+// rcodeString returns the mnemonic of a response code, as defined in RFC 6895.
+func rcodeString(rcode dnsmessage.RCode) string {
+	switch rcode {
+	case dnsmessage.RCodeSuccess:
+		return "NOERROR"
+	case dnsmessage.RCodeFormatError:
+		return "FORMERR"
+	case dnsmessage.RCodeServerFailure:
+		return "SERVFAIL"
+	case dnsmessage.RCodeNameError:
+		return "NXDOMAIN"
+	case dnsmessage.RCodeNotImplemented:
+		return "NOTIMP"
+	case dnsmessage.RCodeRefused:
+		return "REFUSED"
+	}
+	return "RCODE" + strconv.Itoa(int(rcode))
+}
+
+func typeString(t dnsmessage.Type) string {
+	return strings.TrimPrefix(t.String(), "Type")
+}
+
+func trimDot(name string) string {
+	if name == "." {
+		return name
+	}
+	return strings.TrimSuffix(name, ".")
+}
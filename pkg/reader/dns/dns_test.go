@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package dns
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func buildMessage(t *testing.T, response bool, rcode dnsmessage.RCode, answers ...dnsmessage.Resource) []byte {
+	b := dnsmessage.NewBuilder(nil, dnsmessage.Header{ID: 0x1234, Response: response, RCode: rcode})
+	b.EnableCompression()
+	require.NoError(t, b.StartQuestions())
+	require.NoError(t, b.Question(dnsmessage.Question{
+		Name:  dnsmessage.MustNewName("cilium.io."),
+		Type:  dnsmessage.TypeA,
+		Class: dnsmessage.ClassINET,
+	}))
+	require.NoError(t, b.StartAnswers())
+	for _, a := range answers {
+		switch r := a.Body.(type) {
+		case *dnsmessage.AResource:
+			require.NoError(t, b.AResource(a.Header, *r))
+		case *dnsmessage.CNAMEResource:
+			require.NoError(t, b.CNAMEResource(a.Header, *r))
+		}
+	}
+	data, err := b.Finish()
+	require.NoError(t, err)
+	return data
+}
+
+func TestParseQuery(t *testing.T) {
+	data := buildMessage(t, false, dnsmessage.RCodeSuccess)
+	msg, err := Parse(data, false, false)
+	require.NoError(t, err)
+	assert.Equal(t, &Message{ID: 0x1234, QName: "cilium.io", QType: "A"}, msg)
+
+	// DNS over TCP
+	tcp := binary.BigEndian.AppendUint16(nil, uint16(len(data)))
+	msg, err = Parse(append(tcp, data...), true, false)
+	require.NoError(t, err)
+	assert.Equal(t, &Message{ID: 0x1234, QName: "cilium.io", QType: "A"}, msg)
+}
+
+func TestParseResponse(t *testing.T) {
+	hdr := func(name string, typ dnsmessage.Type) dnsmessage.ResourceHeader {
+		return dnsmessage.ResourceHeader{
+			Name:  dnsmessage.MustNewName(name),
+			Type:  typ,
+			Class: dnsmessage.ClassINET,
+			TTL:   300,
+		}
+	}
+	data := buildMessage(t, true, dnsmessage.RCodeSuccess,
+		dnsmessage.Resource{
+			Header: hdr("cilium.io.", dnsmessage.TypeCNAME),
+			Body:   &dnsmessage.CNAMEResource{CNAME: dnsmessage.MustNewName("www.cilium.io.")},
+		},
+		dnsmessage.Resource{
+			Header: hdr("www.cilium.io.", dnsmessage.TypeA),
+			Body:   &dnsmessage.AResource{A: [4]byte{104, 198, 14, 52}},
+		},
+	)
+
+	expected := &Message{
+		ID:       0x1234,
+		Response: true,
+		QName:    "cilium.io",
+		QType:    "A",
+		RCode:    "NOERROR",
+		Answers: []Answer{
+			{Name: "cilium.io", Type: "CNAME", TTL: 300, Data: "www.cilium.io"},
+			{Name: "www.cilium.io", Type: "A", TTL: 300, Data: "104.198.14.52"},
+		},
+	}
+	msg, err := Parse(data, false, false)
+	require.NoError(t, err)
+	assert.Equal(t, expected, msg)
+
+	// a truncated capture returns the answers that were fully captured
+	msg, err = Parse(data[:len(data)-2], false, true)
+	require.NoError(t, err)
+	expected.Answers = expected.Answers[:1]
+	expected.Truncated = true
+	assert.Equal(t, expected, msg)
+
+	// without the truncated flag, a partial message is an error
+	_, err = Parse(data[:len(data)-2], false, false)
+	require.Error(t, err)
+}
+
+func TestParseNXDomain(t *testing.T) {
+	data := buildMessage(t, true, dnsmessage.RCodeNameError)
+	msg, err := Parse(data, false, false)
+	require.NoError(t, err)
+	assert.Equal(t, "NXDOMAIN", msg.RCode)
+	assert.Empty(t, msg.Answers)
+}
@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package http
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// RedactedValue replaces the values of redacted headers.
+const RedactedValue = "*****"
+
+var ErrNotHTTP = errors.New("not an HTTP/1.x message")
+
+type Header struct {
+	Name  string
+	Value string
+}
+
+// Message is the start line and headers of an HTTP/1.x request or response.
+type Message struct {
+	// request
+	Method string
+	Path   string
+	// response
+	StatusCode uint32
+	Reason     string
+
+	Version string
+	Headers []Header
+	// Truncated is set if the end of the headers was not found.
+	Truncated bool
+}
+
+// Parse parses the start of an HTTP/1.x request or response. The data might
+// be a prefix of the message, in which case the headers that were fully
+// captured are returned and Truncated is set. The values of the headers in
+// redact, which must be lower case, are replaced with RedactedValue. Parse
+// returns ErrNotHTTP if the data does not start with an HTTP/1.x request or
+// status line.
+func Parse(data []byte, redact map[string]struct{}) (*Message, error) {
+	line, rest, found := cutLine(data)
+	if !found {
+		return nil, ErrNotHTTP
+	}
+
+	msg := &Message{}
+	if !parseStartLine(msg, line) {
+		return nil, ErrNotHTTP
+	}
+
+	for {
+		line, rest, found = cutLine(rest)
+		if !found {
+			msg.Truncated = true
+			return msg, nil
+		}
+		if len(line) == 0 {
+			return msg, nil
+		}
+		name, value, ok := bytes.Cut(line, []byte(":"))
+		if !ok || len(name) == 0 {
+			// not a header, give up on the rest of the message
+			msg.Truncated = true
+			return msg, nil
+		}
+		h := Header{
+			Name:  string(name),
+			Value: string(bytes.TrimSpace(value)),
+		}
+		if _, ok := redact[strings.ToLower(h.Name)]; ok {
+			h.Value = RedactedValue
+		}
+		msg.Headers = append(msg.Headers, h)
+	}
+}
+
+func parseStartLine(msg *Message, line []byte) bool {
+	if bytes.HasPrefix(line, []byte("HTTP/1.")) {
+		// status line: HTTP/1.1 200 OK
+		version, rest, _ := bytes.Cut(line, []byte(" "))
+		code, reason, _ := bytes.Cut(rest, []byte(" "))
+		status, err := strconv.ParseUint(string(code), 10, 16)
+		if err != nil || len(code) != 3 {
+			return false
+		}
+		msg.Version = string(version)
+		msg.StatusCode = uint32(status)
+		msg.Reason = string(reason)
+		return true
+	}
+
+	// request line: GET /index.html HTTP/1.1
+	fields := bytes.Split(line, []byte(" "))
+	if len(fields) != 3 || !isMethod(fields[0]) || len(fields[1]) == 0 ||
+		!bytes.HasPrefix(fields[2], []byte("HTTP/1.")) {
+		return false
+	}
+	msg.Method = string(fields[0])
+	msg.Path = string(fields[1])
+	msg.Version = string(fields[2])
+	return true
+}
+
+func isMethod(method []byte) bool {
+	if len(method) == 0 {
+		return false
+	}
+	for _, c := range method {
+		if (c < 'A' || c > 'Z') && c != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// cutLine cuts the first line of data, terminated by CRLF or LF.
+func cutLine(data []byte) (line, rest []byte, found bool) {
+	line, rest, found = bytes.Cut(data, []byte("\n"))
+	if !found {
+		return nil, nil, false
+	}
+	return bytes.TrimSuffix(line, []byte("\r")), rest, true
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRequest(t *testing.T) {
+	data := "GET /index.html HTTP/1.1\r\nHost: cilium.io\r\nAuthorization: Bearer secret\r\nUser-Agent: curl/8.5.0\r\n\r\nbody"
+	msg, err := Parse([]byte(data), map[string]struct{}{"authorization": {}})
+	require.NoError(t, err)
+	assert.Equal(t, &Message{
+		Method:  "GET",
+		Path:    "/index.html",
+		Version: "HTTP/1.1",
+		Headers: []Header{
+			{Name: "Host", Value: "cilium.io"},
+			{Name: "Authorization", Value: RedactedValue},
+			{Name: "User-Agent", Value: "curl/8.5.0"},
+		},
+	}, msg)
+}
+
+func TestParseResponse(t *testing.T) {
+	data := "HTTP/1.1 404 Not Found\r\nContent-Length: 0\r\n\r\n"
+	msg, err := Parse([]byte(data), nil)
+	require.NoError(t, err)
+	assert.Equal(t, &Message{
+		StatusCode: 404,
+		Reason:     "Not Found",
+		Version:    "HTTP/1.1",
+		Headers: []Header{
+			{Name: "Content-Length", Value: "0"},
+		},
+	}, msg)
+}
+
+func TestParseTruncated(t *testing.T) {
+	data := "POST /api HTTP/1.1\r\nHost: cilium.io\r\nContent-Ty"
+	msg, err := Parse([]byte(data), nil)
+	require.NoError(t, err)
+	assert.Equal(t, &Message{
+		Method:    "POST",
+		Path:      "/api",
+		Version:   "HTTP/1.1",
+		Headers:   []Header{{Name: "Host", Value: "cilium.io"}},
+		Truncated: true,
+	}, msg)
+}
+
+func TestParseNotHTTP(t *testing.T) {
+	for _, data := range []string{
+		"",
+		"GET /index.html HTTP/1.1",
+		"PRI * HTTP/2.0\r\n\r\nSM\r\n\r\n",
+		"HTTP/1.1 OK\r\n\r\n",
+		"get / HTTP/1.1\r\n\r\n",
+		"\x16\x03\x01\x02\x00\x01\x00\x01\xfc\x03\x03\n",
+	} {
+		_, err := Parse([]byte(data), nil)
+		require.ErrorIs(t, err, ErrNotHTTP, data)
+	}
+}
@@ -4,7 +4,9 @@
 package node
 
 import (
+	"maps"
 	"os"
+	"sync/atomic"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/pkg/logger"
@@ -20,7 +22,9 @@ const (
 var (
 	nodeName       string
 	exportNodeName string
-	nodeLabels     = map[string]string{}
+	// nodeLabels is updated when the labels of the node change, the maps
+	// it points to are not modified.
+	nodeLabels atomic.Pointer[map[string]string]
 )
 
 func init() {
@@ -60,8 +64,20 @@ func SetNodeName() {
 	}
 }
 
+// SetNodeLabels sets the labels of the Kubernetes node the agent runs on.
 func SetNodeLabels(labels map[string]string) {
-	nodeLabels = labels
+	labels = maps.Clone(labels)
+	nodeLabels.Store(&labels)
+}
+
+// GetNodeLabels returns the labels of the Kubernetes node the agent runs on.
+// They are empty if the agent does not run in a Kubernetes cluster, or if the
+// node could not be retrieved. The returned map must not be modified.
+func GetNodeLabels() map[string]string {
+	if labels := nodeLabels.Load(); labels != nil {
+		return *labels
+	}
+	return nil
 }
 
 // GetNodeNameForExport returns node name string for JSON export. It uses the HUBBLE_NODE_NAME
@@ -86,5 +102,5 @@ func GetNodeName() string {
 func SetCommonFields(ev *tetragon.GetEventsResponse) {
 	ev.NodeName = exportNodeName
 	ev.ClusterName = option.Config.ClusterName
-	ev.NodeLabels = nodeLabels
+	ev.NodeLabels = GetNodeLabels()
 }
@@ -0,0 +1,343 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// Package replay implements a bounded, on-disk buffer of recent events. Events
+// are assigned a node-local sequence number when they are recorded, which
+// clients can later use as a cursor to replay the events they missed.
+package replay
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
+	"github.com/cilium/tetragon/pkg/option"
+)
+
+const (
+	// a buffer is split into (roughly) this many segments, so that
+	// retention removes old events in reasonably small chunks
+	segmentsPerBuffer = 8
+	minSegmentSize    = 1024 * 1024
+
+	pruneInterval = time.Minute
+)
+
+type request struct {
+	ev      *tetragon.GetEventsResponse
+	flushed chan struct{}
+}
+
+// Buffer is a size and age bounded ring of events stored on disk.
+type Buffer struct {
+	dir         string
+	maxSize     int64
+	maxAge      time.Duration
+	segmentSize int64
+
+	// seq is the last assigned sequence number. It is only accessed by
+	// Record, which callers serialize.
+	seq uint64
+
+	queue chan request
+	done  chan struct{}
+	wg    sync.WaitGroup
+
+	// mu protects segments. The last segment is the one being written to.
+	mu       sync.Mutex
+	segments []*segment
+	active   *os.File
+}
+
+// New opens (or creates) the replay buffer in dir. Existing segments are
+// recovered so that sequence numbers keep increasing across agent restarts.
+// maxSize bounds the total size of the buffer in bytes, and maxAge, if not
+// zero, bounds the age of the buffered events.
+func New(dir string, maxSize int64, maxAge time.Duration) (*Buffer, error) {
+	if maxSize <= 0 {
+		return nil, fmt.Errorf("invalid replay buffer size %d", maxSize)
+	}
+	if maxAge < 0 {
+		return nil, fmt.Errorf("invalid replay buffer age %s", maxAge)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create replay buffer directory: %w", err)
+	}
+
+	queueSize := uint(10000)
+	if option.Config.EventQueueSize > 0 {
+		queueSize = option.Config.EventQueueSize
+	}
+	b := &Buffer{
+		dir:         dir,
+		maxSize:     maxSize,
+		maxAge:      maxAge,
+		segmentSize: max(maxSize/segmentsPerBuffer, minSegmentSize),
+		queue:       make(chan request, queueSize),
+		done:        make(chan struct{}),
+	}
+	if err := b.recover(); err != nil {
+		return nil, err
+	}
+	b.prune(time.Now())
+
+	b.wg.Add(1)
+	go b.run()
+	return b, nil
+}
+
+func (b *Buffer) recover() error {
+	entries, err := os.ReadDir(b.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read replay buffer directory: %w", err)
+	}
+	for _, e := range entries {
+		first, ok := parseSegmentName(e.Name())
+		if !ok || !e.Type().IsRegular() {
+			continue
+		}
+		path := filepath.Join(b.dir, e.Name())
+		seg, err := recoverSegment(path, first)
+		if err != nil {
+			return fmt.Errorf("failed to recover replay segment %s: %w", path, err)
+		}
+		if seg.size == 0 {
+			os.Remove(path)
+			continue
+		}
+		b.segments = append(b.segments, seg)
+	}
+	slices.SortFunc(b.segments, func(x, y *segment) int {
+		return cmp.Compare(x.first, y.first)
+	})
+	if n := len(b.segments); n > 0 {
+		b.seq = b.segments[n-1].last
+	}
+	logger.GetLogger().Info("Opened event replay buffer",
+		"directory", b.dir,
+		"segments", len(b.segments),
+		"sequence", b.seq)
+	return nil
+}
+
+// Record assigns the next sequence number to ev and queues it for writing.
+// Calls to Record must be serialized by the caller, so that the order of
+// sequence numbers matches the order in which events are delivered to
+// listeners. If the write queue is full, the event is not buffered.
+func (b *Buffer) Record(ev *tetragon.GetEventsResponse) {
+	b.seq++
+	ev.Sequence = b.seq
+	select {
+	case b.queue <- request{ev: ev}:
+	default:
+		recordsDropped.Inc()
+	}
+}
+
+// Close stops the writer and closes the active segment. Events queued but not
+// yet written are lost.
+func (b *Buffer) Close() {
+	close(b.done)
+	b.wg.Wait()
+}
+
+func (b *Buffer) run() {
+	defer b.wg.Done()
+	ticker := time.NewTicker(pruneInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case req := <-b.queue:
+			if req.flushed != nil {
+				close(req.flushed)
+				continue
+			}
+			if err := b.write(req.ev); err != nil {
+				recordsDropped.Inc()
+				logger.GetLogger().Warn("Failed to write event to replay buffer", logfields.Error, err)
+			}
+		case <-ticker.C:
+			b.prune(time.Now())
+		case <-b.done:
+			b.mu.Lock()
+			if b.active != nil {
+				b.active.Close()
+				b.active = nil
+			}
+			b.mu.Unlock()
+			return
+		}
+	}
+}
+
+func (b *Buffer) write(ev *tetragon.GetEventsResponse) error {
+	payload, err := proto.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	r := &record{
+		seq:     ev.GetSequence(),
+		payload: payload,
+	}
+	if ev.GetTime() != nil {
+		r.time = ev.GetTime().AsTime().UnixNano()
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	n := len(b.segments)
+	if b.active == nil || b.segments[n-1].size >= b.segmentSize {
+		if err := b.rotate(r.seq); err != nil {
+			return err
+		}
+		n = len(b.segments)
+	}
+	// Write the whole record at once so that concurrent readers never
+	// observe a partial record within the size they snapshotted.
+	if _, err := b.active.Write(encodeRecord(r)); err != nil {
+		return err
+	}
+	seg := b.segments[n-1]
+	seg.last = r.seq
+	seg.lastTime = r.time
+	seg.size += int64(headerSize + len(payload))
+	bufferSize.Add(float64(headerSize + len(payload)))
+	return nil
+}
+
+// rotate closes the active segment and starts a new one whose first record
+// has sequence first. Must be called with mu held.
+func (b *Buffer) rotate(first uint64) error {
+	if b.active != nil {
+		b.active.Close()
+		b.active = nil
+	}
+	path := filepath.Join(b.dir, segmentName(first))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to create replay segment: %w", err)
+	}
+	b.active = f
+	b.segments = append(b.segments, &segment{path: path, first: first, last: first - 1})
+	b.pruneLocked(time.Now())
+	return nil
+}
+
+func (b *Buffer) prune(now time.Time) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.pruneLocked(now)
+}
+
+// pruneLocked removes the oldest segments until the buffer is within its size
+// and age limits. The most recent segment is never removed. Must be called
+// with mu held.
+func (b *Buffer) pruneLocked(now time.Time) {
+	var total int64
+	for _, seg := range b.segments {
+		total += seg.size
+	}
+	for len(b.segments) > 1 {
+		oldest := b.segments[0]
+		expired := b.maxAge > 0 && oldest.lastTime < now.Add(-b.maxAge).UnixNano()
+		if total <= b.maxSize && !expired {
+			break
+		}
+		if err := os.Remove(oldest.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			logger.GetLogger().Warn("Failed to remove replay segment", "file", oldest.path, logfields.Error, err)
+		}
+		total -= oldest.size
+		b.segments = b.segments[1:]
+	}
+	bufferSize.Set(float64(total))
+}
+
+// flush waits until all events recorded before the call have been written.
+func (b *Buffer) flush(ctx context.Context) error {
+	flushed := make(chan struct{})
+	select {
+	case b.queue <- request{flushed: flushed}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-flushed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Replay calls fn, in order, for every buffered event after cursor. It
+// returns the sequence number of the last event replayed, or zero if no
+// events were replayed. Events recorded before Replay is called are
+// guaranteed to be considered, so a caller that starts receiving live events
+// before calling Replay can use the returned sequence number to skip
+// duplicates.
+func (b *Buffer) Replay(ctx context.Context, cursor *tetragon.EventCursor, fn func(*tetragon.GetEventsResponse) error) (uint64, error) {
+	if err := b.flush(ctx); err != nil {
+		return 0, err
+	}
+
+	after := func(r *record) bool {
+		return r.seq > cursor.GetSequence()
+	}
+	if cursor.GetTime() != nil {
+		ts := cursor.GetTime().AsTime().UnixNano()
+		after = func(r *record) bool {
+			return r.time > ts
+		}
+	}
+
+	b.mu.Lock()
+	segments := make([]segment, 0, len(b.segments))
+	for _, seg := range b.segments {
+		segments = append(segments, *seg)
+	}
+	b.mu.Unlock()
+
+	var last uint64
+	for _, seg := range segments {
+		if seg.size == 0 || seg.last <= cursor.GetSequence() {
+			continue
+		}
+		err := readSegment(seg.path, seg.size, func(r *record) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if !after(r) {
+				return nil
+			}
+			ev := &tetragon.GetEventsResponse{}
+			if err := proto.Unmarshal(r.payload, ev); err != nil {
+				return fmt.Errorf("failed to decode replayed event %d: %w", r.seq, err)
+			}
+			if err := fn(ev); err != nil {
+				return err
+			}
+			last = r.seq
+			eventsReplayed.Inc()
+			return nil
+		})
+		// the segment might have been pruned since we took the snapshot
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return last, err
+		}
+	}
+	return last, nil
+}
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package replay
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+var baseTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func testEvent(i int) *tetragon.GetEventsResponse {
+	return &tetragon.GetEventsResponse{
+		Event: &tetragon.GetEventsResponse_ProcessExec{
+			ProcessExec: &tetragon.ProcessExec{
+				Process: &tetragon.Process{
+					Binary: "/bin/test",
+				},
+			},
+		},
+		NodeName: "node",
+		Time:     timestamppb.New(baseTime.Add(time.Duration(i) * time.Second)),
+	}
+}
+
+func replayAll(t *testing.T, b *Buffer, cursor *tetragon.EventCursor) []uint64 {
+	var seqs []uint64
+	last, err := b.Replay(t.Context(), cursor, func(ev *tetragon.GetEventsResponse) error {
+		require.Equal(t, "node", ev.GetNodeName())
+		seqs = append(seqs, ev.GetSequence())
+		return nil
+	})
+	require.NoError(t, err)
+	if len(seqs) > 0 {
+		require.Equal(t, seqs[len(seqs)-1], last)
+	} else {
+		require.Zero(t, last)
+	}
+	return seqs
+}
+
+func seqRange(from, to uint64) []uint64 {
+	var ret []uint64
+	for i := from; i <= to; i++ {
+		ret = append(ret, i)
+	}
+	return ret
+}
+
+func TestReplayCursor(t *testing.T) {
+	b, err := New(t.TempDir(), 1024*1024*1024, 0)
+	require.NoError(t, err)
+	defer b.Close()
+
+	for i := 1; i <= 10; i++ {
+		ev := testEvent(i)
+		b.Record(ev)
+		require.Equal(t, uint64(i), ev.GetSequence())
+	}
+
+	seqs := replayAll(t, b, &tetragon.EventCursor{
+		Position: &tetragon.EventCursor_Sequence{Sequence: 0},
+	})
+	require.Equal(t, seqRange(1, 10), seqs)
+
+	seqs = replayAll(t, b, &tetragon.EventCursor{
+		Position: &tetragon.EventCursor_Sequence{Sequence: 7},
+	})
+	require.Equal(t, seqRange(8, 10), seqs)
+
+	seqs = replayAll(t, b, &tetragon.EventCursor{
+		Position: &tetragon.EventCursor_Sequence{Sequence: 10},
+	})
+	require.Empty(t, seqs)
+
+	// event i is observed at baseTime + i seconds
+	seqs = replayAll(t, b, &tetragon.EventCursor{
+		Position: &tetragon.EventCursor_Time{Time: timestamppb.New(baseTime.Add(4 * time.Second))},
+	})
+	require.Equal(t, seqRange(5, 10), seqs)
+}
+
+func TestReplayRecover(t *testing.T) {
+	dir := t.TempDir()
+	b, err := New(dir, 1024*1024*1024, 0)
+	require.NoError(t, err)
+	for i := 1; i <= 5; i++ {
+		b.Record(testEvent(i))
+	}
+	// make sure all events are written before closing
+	require.NoError(t, b.flush(t.Context()))
+	b.Close()
+
+	// simulate a record that was partially written during a crash
+	segs, err := filepath.Glob(filepath.Join(dir, "*"+segmentSuffix))
+	require.NoError(t, err)
+	require.Len(t, segs, 1)
+	f, err := os.OpenFile(segs[0], os.O_WRONLY|os.O_APPEND, 0)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0x1, 0x2, 0x3})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	b, err = New(dir, 1024*1024*1024, 0)
+	require.NoError(t, err)
+	defer b.Close()
+
+	// sequence numbers continue where they were left off
+	ev := testEvent(6)
+	b.Record(ev)
+	require.Equal(t, uint64(6), ev.GetSequence())
+
+	seqs := replayAll(t, b, &tetragon.EventCursor{
+		Position: &tetragon.EventCursor_Sequence{Sequence: 3},
+	})
+	require.Equal(t, seqRange(4, 6), seqs)
+}
+
+func TestReplayPrune(t *testing.T) {
+	dir := t.TempDir()
+	b, err := New(dir, 4*minSegmentSize, 0)
+	require.NoError(t, err)
+	defer b.Close()
+
+	// each record is larger than 1/8 of a segment, so that we end up
+	// rotating segments and dropping the oldest ones
+	payload := make([]byte, minSegmentSize/8)
+	const n = 100
+	for i := 1; i <= n; i++ {
+		ev := testEvent(i)
+		ev.GetProcessExec().Process.Arguments = string(payload)
+		b.Record(ev)
+	}
+
+	seqs := replayAll(t, b, &tetragon.EventCursor{})
+	require.NotEmpty(t, seqs)
+	require.Less(t, len(seqs), n)
+	// the most recent events are kept, without gaps
+	require.Equal(t, seqRange(uint64(n-len(seqs)+1), n), seqs)
+
+	var total int64
+	b.mu.Lock()
+	for _, seg := range b.segments {
+		total += seg.size
+	}
+	b.mu.Unlock()
+	require.LessOrEqual(t, total, b.maxSize+b.segmentSize)
+}
+
+func TestReplayPruneAge(t *testing.T) {
+	b, err := New(t.TempDir(), 1024*1024*1024, time.Hour)
+	require.NoError(t, err)
+	defer b.Close()
+
+	for i := 1; i <= 3; i++ {
+		b.Record(testEvent(i))
+	}
+	require.NoError(t, b.flush(t.Context()))
+	b.mu.Lock()
+	b.rotate(b.seq + 1)
+	b.mu.Unlock()
+	b.Record(&tetragon.GetEventsResponse{
+		NodeName: "node",
+		Time:     timestamppb.Now(),
+	})
+
+	// baseTime is way older than an hour, so the first segment goes away
+	b.prune(time.Now())
+	seqs := replayAll(t, b, &tetragon.EventCursor{})
+	require.Equal(t, []uint64{4}, seqs)
+}
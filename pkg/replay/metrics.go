@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package replay
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/cilium/tetragon/pkg/metrics"
+	"github.com/cilium/tetragon/pkg/metrics/consts"
+)
+
+var (
+	recordsDropped = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: consts.MetricsNamespace,
+		Name:      "event_replay_records_dropped_total",
+		Help:      "Number of events that could not be written to the event replay buffer",
+	})
+
+	bufferSize = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: consts.MetricsNamespace,
+		Name:      "event_replay_buffer_size_bytes",
+		Help:      "Size of the event replay buffer on disk",
+	})
+
+	eventsReplayed = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: consts.MetricsNamespace,
+		Name:      "events_replayed_total",
+		Help:      "Number of events replayed to GetEvents clients from the event replay buffer",
+	})
+)
+
+func RegisterMetrics(group metrics.Group) {
+	group.MustRegister(
+		recordsDropped,
+		bufferSize,
+		eventsReplayed,
+	)
+}
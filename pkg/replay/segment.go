@@ -0,0 +1,162 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package replay
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	segmentSuffix = ".seg"
+
+	// record header: sequence (8 bytes), event time in unix nanoseconds
+	// (8 bytes), payload length (4 bytes) and payload CRC32 (4 bytes).
+	headerSize = 24
+
+	// maxPayloadSize bounds the payload length read back from disk so that a
+	// corrupted header does not result in a huge allocation.
+	maxPayloadSize = 64 * 1024 * 1024
+)
+
+var errCorruptRecord = errors.New("corrupt replay record")
+
+// record is a single event stored in a segment.
+type record struct {
+	seq     uint64
+	time    int64
+	payload []byte
+}
+
+// segment is an append-only file holding records with consecutive sequence
+// numbers. Segments are named after the sequence number of their first record.
+type segment struct {
+	path     string
+	first    uint64
+	last     uint64
+	lastTime int64
+	size     int64
+}
+
+func segmentName(first uint64) string {
+	return fmt.Sprintf("%020d%s", first, segmentSuffix)
+}
+
+func parseSegmentName(name string) (uint64, bool) {
+	if !strings.HasSuffix(name, segmentSuffix) {
+		return 0, false
+	}
+	first, err := strconv.ParseUint(strings.TrimSuffix(name, segmentSuffix), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return first, true
+}
+
+func encodeRecord(r *record) []byte {
+	buf := make([]byte, headerSize+len(r.payload))
+	binary.LittleEndian.PutUint64(buf[0:8], r.seq)
+	binary.LittleEndian.PutUint64(buf[8:16], uint64(r.time))
+	binary.LittleEndian.PutUint32(buf[16:20], uint32(len(r.payload)))
+	binary.LittleEndian.PutUint32(buf[20:24], crc32.ChecksumIEEE(r.payload))
+	copy(buf[headerSize:], r.payload)
+	return buf
+}
+
+// readRecord reads the next record from rd. It returns io.EOF if there are no
+// more records, and errCorruptRecord if the record is truncated or its
+// checksum does not match.
+func readRecord(rd io.Reader) (*record, error) {
+	var hdr [headerSize]byte
+	if _, err := io.ReadFull(rd, hdr[:]); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, errCorruptRecord
+		}
+		return nil, err
+	}
+	size := binary.LittleEndian.Uint32(hdr[16:20])
+	if size > maxPayloadSize {
+		return nil, errCorruptRecord
+	}
+	r := &record{
+		seq:     binary.LittleEndian.Uint64(hdr[0:8]),
+		time:    int64(binary.LittleEndian.Uint64(hdr[8:16])),
+		payload: make([]byte, size),
+	}
+	if _, err := io.ReadFull(rd, r.payload); err != nil {
+		return nil, errCorruptRecord
+	}
+	if crc32.ChecksumIEEE(r.payload) != binary.LittleEndian.Uint32(hdr[20:24]) {
+		return nil, errCorruptRecord
+	}
+	return r, nil
+}
+
+// readSegment calls fn for every record in the first size bytes of the
+// segment file at path.
+func readSegment(path string, size int64, fn func(*record) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	rd := bufio.NewReader(io.LimitReader(f, size))
+	for {
+		r, err := readRecord(rd)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", filepath.Base(path), err)
+		}
+		if err := fn(r); err != nil {
+			return err
+		}
+	}
+}
+
+// recoverSegment scans the segment file at path and returns its metadata. A
+// trailing partial or corrupt record, as left behind by an unclean shutdown,
+// is truncated.
+func recoverSegment(path string, first uint64) (*segment, error) {
+	f, err := os.OpenFile(path, os.O_RDWR, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	seg := &segment{path: path, first: first}
+	rd := bufio.NewReader(f)
+	for {
+		r, err := readRecord(rd)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			if !errors.Is(err, errCorruptRecord) {
+				return nil, err
+			}
+			if err := f.Truncate(seg.size); err != nil {
+				return nil, err
+			}
+			break
+		}
+		if seg.size == 0 {
+			seg.first = r.seq
+		}
+		seg.last = r.seq
+		seg.lastTime = r.time
+		seg.size += int64(headerSize + len(r.payload))
+	}
+	return seg, nil
+}
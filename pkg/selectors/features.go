@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package selectors
+
+// Features are the features of the kernel and of the agent that selectors
+// depend on. They allow to compile selectors for other hosts than the one
+// running the code, for example to validate policies.
+type Features struct {
+	// LargeProgs is set if large BPF programs are supported
+	LargeProgs bool
+	// ParentsMap is set if the parents of processes are tracked
+	ParentsMap bool
+}
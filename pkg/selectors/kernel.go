@@ -97,6 +97,24 @@ var actionRateLimitScope = map[string]uint32{
 	"global":  ActionRateLimitScopeGlobal,
 }
 
+const (
+	ActionSampleNone = iota
+	ActionSampleOneInN
+	ActionSampleProbability
+)
+
+const (
+	ActionSampleScopeGlobal = iota
+	ActionSampleScopeProcess
+	ActionSampleScopeCgroup
+)
+
+var actionSampleScope = map[string]uint32{
+	"global":  ActionSampleScopeGlobal,
+	"process": ActionSampleScopeProcess,
+	"cgroup":  ActionSampleScopeCgroup,
+}
+
 // Action argument table entry (for URL and FQDN arguments)
 type ActionArgEntry struct {
 	arg     string
@@ -685,7 +703,7 @@ func writeMatchValues(k *KernelSelectorState, values []string, ty, op uint32) er
 		switch ty {
 
 		case gt.GenericIntType, gt.GenericS32Type, gt.GenericSizeType, gt.GenericS16Type, gt.GenericS8Type:
-			if (ty == gt.GenericS16Type || ty == gt.GenericS8Type) && !config.EnableLargeProgs() {
+			if (ty == gt.GenericS16Type || ty == gt.GenericS8Type) && !k.largeProgs() {
 				return fmt.Errorf("MatchArgs type %s is only supported in kernels supporting large programs (normally versions >= 5.3)", gt.GenericTypeString(int(ty)))
 			}
 			i, err := strconv.ParseInt(v, 0, 32)
@@ -694,7 +712,7 @@ func writeMatchValues(k *KernelSelectorState, values []string, ty, op uint32) er
 			}
 			WriteSelectorInt32(&k.data, int32(i))
 		case gt.GenericU32Type, gt.GenericU16Type, gt.GenericU8Type:
-			if (ty == gt.GenericU16Type || ty == gt.GenericU8Type) && !config.EnableLargeProgs() {
+			if (ty == gt.GenericU16Type || ty == gt.GenericU8Type) && !k.largeProgs() {
 				return fmt.Errorf("MatchArgs type %s is only supported in kernels supporting large programs (normally versions >= 5.3)", gt.GenericTypeString(int(ty)))
 			}
 			i, err := strconv.ParseUint(v, 0, 32)
@@ -858,10 +876,10 @@ func writeMatchFileType(k *KernelSelectorState, values []string, op uint32) erro
 	return writeMatchValues(k, sValues, gt.GenericU32Type, op)
 }
 
-func checkOp(op uint32) error {
+func checkOp(k *KernelSelectorState, op uint32) error {
 	switch op {
 	case SelectorOpGT, SelectorOpLT, SelectorOpCapabilitiesGained:
-		if !config.EnableLargeProgs() {
+		if !k.largeProgs() {
 			opName := selectorOpStringTable[op]
 			return fmt.Errorf(
 				"operator %d (%s) is only supported in kernels supporting large programs (normally versions >= 5.3)",
@@ -966,7 +984,7 @@ func parseMatchArg(k *KernelSelectorState, arg *v1alpha1.ArgSelector, sig []v1al
 	if err != nil {
 		return fmt.Errorf("matcharg error: %w", err)
 	}
-	err = checkOp(op)
+	err = checkOp(k, op)
 	if err != nil {
 		return fmt.Errorf("matcharg error: %w", err)
 	}
@@ -1081,7 +1099,7 @@ func parseMatchArg(k *KernelSelectorState, arg *v1alpha1.ArgSelector, sig []v1al
 func ParseMatchArgs(k *KernelSelectorState, matchArgs []v1alpha1.ArgSelector, matchData []v1alpha1.ArgSelector,
 	args []v1alpha1.KProbeArg, data []v1alpha1.KProbeArg) error {
 	maxArgs := 1
-	if config.EnableLargeProgs() {
+	if k.largeProgs() {
 		maxArgs = 5 // we support up 5 argument filters under matchArgs with kernels >= 5.3, otherwise 1 argument
 	}
 	if len(matchArgs)+len(matchData) > maxArgs {
@@ -1159,6 +1177,43 @@ func parseRateLimit(str string, scopeStr string) (uint32, uint32, error) {
 	return uint32(rateLimit), scope, nil
 }
 
+// User specifies sample either as "1/N" (or "N") or as a probability. We store
+// the mode with N or the probability scaled to the uint32 range, which is what
+// bpf_get_prandom_u32() returns.
+func parseSample(str string, scopeStr string) (mode uint32, value uint32, scope uint32, err error) {
+	if strings.Contains(str, ".") {
+		p, err := strconv.ParseFloat(str, 64)
+		if err != nil || p <= 0 || p > 1 {
+			return 0, 0, 0, fmt.Errorf("parseSample: sample probability %s is invalid, must be in (0, 1]", str)
+		}
+		if scopeStr != "" {
+			return 0, 0, 0, errors.New("parseSample: sampleScope is only valid with a \"1/N\" sample")
+		}
+		if p == 1 {
+			return ActionSampleNone, 0, 0, nil
+		}
+		return ActionSampleProbability, uint32(p * (1 << 32)), 0, nil
+	}
+
+	nStr := strings.TrimPrefix(str, "1/")
+	n, err := strconv.ParseUint(nStr, 10, 32)
+	if err != nil || n == 0 {
+		return 0, 0, 0, fmt.Errorf("parseSample: sample value %s is invalid", str)
+	}
+	scope = uint32(ActionSampleScopeGlobal)
+	if scopeStr != "" {
+		var ok bool
+		scope, ok = actionSampleScope[scopeStr]
+		if !ok {
+			return 0, 0, 0, fmt.Errorf("parseSample: sampleScope value %s is invalid", scopeStr)
+		}
+	}
+	if n == 1 {
+		return ActionSampleNone, 0, 0, nil
+	}
+	return ActionSampleOneInN, uint32(n), scope, nil
+}
+
 func ParseMatchAction(k *KernelSelectorState, action *v1alpha1.ActionSelector, actionArgTable *idtable.Table) error {
 	act, ok := actionTypeTable[strings.ToLower(action.Action)]
 	if !ok {
@@ -1179,6 +1234,29 @@ func ParseMatchAction(k *KernelSelectorState, action *v1alpha1.ActionSelector, a
 		}
 	}
 
+	sampleMode := uint32(ActionSampleNone)
+	sampleValue := uint32(0)
+	sampleScope := uint32(0)
+	sampleID := uint32(0)
+	if action.Sample != "" || action.SampleScope != "" {
+		if act != ActionTypePost {
+			return fmt.Errorf("sampling can only be applied to post action (was applied to '%s')", action.Action)
+		}
+		if action.Sample == "" {
+			return errors.New("sampleScope requires a sample")
+		}
+		var err error
+		sampleMode, sampleValue, sampleScope, err = parseSample(action.Sample, action.SampleScope)
+		if err != nil {
+			return err
+		}
+		if sampleMode == ActionSampleOneInN {
+			// counters of different actions of the hook are kept apart
+			k.sampleIDs++
+			sampleID = k.sampleIDs
+		}
+	}
+
 	switch act {
 	case ActionTypeFollowFd, ActionTypeCopyFd:
 		WriteSelectorUint32(&k.data, action.ArgFd)
@@ -1215,6 +1293,10 @@ func ParseMatchAction(k *KernelSelectorState, action *v1alpha1.ActionSelector, a
 	case ActionTypePost:
 		WriteSelectorUint32(&k.data, rateLimit)
 		WriteSelectorUint32(&k.data, rateLimitScope)
+		WriteSelectorUint32(&k.data, sampleMode)
+		WriteSelectorUint32(&k.data, sampleValue)
+		WriteSelectorUint32(&k.data, sampleScope)
+		WriteSelectorUint32(&k.data, sampleID)
 		kernelStackTrace := uint32(0)
 		if action.KernelStackTrace {
 			kernelStackTrace = 1
@@ -1329,7 +1411,7 @@ func ParseMatchNamespace(k *KernelSelectorState, action *v1alpha1.NamespaceSelec
 
 func ParseMatchNamespaces(k *KernelSelectorState, actions []v1alpha1.NamespaceSelector) error {
 	maxNActions := 4 // 4 should match the value of the NUM_NS_FILTERS_SMALL in pfilter.h
-	if config.EnableLargeProgs() {
+	if k.largeProgs() {
 		maxNActions = 10 // 10 should match the value of ns_max_types in hubble_msg.h
 	}
 	if len(actions) > maxNActions {
@@ -1375,7 +1457,7 @@ func ParseMatchNamespaceChanges(k *KernelSelectorState, actions []v1alpha1.Names
 	if len(actions) > 1 {
 		return fmt.Errorf("matchNamespaceChanges supports only a single filter (current number of filters is %d)", len(actions))
 	}
-	if (len(actions) == 1) && !config.EnableLargeProgs() {
+	if (len(actions) == 1) && !k.largeProgs() {
 		return errors.New("matchNamespaceChanges is only supported in kernels >= 5.3")
 	}
 	loff := AdvanceSelectorLength(&k.data)
@@ -1522,7 +1604,7 @@ func ParseMatchBinary(k *KernelSelectorState, b *v1alpha1.BinarySelector, selIdx
 			k.WriteMatchBinariesPath(selectorType.keyFromSelectorID(selIdx), s)
 		}
 	case SelectorOpPrefix, SelectorOpNotPrefix:
-		if !config.EnableLargeProgs() {
+		if !k.largeProgs() {
 			return fmt.Errorf("%s error: \"Prefix\" and \"NotPrefix\" operators need large BPF progs (kernel>5.3)", selectorType)
 		}
 		sel.MapID, err = writePrefixBinaries(k, b.Values)
@@ -1530,7 +1612,7 @@ func ParseMatchBinary(k *KernelSelectorState, b *v1alpha1.BinarySelector, selIdx
 			return fmt.Errorf("failed to write the prefix operator for the %s selector: %w", selectorType, err)
 		}
 	case SelectorOpPostfix, SelectorOpNotPostfix:
-		if !config.EnableLargeProgs() {
+		if !k.largeProgs() {
 			return fmt.Errorf("%s error: \"Postfix\" and \"NotPostfix\" operators need large BPF progs (kernel>5.3)", selectorType)
 		}
 		sel.MapID, err = writePostfixBinaries(k, b.Values)
@@ -1551,7 +1633,7 @@ func ParseMatchBinaries(k *KernelSelectorState, binaries []v1alpha1.BinarySelect
 		return errors.New("only support a single matchBinaries per selector")
 	}
 
-	if len(binaries) > 0 && selector == matchParentBinaries && !option.Config.ParentsMapEnabled {
+	if len(binaries) > 0 && selector == matchParentBinaries && !k.parentsMap() {
 		return errors.New("matchParentBinaries selector can be used only with parents map enabled")
 	}
 
@@ -1563,6 +1645,20 @@ func ParseMatchBinaries(k *KernelSelectorState, binaries []v1alpha1.BinarySelect
 	return nil
 }
 
+func (k *KernelSelectorState) largeProgs() bool {
+	if k.features != nil {
+		return k.features.LargeProgs
+	}
+	return config.EnableLargeProgs()
+}
+
+func (k *KernelSelectorState) parentsMap() bool {
+	if k.features != nil {
+		return k.features.ParentsMap
+	}
+	return option.Config.ParentsMapEnabled
+}
+
 type KernelSelectorArgs struct {
 	Selectors      []v1alpha1.KProbeSelector
 	Args           []v1alpha1.KProbeArg
@@ -1571,6 +1667,9 @@ type KernelSelectorArgs struct {
 	ListReader     ValueReader
 	Maps           *KernelSelectorMaps
 	IsUprobe       bool
+	// Features the selectors are compiled for, the ones of the host and
+	// the agent if nil
+	Features *Features
 }
 
 // The byte array storing the selector configuration has the following format
@@ -1622,7 +1721,7 @@ func InitKernelSelectors(selectors []v1alpha1.KProbeSelector, args []v1alpha1.KP
 }
 
 func InitKernelReturnSelectors(selectors []v1alpha1.KProbeSelector, returnArg *v1alpha1.KProbeArg, actionArgTable *idtable.Table) ([4096]byte, error) {
-	state, err := InitKernelReturnSelectorState(selectors, returnArg, actionArgTable, nil, nil)
+	state, err := InitKernelReturnSelectorState(selectors, returnArg, actionArgTable, nil, nil, nil)
 	if err != nil {
 		return [4096]byte{}, err
 	}
@@ -1634,12 +1733,14 @@ func createKernelSelectorState(
 	listReader ValueReader,
 	maps *KernelSelectorMaps,
 	isUprobe bool,
+	features *Features,
 	parseSelector func(k *KernelSelectorState, selectors *v1alpha1.KProbeSelector, selIdx int) error,
 ) (*KernelSelectorState, error) {
 	if len(selectors) > MaxSelectors {
 		return nil, fmt.Errorf("no more than %d selectors supported (%d provided)", MaxSelectors, len(selectors))
 	}
 	state := NewKernelSelectorState(listReader, maps, isUprobe)
+	state.features = features
 
 	WriteSelectorUint32(&state.data, uint32(len(selectors)))
 	soff := make([]uint32, len(selectors))
@@ -1690,23 +1791,29 @@ func InitKernelSelectorState(args *KernelSelectorArgs) (*KernelSelectorState, er
 		return nil
 	}
 
-	return createKernelSelectorState(args.Selectors, args.ListReader, args.Maps, args.IsUprobe, parse)
+	return createKernelSelectorState(args.Selectors, args.ListReader, args.Maps, args.IsUprobe, args.Features, parse)
 }
 
 func InitKernelReturnSelectorState(selectors []v1alpha1.KProbeSelector, returnArg *v1alpha1.KProbeArg,
-	actionArgTable *idtable.Table, listReader ValueReader, maps *KernelSelectorMaps) (*KernelSelectorState, error) {
+	actionArgTable *idtable.Table, listReader ValueReader, maps *KernelSelectorMaps, features *Features) (*KernelSelectorState, error) {
 
 	parse := func(k *KernelSelectorState, selector *v1alpha1.KProbeSelector, _ int) error {
 		if err := ParseMatchArgs(k, selector.MatchReturnArgs, []v1alpha1.ArgSelector{}, []v1alpha1.KProbeArg{*returnArg}, []v1alpha1.KProbeArg{}); err != nil {
 			return fmt.Errorf("parseMatchArgs  error: %w", err)
 		}
+		for _, action := range selector.MatchReturnActions {
+			// return probes do not share the sample counters of their hook
+			if action.Sample != "" || action.SampleScope != "" {
+				return errors.New("parseMatchActions error: sampling is not supported in matchReturnActions")
+			}
+		}
 		if err := ParseMatchActions(k, selector.MatchReturnActions, actionArgTable); err != nil {
 			return fmt.Errorf("parseMatchActions error: %w", err)
 		}
 		return nil
 	}
 
-	return createKernelSelectorState(selectors, listReader, maps, false, parse)
+	return createKernelSelectorState(selectors, listReader, maps, false, features, parse)
 }
 
 func CleanupKernelSelectorState(state *KernelSelectorState) error {
@@ -1837,6 +1944,17 @@ func HasRateLimit(selectors []v1alpha1.KProbeSelector) bool {
 	return false
 }
 
+func HasSample(selectors []v1alpha1.KProbeSelector) bool {
+	for _, selector := range selectors {
+		for _, matchAction := range selector.MatchActions {
+			if len(matchAction.Sample) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func HasStackTrace(selectors []v1alpha1.KProbeSelector) bool {
 	for _, selector := range selectors {
 		for _, matchAction := range selector.MatchActions {
@@ -827,6 +827,10 @@ func TestParseMatchAction(t *testing.T) {
 		0x00, 0x00, 0x00, 0x00, // Action = "post"
 		0x00, 0x00, 0x00, 0x00, // DontRepeatFor = 0
 		0x00, 0x00, 0x00, 0x00, // DontRepeatForScope = 0
+		0x00, 0x00, 0x00, 0x00, // SampleMode = 0
+		0x00, 0x00, 0x00, 0x00, // SampleValue = 0
+		0x00, 0x00, 0x00, 0x00, // SampleScope = 0
+		0x00, 0x00, 0x00, 0x00, // SampleID = 0
 		0x00, 0x00, 0x00, 0x00, // StackTrace = 0
 		0x00, 0x00, 0x00, 0x00, // UserStackTrace = 0
 		0x00, 0x00, 0x00, 0x00, // ImaHash = 0
@@ -841,11 +845,15 @@ func TestParseMatchAction(t *testing.T) {
 		0x00, 0x00, 0x00, 0x00, // Action = "post"
 		0x00, 0x00, 0x00, 0x00, // DontRepeatFor = 0
 		0x00, 0x00, 0x00, 0x00, // DontRepeatForScope = 0
+		0x00, 0x00, 0x00, 0x00, // SampleMode = 0
+		0x00, 0x00, 0x00, 0x00, // SampleValue = 0
+		0x00, 0x00, 0x00, 0x00, // SampleScope = 0
+		0x00, 0x00, 0x00, 0x00, // SampleID = 0
 		0x00, 0x00, 0x00, 0x00, // StackTrace = 0
 		0x00, 0x00, 0x00, 0x00, // UserStackTrace = 0
 		0x00, 0x00, 0x00, 0x00, // ImaHash = 0
 	}
-	length := []byte{52, 0x00, 0x00, 0x00}
+	length := []byte{84, 0x00, 0x00, 0x00}
 	expected := append(length, expected1[:]...)
 	expected = append(expected, expected2[:]...)
 
@@ -948,11 +956,11 @@ func TestInitKernelSelectors(t *testing.T) {
 	}
 
 	expectedSelsizeSmall := []byte{
-		0x18, 0x01, 0x00, 0x00, // size = pids + args + actions + namespaces + capabilities  + 4
+		0x28, 0x01, 0x00, 0x00, // size = pids + args + actions + namespaces + capabilities  + 4
 	}
 
 	expectedSelsizeLarge := []byte{
-		0x4c, 0x01, 0x00, 0x00, // size = pids + args + actions + namespaces + namespacesChanges + capabilities + capabilityChanges + 4
+		0x5c, 0x01, 0x00, 0x00, // size = pids + args + actions + namespaces + namespacesChanges + capabilities + capabilityChanges + 4
 	}
 
 	expectedFilters := []byte{
@@ -1070,10 +1078,14 @@ func TestInitKernelSelectors(t *testing.T) {
 		0x02, 0x00, 0x00, 0x00, // value 2
 
 		// actions header
-		40, 0x00, 0x00, 0x00, // size = (6 * sizeof(uint32) * number of actions) + args
+		56, 0x00, 0x00, 0x00, // size = (10 * sizeof(uint32) * number of actions) + args
 		0x00, 0x00, 0x00, 0x00, // post to userspace
 		0x00, 0x00, 0x00, 0x00, // DontRepeatFor = 0
 		0x00, 0x00, 0x00, 0x00, // DontRepeatForScope = 0
+		0x00, 0x00, 0x00, 0x00, // SampleMode = 0
+		0x00, 0x00, 0x00, 0x00, // SampleValue = 0
+		0x00, 0x00, 0x00, 0x00, // SampleScope = 0
+		0x00, 0x00, 0x00, 0x00, // SampleID = 0
 		0x00, 0x00, 0x00, 0x00, // StackTrace = 0
 		0x00, 0x00, 0x00, 0x00, // UserStackTrace = 0
 		0x00, 0x00, 0x00, 0x00, // ImaHash = 0
@@ -1109,10 +1121,14 @@ func TestInitKernelSelectors(t *testing.T) {
 		0xff, 0xff, 0xff, 0xff, // map ID for strings 2049-4096
 
 		// actions header
-		40, 0x00, 0x00, 0x00, // size = (6 * sizeof(uint32) * number of actions) + args + 4
+		56, 0x00, 0x00, 0x00, // size = (10 * sizeof(uint32) * number of actions) + args + 4
 		0x00, 0x00, 0x00, 0x00, // post to userspace
 		0x00, 0x00, 0x00, 0x00, // DontRepeatFor = 0
 		0x00, 0x00, 0x00, 0x00, // DontRepeatForScope = 0
+		0x00, 0x00, 0x00, 0x00, // SampleMode = 0
+		0x00, 0x00, 0x00, 0x00, // SampleValue = 0
+		0x00, 0x00, 0x00, 0x00, // SampleScope = 0
+		0x00, 0x00, 0x00, 0x00, // SampleID = 0
 		0x00, 0x00, 0x00, 0x00, // StackTrace = 0
 		0x00, 0x00, 0x00, 0x00, // UserStackTrace = 0
 		0x00, 0x00, 0x00, 0x00, // ImaHash = 0
@@ -1296,23 +1312,27 @@ func TestReturnSelectorArgIntActionFollowfd(t *testing.T) {
 
 	expU32Push(1)  // off: 0       number of selectors
 	expU32Push(4)  // off: 4       relative ofset of selector (4 + 4 = 8)
-	expU32Push(68) // off: 8       selector: length
+	expU32Push(84) // off: 8       selector: length
 	expU32Push(24) // off: 12      selector: matchReturnArgs length
 	expU32Push(0)  // off: 16      selector: matchReturnArgs arg offset[0]
 	expU32Push(0)  // off: 20      selector: matchReturnArgs arg offset[1]
 	expU32Push(0)  // off: 24      selector: matchReturnArgs arg offset[2]
 	expU32Push(0)  // off: 28      selector: matchReturnArgs arg offset[3]
 	expU32Push(0)  // off: 32      selector: matchReturnArgs arg offset[4]
-	expU32Push(40) // off: 36      selector: matchReturnActions length
+	expU32Push(56) // off: 36      selector: matchReturnActions length
 	expU32Push(0)  // off: 40      selector: selectors.ActionTypePost
 	expU32Push(0)  // off: 44      selector: rateLimit
-	expU32Push(0)  // off: 44      selector: rateLimitScope
-	expU32Push(0)  // off: 48      selector: stackTrace
-	expU32Push(0)  // off: 52      selector: userStackTrace
-	expU32Push(0)  // off: 56      selector: imaHash
-	expU32Push(1)  // off: 60      selector: selectors.ActionTypeFollowFd
-	expU32Push(7)  // off: 64      selector: action.ArgFd
-	expU32Push(8)  // off: 68      selector: action.ArgName
+	expU32Push(0)  // off: 48      selector: rateLimitScope
+	expU32Push(0)  // off: 52      selector: sampleMode
+	expU32Push(0)  // off: 56      selector: sampleValue
+	expU32Push(0)  // off: 60      selector: sampleScope
+	expU32Push(0)  // off: 64      selector: sampleID
+	expU32Push(0)  // off: 68      selector: stackTrace
+	expU32Push(0)  // off: 72      selector: userStackTrace
+	expU32Push(0)  // off: 76      selector: imaHash
+	expU32Push(1)  // off: 80      selector: selectors.ActionTypeFollowFd
+	expU32Push(7)  // off: 84      selector: action.ArgFd
+	expU32Push(8)  // off: 88      selector: action.ArgName
 
 	if bytes.Equal(expected[:expectedLen], b[:expectedLen]) == false {
 		t.Errorf("\ngot: %v\nexp: %v\n", b[:expectedLen], expected[:expectedLen])
@@ -1425,3 +1445,55 @@ func TestParseCapabilityMask(t *testing.T) {
 	_, err = parseCapabilitiesMask("CAP_PIZZA")
 	assert.Error(t, err)
 }
+
+func TestParseSample(t *testing.T) {
+	mode, value, scope, err := parseSample("1/100", "")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(ActionSampleOneInN), mode)
+	assert.Equal(t, uint32(100), value)
+	assert.Equal(t, uint32(ActionSampleScopeGlobal), scope)
+
+	mode, value, scope, err = parseSample("10", "cgroup")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(ActionSampleOneInN), mode)
+	assert.Equal(t, uint32(10), value)
+	assert.Equal(t, uint32(ActionSampleScopeCgroup), scope)
+
+	mode, value, _, err = parseSample("0.25", "")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(ActionSampleProbability), mode)
+	assert.Equal(t, uint32(1<<30), value)
+
+	// sampling everything is the same as not sampling
+	mode, _, _, err = parseSample("1/1", "process")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(ActionSampleNone), mode)
+	mode, _, _, err = parseSample("1.0", "")
+	require.NoError(t, err)
+	assert.Equal(t, uint32(ActionSampleNone), mode)
+
+	for _, tc := range []struct{ sample, scope string }{
+		{"1/0", ""},
+		{"1/pizza", ""},
+		{"0.0", ""},
+		{"1.5", ""},
+		{"0.5", "process"},
+		{"1/10", "node"},
+	} {
+		_, _, _, err = parseSample(tc.sample, tc.scope)
+		assert.Error(t, err, "sample %q scope %q", tc.sample, tc.scope)
+	}
+
+	var actionArgTable idtable.Table
+	k := &KernelSelectorState{data: KernelSelectorData{off: 0}}
+	err = ParseMatchAction(k, &v1alpha1.ActionSelector{Action: "sigkill", Sample: "1/10"}, &actionArgTable)
+	require.Error(t, err)
+	err = ParseMatchAction(k, &v1alpha1.ActionSelector{Action: "post", SampleScope: "cgroup"}, &actionArgTable)
+	require.Error(t, err)
+
+	returnArg := v1alpha1.KProbeArg{Index: 0, Type: "int"}
+	_, err = InitKernelReturnSelectors([]v1alpha1.KProbeSelector{{
+		MatchReturnActions: []v1alpha1.ActionSelector{{Action: "post", Sample: "1/10"}},
+	}}, &returnArg, &actionArgTable)
+	require.ErrorContains(t, err, "sampling is not supported in matchReturnActions")
+}
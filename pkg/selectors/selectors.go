@@ -138,6 +138,14 @@ type KernelSelectorState struct {
 	subStrs []string
 
 	celExprFunctions CelExprFunctions
+
+	// sampleIDs is the number of post actions with "1/N" sampling, used to
+	// give each of them its own counters
+	sampleIDs uint32
+
+	// features the selectors are compiled for, the ones of the host and
+	// the agent if nil
+	features *Features
 }
 
 func NewKernelSelectorState(listReader ValueReader, maps *KernelSelectorMaps, isUprobe bool) *KernelSelectorState {
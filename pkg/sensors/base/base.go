@@ -26,6 +26,7 @@ import (
 const (
 	execveMapMaxEntries = 32768
 	RingBufMapName      = "tg_rb_events"
+	RingBufHighMapName  = "tg_rb_events_high"
 )
 
 var (
@@ -70,8 +71,9 @@ var (
 	).SetPolicy(sensors.BaseSensorName)
 
 	/* Event Ring map */
-	TCPMonMap     = program.MapBuilder("tcpmon_map", Execve)
-	RingBufEvents = program.MapBuilder(RingBufMapName, Execve, Exit, Fork)
+	TCPMonMap         = program.MapBuilder("tcpmon_map", Execve)
+	RingBufEvents     = program.MapBuilder(RingBufMapName, Execve, Exit, Fork)
+	RingBufEventsHigh = program.MapBuilder(RingBufHighMapName, Execve)
 	/* Networking and Process Monitoring maps */
 	ExecveMap           = program.MapBuilder("execve_map", Execve, Exit, Fork, ExecveBprmCommit, ExecveMapUpdate)
 	ExecveTailCallsMap  = program.MapBuilderProgram("execve_calls", Execve)
@@ -159,6 +161,10 @@ func setupSensor() {
 		Execve.RewriteConstants["ENV_VARS_ENABLED"] = uint8(1)
 	}
 
+	if option.Config.ProcessArgsFullCapture && config.EnableLargeProgs() {
+		Execve.RewriteConstants["ARGS_FULL_CAPTURE"] = uint8(1)
+	}
+
 	if option.Config.ParentsMapEnabled {
 		Execve.RewriteConstants["PARENTS_MAP_ENABLED"] = uint8(1)
 	}
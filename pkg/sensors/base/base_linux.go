@@ -50,6 +50,13 @@ func GetDefaultMaps() []*program.Map {
 		RingBufEvents.SetMaxEntries(rbSize)
 		logger.GetLogger().Info("BPF ring buffer size (bytes)", "total", strutils.SizeWithSuffix(rbSize))
 		maps = append(maps, RingBufEvents)
+
+		rbSizeHigh := config.GetRBSizeHigh()
+		RingBufEventsHigh.SetMaxEntries(rbSizeHigh)
+		if config.EnableHighPriorityRingBuf() {
+			logger.GetLogger().Info("BPF high priority ring buffer size (bytes)", "total", strutils.SizeWithSuffix(rbSizeHigh))
+		}
+		maps = append(maps, RingBufEventsHigh)
 	}
 	return maps
 
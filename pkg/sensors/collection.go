@@ -30,6 +30,7 @@ const (
 	ErrorState
 	LoadingState
 	UnloadingState
+	NotApplicableState
 )
 
 func (s TracingPolicyState) ToTetragonState() tetragon.TracingPolicyState {
@@ -46,6 +47,8 @@ func (s TracingPolicyState) ToTetragonState() tetragon.TracingPolicyState {
 		return tetragon.TracingPolicyState_TP_STATE_LOADING
 	case UnloadingState:
 		return tetragon.TracingPolicyState_TP_STATE_UNLOADING
+	case NotApplicableState:
+		return tetragon.TracingPolicyState_TP_STATE_NOT_APPLICABLE
 	default:
 		return tetragon.TracingPolicyState_TP_STATE_UNKNOWN
 	}
@@ -162,6 +165,7 @@ func (c *collection) stats() *tetragon.TracingPolicyStats {
 			MonitorOverride:       stats.ActionsCount[policystats.PolicyMonitorOverride],
 			NotifyEnforcer:        stats.ActionsCount[policystats.PolicyNotifyEnforcer],
 			MonitorNotifyEnforcer: stats.ActionsCount[policystats.PolicyMonitorNotifyEnforcer],
+			SampledOut:            stats.ActionsCount[policystats.PolicySampledOut],
 		},
 	}
 }
@@ -116,21 +116,24 @@ func execParse(reader *bytes.Reader) (processapi.MsgProcess, error) {
 		return proc, err
 	}
 
-	readData := func(size uint16) ([]byte, error) {
+	// readData returns the data of a data event and the number of bytes
+	// that did not fit in it.
+	readData := func(size uint16) ([]byte, uint32, error) {
 		var desc dataapi.DataEventDesc
 
 		if uint16(unsafe.Sizeof(desc)) != size {
-			return nil, errors.New("msg exec mismatched size")
+			return nil, 0, errors.New("msg exec mismatched size")
 		}
 		if err := binary.Read(reader, binary.LittleEndian, &desc); err != nil {
-			return nil, err
+			return nil, 0, err
 		}
-		return observer.DataGet(desc)
+		data, err := observer.DataGet(desc)
+		return data, desc.Leftover, err
 	}
 
 	if exec.SizePath != 0 {
 		if exec.Flags&api.EventDataFilename != 0 {
-			data, err := readData(exec.SizePath)
+			data, _, err := readData(exec.SizePath)
 			if err != nil {
 				return proc, err
 			}
@@ -149,14 +152,21 @@ func execParse(reader *bytes.Reader) (processapi.MsgProcess, error) {
 
 	if exec.SizeArgs != 0 {
 		if exec.Flags&api.EventDataArgs != 0 {
-			data, err := readData(exec.SizeArgs)
+			data, leftover, err := readData(exec.SizeArgs)
 			if err != nil {
 				return proc, err
 			}
-			// cut the zero byte
+			// the arguments did not fit in the data event, so they
+			// are missing their tail, including the zero byte
+			if leftover != 0 {
+				proc.Flags |= api.EventTruncArgs
+				proc.ArgsLeftover = leftover
+			} else if len(data) > 0 {
+				// cut the zero byte
+				data = data[:len(data)-1]
+			}
 			if len(data) > 0 {
-				n := len(data) - 1
-				cmdArgs = bytes.Split(data[:n], []byte{0x00})
+				cmdArgs = bytes.Split(data, []byte{0x00})
 			}
 		} else {
 			data := make([]byte, exec.SizeArgs)
@@ -181,12 +191,17 @@ func execParse(reader *bytes.Reader) (processapi.MsgProcess, error) {
 		var err error
 
 		if exec.Flags&api.EventDataEnvs != 0 {
-			data, err = readData(exec.SizeEnvs)
+			var leftover uint32
+			data, leftover, err = readData(exec.SizeEnvs)
 			if err != nil {
 				return proc, err
 			}
-			// cut the zero byte
-			data = data[:len(data)-1]
+			if leftover != 0 {
+				proc.EnvsLeftover = leftover
+			} else if len(data) > 0 {
+				// cut the zero byte
+				data = data[:len(data)-1]
+			}
 		} else {
 			data = make([]byte, exec.SizeEnvs)
 			if err := binary.Read(reader, binary.LittleEndian, &data); err != nil {
@@ -941,6 +941,49 @@ func TestExecParse(t *testing.T) {
 		assert.Equal(t, string(cwd), decCwd)
 	})
 
+	t.Run("Truncated args as data event", func(t *testing.T) {
+		observer.DataPurge()
+
+		// - filename (string)
+		// - args (data event, truncated without the zero byte)
+		// - cwd (string)
+
+		var args []byte
+		args = append(args, 'a', 'r', 'g', '1', 0, 'a', 'r')
+
+		id := dataapi.DataEventId{Pid: 1, Time: 2}
+		desc := dataapi.DataEventDesc{Error: 0, Pad: 0, Leftover: 3, Size: uint32(len(args[:])), Id: id}
+		err = observer.DataAdd(id, args)
+		require.NoError(t, err)
+
+		exec.Flags = api.EventDataArgs
+		exec.Size = uint32(processapi.MSG_SIZEOF_EXECVE + len(filename) + binary.Size(desc) + len(cwd))
+		exec.SizePath = uint16(len(filename))
+		exec.SizeArgs = uint16(binary.Size(desc))
+		exec.SizeCwd = uint16(len(cwd))
+
+		var buf bytes.Buffer
+		binary.Write(&buf, binary.LittleEndian, exec)
+		binary.Write(&buf, binary.LittleEndian, filename)
+		binary.Write(&buf, binary.LittleEndian, desc)
+		binary.Write(&buf, binary.LittleEndian, cwd)
+
+		reader := bytes.NewReader(buf.Bytes())
+
+		process, err := execParse(reader)
+		require.NoError(t, err)
+
+		// execParse check
+		assert.Equal(t, string(args)+"\x00"+string(cwd), process.Args)
+		assert.Equal(t, uint32(3), process.ArgsLeftover)
+		assert.NotZero(t, process.Flags&api.EventTruncArgs)
+
+		// ArgsDecoder check
+		decArgs, decCwd := proc.ArgsDecoder(process.Args, process.Flags)
+		assert.Equal(t, "arg1 ar", decArgs)
+		assert.Equal(t, string(cwd), decCwd)
+	})
+
 	t.Run("Filename and args as data event", func(t *testing.T) {
 		observer.DataPurge()
 
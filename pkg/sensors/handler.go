@@ -9,9 +9,16 @@ import (
 	"sync"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/bpf"
 	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	slimv1 "github.com/cilium/tetragon/pkg/k8s/slim/k8s/apis/meta/v1"
+	"github.com/cilium/tetragon/pkg/labels"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
 	"github.com/cilium/tetragon/pkg/policyfilter"
+	"github.com/cilium/tetragon/pkg/policysig"
+	"github.com/cilium/tetragon/pkg/reader/node"
+	"github.com/cilium/tetragon/pkg/sensors/program"
 	"github.com/cilium/tetragon/pkg/tracingpolicy"
 )
 
@@ -96,21 +103,42 @@ func (h *handler) updatePolicyFilter(tp tracingpolicy.TracingPolicy, tpID uint64
 		}
 	}
 
+	var workloadSelector *v1alpha1.WorkloadSelector
+	if ws := tp.TpSpec().WorkloadSelector; ws != nil {
+		if len(ws.Kinds)+len(ws.Names) > 0 {
+			workloadSelector = ws
+		}
+	}
+
 	// we do not call AddPolicy unless filtering is actually needed. This
 	// means that if policyfilter is disabled
 	// (option.Config.EnablePolicyFilter is false) then loading the policy
 	// will only fail if filtering is required.
-	if namespace == "" && podSelector == nil && containerSelector == nil {
+	if namespace == "" && podSelector == nil && containerSelector == nil && workloadSelector == nil {
 		return policyfilter.NoFilterID, nil
 	}
 
 	filterID := policyfilter.PolicyID(tpID)
-	if err := h.pfState.AddPolicy(filterID, namespace, podSelector, containerSelector); err != nil {
+	if err := h.pfState.AddPolicy(filterID, namespace, podSelector, containerSelector, workloadSelector); err != nil {
 		return policyfilter.NoFilterID, err
 	}
 	return filterID, nil
 }
 
+// nodeSelected returns whether the node selector of the policy, if any,
+// matches the labels of the node the agent runs on.
+func nodeSelected(tp tracingpolicy.TracingPolicy) (bool, error) {
+	ns := tp.TpSpec().NodeSelector
+	if ns == nil || len(ns.MatchLabels)+len(ns.MatchExpressions) == 0 {
+		return true, nil
+	}
+	selector, err := labels.SelectorFromLabelSelector(ns)
+	if err != nil {
+		return false, fmt.Errorf("invalid node selector: %w", err)
+	}
+	return selector.Match(node.GetNodeLabels()), nil
+}
+
 func (h *handler) addTracingPolicy(op *tracingPolicyAdd) error {
 	h.collections.mu.Lock()
 	defer h.collections.mu.Unlock()
@@ -129,6 +157,42 @@ func (h *handler) addTracingPolicy(op *tracingPolicyAdd) error {
 	}
 	collections[op.ck] = &col
 
+	// refuse policies that are not signed by a trusted key, if the agent
+	// requires signed policies.
+	if err := policysig.VerifyPolicy(op.tp); err != nil {
+		col.err = err
+		col.state = LoadErrorState
+		return err
+	}
+
+	// skip policies that do not select the node the agent runs on.
+	applicable, err := nodeSelected(op.tp)
+	if err != nil {
+		col.err = err
+		col.state = LoadErrorState
+		return err
+	}
+	if !applicable {
+		if len(node.GetNodeLabels()) == 0 {
+			logger.GetLogger().Warn("Tracing policy has a node selector but the labels of this node are unknown, skipping it until they are",
+				"policy", op.ck.String())
+		} else {
+			logger.GetLogger().Info("Tracing policy does not select this node, skipping it",
+				"policy", op.ck.String())
+		}
+		col.state = NotApplicableState
+		return nil
+	}
+
+	return h.doLoadTracingPolicy(&col)
+}
+
+// doLoadTracingPolicy sets up the policy filter and loads the sensors of a
+// policy that selects the node.
+//
+// should be called with h.collections.mu locked (for writing)
+func (h *handler) doLoadTracingPolicy(col *collection) error {
+	tp := col.tracingpolicy
 	// update policy filter state before loading the sensors of the policy.
 	//
 	// The filterID is set to a non-zero value only if we need to apply
@@ -138,7 +202,7 @@ func (h *handler) addTracingPolicy(op *tracingPolicyAdd) error {
 	// to work if no filtering is needed. A sensor that does not support
 	// policyfilter should return an error on PolicyHandler if a filter id
 	// other than filterID is passed.
-	filterID, err := h.updatePolicyFilter(op.tp, tpID)
+	filterID, err := h.updatePolicyFilter(tp, col.tracingpolicyID)
 	if err != nil {
 		col.err = err
 		col.state = LoadErrorState
@@ -146,7 +210,7 @@ func (h *handler) addTracingPolicy(op *tracingPolicyAdd) error {
 	}
 	col.policyfilterID = uint64(filterID)
 
-	sensors, err := sensorsFromPolicyHandlers(op.tp, filterID)
+	sensors, err := sensorsFromPolicyHandlers(tp, filterID)
 	if err != nil {
 		col.err = err
 		col.state = LoadErrorState
@@ -158,7 +222,7 @@ func (h *handler) addTracingPolicy(op *tracingPolicyAdd) error {
 
 	// unlock so that policyLister can access the collections (read-only) while we are loading.
 	h.collections.mu.Unlock()
-	err = h.load(&col)
+	err = h.load(col)
 	h.collections.mu.Lock()
 
 	if err != nil {
@@ -170,6 +234,129 @@ func (h *handler) addTracingPolicy(op *tracingPolicyAdd) error {
 	return nil
 }
 
+// doUnloadTracingPolicy destroys the sensors and removes the policy filter
+// of a policy that no longer selects the node.
+//
+// should be called with h.collections.mu locked (for writing)
+func (h *handler) doUnloadTracingPolicy(col *collection) error {
+	col.state = UnloadingState
+	// unlock so that policyLister can access the collections (read-only) while we are unloading.
+	h.collections.mu.Unlock()
+	h.muLoad.Lock()
+	col.destroy(true)
+	h.muLoad.Unlock()
+	h.collections.mu.Lock()
+
+	col.sensors = nil
+	col.state = NotApplicableState
+	filterID := policyfilter.PolicyID(col.policyfilterID)
+	col.policyfilterID = 0
+	if err := h.pfState.DelPolicy(filterID); err != nil {
+		return fmt.Errorf("failed to remove tracing policy %q from policyfilter: %w", col.name, err)
+	}
+	return nil
+}
+
+// updateNodeLabels evaluates the node selector of the tracing policies again,
+// after the labels of the node changed: the policies that now select the node
+// are loaded, and the ones that no longer select it are unloaded.
+func (h *handler) updateNodeLabels() error {
+	h.collections.mu.Lock()
+	defer h.collections.mu.Unlock()
+
+	// the lock is released while loading, so iterate over a copy
+	cols := make(map[collectionKey]*collection, len(h.collections.c))
+	for ck, col := range h.collections.c {
+		if col.tracingpolicy != nil {
+			cols[ck] = col
+		}
+	}
+
+	var errs error
+	for ck, col := range cols {
+		if h.collections.c[ck] != col {
+			// deleted or replaced while the lock was released
+			continue
+		}
+		selected, err := nodeSelected(col.tracingpolicy)
+		if err != nil {
+			continue
+		}
+		switch {
+		case selected && col.state == NotApplicableState:
+			logger.GetLogger().Info("Tracing policy now selects this node, loading it", "policy", ck.String())
+			if err := h.doLoadTracingPolicy(col); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("failed to load tracing policy %s: %w", ck, err))
+			}
+		case !selected && (col.state == EnabledState || col.state == DisabledState):
+			logger.GetLogger().Info("Tracing policy no longer selects this node, unloading it", "policy", ck.String())
+			errs = errors.Join(errs, h.doUnloadTracingPolicy(col))
+		}
+	}
+	return errs
+}
+
+// validateTracingPolicy runs the policy through the same pipeline as
+// addTracingPolicy, up to and including the BPF verifier, without attaching
+// the programs or registering the policy. Policy filtering is not set up, so
+// programs are verified without it.
+func (h *handler) validateTracingPolicy(tp tracingpolicy.TracingPolicy) *tetragon.ValidateTracingPolicyResponse {
+	ret := &tetragon.ValidateTracingPolicyResponse{Name: tp.TpName()}
+	if tpNs, ok := tp.(tracingpolicy.TracingPolicyNamespaced); ok {
+		ret.Namespace = tpNs.TpNamespace()
+	}
+
+	sensors, err := sensorsFromPolicyHandlers(tp, policyfilter.NoFilterID)
+	if err != nil {
+		ret.FailedStage = tetragon.TracingPolicyValidationStage_TP_VALIDATION_STAGE_SENSOR
+		ret.Error = err.Error()
+		return ret
+	}
+
+	h.muLoad.Lock()
+	defer h.muLoad.Unlock()
+
+	var errs error
+	mapsInfo := map[string]bpf.ExtendedMapInfo{}
+	for _, si := range sensors {
+		s, ok := si.(*Sensor)
+		if !ok {
+			errs = errors.Join(errs, fmt.Errorf("cannot verify sensor of type %T", si))
+			continue
+		}
+		hooks, err := s.Verify(h.bpfDir)
+		errs = errors.Join(errs, err)
+		ret.Hooks = append(ret.Hooks, hooks...)
+		for _, p := range s.Progs {
+			// Verified programs get private copies of maps that are shared
+			// once loaded, so identify maps by name rather than by ID.
+			for _, info := range p.LoadedMapsInfo {
+				if !program.IsGlobalMap(info.Name) {
+					mapsInfo[info.Name] = info
+				}
+			}
+		}
+		// release the state allocated by the policy handlers
+		if s.DestroyHook != nil {
+			if err := s.DestroyHook(); err != nil {
+				logger.GetLogger().Warn("Destroy hook failed", "sensor", s.Name, logfields.Error, err)
+			}
+		}
+		s.Destroyed = true
+	}
+	for _, info := range mapsInfo {
+		ret.KernelMemoryBytes += uint64(info.Memlock)
+	}
+
+	if errs != nil {
+		ret.FailedStage = tetragon.TracingPolicyValidationStage_TP_VALIDATION_STAGE_LOAD
+		ret.Error = errs.Error()
+		return ret
+	}
+	ret.Valid = true
+	return ret
+}
+
 func (h *handler) deleteTracingPolicy(op *tracingPolicyDelete) error {
 	h.collections.mu.Lock()
 	collections := h.collections.c
@@ -7,10 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"github.com/cilium/ebpf"
 	"github.com/cilium/ebpf/btf"
 
+	"github.com/cilium/tetragon/api/v1/tetragon"
 	cachedbtf "github.com/cilium/tetragon/pkg/btf"
 	"github.com/cilium/tetragon/pkg/kernels"
 	"github.com/cilium/tetragon/pkg/logger"
@@ -114,6 +116,64 @@ func (s *Sensor) loadMap(bpfDir string, loaderCache *loaderCache, m *program.Map
 	return nil
 }
 
+// Verify loads the programs of the sensor into the verifier without attaching
+// them. Maps are neither populated nor pinned: programs reuse the maps that
+// are already pinned under bpfDir (e.g., global maps) and get private copies of
+// the others, which are released once the program is verified.
+//
+// It returns the per-program results, and an error if a program that must load
+// (see program.Program.ErrorFatal) failed to do so.
+func (s *Sensor) Verify(bpfDir string) ([]*tetragon.TracingPolicyHookValidation, error) {
+	if s.Destroyed {
+		return nil, fmt.Errorf("sensor %s has been previously destroyed, please recreate it before verifying", s.Name)
+	}
+
+	version, _, err := kernels.GetKernelVersion(option.Config.KernelVersion, option.Config.ProcFS)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.FindPrograms(); err != nil {
+		return nil, fmt.Errorf("could not find BPF programs: %w", err)
+	}
+	for _, m := range s.Maps {
+		s.setMapPinPath(m)
+	}
+
+	var errs error
+	ret := make([]*tetragon.TracingPolicyHookValidation, 0, len(s.Progs))
+	for _, p := range s.Progs {
+		p.VerifyOnly = true
+		err := loadInstance(bpfDir, p, s.Maps, version, 0)
+		p.VerifyOnly = false
+
+		hook := &tetragon.TracingPolicyHookValidation{
+			Sensor: s.Name,
+			Object: filepath.Base(p.Name),
+			Label:  p.Label,
+			Attach: p.Attach,
+		}
+		if err != nil {
+			hook.Error = err.Error()
+			hook.VerifierLog = program.SlimVerifierError(err)
+			if p.ErrorFatal {
+				errs = errors.Join(errs, fmt.Errorf("program %s (%s): %w", p.Label, p.Attach, err))
+			}
+		} else {
+			logs := make([]string, 0, len(p.VerifierStats))
+			for _, st := range p.VerifierStats {
+				hook.VerifiedInstructions += st.Instructions
+				if st.Log != "" {
+					logs = append(logs, st.Name+": "+st.Log)
+				}
+			}
+			hook.VerifierLog = strings.Join(logs, "\n")
+		}
+		ret = append(ret, hook)
+	}
+	flushKernelSpec()
+	return ret, errs
+}
+
 func observerLoadInstance(bpfDir string, load *program.Program, maps []*program.Map) error {
 	version, _, err := kernels.GetKernelVersion(option.Config.KernelVersion, option.Config.ProcFS)
 	if err != nil {
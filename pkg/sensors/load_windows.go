@@ -4,8 +4,10 @@
 package sensors
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/pkg/kernels"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/option"
@@ -40,6 +42,10 @@ func loadInstance(bpfDir string, load *program.Program, maps []*program.Map, _,
 	return fmt.Errorf("program %s has unregistered type '%s'", load.Label, load.Type)
 }
 
+func (s *Sensor) Verify(_ string) ([]*tetragon.TracingPolicyHookValidation, error) {
+	return nil, errors.New("verifying sensors is not supported on windows")
+}
+
 func observerMinReqs() (bool, error) {
 	return true, nil
 }
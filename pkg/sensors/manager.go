@@ -14,6 +14,7 @@ import (
 	"github.com/cilium/tetragon/pkg/logger/logfields"
 	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/policyfilter"
+	"github.com/cilium/tetragon/pkg/reader/node"
 	"github.com/cilium/tetragon/pkg/tracingpolicy"
 )
 
@@ -134,6 +135,13 @@ func (h *Manager) AddTracingPolicy(ctx context.Context, tp tracingpolicy.Tracing
 	return h.handler.addTracingPolicy(op)
 }
 
+// ValidateTracingPolicy checks whether a tracing policy can be loaded, by
+// building its sensors and loading their programs into the verifier. Nothing
+// is attached and the policy is not added.
+func (h *Manager) ValidateTracingPolicy(_ context.Context, tp tracingpolicy.TracingPolicy) (*tetragon.ValidateTracingPolicyResponse, error) {
+	return h.handler.validateTracingPolicy(tp), nil
+}
+
 // DeleteTracingPolicy deletes a new sensor based on a tracing policy
 func (h *Manager) DeleteTracingPolicy(ctx context.Context, name string, namespace string) error {
 	ck := collectionKey{name, namespace}
@@ -162,6 +170,14 @@ func (h *Manager) ConfigureTracingPolicy(_ context.Context, conf *tetragon.Confi
 	return h.handler.configureTracingPolicy(ck, conf.Mode, conf.Enable)
 }
 
+// SetNodeLabels sets the labels of the node the agent runs on, and loads or
+// unloads the tracing policies whose node selector matches them differently
+// than the previous labels.
+func (h *Manager) SetNodeLabels(_ context.Context, labels map[string]string) error {
+	node.SetNodeLabels(labels)
+	return h.handler.updateNodeLabels()
+}
+
 // ListTracingPolicies returns a list of the active tracing policies
 func (h *Manager) ListTracingPolicies(_ context.Context) (*tetragon.ListTracingPoliciesResponse, error) {
 	ret := &tetragon.ListTracingPoliciesResponse{}
@@ -219,6 +235,16 @@ func (h *Manager) LogSensorsAndProbes(ctx context.Context) {
 	log.Info("Registered probe types", "types", strings.Join(names, ", "))
 }
 
+// ListTracingPolicySpecs returns the specs of the loaded tracing policies, by
+// name. Policies in different namespaces can have the same name.
+func (h *Manager) ListTracingPolicySpecs(ctx context.Context) map[string][]*v1alpha1.TracingPolicySpec {
+	ret := make(map[string][]*v1alpha1.TracingPolicySpec)
+	for _, col := range h.ListCollections(ctx, true) {
+		ret[col.TracingpolicyName] = append(ret[col.TracingpolicyName], col.TracingpolicySpec)
+	}
+	return ret
+}
+
 // ListCollections is not exposed via grpc;
 // can be used internally to access the list of Collection
 // (deep copied to avoid sync issues).
@@ -14,6 +14,7 @@ import (
 	"github.com/cilium/tetragon/pkg/bpf"
 	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	"github.com/cilium/tetragon/pkg/policyfilter"
+	"github.com/cilium/tetragon/pkg/reader/node"
 	"github.com/cilium/tetragon/pkg/sensors/program"
 	"github.com/cilium/tetragon/pkg/tracingpolicy"
 
@@ -32,6 +33,13 @@ func (d *dummyHandler) PolicyHandler(_ tracingpolicy.TracingPolicy, _ policyfilt
 	return d.s, d.e
 }
 
+// perPolicyHandler returns a new sensor for each policy, named after it.
+type perPolicyHandler struct{}
+
+func (perPolicyHandler) PolicyHandler(tp tracingpolicy.TracingPolicy, _ policyfilter.PolicyID) (SensorIface, error) {
+	return &Sensor{Name: tp.TpName() + "-sensor"}, nil
+}
+
 // TestAddPolicy tests the addition of a policy with a dummy sensor
 func TestAddPolicy(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -226,6 +234,61 @@ func TestPolicyStates(t *testing.T) {
 		assert.Len(t, l.Policies, 1)
 		assert.Equal(t, DisabledState.ToTetragonState(), l.Policies[0].State)
 	})
+
+	t.Run("NotApplicable", func(t *testing.T) {
+		RegisterPolicyHandlerAtInit("dummy", perPolicyHandler{})
+		t.Cleanup(func() {
+			delete(registeredPolicyHandlers, "dummy")
+		})
+		oldLabels := node.GetNodeLabels()
+		node.SetNodeLabels(map[string]string{"gpu": "true"})
+		t.Cleanup(func() {
+			node.SetNodeLabels(oldLabels)
+		})
+
+		mgr, err := StartSensorManager("")
+		require.NoError(t, err)
+		gpuPolicy := v1alpha1.TracingPolicy{}
+		gpuPolicy.Name = "gpu-policy"
+		gpuPolicy.Spec.NodeSelector = &slimv1.LabelSelector{
+			MatchLabels: map[string]slimv1.MatchLabelsValue{"gpu": "true"},
+		}
+		err = mgr.AddTracingPolicy(ctx, &gpuPolicy)
+		require.NoError(t, err)
+		ingressPolicy := v1alpha1.TracingPolicy{}
+		ingressPolicy.Name = "ingress-policy"
+		ingressPolicy.Spec.NodeSelector = &slimv1.LabelSelector{
+			MatchLabels: map[string]slimv1.MatchLabelsValue{"ingress": "true"},
+		}
+		err = mgr.AddTracingPolicy(ctx, &ingressPolicy)
+		require.NoError(t, err)
+
+		l, err := mgr.ListTracingPolicies(ctx)
+		require.NoError(t, err)
+		require.Len(t, l.Policies, 2)
+		states := map[string]tetragon.TracingPolicyState{}
+		for _, pol := range l.Policies {
+			states[pol.Name] = pol.State
+		}
+		assert.Equal(t, EnabledState.ToTetragonState(), states[gpuPolicy.Name])
+		assert.Equal(t, NotApplicableState.ToTetragonState(), states[ingressPolicy.Name])
+
+		// the policies are evaluated again when the node labels change
+		err = mgr.SetNodeLabels(ctx, map[string]string{"ingress": "true"})
+		require.NoError(t, err)
+		l, err = mgr.ListTracingPolicies(ctx)
+		require.NoError(t, err)
+		for _, pol := range l.Policies {
+			states[pol.Name] = pol.State
+		}
+		assert.Equal(t, NotApplicableState.ToTetragonState(), states[gpuPolicy.Name])
+		assert.Equal(t, EnabledState.ToTetragonState(), states[ingressPolicy.Name])
+
+		err = mgr.DeleteTracingPolicy(ctx, gpuPolicy.Name, gpuPolicy.Namespace)
+		require.NoError(t, err)
+		err = mgr.DeleteTracingPolicy(ctx, ingressPolicy.Name, ingressPolicy.Namespace)
+		require.NoError(t, err)
+	})
 }
 
 // TestPolicyLoadErrorOverride tests the fact that you can add a TracingPolicy
@@ -93,7 +93,7 @@ func loadProgram(
 		return errors.New("attach function is not provided")
 	}
 
-	keepCollection := option.Config.KeepCollection
+	keepCollection := option.Config.KeepCollection && !load.VerifyOnly
 
 	lc, err := doLoadProgram(bpfDir, load, opts, verbose, keepCollection)
 	if err != nil {
@@ -8,11 +8,14 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"unsafe"
 
 	"github.com/cilium/ebpf"
 	"github.com/cilium/ebpf/btf"
 	"github.com/cilium/ebpf/link"
+	"golang.org/x/sys/unix"
 
 	"github.com/cilium/tetragon/pkg/bpf"
 	cachedbtf "github.com/cilium/tetragon/pkg/btf"
@@ -537,6 +540,21 @@ func LSMOpen(load *Program) OpenFunc {
 	}
 }
 
+// FentryOpen sets the attach target of all the programs of the collection, the
+// tail called programs need the same target as the main fentry/fexit program.
+func FentryOpen(load *Program) OpenFunc {
+	return func(coll *ebpf.CollectionSpec) error {
+		for _, prog := range coll.Programs {
+			if prog.AttachType == ebpf.AttachTraceFEntry || prog.AttachType == ebpf.AttachTraceFExit {
+				prog.AttachTo = load.Attach
+			} else {
+				return errors.New("only AttachTraceFEntry and AttachTraceFExit are supported for fentry programs")
+			}
+		}
+		return nil
+	}
+}
+
 func LSMAttach() AttachFunc {
 	return func(_ *ebpf.Collection, _ *ebpf.CollectionSpec,
 		prog *ebpf.Program, spec *ebpf.ProgramSpec) (unloader.Unloader, error) {
@@ -559,6 +577,56 @@ func LSMAttach() AttachFunc {
 	}
 }
 
+// PerfEventAttach opens a CPU clock perf event on each CPU, sampling at the
+// frequency of the attach data, and attaches the program to them.
+func PerfEventAttach(load *Program) AttachFunc {
+	return func(_ *ebpf.Collection, _ *ebpf.CollectionSpec,
+		prog *ebpf.Program, spec *ebpf.ProgramSpec) (unloader.Unloader, error) {
+
+		data, ok := load.AttachData.(*PerfEventAttachData)
+		if !ok {
+			return nil, fmt.Errorf("attaching '%s' failed: wrong attach data", spec.Name)
+		}
+		cpus, err := ebpf.PossibleCPU()
+		if err != nil {
+			return nil, fmt.Errorf("attaching '%s' failed: %w", spec.Name, err)
+		}
+
+		events := &unloader.PerfEventUnloader{}
+		for cpu := range cpus {
+			attr := unix.PerfEventAttr{
+				Type:   unix.PERF_TYPE_SOFTWARE,
+				Config: unix.PERF_COUNT_SW_CPU_CLOCK,
+				Sample: data.Frequency,
+				Bits:   unix.PerfBitFreq,
+			}
+			attr.Size = uint32(unsafe.Sizeof(attr))
+			fd, err := unix.PerfEventOpen(&attr, -1, cpu, -1, unix.PERF_FLAG_FD_CLOEXEC)
+			if errors.Is(err, unix.ENODEV) {
+				// offline CPU
+				continue
+			}
+			if err != nil {
+				events.Unload(true)
+				return nil, fmt.Errorf("attaching '%s' failed: opening perf event on CPU %d: %w", spec.Name, cpu, err)
+			}
+			events.FDs = append(events.FDs, fd)
+			if err := unix.IoctlSetInt(fd, unix.PERF_EVENT_IOC_SET_BPF, prog.FD()); err != nil {
+				events.Unload(true)
+				return nil, fmt.Errorf("attaching '%s' failed: %w", spec.Name, err)
+			}
+			if err := unix.IoctlSetInt(fd, unix.PERF_EVENT_IOC_ENABLE, 0); err != nil {
+				events.Unload(true)
+				return nil, fmt.Errorf("attaching '%s' failed: enabling perf event: %w", spec.Name, err)
+			}
+		}
+		return unloader.ChainUnloader{
+			&unloader.ProgUnloader{Prog: prog},
+			events,
+		}, nil
+	}
+}
+
 func multiKprobeAttach(load *Program, prog *ebpf.Program,
 	spec *ebpf.ProgramSpec, opts link.KprobeMultiOptions,
 	bpfDir string, extra ...string) (unloader.Unloader, error) {
@@ -758,6 +826,15 @@ func LoadTracingProgram(bpfDir string, load *Program, maps []*Map, verbose int)
 	return loadProgram(bpfDir, load, opts, verbose)
 }
 
+func LoadFentryProgram(bpfDir string, load *Program, maps []*Map, verbose int) error {
+	opts := &LoadOpts{
+		Attach: TracingAttach(load, bpfDir),
+		Open:   FentryOpen(load),
+		Maps:   maps,
+	}
+	return loadProgram(bpfDir, load, opts, verbose)
+}
+
 func LoadLSMProgram(bpfDir string, load *Program, maps []*Map, verbose int) error {
 	opts := &LoadOpts{
 		Attach: LSMAttach(),
@@ -784,6 +861,14 @@ func LoadMultiUprobeProgram(bpfDir string, load *Program, maps []*Map, verbose i
 	return loadProgram(bpfDir, load, opts, verbose)
 }
 
+func LoadPerfEventProgram(bpfDir string, load *Program, maps []*Map, verbose int) error {
+	opts := &LoadOpts{
+		Attach: PerfEventAttach(load),
+		Maps:   maps,
+	}
+	return loadProgram(bpfDir, load, opts, verbose)
+}
+
 func SeccompAttach() AttachFunc {
 	return func(_ *ebpf.Collection, _ *ebpf.CollectionSpec,
 		prog *ebpf.Program, _ *ebpf.ProgramSpec) (unloader.Unloader, error) {
@@ -837,6 +922,29 @@ func slimVerifierError(errStr string) string {
 	return errStr[:headEnd] + "\n...\n" + errStr[tailStart:]
 }
 
+// SlimVerifierError returns a shortened version of the verifier log contained
+// in err, or an empty string if err is not a verifier error.
+func SlimVerifierError(err error) string {
+	var ve *ebpf.VerifierError
+	if errors.As(err, &ve) {
+		return slimVerifierError(fmt.Sprintf("%+v", ve))
+	}
+	return ""
+}
+
+func verifierStats(coll *ebpf.Collection) []VerifierStats {
+	ret := make([]VerifierStats, 0, len(coll.Programs))
+	for name, p := range coll.Programs {
+		stats := VerifierStats{Name: name, Log: strings.TrimSpace(p.VerifierLog)}
+		if info, err := p.Info(); err == nil {
+			stats.Instructions, _ = info.VerifiedInstructions()
+		}
+		ret = append(ret, stats)
+	}
+	slices.SortFunc(ret, func(a, b VerifierStats) int { return strings.Compare(a.Name, b.Name) })
+	return ret
+}
+
 func installTailCalls(bpfDir string, spec *ebpf.CollectionSpec, coll *ebpf.Collection, load *Program) error {
 	// FIXME(JM): This should be replaced by using the cilium/ebpf prog array initialization.
 
@@ -1073,6 +1181,9 @@ func doLoadProgram(
 	}
 
 	opts.MapReplacements = pinnedMaps
+	if load.VerifyOnly {
+		opts.Programs.LogLevel = ebpf.LogLevelStats
+	}
 
 	coll, err := ebpf.NewCollectionWithOptions(spec, opts)
 	if err != nil && btfSpec != nil && load.KernelTypes != nil {
@@ -1151,6 +1262,11 @@ func doLoadProgram(
 		}
 	}
 
+	if load.VerifyOnly {
+		load.VerifierStats = verifierStats(coll)
+		return nil, nil
+	}
+
 	err = installTailCalls(bpfDir, spec, coll, load)
 	if err != nil {
 		return nil, fmt.Errorf("installing tail calls failed: %w", err)
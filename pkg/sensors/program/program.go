@@ -101,6 +101,11 @@ type MultiUprobeAttachData struct {
 	Attach map[string]*MultiUprobeAttachSymbolsCookies
 }
 
+type PerfEventAttachData struct {
+	// Frequency is the sampling frequency in Hz, per CPU.
+	Frequency uint64
+}
+
 // Program reprents a BPF program.
 type Program struct {
 	// Name is the name of the BPF object file.
@@ -181,6 +186,23 @@ type Program struct {
 	// This is currently used for generated code for cel expressions, but can be extended to
 	// other uses.
 	RewriteProg map[string]func(prog *ebpf.ProgramSpec) error
+
+	// VerifyOnly stops loading once the programs passed the verifier: maps
+	// are not populated and the program is neither pinned nor attached.
+	VerifyOnly bool
+	// VerifierStats holds the verifier statistics of the object's programs.
+	// It is populated when VerifyOnly is set.
+	VerifierStats []VerifierStats
+}
+
+// VerifierStats are the verifier statistics of a loaded program.
+type VerifierStats struct {
+	// Name is the name of the program in the object.
+	Name string
+	// Instructions is the number of instructions processed by the verifier.
+	Instructions uint32
+	// Log is the statistics part of the verifier log.
+	Log string
 }
 
 func (p *Program) String() string {
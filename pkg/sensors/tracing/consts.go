@@ -15,6 +15,7 @@ const (
 	// much kernel memory when enabled.
 	stackTraceMapMaxEntries    = 32768
 	ratelimitMapMaxEntries     = 32768
+	sampleMapMaxEntries        = 32768
 	fdInstallMapMaxEntries     = 32000
 	enforcerMapMaxEntries      = 32768
 	overrideMapMaxEntries      = 32768
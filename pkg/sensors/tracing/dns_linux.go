@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// The DNS sensor captures the DNS messages (UDP and TCP, port 53) sent and
+// received by processes and sends a DNS event for each query and response.
+// Messages are captured on the socket layer (security_socket_sendmsg and
+// sock_recvmsg) and parsed in user space.
+//
+// The sensor is not tied to a tracing policy, it is enabled with the
+// --enable-dns-events flag.
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/cilium/tetragon/pkg/api/ops"
+	"github.com/cilium/tetragon/pkg/api/tracingapi"
+	"github.com/cilium/tetragon/pkg/config"
+	"github.com/cilium/tetragon/pkg/grpc/tracing"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
+	"github.com/cilium/tetragon/pkg/observer"
+	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/reader/dns"
+	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/cilium/tetragon/pkg/sensors/base"
+	"github.com/cilium/tetragon/pkg/sensors/program"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	DnsSensorName = "__dns__"
+)
+
+func init() {
+	observer.RegisterEventHandlerAtInit(ops.MSG_OP_DNS, handleDns)
+}
+
+func dnsProgram(attach string, label string, prog string) *program.Program {
+	return program.Builder(
+		config.DnsObj(),
+		attach,
+		label,
+		prog,
+		"kprobe",
+	).SetPolicy(DnsSensorName)
+}
+
+// GetDnsSensor returns the DNS sensor.
+func GetDnsSensor() *sensors.Sensor {
+	progs := []*program.Program{
+		dnsProgram("security_socket_sendmsg", "kprobe/security_socket_sendmsg", "dns_sendmsg"),
+		dnsProgram("sock_recvmsg", "kprobe/sock_recvmsg", "dns_recvmsg"),
+		dnsProgram("sock_recvmsg", "kprobe/sock_recvmsg_ret", "dns_recvmsg_ret").SetRetProbe(true),
+	}
+
+	maps := []*program.Map{
+		program.MapBuilder("dns_heap", progs...),
+		program.MapBuilder("dns_recv_map", progs...),
+		program.MapBuilder("dns_sock_map", progs...),
+		program.MapUserFrom(base.ExecveMap),
+	}
+	if config.EnableV511Progs() && !option.Config.UsePerfRingBuffer {
+		maps = append(maps, program.MapUserFrom(base.RingBufEvents))
+		maps = append(maps, program.MapUserFrom(base.RingBufEventsHigh))
+	}
+
+	return &sensors.Sensor{
+		Name:   DnsSensorName,
+		Progs:  progs,
+		Maps:   maps,
+		Policy: DnsSensorName,
+	}
+}
+
+func handleDns(r *bytes.Reader) ([]observer.Event, error) {
+	m := tracingapi.MsgDns{}
+	err := binary.Read(r, binary.LittleEndian, &m)
+	if err != nil {
+		logger.GetLogger().Warn("Failed to read dns msg", logfields.Error, err)
+		return nil, errors.New("failed to read dns msg")
+	}
+
+	if m.Size > tracingapi.DnsMaxSize {
+		return nil, fmt.Errorf("invalid dns msg size %d", m.Size)
+	}
+	data := make([]byte, m.Size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		logger.GetLogger().Warn("Failed to read dns msg data", logfields.Error, err)
+		return nil, errors.New("failed to read dns msg data")
+	}
+
+	tcp := m.Sock.Tuple.Protocol == unix.IPPROTO_TCP
+	parsed, err := dns.Parse(data, tcp, m.Size < m.OrigSize)
+	if err != nil {
+		logger.GetLogger().Debug("Failed to parse dns msg", logfields.Error, err)
+		return nil, fmt.Errorf("failed to parse dns msg: %w", err)
+	}
+
+	msg := &tracing.MsgProcessDnsUnix{
+		Msg: &m,
+		Dns: parsed,
+	}
+	return []observer.Event{msg}, nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/cilium/tetragon/pkg/metrics/enforcermetrics"
 	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/policyfilter"
+	"github.com/cilium/tetragon/pkg/policyspec"
 	"github.com/cilium/tetragon/pkg/sensors"
 	"github.com/cilium/tetragon/pkg/sensors/program"
 	"github.com/cilium/tetragon/pkg/tracingpolicy"
@@ -161,28 +162,48 @@ func (kp *enforcerPolicy) LoadProbe(args sensors.LoadProbeArgs) error {
 		return program.LoadFmodRetProgram(args.BPFDir, args.Load, args.Maps, "fmodret_enforcer", args.Verbose)
 	}
 
+	if strings.HasPrefix(args.Load.Label, "lsm/") {
+		return program.LoadLSMProgram(args.BPFDir, args.Load, args.Maps, args.Verbose)
+	}
+
+	if args.Load.Label == "raw_tracepoint/sys_exit" {
+		return program.LoadRawTracepointProgram(args.BPFDir, args.Load, args.Maps, args.Verbose)
+	}
+
 	return fmt.Errorf("enforcer loader: unknown label: %s", args.Load.Label)
 }
 
-// select proper override method based on configuration and spec options
-func selectOverrideMethod(overrideMethod OverrideMethod, hasSyscall bool) (OverrideMethod, error) {
+// select proper override method based on configuration and spec options,
+// lsmErr is the error returned when mapping the enforcer calls to LSM hooks
+func selectOverrideMethod(overrideMethod policyspec.OverrideMethod, hasSyscall bool, lsmErr error) (policyspec.OverrideMethod, error) {
 	switch overrideMethod {
-	case OverrideMethodDefault:
-		// by default, first try OverrideReturn and if this does not work try fmod_ret
+	case policyspec.OverrideMethodDefault:
+		// by default, first try OverrideReturn, then fmod_ret and then lsm
 		if bpf.HasOverrideHelper() {
-			overrideMethod = OverrideMethodReturn
+			overrideMethod = policyspec.OverrideMethodReturn
 		} else if bpf.HasModifyReturnSyscall() {
-			overrideMethod = OverrideMethodFmodRet
+			overrideMethod = policyspec.OverrideMethodFmodRet
+		} else if bpf.HasLSMPrograms() && lsmErr == nil {
+			overrideMethod = policyspec.OverrideMethodLsm
+		} else if bpf.HasLSMPrograms() {
+			return policyspec.OverrideMethodInvalid, fmt.Errorf("no override helper or mod_ret support, and cannot use lsm: %w", lsmErr)
 		} else {
-			return OverrideMethodInvalid, errors.New("no override helper or mod_ret support: cannot load enforcer")
+			return policyspec.OverrideMethodInvalid, errors.New("no override helper, mod_ret or BPF LSM support: cannot load enforcer")
 		}
-	case OverrideMethodReturn:
+	case policyspec.OverrideMethodReturn:
 		if !bpf.HasOverrideHelper() {
-			return OverrideMethodInvalid, errors.New("option override return set, but it is not supported")
+			return policyspec.OverrideMethodInvalid, errors.New("option override return set, but it is not supported")
 		}
-	case OverrideMethodFmodRet:
+	case policyspec.OverrideMethodFmodRet:
 		if !bpf.HasModifyReturn() || (hasSyscall && !bpf.HasModifyReturnSyscall()) {
-			return OverrideMethodInvalid, errors.New("option fmod_ret set, but it is not supported")
+			return policyspec.OverrideMethodInvalid, errors.New("option fmod_ret set, but it is not supported")
+		}
+	case policyspec.OverrideMethodLsm:
+		if !bpf.HasLSMPrograms() {
+			return policyspec.OverrideMethodInvalid, errors.New("option lsm set, but BPF LSM is not supported")
+		}
+		if lsmErr != nil {
+			return policyspec.OverrideMethodInvalid, fmt.Errorf("option lsm set, but %w", lsmErr)
 		}
 	}
 
@@ -211,7 +232,7 @@ func (kp *enforcerPolicy) createEnforcerSensor(
 	kh := &enforcerHandler{}
 	for _, call := range enforcer.Calls {
 		var symsToAdd []string
-		if isL, list := isList(call, lists); isL {
+		if isL, list := policyspec.IsList(call, lists); isL {
 			if list == nil {
 				return nil, fmt.Errorf("error list '%s' not found", call)
 			}
@@ -257,7 +278,7 @@ func (kp *enforcerPolicy) createEnforcerSensor(
 	var load *program.Program
 	var progs []*program.Program
 	var maps []*program.Map
-	specOpts, err := getSpecOptions(opts)
+	specOpts, err := policyspec.GetSpecOptions(opts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get spec options: %w", err)
 	}
@@ -268,25 +289,31 @@ func (kp *enforcerPolicy) createEnforcerSensor(
 
 	// select proper override method based on configuration and spec options
 	overrideMethod := specOpts.OverrideMethod
+	lsmHooks, lsmErr := enforcerLsmHooks(kh.syscallsSyms)
 
 	// we can't use override return for security_* functions (kernel limitation)
-	// switch to fmod_ret and warn
-	if hasSecurity && overrideMethod != OverrideMethodFmodRet {
+	// switch to fmod_ret, or to lsm if fmod_ret is not supported, and warn
+	if hasSecurity && overrideMethod != policyspec.OverrideMethodFmodRet && overrideMethod != policyspec.OverrideMethodLsm {
 		// fail if override-return is directly requested
-		if overrideMethod == OverrideMethodReturn {
+		if overrideMethod == policyspec.OverrideMethodReturn {
 			return nil, errors.New("enforcer: can't override security function with override-return")
 		}
-		overrideMethod = OverrideMethodFmodRet
-		logger.GetLogger().Info("enforcer: forcing fmod_ret (security_* call detected)")
+		if !bpf.HasModifyReturn() && bpf.HasLSMPrograms() && lsmErr == nil {
+			overrideMethod = policyspec.OverrideMethodLsm
+			logger.GetLogger().Info("enforcer: forcing lsm (security_* call detected and fmod_ret not supported)")
+		} else {
+			overrideMethod = policyspec.OverrideMethodFmodRet
+			logger.GetLogger().Info("enforcer: forcing fmod_ret (security_* call detected)")
+		}
 	}
 
-	overrideMethod, err = selectOverrideMethod(overrideMethod, hasSyscall)
+	overrideMethod, err = selectOverrideMethod(overrideMethod, hasSyscall, lsmErr)
 	if err != nil {
 		return nil, err
 	}
 
 	switch overrideMethod {
-	case OverrideMethodReturn:
+	case policyspec.OverrideMethodReturn:
 		useMulti := !specOpts.DisableKprobeMulti && !option.Config.DisableKprobeMulti && bpf.HasKprobeMulti() && !isArm()
 		logger.GetLogger().Info(fmt.Sprintf("enforcer: using override return (multi-kprobe: %t)", useMulti))
 		label := "kprobe/enforcer"
@@ -307,7 +334,7 @@ func (kp *enforcerPolicy) createEnforcerSensor(
 
 		progs = append(progs, load)
 		maps = append(maps, enforcerMaps(load)...)
-	case OverrideMethodFmodRet:
+	case policyspec.OverrideMethodFmodRet:
 		// for fmod_ret, we need one program per syscall
 		logger.GetLogger().Info("enforcer: using fmod_ret")
 		for _, syscallSym := range kh.syscallsSyms {
@@ -322,6 +349,33 @@ func (kp *enforcerPolicy) createEnforcerSensor(
 			progs = append(progs, load)
 			maps = append(maps, enforcerMaps(load)...)
 		}
+	case policyspec.OverrideMethodLsm:
+		// for lsm, we need one program per LSM hook
+		logger.GetLogger().Info(fmt.Sprintf("enforcer: using lsm (hooks: %s)", lsmHooks))
+		for _, hook := range lsmHooks {
+			load = program.Builder(
+				path.Join(option.Config.HubbleLib, "bpf_lsm_enforcer.o"),
+				hook,
+				"lsm/file_open",
+				"lsm_"+hook,
+				"enforcer").
+				SetLoaderData(policyName).
+				SetPolicy(policyName)
+			progs = append(progs, load)
+			maps = append(maps, enforcerMaps(load)...)
+		}
+		// drop the notifications of syscalls that exit without reaching
+		// the LSM hook
+		load = program.Builder(
+			path.Join(option.Config.HubbleLib, "bpf_lsm_enforcer.o"),
+			"raw_syscalls/sys_exit",
+			"raw_tracepoint/sys_exit",
+			"lsm_sys_exit",
+			"enforcer").
+			SetLoaderData(policyName).
+			SetPolicy(policyName)
+		progs = append(progs, load)
+		maps = append(maps, enforcerMaps(load)...)
 	default:
 		return nil, fmt.Errorf("unexpected override method: %d", overrideMethod)
 	}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/policyspec"
 )
 
 type EnforcerSpecBuilder struct {
@@ -67,16 +68,21 @@ func (ksb *EnforcerSpecBuilder) WithMatchBinaries(bins ...string) *EnforcerSpecB
 }
 
 func (ksb *EnforcerSpecBuilder) WithOverrideReturn() *EnforcerSpecBuilder {
-	ksb.overrideMethod = valOverrideReturn
+	ksb.overrideMethod = policyspec.ValOverrideReturn
 	return ksb
 }
 
 func (ksb *EnforcerSpecBuilder) WithFmodRet() *EnforcerSpecBuilder {
-	ksb.overrideMethod = valFmodRet
+	ksb.overrideMethod = policyspec.ValFmodRet
 	return ksb
 
 }
 
+func (ksb *EnforcerSpecBuilder) WithLsm() *EnforcerSpecBuilder {
+	ksb.overrideMethod = policyspec.ValLsm
+	return ksb
+}
+
 func (ksb *EnforcerSpecBuilder) WithDefaultOverride() *EnforcerSpecBuilder {
 	ksb.overrideMethod = ""
 	return ksb
@@ -153,7 +159,7 @@ func (ksb *EnforcerSpecBuilder) Build() (*v1alpha1.TracingPolicy, error) {
 
 	if ksb.overrideMethod != "" {
 		options = append(options, v1alpha1.OptionSpec{
-			Name:  keyOverrideMethod,
+			Name:  policyspec.KeyOverrideMethod,
 			Value: ksb.overrideMethod,
 		})
 	}
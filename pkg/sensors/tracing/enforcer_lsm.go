@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+//go:build !windows
+
+package tracing
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cilium/tetragon/pkg/arch"
+)
+
+// enforcerSyscallLsmHooks maps syscalls to the LSM hooks that the lsm
+// override method uses to deny them. A syscall can map to several hooks when
+// it covers several operations, in which case the enforcer denies the first
+// one that is reached.
+var enforcerSyscallLsmHooks = map[string][]string{
+	"open":               {"file_open"},
+	"openat":             {"file_open"},
+	"openat2":            {"file_open"},
+	"open_by_handle_at":  {"file_open"},
+	"creat":              {"file_open"},
+	"execve":             {"bprm_check_security"},
+	"execveat":           {"bprm_check_security"},
+	"read":               {"file_permission"},
+	"readv":              {"file_permission"},
+	"pread64":            {"file_permission"},
+	"preadv":             {"file_permission"},
+	"preadv2":            {"file_permission"},
+	"write":              {"file_permission"},
+	"writev":             {"file_permission"},
+	"pwrite64":           {"file_permission"},
+	"pwritev":            {"file_permission"},
+	"pwritev2":           {"file_permission"},
+	"ioctl":              {"file_ioctl"},
+	"mmap":               {"mmap_file"},
+	"mprotect":           {"file_mprotect"},
+	"unlink":             {"inode_unlink"},
+	"unlinkat":           {"inode_unlink", "inode_rmdir"},
+	"rmdir":              {"inode_rmdir"},
+	"mkdir":              {"inode_mkdir"},
+	"mkdirat":            {"inode_mkdir"},
+	"mknod":              {"inode_mknod"},
+	"mknodat":            {"inode_mknod"},
+	"rename":             {"inode_rename"},
+	"renameat":           {"inode_rename"},
+	"renameat2":          {"inode_rename"},
+	"link":               {"inode_link"},
+	"linkat":             {"inode_link"},
+	"symlink":            {"inode_symlink"},
+	"symlinkat":          {"inode_symlink"},
+	"chmod":              {"inode_setattr"},
+	"fchmod":             {"inode_setattr"},
+	"fchmodat":           {"inode_setattr"},
+	"fchmodat2":          {"inode_setattr"},
+	"chown":              {"inode_setattr"},
+	"fchown":             {"inode_setattr"},
+	"fchownat":           {"inode_setattr"},
+	"lchown":             {"inode_setattr"},
+	"truncate":           {"inode_setattr"},
+	"ftruncate":          {"inode_setattr"},
+	"setxattr":           {"inode_setxattr"},
+	"lsetxattr":          {"inode_setxattr"},
+	"fsetxattr":          {"inode_setxattr"},
+	"removexattr":        {"inode_removexattr"},
+	"lremovexattr":       {"inode_removexattr"},
+	"fremovexattr":       {"inode_removexattr"},
+	"mount":              {"sb_mount"},
+	"umount2":            {"sb_umount"},
+	"pivot_root":         {"sb_pivotroot"},
+	"socket":             {"socket_create"},
+	"socketpair":         {"socket_create"},
+	"bind":               {"socket_bind"},
+	"connect":            {"socket_connect"},
+	"listen":             {"socket_listen"},
+	"accept":             {"socket_accept"},
+	"accept4":            {"socket_accept"},
+	"sendto":             {"socket_sendmsg"},
+	"sendmsg":            {"socket_sendmsg"},
+	"sendmmsg":           {"socket_sendmsg"},
+	"recvfrom":           {"socket_recvmsg"},
+	"recvmsg":            {"socket_recvmsg"},
+	"recvmmsg":           {"socket_recvmsg"},
+	"setsockopt":         {"socket_setsockopt"},
+	"getsockopt":         {"socket_getsockopt"},
+	"shutdown":           {"socket_shutdown"},
+	"kill":               {"task_kill"},
+	"tkill":              {"task_kill"},
+	"tgkill":             {"task_kill"},
+	"rt_sigqueueinfo":    {"task_kill"},
+	"rt_tgsigqueueinfo":  {"task_kill"},
+	"pidfd_send_signal":  {"task_kill"},
+	"ptrace":             {"ptrace_access_check", "ptrace_traceme"},
+	"process_vm_readv":   {"ptrace_access_check"},
+	"process_vm_writev":  {"ptrace_access_check"},
+	"prctl":              {"task_prctl"},
+	"setuid":             {"task_fix_setuid"},
+	"setreuid":           {"task_fix_setuid"},
+	"setresuid":          {"task_fix_setuid"},
+	"setfsuid":           {"task_fix_setuid"},
+	"setgid":             {"task_fix_setgid"},
+	"setregid":           {"task_fix_setgid"},
+	"setresgid":          {"task_fix_setgid"},
+	"setfsgid":           {"task_fix_setgid"},
+	"setgroups":          {"task_fix_setgroups"},
+	"capset":             {"capset"},
+	"setpriority":        {"task_setnice"},
+	"sched_setscheduler": {"task_setscheduler"},
+	"sched_setparam":     {"task_setscheduler"},
+	"sched_setattr":      {"task_setscheduler"},
+	"sched_setaffinity":  {"task_setscheduler"},
+	"setrlimit":          {"task_setrlimit"},
+	"prlimit64":          {"task_setrlimit"},
+	"setpgid":            {"task_setpgid"},
+	"bpf":                {"bpf"},
+	"perf_event_open":    {"perf_event_open"},
+	"init_module":        {"kernel_load_data"},
+	"finit_module":       {"kernel_read_file"},
+	"kexec_load":         {"kernel_load_data"},
+	"kexec_file_load":    {"kernel_read_file"},
+	"syslog":             {"syslog"},
+	"settimeofday":       {"settime"},
+	"clock_settime":      {"settime"},
+	"adjtimex":           {"settime"},
+	"clock_adjtime":      {"settime"},
+	"quotactl":           {"quotactl"},
+	"msgget":             {"msg_queue_alloc_security"},
+	"shmget":             {"shm_alloc_security"},
+	"shmat":              {"shm_shmat"},
+	"semget":             {"sem_alloc_security"},
+}
+
+// enforcerLsmHooks returns the LSM hooks that the lsm override method uses to
+// deny the enforcer symbols: the syscalls mapped in enforcerSyscallLsmHooks
+// and the security_ functions, whose hook has the same name without prefix.
+func enforcerLsmHooks(syms []string) ([]string, error) {
+	var hooks, unsupported []string
+	for _, sym := range syms {
+		if hook, ok := strings.CutPrefix(sym, "security_"); ok {
+			hooks = append(hooks, hook)
+			continue
+		}
+		_, name := arch.CutSyscallPrefix(sym)
+		name = strings.TrimPrefix(name, "compat_")
+		name = strings.TrimPrefix(name, "sys_")
+		syscallHooks, ok := enforcerSyscallLsmHooks[name]
+		if !ok {
+			unsupported = append(unsupported, sym)
+			continue
+		}
+		hooks = append(hooks, syscallHooks...)
+	}
+	if len(unsupported) > 0 {
+		return nil, fmt.Errorf("no LSM hook is known for %s", strings.Join(unsupported, ", "))
+	}
+	slices.Sort(hooks)
+	return slices.Compact(hooks), nil
+}
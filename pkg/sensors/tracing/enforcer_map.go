@@ -46,10 +46,11 @@ type EnforcerMapKey struct {
 }
 
 type EnforcerMapVal struct {
-	Err    int16
-	Sig    int16
-	FuncID uint32
-	Arg    uint32
+	Err       int16
+	Sig       int16
+	FuncID    uint32
+	Arg       uint32
+	SyscallNr int32
 }
 
 func (m EnforcerMap) Dump() (map[EnforcerMapKey]EnforcerMapVal, error) {
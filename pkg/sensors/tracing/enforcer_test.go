@@ -198,6 +198,108 @@ func TestEnforcerOverrideManySyscalls(t *testing.T) {
 	})
 }
 
+func TestEnforcerOverrideLsm(t *testing.T) {
+	if !bpf.HasSignalHelper() {
+		t.Skip("skipping enforcer test, bpf_send_signal helper not available")
+	}
+	if !bpf.HasLSMPrograms() {
+		t.Skip("skipping enforcer test, BPF LSM is not available")
+	}
+
+	test := testutils.RepoRootPath("contrib/tester-progs/enforcer-tester")
+	yaml := NewEnforcerSpecBuilder("enforcer-override").
+		WithSyscallList("sys_prctl").
+		WithMatchBinaries(test).
+		WithOverrideValue(-17). // EEXIST
+		WithLsm().
+		MustYAML()
+
+	tpChecker := eventchecker.NewProcessTracepointChecker("").
+		WithArgs(eventchecker.NewKprobeArgumentListMatcher().
+			WithOperator(lc.Ordered).
+			WithValues(
+				eventchecker.NewKprobeArgumentChecker().WithSyscallId(mkSysIDChecker(t, unix.SYS_PRCTL)),
+			)).
+		WithAction(tetragon.KprobeAction_KPROBE_ACTION_NOTIFYENFORCER)
+
+	checker := eventchecker.NewUnorderedEventChecker(tpChecker)
+
+	checkerFunc := func(t *testing.T, _ error, rc int) {
+		if rc != int(syscall.EEXIST) {
+			t.Fatalf("Wrong exit code %d expected %d", rc, int(syscall.EEXIST))
+		}
+	}
+
+	testEnforcer(t, yaml, checker, newCmdChecker(test, checkerFunc))
+}
+
+// TestEnforcerLsmFailedSyscall checks that the notification of a syscall that
+// fails before reaching the LSM hook does not deny a later operation of the
+// same thread that reaches it.
+func TestEnforcerLsmFailedSyscall(t *testing.T) {
+	if !bpf.HasSignalHelper() {
+		t.Skip("skipping enforcer test, bpf_send_signal helper not available")
+	}
+	if !bpf.HasLSMPrograms() {
+		t.Skip("skipping enforcer test, BPF LSM is not available")
+	}
+
+	test := testutils.RepoRootPath("contrib/tester-progs/enforcer-lsm-tester")
+	missing := "/tetragon-enforcer-lsm-tester/missing"
+
+	tracingPolicy := `
+apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "enforcer-lsm-failed-syscall"
+spec:
+  options:
+    - name: "override-method"
+      value: "lsm"
+  enforcers:
+  - calls:
+    - "sys_openat"
+  kprobes:
+  - call: "sys_openat"
+    syscall: true
+    args:
+    - index: 1
+      type: "string"
+    selectors:
+    - matchBinaries:
+      - operator: "In"
+        values:
+        - "` + test + `"
+      matchArgs:
+      - index: 1
+        operator: "Equal"
+        values:
+        - "` + missing + `"
+      matchActions:
+      - action: "NotifyEnforcer"
+        argError: -17
+`
+
+	kpChecker := eventchecker.NewProcessKprobeChecker("").
+		WithFunctionName(sm.Suffix("sys_openat")).
+		WithArgs(eventchecker.NewKprobeArgumentListMatcher().
+			WithOperator(lc.Ordered).
+			WithValues(
+				eventchecker.NewKprobeArgumentChecker().WithStringArg(sm.Full(missing)),
+			)).
+		WithAction(tetragon.KprobeAction_KPROBE_ACTION_NOTIFYENFORCER)
+
+	checker := eventchecker.NewUnorderedEventChecker(kpChecker)
+
+	checkerFunc := func(t *testing.T, _ error, rc int) {
+		if rc != 0 {
+			t.Fatalf("Wrong exit code %d expected 0", rc)
+		}
+	}
+
+	testEnforcer(t, tracingPolicy, checker, newCmdChecker(test, checkerFunc))
+}
+
 func mkSysIDChecker(t *testing.T, id uint64) *eventchecker.SyscallIdChecker {
 	abi, err := syscallinfo.DefaultABI()
 	require.NoError(t, err)
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// The flow sensor summarizes TCP connections. It tracks the connections
+// established (tcp_connect) or accepted (inet_csk_accept) by processes and
+// sends a FLOW event with the connection counters when the connection is
+// closed (tcp_close) and, optionally, periodically while the connection is
+// active (tcp_sendmsg and tcp_cleanup_rbuf).
+//
+// The sensor is not tied to a tracing policy, it is enabled with the
+// --enable-network-flows flag.
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/cilium/tetragon/pkg/api/ops"
+	"github.com/cilium/tetragon/pkg/api/tracingapi"
+	"github.com/cilium/tetragon/pkg/config"
+	"github.com/cilium/tetragon/pkg/grpc/tracing"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
+	"github.com/cilium/tetragon/pkg/observer"
+	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/cilium/tetragon/pkg/sensors/base"
+	"github.com/cilium/tetragon/pkg/sensors/program"
+)
+
+const (
+	FlowSensorName = "__flow__"
+)
+
+func init() {
+	observer.RegisterEventHandlerAtInit(ops.MSG_OP_FLOW, handleFlow)
+}
+
+func flowProgram(attach string, label string, prog string) *program.Program {
+	return program.Builder(
+		config.FlowObj(),
+		attach,
+		label,
+		prog,
+		"kprobe",
+	).SetPolicy(FlowSensorName)
+}
+
+// GetFlowSensor returns the flow sensor.
+func GetFlowSensor() *sensors.Sensor {
+	progs := []*program.Program{
+		flowProgram("tcp_connect", "kprobe/tcp_connect", "flow_tcp_connect"),
+		flowProgram("inet_csk_accept", "kprobe/inet_csk_accept", "flow_inet_csk_accept").SetRetProbe(true),
+		flowProgram("tcp_sendmsg", "kprobe/tcp_sendmsg", "flow_tcp_sendmsg"),
+		flowProgram("tcp_cleanup_rbuf", "kprobe/tcp_cleanup_rbuf", "flow_tcp_cleanup_rbuf"),
+		flowProgram("tcp_close", "kprobe/tcp_close", "flow_tcp_close"),
+	}
+
+	interval := uint64(option.Config.NetworkFlowReportInterval.Nanoseconds())
+	for _, prog := range progs {
+		prog.RewriteConstants["FLOW_REPORT_INTERVAL"] = interval
+	}
+
+	maps := []*program.Map{
+		program.MapBuilder("flow_map", progs...),
+		program.MapBuilder("flow_heap", progs...),
+		program.MapUserFrom(base.ExecveMap),
+	}
+	if config.EnableV511Progs() && !option.Config.UsePerfRingBuffer {
+		maps = append(maps, program.MapUserFrom(base.RingBufEvents))
+		maps = append(maps, program.MapUserFrom(base.RingBufEventsHigh))
+	}
+
+	return &sensors.Sensor{
+		Name:   FlowSensorName,
+		Progs:  progs,
+		Maps:   maps,
+		Policy: FlowSensorName,
+	}
+}
+
+func handleFlow(r *bytes.Reader) ([]observer.Event, error) {
+	m := tracingapi.MsgFlow{}
+	err := binary.Read(r, binary.LittleEndian, &m)
+	if err != nil {
+		logger.GetLogger().Warn("Failed to read flow msg", logfields.Error, err)
+		return nil, errors.New("failed to read flow msg")
+	}
+
+	msg := &tracing.MsgProcessNetworkFlowUnix{
+		Msg: &m,
+	}
+	return []observer.Event{msg}, nil
+}
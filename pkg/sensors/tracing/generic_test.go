@@ -15,6 +15,7 @@ import (
 
 	api "github.com/cilium/tetragon/pkg/api/tracingapi"
 	gt "github.com/cilium/tetragon/pkg/generictypes"
+	"github.com/cilium/tetragon/pkg/policyspec"
 	"github.com/cilium/tetragon/pkg/tracingpolicy"
 )
 
@@ -314,7 +315,7 @@ func TestAppendMacrosSelectors(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			err := appendMacrosSelectors(test.selectors, test.macros)
+			err := policyspec.AppendMacrosSelectors(test.selectors, test.macros)
 			if test.expectErr {
 				require.Error(t, err)
 			} else {
@@ -15,7 +15,6 @@ import (
 	"net/http"
 	"path"
 	"runtime"
-	"slices"
 	"strings"
 
 	"github.com/cilium/ebpf"
@@ -43,6 +42,7 @@ import (
 	"github.com/cilium/tetragon/pkg/observer"
 	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/policyfilter"
+	"github.com/cilium/tetragon/pkg/policyspec"
 	"github.com/cilium/tetragon/pkg/selectors"
 	"github.com/cilium/tetragon/pkg/sensors"
 	"github.com/cilium/tetragon/pkg/sensors/base"
@@ -72,6 +72,7 @@ type kprobeLoadArgs struct {
 	selectors kprobeSelectors
 	retprobe  bool
 	syscall   bool
+	fentry    bool
 	config    *api.EventConfig
 }
 
@@ -262,6 +263,10 @@ func createMultiKprobeSensor(polInfo *policyInfo, multiIDs []idtable.EntryID, ha
 		maps = append(maps, ratelimitMap)
 	}
 
+	if config.EnableLargeProgs() {
+		maps = append(maps, createSampleMap(load, has.sample))
+	}
+
 	if has.enforcer {
 		maps = append(maps, enforcerMapsUser(load)...)
 	}
@@ -331,14 +336,6 @@ func createMultiKprobeSensor(polInfo *policyInfo, multiIDs []idtable.EntryID, ha
 	return progs, maps, nil
 }
 
-func validateKprobeType(ty string) error {
-	invalidArgTypes := []string{"auto", "syscall64"}
-	if slices.Contains(invalidArgTypes, ty) {
-		return fmt.Errorf("type '%s' is invalid for kprobes", ty)
-	}
-	return nil
-}
-
 type kpValidateInfo struct {
 	calls   []string
 	syscall bool
@@ -378,12 +375,13 @@ func preValidateKprobe(
 	ks *ksyms.Ksyms,
 	btfobj *btf.Spec,
 	lists []v1alpha1.ListSpec,
+	autoTypes bool,
 ) (*kpValidateInfo, error) {
 	isSyscall := f.Syscall
 	var calls []string
 	// the f.Call is either defined as list:NAME
 	// or specifies directly the function
-	if isL, list := isList(f.Call, lists); isL {
+	if isL, list := policyspec.IsList(f.Call, lists); isL {
 		if list == nil {
 			return nil, fmt.Errorf("error list '%s' not found", f.Call)
 		}
@@ -392,7 +390,7 @@ func preValidateKprobe(
 		if err != nil {
 			return nil, fmt.Errorf("failed to get symbols from list '%s': %w", f.Call, err)
 		}
-		if isSyscallListType(list.Type) {
+		if policyspec.IsSyscallListType(list.Type) {
 			isSyscall = true
 		}
 	} else {
@@ -447,7 +445,7 @@ func preValidateKprobe(
 	}
 
 	for idxArg, arg := range f.Args {
-		if err := validateKprobeType(arg.Type); err != nil {
+		if err := policyspec.ValidateKprobeType(arg.Type, autoTypes); err != nil {
 			return nil, fmt.Errorf("args[%d].type: %w", idxArg, err)
 		}
 	}
@@ -471,7 +469,7 @@ func allKprobesIgnored(info []*kpValidateInfo) bool {
 // preValidateKprobes pre-validates the semantics and BTF information of a Kprobe spec
 // Furthermore, it does some preprocessing of the calls and returns one kpValidateInfo struct per
 // kprobe. It also validates that if any selector uses NotifyEnforcer action, the spec contains enforcers.
-func preValidateKprobes(log logger.FieldLogger, kprobes []v1alpha1.KProbeSpec, lists []v1alpha1.ListSpec, enforcers []v1alpha1.EnforcerSpec) ([]*kpValidateInfo, error) {
+func preValidateKprobes(log logger.FieldLogger, kprobes []v1alpha1.KProbeSpec, lists []v1alpha1.ListSpec, enforcers []v1alpha1.EnforcerSpec, specOpts *policyspec.SpecOptions) ([]*kpValidateInfo, error) {
 	btfobj, err := btf.NewBTF()
 	if err != nil {
 		return nil, err
@@ -501,7 +499,7 @@ func preValidateKprobes(log logger.FieldLogger, kprobes []v1alpha1.KProbeSpec, l
 	ret := make([]*kpValidateInfo, len(kprobes))
 	for i := range kprobes {
 		var err error
-		ret[i], err = preValidateKprobe(log, &kprobes[i], ks, btfobj, lists)
+		ret[i], err = preValidateKprobe(log, &kprobes[i], ks, btfobj, lists, specOpts.KprobeAttachType == policyspec.KprobeAttachTypeFentry)
 		if err != nil {
 			return nil, fmt.Errorf("error in spec.kprobes[%d]: %w", i, err)
 		}
@@ -512,6 +510,7 @@ func preValidateKprobes(log logger.FieldLogger, kprobes []v1alpha1.KProbeSpec, l
 
 type addKprobeIn struct {
 	useMulti      bool
+	useFentry     bool
 	sensorPath    string
 	policyName    string
 	policyID      policyfilter.PolicyID
@@ -522,6 +521,7 @@ type addKprobeIn struct {
 type hasMaps struct {
 	stackTrace bool
 	rateLimit  bool
+	sample     bool
 	fdInstall  bool
 	enforcer   bool
 	override   bool
@@ -537,6 +537,7 @@ func hasMapsSetup(spec *v1alpha1.TracingPolicySpec) hasMaps {
 		has.fdInstall = has.fdInstall || selectors.HasFDInstall(kprobe.Selectors)
 		has.enforcer = has.enforcer || len(spec.Enforcers) != 0
 		has.rateLimit = has.rateLimit || selectors.HasRateLimit(kprobe.Selectors)
+		has.sample = has.sample || selectors.HasSample(kprobe.Selectors)
 		has.sockTrack = has.sockTrack || selectors.HasSockTrack(&kprobe)
 		has.override = has.override || selectors.HasOverride(kprobe.Selectors)
 		has.selector = has.selector || selectors.HasSelector(&kprobe)
@@ -548,6 +549,37 @@ func isArm() bool {
 	return runtime.GOARCH == "arm64"
 }
 
+// checkFentry returns an error if the kprobes of the policy cannot be attached
+// with fentry/fexit programs, in which case kprobes are used instead.
+func checkFentry(spec *v1alpha1.TracingPolicySpec, valInfo []*kpValidateInfo, has hasMaps) error {
+	if !config.EnableV61Progs() {
+		return errors.New("fentry programs require kernel >= 6.1")
+	}
+	if !bpf.HasFentry() {
+		return errors.New("BPF trampolines are not supported")
+	}
+	// fentry programs can't override the return value
+	if has.override {
+		return errors.New("override action is not supported")
+	}
+	for i, kprobe := range spec.KProbes {
+		if valInfo[i].ignore {
+			continue
+		}
+		for _, data := range kprobe.Data {
+			if hasPtRegsSource(&data) {
+				return errors.New("pt_regs data source is not supported")
+			}
+		}
+		for _, sym := range valInfo[i].calls {
+			if _, err := btf.FindBTFFunc(sym); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func createGenericKprobeSensor(
 	spec *v1alpha1.TracingPolicySpec,
 	name string,
@@ -557,18 +589,31 @@ func createGenericKprobeSensor(
 	var progs []*program.Program
 	var maps []*program.Map
 	var ids []idtable.EntryID
-	var useMulti bool
+	var useMulti, useFentry bool
 	var selMaps *selectors.KernelSelectorMaps
 
 	kprobes := spec.KProbes
 
 	has := hasMapsSetup(spec)
 
+	// use fentry/fexit programs if requested by spec option, and fallback
+	// to kprobes if they are not supported
+	if polInfo.specOpts.KprobeAttachType == policyspec.KprobeAttachTypeFentry {
+		if err := checkFentry(spec, valInfo, has); err != nil {
+			logger.GetLogger().Warn("Cannot attach with fentry, falling back to kprobes",
+				"policy", polInfo.name, logfields.Error, err)
+		} else {
+			useFentry = true
+		}
+	}
+
 	// use multi kprobe only if:
+	// - fentry is not used, the kernel has no multi link for fentry
+	//   programs so they are loaded and attached for each function
 	// - it's not disabled by spec option
 	// - it's not disabled by command line option
 	// - there's support detected
-	if !polInfo.specOpts.DisableKprobeMulti {
+	if !useFentry && !polInfo.specOpts.DisableKprobeMulti {
 		useMulti = !option.Config.DisableKprobeMulti && bpf.HasKprobeMulti()
 
 		// arm does not override on top of kprobe.multi
@@ -583,6 +628,7 @@ func createGenericKprobeSensor(
 
 	in := addKprobeIn{
 		useMulti:      useMulti,
+		useFentry:     useFentry,
 		sensorPath:    name,
 		policyID:      polInfo.policyID,
 		policyName:    polInfo.name,
@@ -593,9 +639,11 @@ func createGenericKprobeSensor(
 	dups := make(map[string]int)
 
 	for i := range kprobes {
-		if err := appendMacrosSelectors(kprobes[i].Selectors, spec.SelectorsMacros); err != nil {
+		if err := policyspec.AppendMacrosSelectors(kprobes[i].Selectors, spec.SelectorsMacros); err != nil {
 			return nil, fmt.Errorf("append macros selectors: %w", err)
 		}
+		// macros can add sampled post actions
+		has.sample = has.sample || selectors.HasSample(kprobes[i].Selectors)
 
 		if valInfo[i].ignore {
 			continue
@@ -635,6 +683,7 @@ func createGenericKprobeSensor(
 	maps = append(maps, program.MapUserFrom(base.ExecveMap))
 	if config.EnableV511Progs() && !option.Config.UsePerfRingBuffer {
 		maps = append(maps, program.MapUserFrom(base.RingBufEvents))
+		maps = append(maps, program.MapUserFrom(base.RingBufEventsHigh))
 	}
 
 	if option.Config.ParentsMapEnabled {
@@ -748,6 +797,14 @@ func addKprobe(funcName string, instance int, f *v1alpha1.KProbeSpec, in *addKpr
 			ok     bool
 		)
 
+		if a.Type == "auto" && a.Resolve == "" && !hasCurrentTaskSource(a) {
+			param, err := btf.FindBTFFuncParamFromHook(funcName, int(a.Index))
+			if err != nil {
+				return fmt.Errorf("error on hook %q for index %d : %w", funcName, a.Index, err)
+			}
+			argType = gt.GenericTypeFromBTF(param.Type)
+		}
+
 		if hasPtRegsSource(a) {
 			regArg.Offset, regArg.Size, ok = asm.RegOffsetSize(a.Resolve)
 			if !ok {
@@ -897,6 +954,7 @@ func addKprobe(funcName string, instance int, f *v1alpha1.KProbeSpec, in *addKpr
 		loadArgs: kprobeLoadArgs{
 			retprobe: setRetprobe,
 			syscall:  f.Syscall,
+			fentry:   in.useFentry,
 			config:   eventConfig,
 		},
 		argSigPrinters:    argSigPrinters,
@@ -928,7 +986,7 @@ func addKprobe(funcName string, instance int, f *v1alpha1.KProbeSpec, in *addKpr
 
 	if f.Return {
 		kprobeEntry.loadArgs.selectors.retrn, err = selectors.InitKernelReturnSelectorState(f.Selectors, f.ReturnArg,
-			&kprobeEntry.actionArgs, nil, in.selMaps)
+			&kprobeEntry.actionArgs, nil, in.selMaps, nil)
 		if err != nil {
 			return errFn(err)
 		}
@@ -952,6 +1010,11 @@ func createKprobeSensorFromEntry(polInfo *policyInfo, kprobeEntry *genericKprobe
 	progs []*program.Program, maps []*program.Map, has hasMaps) ([]*program.Program, []*program.Map) {
 
 	loadProgName, loadProgRetName := config.GenericKprobeObjs(false)
+	label, retLabel := "kprobe/generic_kprobe", "kprobe/generic_retkprobe"
+	if kprobeEntry.loadArgs.fentry {
+		loadProgName, loadProgRetName = config.GenericFentryObjs()
+		label, retLabel = "fentry/generic_kprobe", "fexit/generic_retkprobe"
+	}
 	isSecurityFunc := strings.HasPrefix(kprobeEntry.funcName, "security_")
 
 	pinProg := kprobeEntry.funcName
@@ -962,7 +1025,7 @@ func createKprobeSensorFromEntry(polInfo *policyInfo, kprobeEntry *genericKprobe
 	load := program.Builder(
 		path.Join(option.Config.HubbleLib, loadProgName),
 		kprobeEntry.funcName,
-		"kprobe/generic_kprobe",
+		label,
 		pinProg,
 		"generic_kprobe").
 		SetLoaderData(kprobeEntry.tableId).
@@ -1033,6 +1096,10 @@ func createKprobeSensorFromEntry(polInfo *policyInfo, kprobeEntry *genericKprobe
 		maps = append(maps, ratelimitMap)
 	}
 
+	if config.EnableLargeProgs() {
+		maps = append(maps, createSampleMap(load, has.sample))
+	}
+
 	if has.enforcer {
 		maps = append(maps, enforcerMapsUser(load)...)
 	}
@@ -1057,7 +1124,7 @@ func createKprobeSensorFromEntry(polInfo *policyInfo, kprobeEntry *genericKprobe
 		loadret := program.Builder(
 			path.Join(option.Config.HubbleLib, loadProgRetName),
 			kprobeEntry.funcName,
-			"kprobe/generic_retkprobe",
+			retLabel,
 			pinRetProg,
 			"generic_kprobe").
 			SetRetProbe(true).
@@ -1161,7 +1228,12 @@ func loadSingleKprobeSensor(id idtable.EntryID, bpfDir string, load *program.Pro
 	}
 	load.MapLoad = append(load.MapLoad, config)
 
-	if err := program.LoadKprobeProgram(bpfDir, load, maps, verbose); err == nil {
+	if gk.loadArgs.fentry {
+		err = program.LoadFentryProgram(bpfDir, load, maps, verbose)
+	} else {
+		err = program.LoadKprobeProgram(bpfDir, load, maps, verbose)
+	}
+	if err == nil {
 		logger.GetLogger().Info(fmt.Sprintf("Loaded generic kprobe program: %s -> %s", load.Name, load.Attach))
 	} else {
 		return err
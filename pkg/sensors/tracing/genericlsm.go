@@ -31,6 +31,7 @@ import (
 	"github.com/cilium/tetragon/pkg/observer"
 	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/policyfilter"
+	"github.com/cilium/tetragon/pkg/policyspec"
 	"github.com/cilium/tetragon/pkg/selectors"
 	"github.com/cilium/tetragon/pkg/sensors"
 	"github.com/cilium/tetragon/pkg/sensors/base"
@@ -357,10 +358,12 @@ func createGenericLsmSensor(
 		selMaps:    selMaps,
 	}
 
+	sample := false
 	for _, hook := range lsmHooks {
-		if err := appendMacrosSelectors(hook.Selectors, spec.SelectorsMacros); err != nil {
+		if err := policyspec.AppendMacrosSelectors(hook.Selectors, spec.SelectorsMacros); err != nil {
 			return nil, fmt.Errorf("append macros selectors: %w", err)
 		}
+		sample = sample || selectors.HasSample(hook.Selectors)
 
 		id, err := addLsm(&hook, &in)
 		if err != nil {
@@ -374,12 +377,13 @@ func createGenericLsmSensor(
 		if err != nil {
 			return nil, err
 		}
-		progs, maps = createLsmSensorFromEntry(polInfo, gl, progs, maps)
+		progs, maps = createLsmSensorFromEntry(polInfo, gl, progs, maps, sample)
 	}
 
 	maps = append(maps, program.MapUserFrom(base.ExecveMap))
 	if config.EnableV511Progs() && !option.Config.UsePerfRingBuffer {
 		maps = append(maps, program.MapUserFrom(base.RingBufEvents))
+		maps = append(maps, program.MapUserFrom(base.RingBufEventsHigh))
 	}
 
 	if option.Config.ParentsMapEnabled {
@@ -454,7 +458,7 @@ func imaProgName(lsmEntry *genericLsm) (string, string) {
 }
 
 func createLsmSensorFromEntry(polInfo *policyInfo, lsmEntry *genericLsm,
-	progs []*program.Program, maps []*program.Map) ([]*program.Program, []*program.Map) {
+	progs []*program.Program, maps []*program.Map, sample bool) ([]*program.Program, []*program.Map) {
 
 	loadProgCoreName, loadProgOutputName := config.GenericLsmObjs()
 
@@ -521,6 +525,10 @@ func createLsmSensorFromEntry(polInfo *policyInfo, lsmEntry *genericLsm,
 
 	maps = append(maps, createSelectorMaps(load, lsmEntry.selectors)...)
 
+	if config.EnableLargeProgs() {
+		maps = append(maps, createSampleMap(load, sample))
+	}
+
 	callHeap := program.MapBuilderProgram("process_call_heap", load)
 	maps = append(maps, callHeap)
 	callHeapOutput := program.MapBuilderProgram("process_call_heap", loadOutput)
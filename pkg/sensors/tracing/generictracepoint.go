@@ -30,6 +30,7 @@ import (
 	"github.com/cilium/tetragon/pkg/observer"
 	"github.com/cilium/tetragon/pkg/option"
 	"github.com/cilium/tetragon/pkg/policyfilter"
+	"github.com/cilium/tetragon/pkg/policyspec"
 	"github.com/cilium/tetragon/pkg/reader/network"
 	"github.com/cilium/tetragon/pkg/selectors"
 	"github.com/cilium/tetragon/pkg/sensors"
@@ -505,13 +506,18 @@ func createGenericTracepointSensor(
 		enforcer: len(spec.Enforcers) != 0,
 	}
 
-	maps := []*program.Map{}
-	progs := make([]*program.Program, 0, len(tracepoints))
+	// the sample map is shared by the programs of the sensor, so it is
+	// sized for all the tracepoints
 	for _, tp := range tracepoints {
-		if err := appendMacrosSelectors(tp.Spec.Selectors, spec.SelectorsMacros); err != nil {
+		if err := policyspec.AppendMacrosSelectors(tp.Spec.Selectors, spec.SelectorsMacros); err != nil {
 			return nil, fmt.Errorf("append macros selectos: %w", err)
 		}
+		has.sample = has.sample || selectors.HasSample(tp.Spec.Selectors)
+	}
 
+	maps := []*program.Map{}
+	progs := make([]*program.Program, 0, len(tracepoints))
+	for _, tp := range tracepoints {
 		pinProg := sensors.PathJoin(fmt.Sprintf("%s:%s", tp.Info.Subsys, tp.Info.Event))
 		attach := fmt.Sprintf("%s/%s", tp.Info.Subsys, tp.Info.Event)
 		label := "tracepoint/generic_tracepoint"
@@ -548,6 +554,10 @@ func createGenericTracepointSensor(
 		filterMap := program.MapBuilderProgram("filter_map", prog0)
 		maps = append(maps, filterMap)
 
+		if config.EnableLargeProgs() {
+			maps = append(maps, createSampleMap(prog0, has.sample))
+		}
+
 		maps = append(maps, createSelectorMaps(prog0, tp.selectors)...)
 
 		if has.enforcer {
@@ -567,6 +577,7 @@ func createGenericTracepointSensor(
 	maps = append(maps, program.MapUserFrom(base.ExecveMap))
 	if config.EnableV511Progs() && !option.Config.UsePerfRingBuffer {
 		maps = append(maps, program.MapUserFrom(base.RingBufEvents))
+		maps = append(maps, program.MapUserFrom(base.RingBufEventsHigh))
 	}
 
 	if option.Config.ParentsMapEnabled {
@@ -636,7 +647,7 @@ func (tp *genericTracepoint) InitKernelSelectors(lists []v1alpha1.ListSpec) erro
 		Args:           selArgs,
 		Data:           []v1alpha1.KProbeArg{},
 		ActionArgTable: &tp.actionArgs,
-		ListReader:     &listReader{lists},
+		ListReader:     &policyspec.ListReader{Lists: lists},
 	})
 	if err != nil {
 		return err
@@ -1006,8 +1017,8 @@ func handleMsgGenericTracepoint(
 				logger.GetLogger().Warn(fmt.Sprintf("Size type error sizeof %d", m.Common.Size), logfields.Error, err)
 			}
 			if option.Config.CompatibilitySyscall64SizeType {
-				// NB: clear Is32Bit to mantain previous behaviour
-				val = val & (^uint64(Is32Bit))
+				// NB: clear policyspec.Is32Bit to mantain previous behaviour
+				val = val & (^uint64(policyspec.Is32Bit))
 				unix.Args = append(unix.Args, val)
 			} else {
 				val := parseSyscall64Value(val)
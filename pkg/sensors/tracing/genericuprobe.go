@@ -37,6 +37,7 @@ import (
 	"github.com/cilium/tetragon/pkg/logger/logfields"
 	"github.com/cilium/tetragon/pkg/observer"
 	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/policyspec"
 	"github.com/cilium/tetragon/pkg/selectors"
 	"github.com/cilium/tetragon/pkg/sensors"
 	"github.com/cilium/tetragon/pkg/sensors/base"
@@ -391,6 +392,7 @@ type uprobeHas struct {
 	sleepableOffload bool
 	sleepablePreload bool
 	substring        bool
+	sample           bool
 }
 
 func createGenericUprobeSensor(
@@ -415,7 +417,7 @@ func createGenericUprobeSensor(
 	}
 
 	for _, uprobe := range spec.UProbes {
-		if err = appendMacrosSelectors(uprobe.Selectors, spec.SelectorsMacros); err != nil {
+		if err = policyspec.AppendMacrosSelectors(uprobe.Selectors, spec.SelectorsMacros); err != nil {
 			return nil, fmt.Errorf("append macros selectors: %w", err)
 		}
 
@@ -438,6 +440,7 @@ func createGenericUprobeSensor(
 	maps = append(maps, program.MapUserFrom(base.ExecveMap))
 	if config.EnableV511Progs() && !option.Config.UsePerfRingBuffer {
 		maps = append(maps, program.MapUserFrom(base.RingBufEvents))
+		maps = append(maps, program.MapUserFrom(base.RingBufEventsHigh))
 	}
 
 	if option.Config.ParentsMapEnabled {
@@ -509,6 +512,8 @@ func addUprobe(spec *v1alpha1.UProbeSpec, ids []idtable.EntryID, in *addUprobeIn
 		has.sleepableOffload = true
 	}
 
+	has.sample = has.sample || selectors.HasSample(spec.Selectors)
+
 	if selectors.HasOperator(spec.Selectors, selectors.SelectorOpSubString) {
 		if !bpf.HasKfunc("bpf_strnstr") {
 			return nil, errors.New("can't use SubString operator, no kernel support")
@@ -537,7 +542,7 @@ func addUprobe(spec *v1alpha1.UProbeSpec, ids []idtable.EntryID, in *addUprobeIn
 	var uprobeRetSelectorState *selectors.KernelSelectorState
 	if spec.Return {
 		uprobeRetSelectorState, err = selectors.InitKernelReturnSelectorState(spec.Selectors, spec.ReturnArg,
-			nil, nil, nil)
+			nil, nil, nil, nil)
 		if err != nil {
 			return nil, err
 		}
@@ -864,6 +869,10 @@ func createMultiUprobeSensor(polInfo *policyInfo, sensorPath string, multiIDs []
 	maps = append(maps, configMap, tailCalls, filterMap, retProbe)
 	maps = append(maps, createSelectorMaps(load, getUprobeProgramSelector(load, nil))...)
 
+	if config.EnableLargeProgs() {
+		maps = append(maps, createSampleMap(load, has.sample))
+	}
+
 	if has.substring {
 		substringMap := program.MapBuilderSensor("substring_map", load)
 		substringMap.SetMaxEntries(substringMapEntries)
@@ -973,6 +982,10 @@ func createUprobeSensorFromEntry(polInfo *policyInfo, uprobeEntry *genericUprobe
 	maps = append(maps, configMap, tailCalls, filterMap, selMatchBinariesMap, retProbe)
 	maps = append(maps, createSelectorMaps(load, getUprobeProgramSelector(load, uprobeEntry))...)
 
+	if config.EnableLargeProgs() {
+		maps = append(maps, createSampleMap(load, has.sample))
+	}
+
 	if has.substring {
 		substringMap := program.MapBuilderSensor("substring_map", load)
 		substringMap.SetMaxEntries(substringMapEntries)
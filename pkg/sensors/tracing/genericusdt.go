@@ -30,6 +30,7 @@ import (
 	"github.com/cilium/tetragon/pkg/logger/logfields"
 	"github.com/cilium/tetragon/pkg/observer"
 	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/policyspec"
 	"github.com/cilium/tetragon/pkg/selectors"
 	"github.com/cilium/tetragon/pkg/sensors"
 	"github.com/cilium/tetragon/pkg/sensors/base"
@@ -43,6 +44,7 @@ type observerUsdtSensor struct {
 type usdtHas struct {
 	sleepableOffload bool
 	sleepablePreload bool
+	sample           bool
 }
 
 var (
@@ -118,7 +120,7 @@ func createGenericUsdtSensor(
 	hasSetAction := false
 
 	for _, usdt := range spec.Usdts {
-		if err = appendMacrosSelectors(usdt.Selectors, spec.SelectorsMacros); err != nil {
+		if err = policyspec.AppendMacrosSelectors(usdt.Selectors, spec.SelectorsMacros); err != nil {
 			return nil, fmt.Errorf("append macros selectors: %w", err)
 		}
 
@@ -127,6 +129,7 @@ func createGenericUsdtSensor(
 			return nil, err
 		}
 		hasSetAction = hasSetAction || selectors.HasSet(&usdt)
+		has.sample = has.sample || selectors.HasSample(usdt.Selectors)
 	}
 
 	has.sleepableOffload = hasSetAction && config.EnableV61Progs()
@@ -144,6 +147,7 @@ func createGenericUsdtSensor(
 	maps = append(maps, program.MapUserFrom(base.ExecveMap))
 	if config.EnableV511Progs() && !option.Config.UsePerfRingBuffer {
 		maps = append(maps, program.MapUserFrom(base.RingBufEvents))
+		maps = append(maps, program.MapUserFrom(base.RingBufEventsHigh))
 	}
 
 	if option.Config.ParentsMapEnabled {
@@ -185,6 +189,10 @@ func createMultiUsdtSensor(multiIDs []idtable.EntryID, policyName string, has us
 
 	maps = append(maps, configMap, tailCalls, filterMap)
 
+	if config.EnableLargeProgs() {
+		maps = append(maps, createSampleMap(load, has.sample))
+	}
+
 	filterMap.SetMaxEntries(len(multiIDs))
 	configMap.SetMaxEntries(len(multiIDs))
 
@@ -255,6 +263,10 @@ func createUsdtSensorFromEntry(usdtEntry *genericUsdt,
 	selMatchBinariesMap := program.MapBuilderProgram("tg_mb_sel_opts", load)
 	maps = append(maps, configMap, tailCalls, filterMap, selMatchBinariesMap)
 
+	if config.EnableLargeProgs() {
+		maps = append(maps, createSampleMap(load, has.sample))
+	}
+
 	if has.sleepableOffload {
 		sleepableOffloadMap := program.MapBuilderProgram("write_offload", load)
 		sleepableOffloadMap.SetMaxEntries(sleepableOffloadMaxEntries)
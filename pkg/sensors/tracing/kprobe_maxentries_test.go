@@ -81,6 +81,7 @@ func TestMaxEntries(t *testing.T) {
 			{"enforcer_data", 1},
 			{"stack_trace_map", 1},
 			{"ratelimit_map", 1},
+			{"sample_map", 1},
 			{"override_tasks", 1},
 		}, `
 apiVersion: cilium.io/v1alpha1
@@ -183,6 +184,52 @@ spec:
 `)
 	})
 
+	t.Run("sample_map", func(t *testing.T) {
+		run(t, []testMap{
+			{"ratelimit_map", 1},
+			{"sample_map", sampleMapMaxEntries},
+		}, `
+apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "sampled-reads"
+spec:
+  kprobes:
+  - call: "sys_read"
+    syscall: true
+    selectors:
+    - macros:
+      - "sampled"
+  selectorsMacros:
+    sampled:
+      matchActions:
+      - action: Post
+        sample: "1/100"
+        sampleScope: "process"
+`)
+	})
+
+	t.Run("sample_map_tracepoint", func(t *testing.T) {
+		run(t, []testMap{
+			{"sample_map", sampleMapMaxEntries},
+		}, `
+apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "sampled-tracepoints"
+spec:
+  tracepoints:
+  - subsystem: "syscalls"
+    event: "sys_enter_read"
+  - subsystem: "syscalls"
+    event: "sys_enter_write"
+    selectors:
+    - matchActions:
+      - action: Post
+        sample: "1/100"
+`)
+	})
+
 	t.Run("enforcer_data", func(t *testing.T) {
 		if !bpf.HasSignalHelper() {
 			t.Skip("skipping enforcer test, bpf_send_signal helper not available")
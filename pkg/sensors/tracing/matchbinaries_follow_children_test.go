@@ -30,6 +30,7 @@ import (
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/observer"
 	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/policyspec"
 	"github.com/cilium/tetragon/pkg/reader/namespace"
 	"github.com/cilium/tetragon/pkg/reader/notify"
 	"github.com/cilium/tetragon/pkg/sensors/exec/execvemap"
@@ -425,7 +426,7 @@ func TestMatchBinariesFollowChildrenBeforePolicy(t *testing.T) {
 		cmd.Process.Wait()
 	})
 
-	fn, err := SyscallVal("sys_prctl").Symbol()
+	fn, err := policyspec.SyscallVal("sys_prctl").Symbol()
 	require.NoError(t, err)
 
 	cnt := 0
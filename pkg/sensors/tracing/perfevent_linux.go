@@ -0,0 +1,365 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// The perf_event sensor samples the user and kernel stacks of the processes a
+// policy applies to, at a configurable frequency, and aggregates the samples
+// in stack trace trees. Trees are named after the policy and its perfEvents
+// entries, they can be retrieved with the GetStackTraceTree API or exported as
+// pprof profiles.
+
+package tracing
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/cilium/ebpf"
+
+	"github.com/cilium/tetragon/pkg/cgtracker"
+	"github.com/cilium/tetragon/pkg/config"
+	"github.com/cilium/tetragon/pkg/constants"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/ksyms"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
+	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/policyspec"
+	"github.com/cilium/tetragon/pkg/process"
+	"github.com/cilium/tetragon/pkg/procsyms"
+	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/cilium/tetragon/pkg/sensors/base"
+	"github.com/cilium/tetragon/pkg/sensors/program"
+	stt "github.com/cilium/tetragon/pkg/stacktracetree"
+	sttManager "github.com/cilium/tetragon/pkg/stt"
+)
+
+const (
+	perfEventDefaultFrequency = 99
+	// interval at which samples are moved from the BPF maps to the trees
+	perfEventCollectInterval = 10 * time.Second
+)
+
+type perfEventProbe struct{}
+
+func init() {
+	sensors.RegisterProbeType("perf_event", &perfEventProbe{})
+}
+
+func (p *perfEventProbe) LoadProbe(args sensors.LoadProbeArgs) error {
+	return program.LoadPerfEventProgram(args.BPFDir, args.Load, args.Maps, args.Verbose)
+}
+
+// perfEventConfig is struct perf_config of bpf_perf_event.c
+type perfEventConfig struct {
+	PolicyID uint32
+	Kernel   uint8
+	User     uint8
+	Pad      [2]uint8
+}
+
+// perfEventKey is struct perf_key of bpf_perf_event.c
+type perfEventKey struct {
+	CgroupID      uint64
+	Pid           uint32
+	Pad           uint32
+	Ktime         uint64
+	KernelStackID int32
+	UserStackID   int32
+}
+
+// perfEventCollector periodically moves the samples of a perf event program
+// to its stack trace tree.
+type perfEventCollector struct {
+	tree        string
+	counts      *program.Map
+	stacks      *program.Map
+	treeCreated bool
+	stop        chan struct{}
+	done        chan struct{}
+}
+
+func perfEventConfigFromSpec(pe *v1alpha1.PerfEventSpec) (*perfEventConfig, error) {
+	kernel, user, err := policyspec.PerfEventStacks(pe)
+	if err != nil {
+		return nil, err
+	}
+	conf := &perfEventConfig{}
+	if kernel {
+		conf.Kernel = 1
+	}
+	if user {
+		conf.User = 1
+	}
+	return conf, nil
+}
+
+func createPerfEventSensor(
+	spec *v1alpha1.TracingPolicySpec,
+	name string,
+	polInfo *policyInfo,
+) (*sensors.Sensor, error) {
+	var (
+		progs      []*program.Program
+		maps       []*program.Map
+		collectors []*perfEventCollector
+	)
+
+	names := make(map[string]struct{})
+	for i := range spec.PerfEvents {
+		pe := &spec.PerfEvents[i]
+		if pe.Name == "" {
+			return nil, errors.New("perf event name is required")
+		}
+		if _, ok := names[pe.Name]; ok {
+			return nil, fmt.Errorf("duplicate perf event name %q", pe.Name)
+		}
+		names[pe.Name] = struct{}{}
+
+		conf, err := perfEventConfigFromSpec(pe)
+		if err != nil {
+			return nil, err
+		}
+		conf.PolicyID = uint32(polInfo.policyID)
+
+		frequency := pe.Frequency
+		if frequency == 0 {
+			frequency = perfEventDefaultFrequency
+		}
+
+		load := program.Builder(
+			config.PerfEventObj(),
+			pe.Name,
+			"perf_event",
+			fmt.Sprintf("perf_event_%d", i),
+			"perf_event",
+		).SetPolicy(polInfo.name).SetAttachData(&program.PerfEventAttachData{
+			Frequency: frequency,
+		})
+		load.MapLoad = append(load.MapLoad, &program.MapLoad{
+			Name: "perf_config_map",
+			Load: func(m *ebpf.Map, _ string) error {
+				return m.Update(uint32(0), conf, ebpf.UpdateAny)
+			},
+		})
+		progs = append(progs, load)
+
+		collector := &perfEventCollector{
+			tree:   perfEventTreeName(polInfo, pe.Name),
+			counts: program.MapBuilderProgram("perf_counts", load),
+			stacks: program.MapBuilderProgram("perf_stack_map", load),
+		}
+		collectors = append(collectors, collector)
+
+		maps = append(maps,
+			program.MapBuilderProgram("perf_config_map", load),
+			collector.counts,
+			collector.stacks,
+		)
+		if option.Config.EnableCgTrackerID {
+			maps = append(maps, program.MapUser(cgtracker.MapName, load))
+		}
+	}
+
+	maps = append(maps, program.MapUserFrom(base.ExecveMap))
+
+	return &sensors.Sensor{
+		Name:      name,
+		Progs:     progs,
+		Maps:      maps,
+		Policy:    polInfo.name,
+		Namespace: polInfo.namespace,
+		PostLoadHook: func() error {
+			var errs error
+			for _, c := range collectors {
+				errs = errors.Join(errs, c.start())
+			}
+			return errs
+		},
+		PreUnloadHook: func() error {
+			for _, c := range collectors {
+				c.stopCollector()
+			}
+			return nil
+		},
+		DestroyHook: func() error {
+			var errs error
+			for _, c := range collectors {
+				c.stopCollector()
+				if c.treeCreated {
+					errs = errors.Join(errs, sttManager.GetManager().DestroyTree(c.tree))
+					c.treeCreated = false
+				}
+			}
+			return errs
+		},
+	}, nil
+}
+
+// perfEventTreeName returns the name of the stack trace tree of a perf event.
+// Tree names are global, so they are prefixed with the namespace and the name
+// of the policy.
+func perfEventTreeName(polInfo *policyInfo, name string) string {
+	if polInfo.namespace == "" {
+		return polInfo.name + "/" + name
+	}
+	return polInfo.namespace + "/" + polInfo.name + "/" + name
+}
+
+func (c *perfEventCollector) start() error {
+	if !c.treeCreated {
+		if err := sttManager.GetManager().CreateTree(c.tree); err != nil {
+			return fmt.Errorf("failed to create stack trace tree %q: %w", c.tree, err)
+		}
+		c.treeCreated = true
+	}
+
+	c.stop = make(chan struct{})
+	c.done = make(chan struct{})
+	go func() {
+		defer close(c.done)
+		ticker := time.NewTicker(perfEventCollectInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				c.collect()
+			case <-c.stop:
+				c.collect()
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+func (c *perfEventCollector) stopCollector() {
+	if c.stop == nil {
+		return
+	}
+	close(c.stop)
+	<-c.done
+	c.stop = nil
+}
+
+// collect moves the samples counted in BPF to the stack trace tree. Samples
+// counted between the lookup and the deletion of an entry are lost, which is
+// acceptable when sampling.
+func (c *perfEventCollector) collect() {
+	counts, stacks := c.counts.MapHandle, c.stacks.MapHandle
+	if counts == nil || stacks == nil {
+		return
+	}
+
+	kernelSymbols, err := ksyms.KernelSymbols()
+	if err != nil {
+		logger.GetLogger().Warn("perf event: failed to read kernel symbols", logfields.Error, err)
+	}
+
+	var (
+		key     perfEventKey
+		count   uint64
+		keys    []perfEventKey
+		labels  = make(map[perfEventKey]string)
+		stackID = make(map[int32]struct{})
+	)
+	iter := counts.Iterate()
+	for iter.Next(&key, &count) {
+		keys = append(keys, key)
+
+		procKey := perfEventKey{CgroupID: key.CgroupID, Pid: key.Pid, Ktime: key.Ktime}
+		label, ok := labels[procKey]
+		if !ok {
+			label = perfEventLabel(&key)
+			labels[procKey] = label
+		}
+
+		trace := stt.Stt{}
+		// the tree goes from the outermost frame to the innermost one: user
+		// frames first, and then kernel frames.
+		if key.UserStackID >= 0 {
+			stackID[key.UserStackID] = struct{}{}
+			for _, addr := range perfEventStack(stacks, key.UserStackID) {
+				addr, sym := perfEventUserFrame(key.Pid, addr)
+				trace.AppendCount(addr, sym, []string{label}, int(count))
+			}
+		}
+		if key.KernelStackID >= 0 {
+			stackID[key.KernelStackID] = struct{}{}
+			for _, addr := range perfEventStack(stacks, key.KernelStackID) {
+				sym := ""
+				if kernelSymbols != nil {
+					if fnOffset, err := kernelSymbols.GetFnOffset(addr); err == nil {
+						sym = fnOffset.SymName
+					}
+				}
+				trace.AppendCount(addr, sym, []string{label}, int(count))
+			}
+		}
+		if err := sttManager.GetManager().Insert(c.tree, &trace); err != nil {
+			logger.GetLogger().Warn("perf event: failed to insert stack trace", "tree", c.tree, logfields.Error, err)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		logger.GetLogger().Warn("perf event: failed to iterate samples", "tree", c.tree, logfields.Error, err)
+	}
+
+	for i := range keys {
+		counts.Delete(&keys[i])
+	}
+	for id := range stackID {
+		stacks.Delete(uint32(id))
+	}
+}
+
+// perfEventStack returns the addresses of a stack, from the outermost frame to
+// the innermost one.
+func perfEventStack(stacks *ebpf.Map, id int32) []uint64 {
+	var trace [constants.PERF_MAX_STACK_DEPTH]uint64
+	if err := stacks.Lookup(uint32(id), &trace); err != nil {
+		return nil
+	}
+	end := slices.Index(trace[:], 0)
+	if end < 0 {
+		end = len(trace)
+	}
+	addrs := trace[:end]
+	slices.Reverse(addrs)
+	return addrs
+}
+
+// perfEventUserFrame returns the tree address and the symbol of a user space
+// address. Processes map code at different addresses, so frames are keyed by
+// a hash of their module and offset rather than by their address.
+func perfEventUserFrame(pid uint32, addr uint64) (uint64, string) {
+	fsym, err := procsyms.GetFnSymbol(int(pid), addr)
+	if err != nil {
+		return addr, ""
+	}
+	h := fnv.New64a()
+	h.Write([]byte(fsym.Module))
+	h.Write(binary.LittleEndian.AppendUint64(nil, fsym.Offset))
+	sym := fsym.Name
+	if sym == "" {
+		sym = fmt.Sprintf("%s+0x%x", filepath.Base(fsym.Module), fsym.Offset)
+	}
+	return h.Sum64(), sym
+}
+
+// perfEventLabel returns the label of the samples of a process: its pod if it
+// runs in one, its binary otherwise, or its cgroup if the process is unknown.
+func perfEventLabel(key *perfEventKey) string {
+	proc, err := process.Get(process.GetProcessID(key.Pid, key.Ktime))
+	if err != nil {
+		return fmt.Sprintf("cgroup:%d", key.CgroupID)
+	}
+	p := proc.GetProcessCopy()
+	if pod := p.GetPod(); pod != nil {
+		return fmt.Sprintf("pod:%s/%s", pod.GetNamespace(), pod.GetName())
+	}
+	return "binary:" + p.GetBinary()
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package tracing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	sttManager "github.com/cilium/tetragon/pkg/stt"
+)
+
+func TestPerfEventConfigFromSpec(t *testing.T) {
+	conf, err := perfEventConfigFromSpec(&v1alpha1.PerfEventSpec{Name: "cpu"})
+	require.NoError(t, err)
+	require.Equal(t, uint8(1), conf.Kernel)
+	require.Equal(t, uint8(1), conf.User)
+
+	conf, err = perfEventConfigFromSpec(&v1alpha1.PerfEventSpec{Name: "cpu", Stacks: "user"})
+	require.NoError(t, err)
+	require.Equal(t, uint8(0), conf.Kernel)
+	require.Equal(t, uint8(1), conf.User)
+
+	conf, err = perfEventConfigFromSpec(&v1alpha1.PerfEventSpec{Name: "cpu", Stacks: "kernel"})
+	require.NoError(t, err)
+	require.Equal(t, uint8(1), conf.Kernel)
+	require.Equal(t, uint8(0), conf.User)
+
+	_, err = perfEventConfigFromSpec(&v1alpha1.PerfEventSpec{Name: "cpu", Stacks: "both"})
+	require.Error(t, err)
+}
+
+func TestPerfEventTreeName(t *testing.T) {
+	mgr := sttManager.StartSttManager()
+
+	// two policies with a perf event of the same name get their own tree
+	trees := []string{
+		perfEventTreeName(&policyInfo{name: "policy1"}, "cpu"),
+		perfEventTreeName(&policyInfo{name: "policy2"}, "cpu"),
+		perfEventTreeName(&policyInfo{name: "policy1", namespace: "ns"}, "cpu"),
+	}
+	require.Equal(t, []string{"policy1/cpu", "policy2/cpu", "ns/policy1/cpu"}, trees)
+	for _, tree := range trees {
+		require.NoError(t, mgr.CreateTree(tree))
+	}
+
+	// trees are not silently replaced
+	require.Error(t, mgr.CreateTree(trees[0]))
+	require.NoError(t, mgr.DestroyTree(trees[0]))
+	require.NoError(t, mgr.CreateTree(trees[0]))
+}
@@ -4,16 +4,17 @@
 package tracing
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/cilium/ebpf"
 
+	"github.com/cilium/tetragon/pkg/config"
 	"github.com/cilium/tetragon/pkg/eventhandler"
 	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/policyconf"
 	"github.com/cilium/tetragon/pkg/policyfilter"
+	"github.com/cilium/tetragon/pkg/policyspec"
 	"github.com/cilium/tetragon/pkg/policystats"
 	"github.com/cilium/tetragon/pkg/selectors"
 	"github.com/cilium/tetragon/pkg/sensors"
@@ -28,7 +29,7 @@ type policyInfo struct {
 	customHandler eventhandler.Handler
 	policyConf    *program.Map
 	policyStats   *program.Map
-	specOpts      *specOptions
+	specOpts      *policyspec.SpecOptions
 }
 
 func newPolicyInfo(
@@ -90,14 +91,14 @@ func newPolicyInfoFromSpec(
 	spec *v1alpha1.TracingPolicySpec,
 	customHandler eventhandler.Handler,
 ) (*policyInfo, error) {
-	opts, err := getSpecOptions(spec.Options)
+	opts, err := policyspec.GetSpecOptions(spec.Options)
 	if err != nil {
 		return nil, err
 	}
 
 	// If enforcement is not allowed, force monitor only
 	if !hasEnforcementActions(spec) {
-		opts.policyMode = policyconf.MonitorOnlyMode
+		opts.PolicyMode = policyconf.MonitorOnlyMode
 	}
 
 	return &policyInfo{
@@ -128,11 +129,19 @@ func (pi *policyInfo) policyConfMap(prog *program.Program) *program.Map {
 		Name: policyconf.PolicyConfMapName,
 		Load: func(m *ebpf.Map, _ string) error {
 			mode := policyconf.EnforceMode
+			priority := policyconf.NormalPriority
 			if pi.specOpts != nil {
-				mode = pi.specOpts.policyMode
+				mode = pi.specOpts.PolicyMode
+				priority = pi.specOpts.PolicyPriority
+			}
+			if priority == policyconf.HighPriority && !config.EnableHighPriorityRingBuf() {
+				logger.GetLogger().Warn("High priority ring buffer is not available, policy events use the default ring buffer",
+					"policy", pi.name)
+				priority = policyconf.NormalPriority
 			}
 			conf := policyconf.PolicyConf{
-				Mode: mode,
+				Mode:     mode,
+				Priority: priority,
 			}
 			key := uint32(0)
 			return m.Update(key, &conf, ebpf.UpdateAny)
@@ -147,24 +156,8 @@ func (h policyHandler) PolicyHandler(
 ) (sensors.SensorIface, error) {
 
 	spec := policy.TpSpec()
-	sections := 0
-	if len(spec.KProbes) > 0 {
-		sections++
-	}
-	if len(spec.Tracepoints) > 0 {
-		sections++
-	}
-	if len(spec.LsmHooks) > 0 {
-		sections++
-	}
-	if len(spec.UProbes) > 0 {
-		sections++
-	}
-	if len(spec.Usdts) > 0 {
-		sections++
-	}
-	if sections > 1 {
-		return nil, errors.New("tracing policies with multiple sections of kprobes, tracepoints, lsm hooks, uprobes or usdts are currently not supported")
+	if policyspec.SpecSections(spec) > 1 {
+		return nil, policyspec.ErrMultipleSections
 	}
 
 	polInfo, err := newPolicyInfo(policy, policyID)
@@ -178,7 +171,7 @@ func (h policyHandler) PolicyHandler(
 			"policy", tracingpolicy.TpLongname(policy),
 			"sensor", name,
 		)
-		validateInfo, err := preValidateKprobes(log, spec.KProbes, spec.Lists, spec.Enforcers)
+		validateInfo, err := preValidateKprobes(log, spec.KProbes, spec.Lists, spec.Enforcers, polInfo.specOpts)
 		if err != nil {
 			return nil, fmt.Errorf("validation failed: %w", err)
 		}
@@ -201,5 +194,8 @@ func (h policyHandler) PolicyHandler(
 	if len(spec.Usdts) > 0 {
 		return createGenericUsdtSensor(spec, "generic_usdt", polInfo)
 	}
+	if len(spec.PerfEvents) > 0 {
+		return createPerfEventSensor(spec, "perf_event", polInfo)
+	}
 	return nil, nil
 }
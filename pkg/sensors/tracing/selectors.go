@@ -221,6 +221,17 @@ func createSelectorMaps(load *program.Program, state *selectors.KernelSelectorSt
 	return maps
 }
 
+// createSampleMap returns the map counting the events of the "1/N" sampled
+// post actions, shared by the programs of the sensor. It is only resized when
+// the sensor has sampled actions, to reduce the memory footprint when unused.
+func createSampleMap(load *program.Program, sample bool) *program.Map {
+	sampleMap := program.MapBuilderSensor("sample_map", load)
+	if sample {
+		sampleMap.SetMaxEntries(sampleMapMaxEntries)
+	}
+	return sampleMap
+}
+
 func populateArgFilterMaps(
 	k *selectors.KernelSelectorState,
 	pinPathPrefix string,
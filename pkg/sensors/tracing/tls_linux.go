@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+// The TLS plaintext sensor captures the data that processes write to and read
+// from TLS connections, before encryption and after decryption, and sends a
+// TLS event for each HTTP/1.x request and response. It probes the read and
+// write functions of OpenSSL and of the Go crypto/tls package, which are
+// found by inspecting the files configured with --tls-plaintext-paths at
+// startup, and the executed binaries and their root directory afterwards (see
+// TlsDiscovery).
+//
+// The sensor is not tied to a tracing policy, it is enabled with the
+// --enable-tls-plaintext flag.
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"syscall"
+
+	"github.com/cilium/tetragon/pkg/api/ops"
+	"github.com/cilium/tetragon/pkg/api/tracingapi"
+	"github.com/cilium/tetragon/pkg/config"
+	"github.com/cilium/tetragon/pkg/elf"
+	"github.com/cilium/tetragon/pkg/grpc/tracing"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
+	"github.com/cilium/tetragon/pkg/observer"
+	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/reader/http"
+	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/cilium/tetragon/pkg/sensors/base"
+	"github.com/cilium/tetragon/pkg/sensors/program"
+)
+
+const (
+	TlsSensorName = "__tls__"
+)
+
+var (
+	// sections of the entry probes, by symbol
+	tlsEntryLabels = map[string]string{
+		"SSL_write":          "uprobe/tls_ssl_write",
+		"SSL_read":           "uprobe/tls_ssl_read",
+		"SSL_write_ex":       "uprobe/tls_ssl_write_ex",
+		"SSL_read_ex":        "uprobe/tls_ssl_read_ex",
+		elf.GoTLSWriteSymbol: "uprobe/tls_go_write",
+		elf.GoTLSReadSymbol:  "uprobe/tls_go_read",
+	}
+
+	tlsRedactHeaders map[string]struct{}
+)
+
+type tlsProbe struct{}
+
+func init() {
+	sensors.RegisterProbeType("tls_uprobe", &tlsProbe{})
+	observer.RegisterEventHandlerAtInit(ops.MSG_OP_TLS, handleTls)
+}
+
+func (p *tlsProbe) LoadProbe(args sensors.LoadProbeArgs) error {
+	return program.LoadUprobeProgram(args.BPFDir, args.Load, args.Maps, args.Verbose)
+}
+
+// tlsFileID identifies a file by its device and inode, so that files reached
+// through different paths (symbolic links, /proc/<pid>/root) are probed once.
+type tlsFileID struct {
+	dev uint64
+	ino uint64
+}
+
+type tlsFile struct {
+	path   string
+	target *elf.TLSTarget
+}
+
+// tlsTargets returns the TLS targets of the files matching the patterns
+// inside root. Files in seen are skipped, and the inspected files are added
+// to it.
+func tlsTargets(root string, patterns []string, seen map[tlsFileID]struct{}) map[tlsFileID]*tlsFile {
+	// Absolute symbolic links would be resolved against the root of the
+	// agent, so they are only followed on the host. The files they point to
+	// usually match the patterns as well.
+	stat := os.Stat
+	if root != "/" {
+		stat = os.Lstat
+	}
+
+	targets := make(map[tlsFileID]*tlsFile)
+	for _, pattern := range patterns {
+		matches, err := filepath.Glob(filepath.Join(root, pattern))
+		if err != nil {
+			logger.GetLogger().Warn("Invalid TLS plaintext path pattern", "pattern", pattern, logfields.Error, err)
+			continue
+		}
+		for _, path := range matches {
+			st, err := stat(path)
+			if err != nil || !st.Mode().IsRegular() {
+				continue
+			}
+			sys, ok := st.Sys().(*syscall.Stat_t)
+			if !ok {
+				continue
+			}
+			id := tlsFileID{dev: sys.Dev, ino: sys.Ino}
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			target, err := tlsTarget(path)
+			if errors.Is(err, elf.ErrNoTLSLibrary) {
+				logger.GetLogger().Debug("No TLS library found", "path", path)
+				continue
+			} else if err != nil {
+				logger.GetLogger().Debug("Failed to inspect TLS library", "path", path, logfields.Error, err)
+				continue
+			}
+			targets[id] = &tlsFile{path: path, target: target}
+		}
+	}
+	return targets
+}
+
+func tlsTarget(path string) (*elf.TLSTarget, error) {
+	se, err := elf.OpenSafeELFFile(path)
+	if err != nil {
+		return nil, err
+	}
+	defer se.Close()
+	return se.TLSTarget()
+}
+
+func tlsUprobe(path, symbol string, address, offset uint64, label string, idx int) *program.Program {
+	return program.Builder(
+		config.TlsObj(),
+		fmt.Sprintf("%s %s", path, symbol),
+		label,
+		fmt.Sprintf("tls_uprobe_%d", idx),
+		"tls_uprobe",
+	).SetPolicy(TlsSensorName).SetAttachData(&program.UprobeAttachData{
+		Path:    path,
+		Symbol:  symbol,
+		Address: address,
+		Offset:  offset,
+	})
+}
+
+func tlsKprobe(attach string, label string, prog string) *program.Program {
+	return program.Builder(
+		config.TlsObj(),
+		attach,
+		label,
+		prog,
+		"kprobe",
+	).SetPolicy(TlsSensorName)
+}
+
+// tlsUprobes returns the uprobes of the TLS functions of a file.
+func tlsUprobes(file *tlsFile, idx int) []*program.Program {
+	var progs []*program.Program
+	path, target := file.path, file.target
+	for _, sym := range target.Symbols {
+		address := target.Offsets[sym]
+		progs = append(progs, tlsUprobe(path, sym, address, 0, tlsEntryLabels[sym], idx+len(progs)))
+		switch target.Library {
+		case elf.TLSLibraryOpenSSL:
+			progs = append(progs, tlsUprobe(path, sym, address, 0, "uprobe/tls_ssl_ret", idx+len(progs)).SetRetProbe(true))
+		case elf.TLSLibraryGo:
+			for _, off := range target.ReturnOffsets[sym] {
+				progs = append(progs, tlsUprobe(path, sym, address, off, "uprobe/tls_go_ret", idx+len(progs)))
+			}
+		}
+	}
+	return progs
+}
+
+// GetTlsSensor returns the TLS plaintext sensor, with probes for the TLS
+// libraries found in the paths configured with --tls-plaintext-paths, and
+// the discovery of the libraries and binaries found later.
+func GetTlsSensor() (*sensors.Sensor, *TlsDiscovery) {
+	discovery := newTlsDiscovery(option.Config.TlsPlaintextPaths)
+	targets := tlsTargets("/", option.Config.TlsPlaintextPaths, discovery.seen)
+	if len(targets) == 0 {
+		logger.GetLogger().Warn("No TLS library found at startup, TLS libraries and binaries will be probed when they are executed",
+			"paths", strings.Join(option.Config.TlsPlaintextPaths, ","))
+	}
+
+	progs := []*program.Program{
+		tlsKprobe("security_socket_sendmsg", "kprobe/security_socket_sendmsg", "tls_sendmsg"),
+		tlsKprobe("sock_recvmsg", "kprobe/sock_recvmsg", "tls_recvmsg"),
+	}
+
+	files := make([]*tlsFile, 0, len(targets))
+	for id, file := range targets {
+		files = append(files, file)
+		discovery.probed[id] = struct{}{}
+	}
+	slices.SortFunc(files, func(a, b *tlsFile) int {
+		return strings.Compare(a.path, b.path)
+	})
+
+	for _, file := range files {
+		progs = append(progs, tlsUprobes(file, len(progs))...)
+		logger.GetLogger().Info("Found TLS library", "path", file.path, "symbols", file.target.Symbols)
+	}
+
+	maps := []*program.Map{
+		program.MapBuilder("tls_heap", progs...),
+		program.MapBuilder("tls_procs", progs...),
+		program.MapBuilder("tls_sock_map", progs...),
+		program.MapBuilder("tls_call_map", progs...),
+		program.MapBuilder("tls_go_call_map", progs...),
+		program.MapUserFrom(base.ExecveMap),
+	}
+	if config.EnableV511Progs() && !option.Config.UsePerfRingBuffer {
+		maps = append(maps, program.MapUserFrom(base.RingBufEvents))
+		maps = append(maps, program.MapUserFrom(base.RingBufEventsHigh))
+	}
+	discovery.maps = maps
+
+	tlsRedactHeaders = make(map[string]struct{})
+	for _, h := range option.Config.TlsPlaintextRedactHeaders {
+		tlsRedactHeaders[strings.ToLower(h)] = struct{}{}
+	}
+
+	return &sensors.Sensor{
+		Name:   TlsSensorName,
+		Progs:  progs,
+		Maps:   maps,
+		Policy: TlsSensorName,
+	}, discovery
+}
+
+func handleTls(r *bytes.Reader) ([]observer.Event, error) {
+	m := tracingapi.MsgTls{}
+	err := binary.Read(r, binary.LittleEndian, &m)
+	if err != nil {
+		logger.GetLogger().Warn("Failed to read tls msg", logfields.Error, err)
+		return nil, errors.New("failed to read tls msg")
+	}
+
+	if m.Size > tracingapi.TlsMaxSize {
+		return nil, fmt.Errorf("invalid tls msg size %d", m.Size)
+	}
+	data := make([]byte, m.Size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		logger.GetLogger().Warn("Failed to read tls msg data", logfields.Error, err)
+		return nil, errors.New("failed to read tls msg data")
+	}
+
+	// Only the start of HTTP/1.x messages is reported, other data (e.g.
+	// bodies or other protocols) is dropped.
+	parsed, err := http.Parse(data, tlsRedactHeaders)
+	if err != nil {
+		return nil, nil
+	}
+
+	msg := &tracing.MsgProcessTlsUnix{
+		Msg:  &m,
+		Http: parsed,
+	}
+	return []observer.Event{msg}, nil
+}
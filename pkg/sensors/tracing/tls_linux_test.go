@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package tracing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTlsTargetsSeen(t *testing.T) {
+	root := t.TempDir()
+	lib := filepath.Join(root, "usr", "lib")
+	require.NoError(t, os.MkdirAll(lib, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(lib, "libssl.so.3"), []byte("not an elf"), 0o644))
+	require.NoError(t, os.Symlink("/usr/lib/libssl.so.3", filepath.Join(lib, "libssl.so")))
+
+	seen := make(map[tlsFileID]struct{})
+	targets := tlsTargets(root, []string{"/usr/lib/libssl.so*"}, seen)
+	require.Empty(t, targets)
+	// the symbolic link is not followed inside a root directory, only the
+	// file it points to is inspected
+	require.Len(t, seen, 1)
+
+	// files are inspected once
+	targets = tlsTargets(root, []string{"/usr/lib/libssl.so.3"}, seen)
+	require.Empty(t, targets)
+	require.Len(t, seen, 1)
+}
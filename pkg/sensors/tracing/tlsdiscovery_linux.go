@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"syscall"
+
+	"github.com/cilium/tetragon/pkg/grpc/exec"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
+	"github.com/cilium/tetragon/pkg/observer"
+	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/reader/notify"
+	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/cilium/tetragon/pkg/sensors/program"
+)
+
+const (
+	// tlsDiscoveryQueueLen is the number of executed binaries waiting to
+	// be inspected. Binaries executed while the queue is full are not
+	// inspected.
+	tlsDiscoveryQueueLen = 1024
+	// tlsMaxDiscoveredFiles is the number of files the discovery probes.
+	// Each file is probed by its own sensor, which stays loaded until the
+	// agent stops.
+	tlsMaxDiscoveredFiles = 256
+	// tlsMaxSeenFiles is the number of inspected files that are
+	// remembered. Files are inspected again once it is reached.
+	tlsMaxSeenFiles = 65536
+)
+
+type tlsExec struct {
+	pid    uint32
+	binary string
+}
+
+// TlsDiscovery probes the TLS libraries and Go binaries that were not found
+// at startup. It is an observer listener that inspects the binaries of
+// process_exec events and, once per root directory, the files matching
+// --tls-plaintext-paths in the root directory of the process, which covers
+// the binaries and libraries of containers. The files are reached through
+// /proc/<pid>/root, and the TLS functions of each file with a TLS library are
+// probed by loading a sensor that shares the maps of the TLS sensor.
+//
+// Files are inspected in the background after the exec, so the first reads
+// and writes of a process may be missed.
+type TlsDiscovery struct {
+	patterns []string
+	// maps are the maps of the TLS sensor, including the execve map and
+	// the event ring buffers, which the sensors of the discovered files
+	// share.
+	maps  []*program.Map
+	execs chan tlsExec
+
+	// the fields below are only accessed by the discovery goroutine, and
+	// before it starts
+	seen   map[tlsFileID]struct{}
+	roots  map[tlsFileID]struct{}
+	probed map[tlsFileID]struct{}
+	loads  int
+}
+
+func newTlsDiscovery(patterns []string) *TlsDiscovery {
+	return &TlsDiscovery{
+		patterns: patterns,
+		execs:    make(chan tlsExec, tlsDiscoveryQueueLen),
+		seen:     make(map[tlsFileID]struct{}),
+		roots:    make(map[tlsFileID]struct{}),
+		probed:   make(map[tlsFileID]struct{}),
+	}
+}
+
+// Start starts inspecting the executed binaries, until ctx is done.
+func (d *TlsDiscovery) Start(ctx context.Context) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case e := <-d.execs:
+				d.inspect(ctx, e)
+			}
+		}
+	}()
+}
+
+// Notify implements observer.Listener.Notify.
+func (d *TlsDiscovery) Notify(msg notify.Message) error {
+	ev, ok := msg.(*exec.MsgExecveEventUnix)
+	if !ok || ev.Unix == nil {
+		return nil
+	}
+	select {
+	case d.execs <- tlsExec{pid: ev.Unix.Process.PID, binary: ev.Unix.Process.Filename}:
+	default:
+	}
+	return nil
+}
+
+// Close implements observer.Listener.Close.
+func (d *TlsDiscovery) Close() error {
+	return nil
+}
+
+func (d *TlsDiscovery) inspect(ctx context.Context, e tlsExec) {
+	if len(d.probed) >= tlsMaxDiscoveredFiles {
+		return
+	}
+	if len(d.seen) >= tlsMaxSeenFiles {
+		clear(d.seen)
+		clear(d.roots)
+		for id := range d.probed {
+			d.seen[id] = struct{}{}
+		}
+	}
+
+	root := filepath.Join(option.Config.ProcFS, strconv.FormatUint(uint64(e.pid), 10), "root")
+	patterns := []string{e.binary}
+	if st, err := os.Stat(root); err == nil {
+		if sys, ok := st.Sys().(*syscall.Stat_t); ok {
+			id := tlsFileID{dev: sys.Dev, ino: sys.Ino}
+			if _, ok := d.roots[id]; !ok {
+				d.roots[id] = struct{}{}
+				patterns = append(patterns, d.patterns...)
+			}
+		}
+	}
+
+	for id, file := range tlsTargets(root, patterns, d.seen) {
+		if len(d.probed) >= tlsMaxDiscoveredFiles {
+			logger.GetLogger().Warn("Too many TLS libraries found, not probing", "path", file.path, "max", tlsMaxDiscoveredFiles)
+			return
+		}
+		if err := d.load(ctx, file); err != nil {
+			logger.GetLogger().Warn("Failed to probe TLS library", "path", file.path, logfields.Error, err)
+			continue
+		}
+		d.probed[id] = struct{}{}
+		logger.GetLogger().Info("Found TLS library", "path", file.path, "binary", e.binary, "symbols", file.target.Symbols)
+	}
+}
+
+func (d *TlsDiscovery) load(ctx context.Context, file *tlsFile) error {
+	maps := make([]*program.Map, 0, len(d.maps))
+	for _, m := range d.maps {
+		maps = append(maps, program.MapUserFrom(m))
+	}
+	d.loads++
+	name := fmt.Sprintf("%s%d", TlsSensorName, d.loads)
+	sensor := &sensors.Sensor{
+		Name:   name,
+		Progs:  tlsUprobes(file, 0),
+		Maps:   maps,
+		Policy: TlsSensorName,
+	}
+
+	mgr := observer.GetSensorManager()
+	if err := mgr.AddSensor(ctx, name, sensor); err != nil {
+		return err
+	}
+	if err := mgr.EnableSensor(ctx, name); err != nil {
+		return errors.Join(err, mgr.RemoveSensor(ctx, name))
+	}
+	return nil
+}
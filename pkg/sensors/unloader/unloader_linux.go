@@ -40,6 +40,21 @@ func (rdu *RawDetachUnloader) Unload(unpin bool) error {
 	return nil
 }
 
+// PerfEventUnloader closes the perf events a program is attached to. Perf
+// events cannot be pinned, so they are closed even if unpin is false.
+type PerfEventUnloader struct {
+	FDs []int
+}
+
+func (pu *PerfEventUnloader) Unload(_ bool) error {
+	var errs error
+	for _, fd := range pu.FDs {
+		errs = multierr.Append(errs, unix.Close(fd))
+	}
+	pu.FDs = nil
+	return errs
+}
+
 // TcUnloader unloads programs attached to TC filters
 type TcUnloader struct {
 	Attachments []TcAttachment
@@ -40,3 +40,7 @@ func (f *FakeObserver) ListTracingPolicies(ctx context.Context) (*tetragon.ListT
 func (h *FakeObserver) ConfigureTracingPolicy(_ context.Context, _ *tetragon.ConfigureTracingPolicyRequest) error {
 	return nil
 }
+
+func (f *FakeObserver) ValidateTracingPolicy(ctx context.Context, tp tracingpolicy.TracingPolicy) (*tetragon.ValidateTracingPolicyResponse, error) {
+	return &tetragon.ValidateTracingPolicyResponse{Name: tp.TpName(), Valid: true}, nil
+}
@@ -4,6 +4,7 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -17,11 +18,15 @@ import (
 	"github.com/cilium/tetragon/pkg/fieldfilters"
 	"github.com/cilium/tetragon/pkg/filters"
 	"github.com/cilium/tetragon/pkg/health"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/logger/logfields"
 	"github.com/cilium/tetragon/pkg/metrics/eventmetrics"
 	"github.com/cilium/tetragon/pkg/option"
+	"github.com/cilium/tetragon/pkg/policyimpact"
 	"github.com/cilium/tetragon/pkg/process"
+	"github.com/cilium/tetragon/pkg/stacktracetree"
+	sttManager "github.com/cilium/tetragon/pkg/stt"
 	"github.com/cilium/tetragon/pkg/tetragoninfo"
 	"github.com/cilium/tetragon/pkg/tracingpolicy"
 	"github.com/cilium/tetragon/pkg/version"
@@ -48,22 +53,50 @@ type observer interface {
 	// ListTracingPolicies lists active traing policies
 	ListTracingPolicies(ctx context.Context) (*tetragon.ListTracingPoliciesResponse, error)
 	ConfigureTracingPolicy(ctx context.Context, conf *tetragon.ConfigureTracingPolicyRequest) error
+	// ValidateTracingPolicy checks whether a tracing policy can be loaded
+	// without adding it
+	ValidateTracingPolicy(ctx context.Context, policy tracingpolicy.TracingPolicy) (*tetragon.ValidateTracingPolicyResponse, error)
 
 	// {Disable, Enable}TracingPolicy are deprecated, use ConfigureTracingPolicy instead
 	DisableTracingPolicy(ctx context.Context, name string, namespace string) error
 	EnableTracingPolicy(ctx context.Context, name string, namespace string) error
 }
 
+// policySpecLister is implemented by the observers that can return the specs
+// of the loaded tracing policies.
+type policySpecLister interface {
+	// ListTracingPolicySpecs returns the specs of the loaded tracing
+	// policies, by name.
+	ListTracingPolicySpecs(ctx context.Context) map[string][]*v1alpha1.TracingPolicySpec
+}
+
 type hookRunner interface {
 	RunHooks(ctx context.Context, req *tetragon.RuntimeHookRequest) error
 }
 
+// Replayer replays previously recorded events so that GetEvents clients can
+// resume a stream from a cursor.
+type Replayer interface {
+	// Replay calls fn for every recorded event after cursor and returns the
+	// sequence number of the last replayed event.
+	Replay(ctx context.Context, cursor *tetragon.EventCursor, fn func(*tetragon.GetEventsResponse) error) (uint64, error)
+}
+
+// BugtoolCollector collects the debug information of the agent for bugtool.
+type BugtoolCollector interface {
+	// Collect calls send for the files of the sections of req.
+	Collect(ctx context.Context, req *tetragon.GetBugtoolFilesRequest, send func(*tetragon.GetBugtoolFilesResponse) error) error
+}
+
 type Server struct {
 	ctx          context.Context
 	ctxCleanupWG *sync.WaitGroup
 	notifier     Notifier
 	observer     observer
 	hookRunner   hookRunner
+	replayer     Replayer
+	policyImpact *policyimpact.Recorder
+	bugtool      BugtoolCollector
 	tetragon.UnimplementedFineGuidanceSensorsServer
 }
 
@@ -72,13 +105,39 @@ type getEventsListener struct {
 }
 
 func NewServer(ctx context.Context, cleanupWg *sync.WaitGroup, notifier Notifier, observer observer, hookRunner hookRunner) *Server {
-	return &Server{
+	s := &Server{
 		ctx:          ctx,
 		ctxCleanupWG: cleanupWg,
 		notifier:     notifier,
 		observer:     observer,
 		hookRunner:   hookRunner,
 	}
+	s.policyImpact = policyimpact.NewRecorder(func(ctx context.Context) (map[string]*policyimpact.Policy, error) {
+		res, err := s.observer.ListTracingPolicies(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var specs map[string][]*v1alpha1.TracingPolicySpec
+		if lister, ok := s.observer.(policySpecLister); ok {
+			specs = lister.ListTracingPolicySpecs(ctx)
+		}
+		return policyimpact.PoliciesFromList(res, specs), nil
+	})
+	notifier.AddListener(s.policyImpact)
+	go s.policyImpact.Run(ctx)
+	return s
+}
+
+// SetReplayer configures the replayer used to serve GetEvents requests that
+// carry a resume cursor.
+func (s *Server) SetReplayer(replayer Replayer) {
+	s.replayer = replayer
+}
+
+// SetBugtoolCollector configures the collector used to serve GetBugtoolFiles
+// requests.
+func (s *Server) SetBugtoolCollector(collector BugtoolCollector) {
+	s.bugtool = collector
 }
 
 func newListener() *getEventsListener {
@@ -127,7 +186,8 @@ func (s *Server) GetEventsWG(request *tetragon.GetEventsRequest, server tetragon
 		"events.allow_list", request.GetAllowList(),
 		"events.deny_list", request.GetDenyList(),
 		"events.field_filters", request.GetFieldFilters(),
-		"events.aggregation_options", request.GetAggregationOptions())
+		"events.aggregation_options", request.GetAggregationOptions(),
+		"events.resume_from", request.GetResumeFrom())
 	allowList, err := filters.BuildFilterList(s.ctx, request.AllowList, filters.Filters)
 	if err != nil {
 		if readyWG != nil {
@@ -142,6 +202,15 @@ func (s *Server) GetEventsWG(request *tetragon.GetEventsRequest, server tetragon
 		}
 		return err
 	}
+	// Get field filters
+	fieldFilters, err := fieldfilters.FieldFiltersFromGetEventsRequest(request)
+	if err != nil {
+		if readyWG != nil {
+			readyWG.Done()
+		}
+		return fmt.Errorf("failed to create field filters: %w", err)
+	}
+
 	aggregator, err := aggregator.NewAggregator(server, request.AggregationOptions)
 	if err != nil {
 		if readyWG != nil {
@@ -153,6 +222,36 @@ func (s *Server) GetEventsWG(request *tetragon.GetEventsRequest, server tetragon
 		go aggregator.Start()
 	}
 
+	sendEvent := func(event *tetragon.GetEventsResponse) error {
+		if !filters.Apply(allowList, denyList, &pkgEvent.Event{Event: event}) {
+			// Event is filtered out. Nothing to do here. Continue.
+			return nil
+		}
+
+		// Apply field filters
+		for _, filter := range fieldFilters {
+			ev, err := filter.Filter(event)
+			if err != nil {
+				logger.GetLogger().Warn("Failed to apply field filter", "filter", filter, logfields.Error, err)
+				continue
+			}
+			event = ev
+		}
+
+		if aggregator != nil {
+			// Send event to aggregator.
+			select {
+			case aggregator.GetEventChannel() <- event:
+			default:
+				logger.GetLogger().Warn("Aggregator buffer is full. Consider increasing AggregatorOptions.channel_buffer_size.",
+					"request", request)
+			}
+			return nil
+		}
+		// No need to aggregate. Directly send out the response.
+		return server.Send(event)
+	}
+
 	l := newListener()
 	s.notifier.AddListener(l)
 	defer s.removeNotifierAndDrain(l)
@@ -161,43 +260,30 @@ func (s *Server) GetEventsWG(request *tetragon.GetEventsRequest, server tetragon
 	}
 	s.ctxCleanupWG.Add(1)
 	defer s.ctxCleanupWG.Done()
-	for {
-		select {
-		case event := <-l.events:
-			if !filters.Apply(allowList, denyList, &pkgEvent.Event{Event: event}) {
-				// Event is filtered out. Nothing to do here. Continue.
-				continue
-			}
 
-			// Get field filters
-			filters, err := fieldfilters.FieldFiltersFromGetEventsRequest(request)
+	// The listener is registered before replaying, so that no event is
+	// missed between the end of the replay and the live stream. Live events
+	// that were already replayed are skipped based on their sequence number.
+	var lastReplayed uint64
+	if cursor := request.GetResumeFrom(); cursor != nil {
+		if s.replayer == nil {
+			logger.GetLogger().Debug("Ignoring GetEvents resume cursor since the event replay buffer is disabled")
+		} else {
+			lastReplayed, err = s.replayer.Replay(server.Context(), cursor, sendEvent)
 			if err != nil {
-				return fmt.Errorf("failed to create field filters: %w", err)
+				return fmt.Errorf("failed to replay events: %w", err)
 			}
+		}
+	}
 
-			// Apply field filters
-			for _, filter := range filters {
-				ev, err := filter.Filter(event)
-				if err != nil {
-					logger.GetLogger().Warn("Failed to apply field filter", "filter", filter, logfields.Error, err)
-					continue
-				}
-				event = ev
+	for {
+		select {
+		case event := <-l.events:
+			if lastReplayed != 0 && event.GetSequence() <= lastReplayed {
+				continue
 			}
-
-			if aggregator != nil {
-				// Send event to aggregator.
-				select {
-				case aggregator.GetEventChannel() <- event:
-				default:
-					logger.GetLogger().Warn("Aggregator buffer is full. Consider increasing AggregatorOptions.channel_buffer_size.",
-						"request", request)
-				}
-			} else {
-				// No need to aggregate. Directly send out the response.
-				if err = server.Send(event); err != nil {
-					return err
-				}
+			if err := sendEvent(event); err != nil {
+				return err
 			}
 		case <-server.Context().Done():
 			if closer != nil {
@@ -248,6 +334,27 @@ func (s *Server) AddTracingPolicy(ctx context.Context, req *tetragon.AddTracingP
 	return &tetragon.AddTracingPolicyResponse{}, nil
 }
 
+func (s *Server) ValidateTracingPolicy(ctx context.Context, req *tetragon.ValidateTracingPolicyRequest) (*tetragon.ValidateTracingPolicyResponse, error) {
+	tp, err := tracingpolicy.FromYAML(req.GetYaml())
+	if err != nil {
+		return &tetragon.ValidateTracingPolicyResponse{
+			FailedStage: tetragon.TracingPolicyValidationStage_TP_VALIDATION_STAGE_PARSE,
+			Error:       err.Error(),
+		}, nil
+	}
+
+	logger.GetLogger().Debug("Received a ValidateTracingPolicy request", "metadata.name", tp.TpName())
+
+	res, err := s.observer.ValidateTracingPolicy(ctx, tp)
+	if err != nil {
+		logger.GetLogger().Warn("Server ValidateTracingPolicy request failed",
+			logfields.Error, err,
+			"metadata.name", tp.TpName())
+		return nil, err
+	}
+	return res, nil
+}
+
 func (s *Server) DeleteTracingPolicy(ctx context.Context, req *tetragon.DeleteTracingPolicyRequest) (*tetragon.DeleteTracingPolicyResponse, error) {
 	logger.GetLogger().Debug("Received a DeleteTracingPolicy request", "name", req.GetName())
 
@@ -296,6 +403,28 @@ func (s *Server) ListTracingPolicies(ctx context.Context, req *tetragon.ListTrac
 	return ret, err
 }
 
+func (s *Server) GetPolicyImpactReport(ctx context.Context, req *tetragon.GetPolicyImpactReportRequest) (*tetragon.GetPolicyImpactReportResponse, error) {
+	logger.GetLogger().Debug("Received a GetPolicyImpactReport request", "request", req)
+	res, err := s.policyImpact.Report(ctx, req.GetName())
+	if err != nil {
+		logger.GetLogger().Warn("Server GetPolicyImpactReport failed", logfields.Error, err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (s *Server) GetBugtoolFiles(req *tetragon.GetBugtoolFilesRequest, server tetragon.FineGuidanceSensors_GetBugtoolFilesServer) error {
+	logger.GetLogger().Debug("Received a GetBugtoolFiles request", "request", req)
+	if s.bugtool == nil {
+		return errors.New("bugtool collection is not available")
+	}
+	if err := s.bugtool.Collect(server.Context(), req, server.Send); err != nil {
+		logger.GetLogger().Warn("Server GetBugtoolFiles failed", logfields.Error, err)
+		return err
+	}
+	return nil
+}
+
 func (s *Server) RemoveSensor(_ context.Context, req *tetragon.RemoveSensorRequest) (*tetragon.RemoveSensorResponse, error) {
 	logger.GetLogger().Debug("Received a RemoveSensor request", "sensor.name", req.GetName())
 	return nil, errors.New("RemoveSensor is deprecated")
@@ -313,9 +442,38 @@ func (s *Server) DisableSensor(_ context.Context, req *tetragon.DisableSensorReq
 
 func (s *Server) GetStackTraceTree(_ context.Context, req *tetragon.GetStackTraceTreeRequest) (*tetragon.GetStackTraceTreeResponse, error) {
 	logger.GetLogger().Debug("Received a GetStackTraceTree request", "request", req)
-	err := errors.New("unsupported GetStackTraceTree")
-	logger.GetLogger().Warn("Server GetStackTraceTree failed", logfields.Error, err)
-	return nil, err
+	root, err := sttManager.GetManager().ToProto(req.GetName())
+	if err != nil {
+		logger.GetLogger().Warn("Server GetStackTraceTree failed", logfields.Error, err)
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	switch req.GetFormat() {
+	case tetragon.StackTraceTreeFormat_STACK_TRACE_TREE_FORMAT_TREE:
+		return &tetragon.GetStackTraceTreeResponse{Root: root}, nil
+	case tetragon.StackTraceTreeFormat_STACK_TRACE_TREE_FORMAT_PPROF:
+		err = stacktracetree.ToPprof(root).Write(&buf)
+	case tetragon.StackTraceTreeFormat_STACK_TRACE_TREE_FORMAT_FOLDED:
+		err = stacktracetree.WriteFolded(&buf, root)
+	default:
+		err = fmt.Errorf("unknown stack trace tree format %d", req.GetFormat())
+	}
+	if err != nil {
+		logger.GetLogger().Warn("Server GetStackTraceTree failed", logfields.Error, err)
+		return nil, err
+	}
+	return &tetragon.GetStackTraceTreeResponse{Data: buf.Bytes()}, nil
+}
+
+func (s *Server) GetProcessTree(_ context.Context, req *tetragon.GetProcessTreeRequest) (*tetragon.GetProcessTreeResponse, error) {
+	logger.GetLogger().Debug("Received a GetProcessTree request", "request", req)
+	res, err := process.GetProcessTree(req)
+	if err != nil {
+		logger.GetLogger().Warn("Server GetProcessTree failed", logfields.Error, err)
+		return nil, err
+	}
+	return res, nil
 }
 
 func (s *Server) GetVersion(_ context.Context, _ *tetragon.GetVersionRequest) (*tetragon.GetVersionResponse, error) {
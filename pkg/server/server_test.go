@@ -10,9 +10,40 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/idtable"
 	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/policyfilter"
+	"github.com/cilium/tetragon/pkg/selectors"
+	"github.com/cilium/tetragon/pkg/sensors"
+	"github.com/cilium/tetragon/pkg/sensors/program"
+	"github.com/cilium/tetragon/pkg/tracingpolicy"
 )
 
+func init() {
+	sensors.RegisterPolicyHandlerAtInit("server-test", validateTestHandler{})
+}
+
+// validateTestHandler compiles the selectors of the kprobes of a policy and
+// returns a sensor with a program for each of them, whose object file does not
+// exist.
+type validateTestHandler struct{}
+
+func (validateTestHandler) PolicyHandler(tp tracingpolicy.TracingPolicy, _ policyfilter.PolicyID) (sensors.SensorIface, error) {
+	kprobes := tp.TpSpec().KProbes
+	if len(kprobes) == 0 {
+		return nil, nil
+	}
+	s := &sensors.Sensor{Name: tp.TpName() + "-sensor"}
+	for _, kp := range kprobes {
+		if _, err := selectors.InitKernelSelectors(kp.Selectors, kp.Args, kp.Data, idtable.New()); err != nil {
+			return nil, err
+		}
+		s.Progs = append(s.Progs, program.Builder("bpf_server_test_missing.o", kp.Call,
+			"kprobe/generic_kprobe", "server_test", "generic_kprobe"))
+	}
+	return s, nil
+}
+
 func TestServer(t *testing.T) {
 	t.Run("GetDebug", TestGetDebug)
 	t.Run("SetDebug", TestSetDebug)
@@ -64,3 +95,111 @@ func TestSetDebug(t *testing.T) {
 	require.NoError(t, err, "Expected SetDebug to succeed with valid log level")
 	require.NotEqual(t, logger.GetLogLevel(logger.GetLogger()), prevLogLevel, "Expected log level to change, but it didn't")
 }
+
+func TestValidateTracingPolicy(t *testing.T) {
+	srv := &Server{observer: &FakeObserver{}}
+
+	resp, err := srv.ValidateTracingPolicy(t.Context(), &tetragon.ValidateTracingPolicyRequest{Yaml: "not: [a policy"})
+	require.NoError(t, err)
+	require.False(t, resp.Valid)
+	require.Equal(t, tetragon.TracingPolicyValidationStage_TP_VALIDATION_STAGE_PARSE, resp.FailedStage)
+	require.NotEmpty(t, resp.Error)
+
+	yaml := `apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "validate"
+spec:
+  kprobes:
+  - call: "fd_install"
+    syscall: false
+`
+	resp, err = srv.ValidateTracingPolicy(t.Context(), &tetragon.ValidateTracingPolicyRequest{Yaml: yaml})
+	require.NoError(t, err)
+	require.True(t, resp.Valid)
+	require.Equal(t, "validate", resp.Name)
+}
+
+func TestValidateTracingPolicyManager(t *testing.T) {
+	mgr, err := sensors.StartSensorManager("")
+	require.NoError(t, err)
+	srv := &Server{observer: mgr}
+
+	validate := func(yaml string) *tetragon.ValidateTracingPolicyResponse {
+		resp, err := srv.ValidateTracingPolicy(t.Context(), &tetragon.ValidateTracingPolicyRequest{Yaml: yaml})
+		require.NoError(t, err)
+		return resp
+	}
+
+	// parse stage: the policy is not a tracing policy
+	resp := validate(`apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "validate"
+spec:
+  kprobes: "fd_install"
+`)
+	require.False(t, resp.Valid)
+	require.Equal(t, tetragon.TracingPolicyValidationStage_TP_VALIDATION_STAGE_PARSE, resp.FailedStage)
+	require.NotEmpty(t, resp.Error)
+
+	// sensor stage: the selector refers to an argument that does not exist
+	resp = validate(`apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "validate"
+spec:
+  kprobes:
+  - call: "fd_install"
+    syscall: false
+    args:
+    - index: 0
+      type: "int"
+    selectors:
+    - matchArgs:
+      - index: 3
+        operator: "Equal"
+        values:
+        - "1"
+`)
+	require.False(t, resp.Valid)
+	require.Equal(t, "validate", resp.Name)
+	require.Equal(t, tetragon.TracingPolicyValidationStage_TP_VALIDATION_STAGE_SENSOR, resp.FailedStage)
+	require.Contains(t, resp.Error, "argFilter for unknown index")
+
+	// load stage: the programs of the sensor cannot be loaded
+	resp = validate(`apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "validate"
+spec:
+  kprobes:
+  - call: "fd_install"
+    syscall: false
+    args:
+    - index: 0
+      type: "int"
+    selectors:
+    - matchArgs:
+      - index: 0
+        operator: "Equal"
+        values:
+        - "1"
+`)
+	require.False(t, resp.Valid)
+	require.Equal(t, tetragon.TracingPolicyValidationStage_TP_VALIDATION_STAGE_LOAD, resp.FailedStage)
+	require.Contains(t, resp.Error, "bpf_server_test_missing.o")
+
+	// no sensor to load
+	resp = validate(`apiVersion: cilium.io/v1alpha1
+kind: TracingPolicy
+metadata:
+  name: "validate"
+spec: {}
+`)
+	require.True(t, resp.Valid, resp.Error)
+
+	l, err := mgr.ListTracingPolicies(t.Context())
+	require.NoError(t, err)
+	require.Empty(t, l.Policies)
+}
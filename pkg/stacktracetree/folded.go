@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package stacktracetree
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+// WriteFolded writes a stack trace tree as folded stacks, the input format of
+// flamegraph tools: one line per stack, with the frames from the root to the
+// leaf separated by semicolons, followed by a space and the stack count. The
+// count of a stack is the part of the count of its last node that is not
+// accounted for by the children of the node. Lines are sorted.
+func WriteFolded(w io.Writer, root *tetragon.StackTraceNode) error {
+	var lines []string
+	for _, child := range root.GetChildren() {
+		lines = foldedNode(lines, child, nil)
+	}
+	slices.Sort(lines)
+	for _, line := range lines {
+		if _, err := io.WriteString(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func foldedNode(lines []string, node *tetragon.StackTraceNode, frames []string) []string {
+	frames = append(slices.Clip(frames), foldedFrame(node.GetAddress()))
+
+	self := int64(node.GetCount())
+	for _, child := range node.GetChildren() {
+		self -= int64(child.GetCount())
+	}
+	if self > 0 {
+		lines = append(lines, fmt.Sprintf("%s %d\n", strings.Join(frames, ";"), self))
+	}
+
+	for _, child := range node.GetChildren() {
+		lines = foldedNode(lines, child, frames)
+	}
+	return lines
+}
+
+func foldedFrame(addr *tetragon.StackAddress) string {
+	if sym := addr.GetSymbol(); sym != "" {
+		// semicolons separate frames
+		return strings.ReplaceAll(sym, ";", ":")
+	}
+	return fmt.Sprintf("0x%x", addr.GetAddress())
+}
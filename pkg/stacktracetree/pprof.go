@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package stacktracetree
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/pprof/profile"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+)
+
+type pprofLocationKey struct {
+	addr   Addr
+	symbol string
+}
+
+type pprofConverter struct {
+	prof      *profile.Profile
+	locations map[pprofLocationKey]*profile.Location
+	functions map[string]*profile.Function
+}
+
+// ToPprof converts a stack trace tree to a pprof profile. Each node where
+// stacks end, i.e. whose count is larger than the sum of the counts of its
+// children, results in samples for the stack from the root to the node.
+//
+// Labels are kept as pprof sample labels: a "key:value" label becomes the
+// value of the key label, other labels are values of the "label" label. Each
+// sample has at most one label, samples of stacks without labels have none.
+func ToPprof(root *tetragon.StackTraceNode) *profile.Profile {
+	c := pprofConverter{
+		prof: &profile.Profile{
+			SampleType: []*profile.ValueType{{Type: "samples", Unit: "count"}},
+		},
+		locations: make(map[pprofLocationKey]*profile.Location),
+		functions: make(map[string]*profile.Function),
+	}
+	for _, child := range root.GetChildren() {
+		c.addNode(child, nil)
+	}
+	return c.prof
+}
+
+func (c *pprofConverter) location(addr *tetragon.StackAddress) *profile.Location {
+	key := pprofLocationKey{addr: addr.GetAddress(), symbol: addr.GetSymbol()}
+	if loc, ok := c.locations[key]; ok {
+		return loc
+	}
+
+	name := key.symbol
+	if name == "" {
+		name = fmt.Sprintf("0x%x", key.addr)
+	}
+	fn, ok := c.functions[name]
+	if !ok {
+		fn = &profile.Function{
+			ID:         uint64(len(c.prof.Function) + 1),
+			Name:       name,
+			SystemName: name,
+		}
+		c.functions[name] = fn
+		c.prof.Function = append(c.prof.Function, fn)
+	}
+
+	loc := &profile.Location{
+		ID:      uint64(len(c.prof.Location) + 1),
+		Address: key.addr,
+		Line:    []profile.Line{{Function: fn}},
+	}
+	c.locations[key] = loc
+	c.prof.Location = append(c.prof.Location, loc)
+	return loc
+}
+
+// addNode adds the samples of the stacks ending at node, and recurses into
+// its children. stack holds the locations from the root to the parent of
+// node.
+func (c *pprofConverter) addNode(node *tetragon.StackTraceNode, stack []*profile.Location) {
+	stack = append(slices.Clip(stack), c.location(node.GetAddress()))
+
+	self := int64(node.GetCount())
+	labels := make(map[string]int64)
+	for _, lbl := range node.GetLabels() {
+		labels[lbl.GetKey()] += int64(lbl.GetCount())
+	}
+	for _, child := range node.GetChildren() {
+		self -= int64(child.GetCount())
+		for _, lbl := range child.GetLabels() {
+			labels[lbl.GetKey()] -= int64(lbl.GetCount())
+		}
+	}
+
+	if self > 0 {
+		keys := make([]string, 0, len(labels))
+		for key := range labels {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			n := min(labels[key], self)
+			if n <= 0 {
+				continue
+			}
+			c.addSample(stack, n, key)
+			self -= n
+		}
+		if self > 0 {
+			c.addSample(stack, self, "")
+		}
+	}
+
+	for _, child := range node.GetChildren() {
+		c.addNode(child, stack)
+	}
+}
+
+func (c *pprofConverter) addSample(stack []*profile.Location, count int64, label string) {
+	// pprof locations go from the leaf to the root
+	locs := slices.Clone(stack)
+	slices.Reverse(locs)
+
+	sample := &profile.Sample{
+		Location: locs,
+		Value:    []int64{count},
+	}
+	if label != "" {
+		key, value, ok := strings.Cut(label, ":")
+		if !ok {
+			key, value = "label", label
+		}
+		sample.Label = map[string][]string{key: {value}}
+	}
+	c.prof.Sample = append(c.prof.Sample, sample)
+}
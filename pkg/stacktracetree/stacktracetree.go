@@ -67,16 +67,21 @@ type Stt struct {
 
 // Append appends an entry to a stacktrace
 func (p *Stt) Append(addr Addr, sym string, labels []string) {
+	p.AppendCount(addr, sym, labels, 1)
+}
+
+// AppendCount appends an entry to a stacktrace that was seen count times
+func (p *Stt) AppendCount(addr Addr, sym string, labels []string, count int) {
 	node := &SttNode{
 		Addr:     addr,
-		Count:    1,
+		Count:    count,
 		Symbol:   sym,
 		Children: map[Addr]*SttNode{},
 		Labels:   map[string]int{},
 	}
 
 	for _, label := range labels {
-		node.Labels[label] = 1
+		node.Labels[label] = count
 	}
 
 	p.nodes = append(p.nodes, node)
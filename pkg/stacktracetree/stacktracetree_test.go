@@ -5,7 +5,11 @@ package stacktracetree
 
 import (
 	"fmt"
+	"strings"
 	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSimple(_ *testing.T) {
@@ -27,3 +31,62 @@ func TestSimple(_ *testing.T) {
 	tree.Print()
 
 }
+
+func TestToPprof(t *testing.T) {
+	stt0 := Stt{}
+	stt0.AppendCount(0x10, "main", []string{"pod:default/a"}, 3)
+	stt0.AppendCount(0x20, "foo", []string{"pod:default/a"}, 3)
+
+	stt1 := Stt{}
+	stt1.AppendCount(0x10, "main", []string{"other"}, 2)
+
+	tree := CreateSttree()
+	tree.AddStacktrace(&stt0)
+	tree.AddStacktrace(&stt1)
+
+	prof := ToPprof(tree.Root.ToProtoNode())
+	require.NoError(t, prof.CheckValid())
+	require.Len(t, prof.Function, 2)
+	require.Len(t, prof.Location, 2)
+	require.Len(t, prof.Sample, 2)
+
+	samples := map[string]*profile.Sample{}
+	for _, s := range prof.Sample {
+		samples[s.Location[0].Line[0].Function.Name] = s
+	}
+
+	foo := samples["foo"]
+	require.NotNil(t, foo)
+	require.Equal(t, []int64{3}, foo.Value)
+	require.Len(t, foo.Location, 2)
+	require.Equal(t, "main", foo.Location[1].Line[0].Function.Name)
+	require.Equal(t, map[string][]string{"pod": {"default/a"}}, foo.Label)
+
+	main := samples["main"]
+	require.NotNil(t, main)
+	require.Equal(t, []int64{2}, main.Value)
+	require.Len(t, main.Location, 1)
+	require.Equal(t, map[string][]string{"label": {"other"}}, main.Label)
+}
+
+func TestWriteFolded(t *testing.T) {
+	stt0 := Stt{}
+	stt0.AppendCount(0x10, "main", []string{}, 3)
+	stt0.AppendCount(0x20, "foo", []string{}, 3)
+
+	stt1 := Stt{}
+	stt1.AppendCount(0x10, "main", []string{}, 2)
+	stt1.AppendCount(0x30, "", []string{}, 2)
+
+	stt2 := Stt{}
+	stt2.AppendCount(0x10, "main", []string{}, 1)
+
+	tree := CreateSttree()
+	tree.AddStacktrace(&stt0)
+	tree.AddStacktrace(&stt1)
+	tree.AddStacktrace(&stt2)
+
+	var buf strings.Builder
+	require.NoError(t, WriteFolded(&buf, tree.Root.ToProtoNode()))
+	require.Equal(t, "main 1\nmain;0x30 2\nmain;foo 3\n", buf.String())
+}
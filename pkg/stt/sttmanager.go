@@ -6,6 +6,7 @@ package sttManager
 import (
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/cilium/tetragon/api/v1/tetragon"
 	"github.com/cilium/tetragon/pkg/logger"
@@ -55,6 +56,20 @@ func (s *SttMgTreeInsert) SttMgOpDone(e error)  { s.retChan <- e }
 func (s *SttMgTreeToProto) SttMgOpDone(e error) { s.RetChan <- e }
 func (s *SttMgStop) SttMgOpDone(e error)        { s.retChan <- e }
 
+var (
+	globalHandle     Handle
+	globalHandleOnce sync.Once
+)
+
+// GetManager returns the stack trace tree manager of the agent, which is
+// started on first use.
+func GetManager() Handle {
+	globalHandleOnce.Do(func() {
+		globalHandle = StartSttManager()
+	})
+	return globalHandle
+}
+
 func StartSttManager() Handle {
 	c := make(chan SttMgOp)
 	treeMap := make(map[string]*stt.Sttree)
@@ -65,6 +80,10 @@ func StartSttManager() Handle {
 			var err error
 			switch op := op_.(type) {
 			case *SttMgCreateTree:
+				if _, ok := treeMap[op.TreeName]; ok {
+					err = fmt.Errorf("SttMgCreateTree: tree %s already exists", op.TreeName)
+					break
+				}
 				treeMap[op.TreeName] = stt.CreateSttree()
 				err = nil
 			case *SttMgDestroyTree:
@@ -146,3 +165,20 @@ func (h Handle) Insert(tname string, stt *stt.Stt) error {
 	h <- op
 	return <-retc
 }
+
+func (h Handle) ToProto(tname string) (*tetragon.StackTraceNode, error) {
+	if h == nil {
+		return nil, errors.New("ToProto failed, Handle is nil")
+	}
+
+	retc := make(chan error)
+	op := &SttMgTreeToProto{
+		TreeName: tname,
+		RetChan:  retc,
+	}
+	h <- op
+	if err := <-retc; err != nil {
+		return nil, err
+	}
+	return op.RootNode, nil
+}
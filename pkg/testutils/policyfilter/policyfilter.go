@@ -6,6 +6,7 @@ package policyfilter
 import (
 	"k8s.io/client-go/tools/cache"
 
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
 	slimv1 "github.com/cilium/tetragon/pkg/k8s/slim/k8s/apis/meta/v1"
 
 	"github.com/cilium/tetragon/pkg/labels"
@@ -20,7 +21,7 @@ import (
 type DummyPF struct{}
 
 func (s *DummyPF) AddPolicy(_ policyfilter.PolicyID, _ string, _ *slimv1.LabelSelector,
-	_ *slimv1.LabelSelector) error {
+	_ *slimv1.LabelSelector, _ *v1alpha1.WorkloadSelector) error {
 	return nil
 }
 
@@ -19,7 +19,8 @@ import (
 type ExpectedPolicyActions struct {
 	Post, Signal, MonitorSignal,
 	Override, MonitorOverride,
-	NotifyEnforcer, MonitorNotifyEnforcer uint64
+	NotifyEnforcer, MonitorNotifyEnforcer,
+	SampledOut uint64
 }
 
 // Checker holds a check function that verifies that policy statistics
@@ -112,6 +113,15 @@ func NewChecker(t *testing.T, tp tracingpolicy.TracingPolicy, expected ExpectedP
 				after.ActionsCount[ps.PolicyMonitorNotifyEnforcer],
 				expected.MonitorNotifyEnforcer,
 			)
+			require.Equal(
+				t,
+				before.ActionsCount[ps.PolicySampledOut]+expected.SampledOut,
+				after.ActionsCount[ps.PolicySampledOut],
+				"PolicySampledOut delta mismatch: before=%d after=%d expectedDelta=%d",
+				before.ActionsCount[ps.PolicySampledOut],
+				after.ActionsCount[ps.PolicySampledOut],
+				expected.SampledOut,
+			)
 		},
 	}
 }
@@ -39,6 +39,8 @@ type GenericTracingPolicy struct {
 	metav1.TypeMeta
 	Metadata metav1.ObjectMeta          `json:"metadata"`
 	Spec     v1alpha1.TracingPolicySpec `json:"spec"`
+
+	rawSpec map[string]any
 }
 
 func (gtp *GenericTracingPolicy) TpName() string {
@@ -57,12 +59,18 @@ func (gtp *GenericTracingPolicy) GetObjectMetaStruct() *metav1.ObjectMeta {
 	return &gtp.Metadata
 }
 
+func (gtp *GenericTracingPolicy) RawSpec() map[string]any {
+	return gtp.rawSpec
+}
+
 // GenericTracingPolicyNamespaced represents TracingPolicyNamespaced CRD.
 // It implements TracingPolicy and CRDObject interfaces with pointer receivers.
 type GenericTracingPolicyNamespaced struct {
 	metav1.TypeMeta
 	Metadata metav1.ObjectMeta          `json:"metadata"`
 	Spec     v1alpha1.TracingPolicySpec `json:"spec"`
+
+	rawSpec map[string]any
 }
 
 func (gtp *GenericTracingPolicyNamespaced) TpNamespace() string {
@@ -85,6 +93,10 @@ func (gtp *GenericTracingPolicyNamespaced) GetObjectMetaStruct() *metav1.ObjectM
 	return &gtp.Metadata
 }
 
+func (gtp *GenericTracingPolicyNamespaced) RawSpec() map[string]any {
+	return gtp.rawSpec
+}
+
 // FromYAML inspects the YAML input to determine the kind, then dispatches to
 // the generic FromYAML function.
 func FromYAML(data string) (TracingPolicy, error) {
@@ -99,18 +111,40 @@ func FromYAML(data string) (TracingPolicy, error) {
 		if err != nil {
 			return nil, err
 		}
+		obj.rawSpec, err = rawSpec(TPContext, data)
+		if err != nil {
+			return nil, err
+		}
 		return obj, nil
 	case v1alpha1.TPNamespacedKindDefinition:
 		obj, err := TPNContext.FromYAML(data)
 		if err != nil {
 			return nil, err
 		}
+		obj.rawSpec, err = rawSpec(TPNContext, data)
+		if err != nil {
+			return nil, err
+		}
 		return obj, nil
 	default:
 		return nil, fmt.Errorf("unknown CRD kind: %s", unstr.GetKind())
 	}
 }
 
+// rawSpec returns the spec of a policy, with defaults applied, as decoded from
+// its YAML source rather than from the typed object.
+func rawSpec[P crdutils.CRDObject](c *crdutils.CRDContext[P], data string) (map[string]any, error) {
+	_, unstr, err := c.ApplyDefaults([]byte(data))
+	if err != nil {
+		return nil, err
+	}
+	spec, _, err := unstructured.NestedMap(unstr.Object, "spec")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spec: %w", err)
+	}
+	return spec, nil
+}
+
 // FromFile loads a CRD object from a YAML file at the given path.
 func FromFile(path string) (TracingPolicy, error) {
 	data, err := os.ReadFile(path)
@@ -65,8 +65,13 @@ func TestYamlLseek(t *testing.T) {
 		t.Errorf("ReadConfigYaml failed: %s", err)
 	}
 
-	if reflect.DeepEqual(expected, *k) != true {
-		t.Errorf("\ngot:\n%+v\nexpected:\n%+v", *k, expected)
+	if k.RawSpec() == nil {
+		t.Errorf("raw spec of the policy not kept")
+	}
+	got := *k
+	got.rawSpec = nil
+	if reflect.DeepEqual(expected, got) != true {
+		t.Errorf("\ngot:\n%+v\nexpected:\n%+v", got, expected)
 	}
 }
 
@@ -29,6 +29,14 @@ type TracingPolicyNamespaced interface {
 	TpNamespace() string
 }
 
+// TracingPolicyRaw is an interface for tracing policies that keep the spec they
+// were decoded from, as an unstructured object.
+type TracingPolicyRaw interface {
+	TracingPolicy
+	// RawSpec returns the decoded spec of the policy, or nil if unknown
+	RawSpec() map[string]any
+}
+
 // revive:enable:exported
 
 type PolicyInfo struct {
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // Namespace returns the namespace of a policy, or "" if the poilcy is not namespaced
@@ -17,6 +19,19 @@ func Namespace(tp TracingPolicy) string {
 	return ""
 }
 
+// Annotations returns the annotations of a policy, or nil if the policy has none
+func Annotations(tp TracingPolicy) map[string]string {
+	switch t := tp.(type) {
+	case interface{ GetAnnotations() map[string]string }:
+		return t.GetAnnotations()
+	case interface{ GetObjectMetaStruct() *metav1.ObjectMeta }:
+		if meta := t.GetObjectMetaStruct(); meta != nil {
+			return meta.Annotations
+		}
+	}
+	return nil
+}
+
 func sanitize(name string) string {
 	return strings.ReplaceAll(name, "/", "_")
 }
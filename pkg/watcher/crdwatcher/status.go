@@ -0,0 +1,270 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package crdwatcher
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/cilium/tetragon/api/v1/tetragon"
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+	"github.com/cilium/tetragon/pkg/logger"
+	"github.com/cilium/tetragon/pkg/logger/logfields"
+)
+
+const (
+	// maximum length of a field manager name accepted by the API server
+	maxFieldManagerLen = 128
+	// countersPeriods is the number of report periods between two updates
+	// of a node status that only change its counters. Counters change as
+	// soon as a policy sees events, so writing them every period would
+	// update every policy on every node each period.
+	countersPeriods = 10
+)
+
+type policyLister interface {
+	ListTracingPolicies(ctx context.Context) (*tetragon.ListTracingPoliciesResponse, error)
+}
+
+type policyKey struct {
+	namespace string
+	name      string
+}
+
+type policyEntry struct {
+	kind       string
+	generation int64
+	// addErr is the error returned when adding the policy, if any. It is
+	// reported if the policy does not show up in the sensor manager.
+	addErr string
+	// reported is the last status successfully written for the policy
+	reported *v1alpha1.TracingPolicyNodeStatus
+}
+
+// StatusReporter writes the state of the tracing policies loaded from custom
+// resources to the per-node entry of their status subresource. Each agent
+// owns its own entry via server-side apply, so agents never conflict with
+// each other.
+type StatusReporter struct {
+	client       client.Client
+	lister       policyLister
+	nodeName     string
+	fieldManager string
+	interval     time.Duration
+
+	mu       sync.Mutex
+	policies map[policyKey]*policyEntry
+	trigger  chan struct{}
+}
+
+func newStatusReporter(c client.Client, lister policyLister, nodeName string, interval time.Duration) *StatusReporter {
+	return &StatusReporter{
+		client:       c,
+		lister:       lister,
+		nodeName:     nodeName,
+		fieldManager: fieldManagerName(nodeName),
+		interval:     interval,
+		policies:     make(map[policyKey]*policyEntry),
+		trigger:      make(chan struct{}, 1),
+	}
+}
+
+// fieldManagerName returns the server-side apply field manager of the agent
+// running on nodeName.
+func fieldManagerName(nodeName string) string {
+	name := "tetragon-" + nodeName
+	if len(name) <= maxFieldManagerLen {
+		return name
+	}
+	sum := sha256.Sum256([]byte(nodeName))
+	return "tetragon-" + hex.EncodeToString(sum[:])
+}
+
+func tpKind(obj any) (string, policyKey, int64, bool) {
+	switch tp := obj.(type) {
+	case *v1alpha1.TracingPolicy:
+		return v1alpha1.TPKindDefinition, policyKey{name: tp.Name}, tp.Generation, true
+	case *v1alpha1.TracingPolicyNamespaced:
+		return v1alpha1.TPNamespacedKindDefinition, policyKey{namespace: tp.Namespace, name: tp.Name}, tp.Generation, true
+	}
+	return "", policyKey{}, 0, false
+}
+
+// policyAdded records that the policy obj was added, with the given error.
+func (r *StatusReporter) policyAdded(obj any, err error) {
+	if r == nil {
+		return
+	}
+	kind, key, generation, ok := tpKind(obj)
+	if !ok {
+		return
+	}
+	entry := &policyEntry{kind: kind, generation: generation}
+	if err != nil {
+		entry.addErr = err.Error()
+	}
+	r.mu.Lock()
+	r.policies[key] = entry
+	r.mu.Unlock()
+
+	select {
+	case r.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// policyDeleted stops reporting the status of the policy obj.
+func (r *StatusReporter) policyDeleted(obj any) {
+	if r == nil {
+		return
+	}
+	if _, key, _, ok := tpKind(obj); ok {
+		r.mu.Lock()
+		delete(r.policies, key)
+		r.mu.Unlock()
+	}
+}
+
+func (r *StatusReporter) run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.trigger:
+		}
+		r.report(ctx)
+	}
+}
+
+func nodeStatus(nodeName string, entry *policyEntry, pol *tetragon.TracingPolicyStatus) *v1alpha1.TracingPolicyNodeStatus {
+	ret := &v1alpha1.TracingPolicyNodeStatus{
+		NodeName:           nodeName,
+		ObservedGeneration: entry.generation,
+	}
+	if pol == nil {
+		ret.State = "load_error"
+		ret.Error = entry.addErr
+		return ret
+	}
+	ret.State = strings.TrimPrefix(strings.ToLower(pol.GetState().String()), "tp_state_")
+	ret.Mode = strings.TrimPrefix(strings.ToLower(pol.GetMode().String()), "tp_mode_")
+	ret.Error = pol.GetError()
+	ret.KernelMemoryBytes = pol.GetKernelMemoryBytes()
+	if c := pol.GetStats().GetActionCounters(); c != nil {
+		ret.ActionCounters = &v1alpha1.TracingPolicyActionCounters{
+			Post:                  c.GetPost(),
+			Signal:                c.GetSignal(),
+			MonitorSignal:         c.GetMonitorSignal(),
+			Override:              c.GetOverride(),
+			MonitorOverride:       c.GetMonitorOverride(),
+			NotifyEnforcer:        c.GetNotifyEnforcer(),
+			MonitorNotifyEnforcer: c.GetMonitorNotifyEnforcer(),
+			SampledOut:            c.GetSampledOut(),
+		}
+	}
+	return ret
+}
+
+// needsUpdate returns true if status needs to be written given the last
+// reported one. Changes to the state of the policy are written right away,
+// while changes to its counters only are written once countersInterval has
+// elapsed since the last update.
+func needsUpdate(status, reported *v1alpha1.TracingPolicyNodeStatus, now time.Time, countersInterval time.Duration) bool {
+	if reported == nil {
+		return true
+	}
+	s := *status
+	s.LastUpdateTime = reported.LastUpdateTime
+	if equality.Semantic.DeepEqual(&s, reported) {
+		return false
+	}
+	s.ActionCounters = reported.ActionCounters
+	s.KernelMemoryBytes = reported.KernelMemoryBytes
+	if !equality.Semantic.DeepEqual(&s, reported) {
+		return true
+	}
+	return now.Sub(reported.LastUpdateTime.Time) >= countersInterval
+}
+
+// report writes the status of every policy whose status changed since it was
+// last reported, see needsUpdate.
+func (r *StatusReporter) report(ctx context.Context) {
+	res, err := r.lister.ListTracingPolicies(ctx)
+	if err != nil {
+		logger.GetLogger().Warn("Failed to list tracing policies for status report", logfields.Error, err)
+		return
+	}
+	loaded := make(map[policyKey]*tetragon.TracingPolicyStatus, len(res.GetPolicies()))
+	for _, pol := range res.GetPolicies() {
+		loaded[policyKey{namespace: pol.GetNamespace(), name: pol.GetName()}] = pol
+	}
+
+	r.mu.Lock()
+	type update struct {
+		key    policyKey
+		entry  *policyEntry
+		status *v1alpha1.TracingPolicyNodeStatus
+	}
+	var updates []update
+	now := metav1.Now()
+	for key, entry := range r.policies {
+		status := nodeStatus(r.nodeName, entry, loaded[key])
+		if !needsUpdate(status, entry.reported, now.Time, countersPeriods*r.interval) {
+			continue
+		}
+		status.LastUpdateTime = now
+		updates = append(updates, update{key: key, entry: entry, status: status})
+	}
+	r.mu.Unlock()
+
+	for _, u := range updates {
+		if err := r.apply(ctx, u.entry.kind, u.key, u.status); err != nil {
+			if !apierrors.IsNotFound(err) {
+				logger.GetLogger().Warn("Failed to update tracing policy status",
+					"name", u.key.name,
+					"namespace", u.key.namespace,
+					logfields.Error, err)
+			}
+			continue
+		}
+		r.mu.Lock()
+		// the policy might have been updated or deleted in the meantime
+		if r.policies[u.key] == u.entry {
+			u.entry.reported = u.status
+		}
+		r.mu.Unlock()
+	}
+}
+
+// apply writes the node status entry using server-side apply.
+func (r *StatusReporter) apply(ctx context.Context, kind string, key policyKey, status *v1alpha1.TracingPolicyNodeStatus) error {
+	statusObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&v1alpha1.TracingPolicyStatus{
+		Nodes: []v1alpha1.TracingPolicyNodeStatus{*status},
+	})
+	if err != nil {
+		return err
+	}
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion(v1alpha1.SchemeGroupVersion.String())
+	u.SetKind(kind)
+	u.SetName(key.name)
+	u.SetNamespace(key.namespace)
+	u.Object["status"] = statusObj
+	return r.client.Status().Apply(ctx, client.ApplyConfigurationFromUnstructured(u),
+		client.FieldOwner(r.fieldManager), client.ForceOwnership)
+}
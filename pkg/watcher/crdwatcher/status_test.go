@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package crdwatcher
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cilium/tetragon/pkg/k8s/apis/cilium.io/v1alpha1"
+)
+
+func TestNeedsUpdate(t *testing.T) {
+	last := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	interval := 10 * time.Minute
+	reported := &v1alpha1.TracingPolicyNodeStatus{
+		NodeName:           "node",
+		ObservedGeneration: 1,
+		State:              "enabled",
+		Mode:               "enforce",
+		KernelMemoryBytes:  4096,
+		ActionCounters:     &v1alpha1.TracingPolicyActionCounters{Post: 1},
+		LastUpdateTime:     metav1.NewTime(last),
+	}
+	withCounters := func(post uint64) *v1alpha1.TracingPolicyNodeStatus {
+		s := *reported
+		s.LastUpdateTime = metav1.Time{}
+		s.ActionCounters = &v1alpha1.TracingPolicyActionCounters{Post: post}
+		return &s
+	}
+
+	// first report
+	require.True(t, needsUpdate(withCounters(1), nil, last, interval))
+
+	// nothing changed
+	require.False(t, needsUpdate(withCounters(1), reported, last.Add(time.Hour), interval))
+
+	// counters changed: written once the interval elapsed
+	require.False(t, needsUpdate(withCounters(2), reported, last.Add(time.Minute), interval))
+	require.True(t, needsUpdate(withCounters(2), reported, last.Add(interval), interval))
+
+	// kernel memory changed: same as counters
+	status := withCounters(1)
+	status.KernelMemoryBytes = 8192
+	require.False(t, needsUpdate(status, reported, last.Add(time.Minute), interval))
+
+	// state, generation or error changed: written right away
+	status = withCounters(2)
+	status.State = "error"
+	status.Error = "failed"
+	require.True(t, needsUpdate(status, reported, last.Add(time.Minute), interval))
+	status = withCounters(1)
+	status.ObservedGeneration = 2
+	require.True(t, needsUpdate(status, reported, last.Add(time.Minute), interval))
+}
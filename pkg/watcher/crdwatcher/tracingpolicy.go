@@ -9,7 +9,9 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/cache"
 
@@ -19,6 +21,8 @@ import (
 
 	"github.com/cilium/tetragon/pkg/logger"
 	"github.com/cilium/tetragon/pkg/manager"
+	"github.com/cilium/tetragon/pkg/policysig"
+	"github.com/cilium/tetragon/pkg/reader/node"
 	"github.com/cilium/tetragon/pkg/sensors"
 	"github.com/cilium/tetragon/pkg/tracingpolicy"
 )
@@ -48,7 +52,7 @@ func k8sErrorHandler(_ context.Context, e error, _ string, _ ...any) {
 }
 
 func addTracingPolicy(ctx context.Context, log logger.FieldLogger, s *sensors.Manager,
-	obj any,
+	r *StatusReporter, obj any,
 ) {
 	var err error
 	switch tp := obj.(type) {
@@ -66,10 +70,11 @@ func addTracingPolicy(ctx context.Context, log logger.FieldLogger, s *sensors.Ma
 	if err != nil {
 		log.Warn("adding tracing policy failed", logfields.Error, err)
 	}
+	r.policyAdded(obj, err)
 }
 
 func deleteTracingPolicy(ctx context.Context, log logger.FieldLogger, s *sensors.Manager,
-	obj any) {
+	r *StatusReporter, obj any) {
 
 	if dfsu, ok := obj.(cache.DeletedFinalStateUnknown); ok {
 		obj = dfsu.Obj
@@ -89,10 +94,11 @@ func deleteTracingPolicy(ctx context.Context, log logger.FieldLogger, s *sensors
 	if err != nil {
 		log.Warn("delete tracing policy failed", logfields.Error, err)
 	}
+	r.policyDeleted(obj)
 }
 
 func updateTracingPolicy(ctx context.Context, log logger.FieldLogger, s *sensors.Manager,
-	oldObj any, newObj any) {
+	r *StatusReporter, oldObj any, newObj any) {
 
 	update := func(oldTp, newTp tracingpolicy.TracingPolicy) {
 		var namespace string
@@ -104,10 +110,11 @@ func updateTracingPolicy(ctx context.Context, log logger.FieldLogger, s *sensors
 			log.Warn("updateTracingPolicy: failed to remove old policy", "old-name", oldTp.TpName(), logfields.Error, err)
 			return
 		}
-		if err := s.AddTracingPolicy(ctx, newTp); err != nil {
+		err := s.AddTracingPolicy(ctx, newTp)
+		if err != nil {
 			log.Warn("updateTracingPolicy: failed to add new policy", "new-name", newTp.TpName(), logfields.Error, err)
-			return
 		}
+		r.policyAdded(newTp, err)
 	}
 
 	var err error
@@ -118,10 +125,7 @@ func updateTracingPolicy(ctx context.Context, log logger.FieldLogger, s *sensors
 			err = errors.New("type mismatch")
 			break
 		}
-		// FIXME: add proper DeepEquals. The resource might have different
-		//  resource versions but the fields that matter to us are still the
-		//  same.
-		if oldTp.ResourceVersion == newTp.ResourceVersion {
+		if !needsReload(&oldTp.ObjectMeta, &newTp.ObjectMeta) {
 			return
 		}
 
@@ -134,10 +138,7 @@ func updateTracingPolicy(ctx context.Context, log logger.FieldLogger, s *sensors
 			err = errors.New("type mismatch")
 			break
 		}
-		// FIXME: add proper DeepEquals. The resource might have different
-		//  resource versions but the fields that matter to us are still the
-		//  same.
-		if oldTp.ResourceVersion == newTp.ResourceVersion {
+		if !needsReload(&oldTp.ObjectMeta, &newTp.ObjectMeta) {
 			return
 		}
 
@@ -155,8 +156,33 @@ func updateTracingPolicy(ctx context.Context, log logger.FieldLogger, s *sensors
 	}
 }
 
-func AddTracingPolicyInformer(ctx context.Context, m *manager.ControllerManager, s *sensors.Manager) error {
+// needsReload returns true if an update of a policy requires to reload it. The
+// generation only changes with the spec, so that status updates do not cause
+// the policy to be reloaded, but the annotations holding the signature of the
+// policy are not part of the spec and are compared as well.
+func needsReload(oldMeta, newMeta *metav1.ObjectMeta) bool {
+	if oldMeta.Generation != newMeta.Generation {
+		return true
+	}
+	for _, a := range []string{policysig.SignatureAnnotation, policysig.BundleAnnotation} {
+		if oldMeta.Annotations[a] != newMeta.Annotations[a] {
+			return true
+		}
+	}
+	return false
+}
+
+// AddTracingPolicyInformer adds informers that load and unload the tracing
+// policies defined as custom resources. If statusInterval is not zero, changes
+// to the state of the policies on this node are reported in their status every
+// statusInterval, and whenever they are (re)loaded.
+func AddTracingPolicyInformer(ctx context.Context, m *manager.ControllerManager, s *sensors.Manager, statusInterval time.Duration) error {
 	log := logger.GetLogger()
+	var r *StatusReporter
+	if statusInterval > 0 {
+		r = newStatusReporter(m.Manager.GetClient(), s, node.GetNodeName(), statusInterval)
+		go r.run(ctx)
+	}
 	tpInformer, err := m.Manager.GetCache().GetInformer(ctx, &v1alpha1.TracingPolicy{})
 	if err != nil {
 		return err
@@ -164,13 +190,13 @@ func AddTracingPolicyInformer(ctx context.Context, m *manager.ControllerManager,
 	_, err = tpInformer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj any) {
-				addTracingPolicy(ctx, log, s, obj)
+				addTracingPolicy(ctx, log, s, r, obj)
 			},
 			DeleteFunc: func(obj any) {
-				deleteTracingPolicy(ctx, log, s, obj)
+				deleteTracingPolicy(ctx, log, s, r, obj)
 			},
 			UpdateFunc: func(oldObj any, newObj any) {
-				updateTracingPolicy(ctx, log, s, oldObj, newObj)
+				updateTracingPolicy(ctx, log, s, r, oldObj, newObj)
 			}})
 	if err != nil {
 		return err
@@ -183,13 +209,13 @@ func AddTracingPolicyInformer(ctx context.Context, m *manager.ControllerManager,
 	_, err = tpnInformer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc: func(obj any) {
-				addTracingPolicy(ctx, log, s, obj)
+				addTracingPolicy(ctx, log, s, r, obj)
 			},
 			DeleteFunc: func(obj any) {
-				deleteTracingPolicy(ctx, log, s, obj)
+				deleteTracingPolicy(ctx, log, s, r, obj)
 			},
 			UpdateFunc: func(oldObj any, newObj any) {
-				updateTracingPolicy(ctx, log, s, oldObj, newObj)
+				updateTracingPolicy(ctx, log, s, r, oldObj, newObj)
 			}})
 	if err != nil {
 		return err
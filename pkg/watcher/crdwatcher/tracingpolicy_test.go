@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright Authors of Tetragon
+
+package crdwatcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cilium/tetragon/pkg/policysig"
+)
+
+func TestNeedsReload(t *testing.T) {
+	oldMeta := &metav1.ObjectMeta{
+		Generation:      1,
+		ResourceVersion: "1",
+		Annotations:     map[string]string{policysig.SignatureAnnotation: "sig1"},
+	}
+
+	// status update
+	newMeta := oldMeta.DeepCopy()
+	newMeta.ResourceVersion = "2"
+	require.False(t, needsReload(oldMeta, newMeta))
+
+	// unrelated annotation
+	newMeta.Annotations["foo"] = "bar"
+	require.False(t, needsReload(oldMeta, newMeta))
+
+	// spec update
+	newMeta = oldMeta.DeepCopy()
+	newMeta.Generation = 2
+	require.True(t, needsReload(oldMeta, newMeta))
+
+	// signature update
+	newMeta = oldMeta.DeepCopy()
+	newMeta.Annotations[policysig.SignatureAnnotation] = "sig2"
+	require.True(t, needsReload(oldMeta, newMeta))
+
+	// bundle added
+	newMeta = oldMeta.DeepCopy()
+	newMeta.Annotations[policysig.BundleAnnotation] = "bundle"
+	require.True(t, needsReload(oldMeta, newMeta))
+}